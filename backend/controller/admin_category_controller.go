@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IAdminCategoryController interface {
+	GetCategories(c echo.Context) error
+	CreateCategory(c echo.Context) error
+	UpdateCategory(c echo.Context) error
+	DeleteCategory(c echo.Context) error
+	SetItemCategory(c echo.Context) error
+}
+
+type adminCategoryController struct {
+	cu usecase.ICategoryUsecase
+	cp presenter.ICategoryPresenter
+	ip presenter.IItemPresenter
+}
+
+func NewAdminCategoryController(cu usecase.ICategoryUsecase) IAdminCategoryController {
+	cp := presenter.NewCategoryPresenter()
+	ip := presenter.NewItemPresenter()
+	return &adminCategoryController{cu, cp, ip}
+}
+
+type categoryBody struct {
+	Name     string `json:"name" validate:"required"`
+	Slug     string `json:"slug" validate:"required"`
+	ParentId string `json:"parent_id"`
+}
+
+func (acc *adminCategoryController) GetCategories(c echo.Context) error {
+	roots, err := acc.cu.GetCategoryTree()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	response := acc.cp.ToTreeJSON(roots)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (acc *adminCategoryController) CreateCategory(c echo.Context) error {
+	var req categoryBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	category, err := acc.cu.CreateCategory(request.CreateCategoryRequest{
+		Name:     req.Name,
+		Slug:     req.Slug,
+		ParentId: req.ParentId,
+	})
+	if err != nil {
+		return categoryErrorResponse(c, err)
+	}
+	response := acc.cp.ToJSON(category)
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (acc *adminCategoryController) UpdateCategory(c echo.Context) error {
+	var req categoryBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	category, err := acc.cu.UpdateCategory(request.UpdateCategoryRequest{
+		CategoryId: c.Param("id"),
+		Name:       req.Name,
+		Slug:       req.Slug,
+		ParentId:   req.ParentId,
+	})
+	if err != nil {
+		return categoryErrorResponse(c, err)
+	}
+	response := acc.cp.ToJSON(category)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (acc *adminCategoryController) DeleteCategory(c echo.Context) error {
+	if err := acc.cu.DeleteCategory(c.Param("id")); err != nil {
+		return categoryErrorResponse(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (acc *adminCategoryController) SetItemCategory(c echo.Context) error {
+	var req struct {
+		CategoryId string `json:"category_id"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	item, err := acc.cu.SetItemCategory(request.SetItemCategoryRequest{
+		ItemId:     c.Param("id"),
+		CategoryId: req.CategoryId,
+	})
+	if err != nil {
+		return categoryErrorResponse(c, err)
+	}
+	response := acc.ip.ToJSON(item)
+	return c.JSON(http.StatusOK, response)
+}
+
+func categoryErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrCategoryNotFound), errors.Is(err, usecase.ErrItemNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidCategory):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	case errors.Is(err, usecase.ErrDuplicateCategory), errors.Is(err, usecase.ErrCategoryInUse):
+		return c.JSON(http.StatusConflict, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
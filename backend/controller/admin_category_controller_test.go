@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCategoryUsecase struct {
+	mock.Mock
+}
+
+func (m *MockCategoryUsecase) GetCategoryTree() ([]*domain.CategoryNode, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CategoryNode), args.Error(1)
+}
+
+func (m *MockCategoryUsecase) CreateCategory(req request.CreateCategoryRequest) (*domain.Category, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Category), args.Error(1)
+}
+
+func (m *MockCategoryUsecase) UpdateCategory(req request.UpdateCategoryRequest) (*domain.Category, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Category), args.Error(1)
+}
+
+func (m *MockCategoryUsecase) DeleteCategory(categoryId string) error {
+	args := m.Called(categoryId)
+	return args.Error(0)
+}
+
+func (m *MockCategoryUsecase) SetItemCategory(req request.SetItemCategoryRequest) (*domain.Item, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Item), args.Error(1)
+}
+
+const categoryTestId = "f47ac10b-58cc-4372-a567-0e02b2c3d720"
+
+func TestAdminCategoryController_CreateCategory(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockCategoryUsecase)
+	controller := NewAdminCategoryController(mockUsecase)
+
+	slug, _ := domain.NewCategorySlug("yarn")
+	category, _ := domain.NewCategory(nil, "毛糸", *slug)
+	expectedReq := request.CreateCategoryRequest{Name: "毛糸", Slug: "yarn"}
+	mockUsecase.On("CreateCategory", expectedReq).Return(category, nil)
+
+	jsonBody, _ := json.Marshal(map[string]interface{}{"name": "毛糸", "slug": "yarn"})
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/categories", bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := controller.CreateCategory(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	var response presenter.CategoryJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "yarn", response.Slug)
+	assert.Nil(t, response.ParentId)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAdminCategoryController_DeleteCategory(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"deleted", nil, http.StatusNoContent},
+		{"not found", usecase.ErrCategoryNotFound, http.StatusNotFound},
+		{"in use", usecase.ErrCategoryInUse, http.StatusConflict},
+		{"unexpected", fmt.Errorf("database error"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockUsecase := new(MockCategoryUsecase)
+			controller := NewAdminCategoryController(mockUsecase)
+
+			var returnErr error
+			if tt.err != nil {
+				returnErr = fmt.Errorf("%w: detail", tt.err)
+			}
+			mockUsecase.On("DeleteCategory", categoryTestId).Return(returnErr)
+
+			req := httptest.NewRequest(http.MethodDelete, "/v1/admin/categories/"+categoryTestId, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(categoryTestId)
+
+			err := controller.DeleteCategory(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestAdminCategoryController_UpdateCategory_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"invalid", usecase.ErrInvalidCategory, http.StatusBadRequest},
+		{"duplicate slug", usecase.ErrDuplicateCategory, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &MockValidator{}
+			mockUsecase := new(MockCategoryUsecase)
+			controller := NewAdminCategoryController(mockUsecase)
+
+			mockUsecase.On("UpdateCategory", mock.AnythingOfType("request.UpdateCategoryRequest")).
+				Return(nil, fmt.Errorf("%w: detail", tt.err))
+
+			jsonBody, _ := json.Marshal(map[string]interface{}{"name": "毛糸", "slug": "yarn", "parent_id": categoryTestId})
+			req := httptest.NewRequest(http.MethodPut, "/v1/admin/categories/"+categoryTestId, bytes.NewReader(jsonBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(categoryTestId)
+
+			err := controller.UpdateCategory(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
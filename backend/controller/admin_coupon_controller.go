@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IAdminCouponController interface {
+	GetCoupons(c echo.Context) error
+	GetCoupon(c echo.Context) error
+	CreateCoupon(c echo.Context) error
+	UpdateCoupon(c echo.Context) error
+	DeleteCoupon(c echo.Context) error
+}
+
+type adminCouponController struct {
+	cu usecase.ICouponUsecase
+	cp presenter.ICouponPresenter
+}
+
+func NewAdminCouponController(cu usecase.ICouponUsecase) IAdminCouponController {
+	cp := presenter.NewCouponPresenter()
+	return &adminCouponController{cu, cp}
+}
+
+// couponBody の PercentOff は discount_type が "percent" の場合に、AmountOff は "fixed" の場合に指定する
+// Currency が空の場合は JPY として扱う
+type couponBody struct {
+	Code         string     `json:"code" validate:"required"`
+	DiscountType string     `json:"discount_type" validate:"required"`
+	PercentOff   int        `json:"percent_off"`
+	AmountOff    string     `json:"amount_off"`
+	MinimumSpend string     `json:"minimum_spend"`
+	Currency     string     `json:"currency"`
+	StartsAt     *time.Time `json:"starts_at"`
+	EndsAt       *time.Time `json:"ends_at"`
+	UsageLimit   int        `json:"usage_limit"`
+	PerUserLimit int        `json:"per_user_limit"`
+	ItemIds      []string   `json:"item_ids"`
+}
+
+func (acc *adminCouponController) GetCoupons(c echo.Context) error {
+	coupons, err := acc.cu.GetCoupons()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, acc.cp.ToListJSON(coupons))
+}
+
+func (acc *adminCouponController) GetCoupon(c echo.Context) error {
+	coupon, err := acc.cu.GetCoupon(c.Param("id"))
+	if err != nil {
+		return couponErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, acc.cp.ToJSON(coupon))
+}
+
+func (acc *adminCouponController) CreateCoupon(c echo.Context) error {
+	var req couponBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	coupon, err := acc.cu.CreateCoupon(request.CreateCouponRequest{
+		Code:         req.Code,
+		DiscountType: req.DiscountType,
+		PercentOff:   req.PercentOff,
+		AmountOff:    req.AmountOff,
+		MinimumSpend: req.MinimumSpend,
+		Currency:     req.Currency,
+		StartsAt:     req.StartsAt,
+		EndsAt:       req.EndsAt,
+		UsageLimit:   req.UsageLimit,
+		PerUserLimit: req.PerUserLimit,
+		ItemIds:      req.ItemIds,
+	})
+	if err != nil {
+		return couponErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusCreated, acc.cp.ToJSON(coupon))
+}
+
+// UpdateCoupon は利用回数以外を置き換える
+func (acc *adminCouponController) UpdateCoupon(c echo.Context) error {
+	var req couponBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	coupon, err := acc.cu.UpdateCoupon(request.UpdateCouponRequest{
+		CouponId:     c.Param("id"),
+		Code:         req.Code,
+		DiscountType: req.DiscountType,
+		PercentOff:   req.PercentOff,
+		AmountOff:    req.AmountOff,
+		MinimumSpend: req.MinimumSpend,
+		Currency:     req.Currency,
+		StartsAt:     req.StartsAt,
+		EndsAt:       req.EndsAt,
+		UsageLimit:   req.UsageLimit,
+		PerUserLimit: req.PerUserLimit,
+		ItemIds:      req.ItemIds,
+	})
+	if err != nil {
+		return couponErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, acc.cp.ToJSON(coupon))
+}
+
+// DeleteCoupon は一度も利用されていないクーポンだけを削除する。利用されたクーポンは 409 を返す
+func (acc *adminCouponController) DeleteCoupon(c echo.Context) error {
+	if err := acc.cu.DeleteCoupon(c.Param("id")); err != nil {
+		return couponErrorResponse(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func couponErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrCouponNotFound), errors.Is(err, usecase.ErrItemNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidCoupon), errors.Is(err, usecase.ErrInvalidOrder):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	case errors.Is(err, usecase.ErrDuplicateCoupon), errors.Is(err, usecase.ErrCouponInUse):
+		return c.JSON(http.StatusConflict, err.Error())
+	case errors.Is(err, usecase.ErrCouponNotApplicable):
+		return c.JSON(http.StatusUnprocessableEntity, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
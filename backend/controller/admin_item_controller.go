@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -28,11 +30,19 @@ func NewAdminItemController(iu usecase.IItemUsecase) IAdminItemController {
 }
 
 func (aic *adminItemController) GetAllItems(c echo.Context) error {
-	items, err := aic.iu.GetAllItems()
+	listReq, err := request.NewListItemsRequest(c.QueryParams())
 	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	page, err := aic.iu.GetAllItems(listReq)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidQuery) {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
-	response := aic.ip.ToJSONList(items)
+	response := aic.ip.ToPageJSON(page)
 	return c.JSON(http.StatusOK, response)
 }
 
@@ -52,9 +62,13 @@ func (aic *adminItemController) GetItemByID(c echo.Context) error {
 
 func (aic *adminItemController) CreateItem(c echo.Context) error {
 	var req struct {
-		ItemName    string `json:"item_name" validate:"required"`
-		Stock       bool   `json:"stock"`
-		Description string `json:"description"`
+		ItemName          string      `json:"item_name" validate:"required"`
+		OnHandQuantity    int         `json:"on_hand_quantity"`
+		LowStockThreshold int         `json:"low_stock_threshold"`
+		Description       string      `json:"description"`
+		Price             json.Number `json:"price"`
+		Currency          string      `json:"currency"`
+		TaxRate           string      `json:"tax_rate" validate:"omitempty,oneof=standard reduced"`
 	}
 	
 	if err := c.Bind(&req); err != nil {
@@ -70,14 +84,21 @@ func (aic *adminItemController) CreateItem(c echo.Context) error {
 	}
 
 	createReq := request.CreateItemRequest{
-		ItemName:    req.ItemName,
-		Stock:       req.Stock,
-		Description: req.Description,
-		UserId:      userId,
+		ItemName:          req.ItemName,
+		OnHandQuantity:    req.OnHandQuantity,
+		LowStockThreshold: req.LowStockThreshold,
+		Description:       req.Description,
+		Price:             req.Price.String(),
+		Currency:          req.Currency,
+		TaxRate:           req.TaxRate,
+		UserId:            userId,
 	}
 
 	createdItem, err := aic.iu.CreateItem(createReq)
 	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidPrice) {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
 	response := aic.ip.ToJSON(createdItem)
@@ -88,9 +109,12 @@ func (aic *adminItemController) UpdateItem(c echo.Context) error {
 	id := c.Param("id")
 	
 	var req struct {
-		ItemName    string `json:"item_name" validate:"required"`
-		Stock       bool   `json:"stock"`
-		Description string `json:"description"`
+		ItemName          string      `json:"item_name" validate:"required"`
+		LowStockThreshold int         `json:"low_stock_threshold"`
+		Description       string      `json:"description"`
+		Price             json.Number `json:"price"`
+		Currency          string      `json:"currency"`
+		TaxRate           string      `json:"tax_rate" validate:"omitempty,oneof=standard reduced"`
 	}
 	
 	if err := c.Bind(&req); err != nil {
@@ -101,14 +125,20 @@ func (aic *adminItemController) UpdateItem(c echo.Context) error {
 	}
 
 	updateReq := request.UpdateItemRequest{
-		ItemId:      id,
-		ItemName:    req.ItemName,
-		Stock:       req.Stock,
-		Description: req.Description,
+		ItemId:            id,
+		ItemName:          req.ItemName,
+		LowStockThreshold: req.LowStockThreshold,
+		Description:       req.Description,
+		Price:             req.Price.String(),
+		Currency:          req.Currency,
+		TaxRate:           req.TaxRate,
 	}
 
 	updatedItem, err := aic.iu.UpdateItem(updateReq)
 	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidPrice) {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
 	response := aic.ip.ToJSON(updatedItem)
@@ -122,5 +152,5 @@ func (aic *adminItemController) DeleteItem(c echo.Context) error {
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
-	return c.JSON(http.StatusNoContent, nil)
+	return c.NoContent(http.StatusNoContent)
 }
\ No newline at end of file
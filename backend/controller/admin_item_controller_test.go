@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/labstack/echo/v4"
 	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase"
 	"github.com/posiposi/project/backend/usecase/request"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -19,12 +21,12 @@ type MockItemUsecase struct {
 	mock.Mock
 }
 
-func (m *MockItemUsecase) GetAllItems() ([]*domain.Item, error) {
-	args := m.Called()
+func (m *MockItemUsecase) GetAllItems(req request.ListItemsRequest) (*domain.ItemPage, error) {
+	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*domain.Item), args.Error(1)
+	return args.Get(0).(*domain.ItemPage), args.Error(1)
 }
 
 func (m *MockItemUsecase) GetItemByID(itemId string) (*domain.Item, error) {
@@ -56,6 +58,27 @@ func (m *MockItemUsecase) DeleteItem(itemId string) error {
 	return args.Error(0)
 }
 
+func (m *MockItemUsecase) GetOwnItemByID(itemId string, userId string) (*domain.Item, error) {
+	args := m.Called(itemId, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Item), args.Error(1)
+}
+
+func (m *MockItemUsecase) UpdateOwnItem(req request.UpdateItemRequest, userId string) (*domain.Item, error) {
+	args := m.Called(req, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Item), args.Error(1)
+}
+
+func (m *MockItemUsecase) DeleteOwnItem(itemId string, userId string) error {
+	args := m.Called(itemId, userId)
+	return args.Error(0)
+}
+
 func TestAdminItemController_GetAllItems(t *testing.T) {
 	e := echo.New()
 	req := httptest.NewRequest(http.MethodGet, "/admin/items", nil)
@@ -72,20 +95,62 @@ func TestAdminItemController_GetAllItems(t *testing.T) {
 	assert.NoError(t, err)
 	itemName, err := domain.NewItemName("Test Item")
 	assert.NoError(t, err)
-	stock, err := domain.NewStock(true)
+	stock, err := domain.NewStock(1, 0, 0)
 	assert.NoError(t, err)
 	description, err := domain.NewDescription("Test Description")
 	assert.NoError(t, err)
-	item, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description)
+	item, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
 	assert.NoError(t, err)
 	assert.NotNil(t, item)
 
-	mockUsecase.On("GetAllItems").Return([]*domain.Item{item}, nil)
+	page := domain.NewItemPage(domain.Items{*item}, nil, nil)
+	mockUsecase.On("GetAllItems", request.ListItemsRequest{}).Return(page, nil)
 
 	err = controller.GetAllItems(c)
 
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"next_cursor":null`)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAdminItemController_GetAllItems_WithPaginationQuery(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/admin/items?limit=5&cursor=abc&include_total=true", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUsecase := new(MockItemUsecase)
+	controller := NewAdminItemController(mockUsecase)
+
+	total := int64(0)
+	page := domain.NewItemPage(nil, nil, &total)
+	expectedReq := request.ListItemsRequest{Limit: 5, Cursor: "abc", WithTotal: true}
+	mockUsecase.On("GetAllItems", expectedReq).Return(page, nil)
+
+	err := controller.GetAllItems(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"total":0`)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAdminItemController_GetAllItems_InvalidQuery(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/admin/items?cursor=broken", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUsecase := new(MockItemUsecase)
+	controller := NewAdminItemController(mockUsecase)
+
+	mockUsecase.On("GetAllItems", request.ListItemsRequest{Cursor: "broken"}).Return(nil, fmt.Errorf("%w: invalid cursor", usecase.ErrInvalidQuery))
+
+	err := controller.GetAllItems(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
 	mockUsecase.AssertExpectations(t)
 }
 
@@ -107,11 +172,11 @@ func TestAdminItemController_GetItemByID(t *testing.T) {
 	assert.NoError(t, err)
 	itemName, err := domain.NewItemName("Test Item")
 	assert.NoError(t, err)
-	stock, err := domain.NewStock(true)
+	stock, err := domain.NewStock(1, 0, 0)
 	assert.NoError(t, err)
 	description, err := domain.NewDescription("Test Description")
 	assert.NoError(t, err)
-	item, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description)
+	item, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
 	assert.NoError(t, err)
 	assert.NotNil(t, item)
 
@@ -150,7 +215,7 @@ func TestAdminItemController_CreateItem(t *testing.T) {
 	
 	requestBody := map[string]interface{}{
 		"item_name":    "Test Item",
-		"stock":        true,
+		"on_hand_quantity": 1,
 		"description": "Test Description",
 	}
 	jsonBody, _ := json.Marshal(requestBody)
@@ -171,11 +236,11 @@ func TestAdminItemController_CreateItem(t *testing.T) {
 	assert.NoError(t, err)
 	itemName, err := domain.NewItemName("Test Item")
 	assert.NoError(t, err)
-	stock, err := domain.NewStock(true)
+	stock, err := domain.NewStock(1, 0, 0)
 	assert.NoError(t, err)
 	description, err := domain.NewDescription("Test Description")
 	assert.NoError(t, err)
-	item, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description)
+	item, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
 	assert.NoError(t, err)
 	assert.NotNil(t, item)
 
@@ -194,7 +259,6 @@ func TestAdminItemController_UpdateItem(t *testing.T) {
 	
 	requestBody := map[string]interface{}{
 		"item_name":    "Updated Item",
-		"stock":        false,
 		"description": "Updated Description",
 	}
 	jsonBody, _ := json.Marshal(requestBody)
@@ -216,11 +280,11 @@ func TestAdminItemController_UpdateItem(t *testing.T) {
 	assert.NoError(t, err)
 	itemName, err := domain.NewItemName("Updated Item")
 	assert.NoError(t, err)
-	stock, err := domain.NewStock(false)
+	stock, err := domain.NewStock(0, 0, 0)
 	assert.NoError(t, err)
 	description, err := domain.NewDescription("Updated Description")
 	assert.NoError(t, err)
-	item, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description)
+	item, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
 	assert.NoError(t, err)
 	assert.NotNil(t, item)
 
@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IAdminItemImageController interface {
+	UploadImage(c echo.Context) error
+	DeleteImage(c echo.Context) error
+	ReorderImages(c echo.Context) error
+}
+
+type adminItemImageController struct {
+	iiu usecase.IItemImageUsecase
+	iip presenter.IItemImagePresenter
+	ip  presenter.IItemPresenter
+}
+
+func NewAdminItemImageController(iiu usecase.IItemImageUsecase) IAdminItemImageController {
+	iip := presenter.NewItemImagePresenter()
+	ip := presenter.NewItemPresenter()
+	return &adminItemImageController{iiu, iip, ip}
+}
+
+// UploadImage は multipart/form-data の image フィールドで送られた画像を1枚登録する
+func (aiic *adminItemImageController) UploadImage(c echo.Context) error {
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "image file is required")
+	}
+	if fileHeader.Size > usecase.MaxImageBytes {
+		return c.JSON(http.StatusRequestEntityTooLarge, fmt.Sprintf("image must be %d bytes or less", usecase.MaxImageBytes))
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, usecase.MaxImageBytes+1))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	image, err := aiic.iiu.UploadImage(request.UploadItemImageRequest{
+		ItemId: c.Param("id"),
+		Data:   data,
+	})
+	if err != nil {
+		return imageErrorResponse(c, err)
+	}
+	response := aiic.iip.ToJSON(image)
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (aiic *adminItemImageController) DeleteImage(c echo.Context) error {
+	if err := aiic.iiu.DeleteImage(c.Param("id"), c.Param("imageId")); err != nil {
+		return imageErrorResponse(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (aiic *adminItemImageController) ReorderImages(c echo.Context) error {
+	var req struct {
+		ImageIds []string `json:"image_ids"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	item, err := aiic.iiu.ReorderImages(request.ReorderItemImagesRequest{
+		ItemId:   c.Param("id"),
+		ImageIds: req.ImageIds,
+	})
+	if err != nil {
+		return imageErrorResponse(c, err)
+	}
+	response := aiic.ip.ToJSON(item)
+	return c.JSON(http.StatusOK, response)
+}
+
+func imageErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrItemNotFound), errors.Is(err, usecase.ErrImageNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidImage):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	case errors.Is(err, usecase.ErrTooManyImages):
+		return c.JSON(http.StatusConflict, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockItemImageUsecase struct {
+	mock.Mock
+}
+
+func (m *MockItemImageUsecase) UploadImage(req request.UploadItemImageRequest) (*domain.ItemImage, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemImage), args.Error(1)
+}
+
+func (m *MockItemImageUsecase) DeleteImage(itemId string, imageId string) error {
+	args := m.Called(itemId, imageId)
+	return args.Error(0)
+}
+
+func (m *MockItemImageUsecase) ReorderImages(req request.ReorderItemImagesRequest) (*domain.Item, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Item), args.Error(1)
+}
+
+const imageTestItemId = "f47ac10b-58cc-4372-a567-0e02b2c3d730"
+
+func newUploadImageContext(e *echo.Echo, field string, data []byte) (echo.Context, *httptest.ResponseRecorder) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, _ := writer.CreateFormFile(field, "photo.png")
+	part.Write(data)
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/items/"+imageTestItemId+"/images", &body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(imageTestItemId)
+	return c, rec
+}
+
+func TestAdminItemImageController_UploadImage(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemImageUsecase)
+	controller := NewAdminItemImageController(mockUsecase)
+
+	itemId, _ := domain.NewItemId(imageTestItemId)
+	contentType, _ := domain.NewImageContentType("image/png")
+	image := domain.RestoreItemImage("img-1", *itemId, 1, *contentType, 800, 400,
+		domain.StoredImage{Key: "items/x/img-1.png", URL: "https://cdn.example.com/items/x/img-1.png"},
+		domain.StoredImage{Key: "items/x/img-1_thumb.png", URL: "https://cdn.example.com/items/x/img-1_thumb.png"},
+		time.Now())
+	mockUsecase.On("UploadImage", request.UploadItemImageRequest{ItemId: imageTestItemId, Data: []byte("png-bytes")}).Return(image, nil)
+
+	c, rec := newUploadImageContext(e, "image", []byte("png-bytes"))
+	err := controller.UploadImage(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	var response presenter.ItemImageJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "https://cdn.example.com/items/x/img-1_thumb.png", response.ThumbnailUrl)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAdminItemImageController_UploadImage_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		field      string
+		err        error
+		wantStatus int
+	}{
+		{"missing file", "file", nil, http.StatusBadRequest},
+		{"item not found", "image", usecase.ErrItemNotFound, http.StatusNotFound},
+		{"invalid image", "image", usecase.ErrInvalidImage, http.StatusBadRequest},
+		{"too many images", "image", usecase.ErrTooManyImages, http.StatusConflict},
+		{"storage failure", "image", fmt.Errorf("s3 unavailable"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockUsecase := new(MockItemImageUsecase)
+			controller := NewAdminItemImageController(mockUsecase)
+			if tt.err != nil {
+				mockUsecase.On("UploadImage", mock.AnythingOfType("request.UploadItemImageRequest")).
+					Return(nil, fmt.Errorf("%w: detail", tt.err))
+			}
+
+			c, rec := newUploadImageContext(e, tt.field, []byte("data"))
+			err := controller.UploadImage(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestAdminItemImageController_DeleteImage(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemImageUsecase)
+	controller := NewAdminItemImageController(mockUsecase)
+
+	mockUsecase.On("DeleteImage", imageTestItemId, "img-1").Return(nil)
+	mockUsecase.On("DeleteImage", imageTestItemId, "unknown").Return(fmt.Errorf("%w: unknown", usecase.ErrImageNotFound))
+
+	for imageId, wantStatus := range map[string]int{"img-1": http.StatusNoContent, "unknown": http.StatusNotFound} {
+		req := httptest.NewRequest(http.MethodDelete, "/v1/admin/items/"+imageTestItemId+"/images/"+imageId, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id", "imageId")
+		c.SetParamValues(imageTestItemId, imageId)
+
+		err := controller.DeleteImage(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, wantStatus, rec.Code)
+	}
+}
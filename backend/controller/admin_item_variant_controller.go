@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IAdminItemVariantController interface {
+	GetVariants(c echo.Context) error
+	GetVariant(c echo.Context) error
+	CreateVariant(c echo.Context) error
+	UpdateVariant(c echo.Context) error
+	DeleteVariant(c echo.Context) error
+}
+
+type adminItemVariantController struct {
+	ivu usecase.IItemVariantUsecase
+	ivp presenter.IItemVariantPresenter
+}
+
+func NewAdminItemVariantController(ivu usecase.IItemVariantUsecase) IAdminItemVariantController {
+	ivp := presenter.NewItemVariantPresenter()
+	return &adminItemVariantController{ivu, ivp}
+}
+
+type itemVariantBody struct {
+	Sku               string            `json:"sku" validate:"required"`
+	Options           map[string]string `json:"options" validate:"required"`
+	LowStockThreshold int               `json:"low_stock_threshold"`
+	PriceOverride     json.Number       `json:"price_override"`
+	Currency          string            `json:"currency"`
+}
+
+// createItemVariantBody の on_hand_quantity は初期在庫。作成後の実在庫数は在庫移動で変更する
+type createItemVariantBody struct {
+	itemVariantBody
+	OnHandQuantity int `json:"on_hand_quantity"`
+}
+
+func (aivc *adminItemVariantController) GetVariants(c echo.Context) error {
+	item, err := aivc.ivu.GetVariants(c.Param("id"))
+	if err != nil {
+		return variantErrorResponse(c, err)
+	}
+	response := aivc.ivp.ToListJSON(item)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (aivc *adminItemVariantController) GetVariant(c echo.Context) error {
+	variant, item, err := aivc.ivu.GetVariant(c.Param("id"), c.Param("variantId"))
+	if err != nil {
+		return variantErrorResponse(c, err)
+	}
+	response := aivc.ivp.ToJSON(variant, item)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (aivc *adminItemVariantController) CreateVariant(c echo.Context) error {
+	var req createItemVariantBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	createReq := request.CreateItemVariantRequest{
+		ItemId:            c.Param("id"),
+		Sku:               req.Sku,
+		Options:           req.Options,
+		OnHandQuantity:    req.OnHandQuantity,
+		LowStockThreshold: req.LowStockThreshold,
+		PriceOverride:     req.PriceOverride.String(),
+		Currency:          req.Currency,
+	}
+
+	variant, item, err := aivc.ivu.CreateVariant(createReq)
+	if err != nil {
+		return variantErrorResponse(c, err)
+	}
+	response := aivc.ivp.ToJSON(variant, item)
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (aivc *adminItemVariantController) UpdateVariant(c echo.Context) error {
+	var req itemVariantBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	updateReq := request.UpdateItemVariantRequest{
+		ItemId:            c.Param("id"),
+		VariantId:         c.Param("variantId"),
+		Sku:               req.Sku,
+		Options:           req.Options,
+		LowStockThreshold: req.LowStockThreshold,
+		PriceOverride:     req.PriceOverride.String(),
+		Currency:          req.Currency,
+	}
+
+	variant, item, err := aivc.ivu.UpdateVariant(updateReq)
+	if err != nil {
+		return variantErrorResponse(c, err)
+	}
+	response := aivc.ivp.ToJSON(variant, item)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (aivc *adminItemVariantController) DeleteVariant(c echo.Context) error {
+	if err := aivc.ivu.DeleteVariant(c.Param("id"), c.Param("variantId")); err != nil {
+		return variantErrorResponse(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func variantErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrItemNotFound), errors.Is(err, usecase.ErrVariantNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidVariant), errors.Is(err, usecase.ErrInvalidPrice):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	case errors.Is(err, usecase.ErrDuplicateVariant):
+		return c.JSON(http.StatusConflict, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
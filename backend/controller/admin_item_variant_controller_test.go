@@ -0,0 +1,227 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockItemVariantUsecase struct {
+	mock.Mock
+}
+
+func (m *MockItemVariantUsecase) GetVariants(itemId string) (*domain.Item, error) {
+	args := m.Called(itemId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Item), args.Error(1)
+}
+
+func (m *MockItemVariantUsecase) GetVariant(itemId string, variantId string) (*domain.ItemVariant, *domain.Item, error) {
+	args := m.Called(itemId, variantId)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.ItemVariant), args.Get(1).(*domain.Item), args.Error(2)
+}
+
+func (m *MockItemVariantUsecase) CreateVariant(req request.CreateItemVariantRequest) (*domain.ItemVariant, *domain.Item, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.ItemVariant), args.Get(1).(*domain.Item), args.Error(2)
+}
+
+func (m *MockItemVariantUsecase) UpdateVariant(req request.UpdateItemVariantRequest) (*domain.ItemVariant, *domain.Item, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.ItemVariant), args.Get(1).(*domain.Item), args.Error(2)
+}
+
+func (m *MockItemVariantUsecase) DeleteVariant(itemId string, variantId string) error {
+	args := m.Called(itemId, variantId)
+	return args.Error(0)
+}
+
+const (
+	variantTestItemId    = "f47ac10b-58cc-4372-a567-0e02b2c3d901"
+	variantTestVariantId = "f47ac10b-58cc-4372-a567-0e02b2c3d902"
+)
+
+func createVariantTestFixture() (*domain.ItemVariant, *domain.Item) {
+	itemId, _ := domain.NewItemId(variantTestItemId)
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d900")
+	itemName, _ := domain.NewItemName("Hand-knit socks")
+	stock, _ := domain.NewStock(0, 0, 0)
+	description, _ := domain.NewDescription("Wool socks")
+	price, _ := domain.NewMoneyFromString("1000", domain.CurrencyJPY)
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, *price)
+
+	sku, _ := domain.NewSkuCode("SOCK-M")
+	options, _ := domain.NewVariantOptions(map[string]string{"size": "M"})
+	variantStock, _ := domain.NewStock(3, 0, 0)
+	override, _ := domain.NewMoneyFromString("1200", domain.CurrencyJPY)
+	variant := domain.NewItemVariant(*itemId, *sku, *options, *variantStock, override)
+	item, _ = item.WithVariants(domain.ItemVariants{*variant})
+	return variant, item
+}
+
+func newVariantContext(e *echo.Echo, method string, body map[string]interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	jsonBody, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, "/v1/admin/items/"+variantTestItemId+"/variants", bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id", "variantId")
+	c.SetParamValues(variantTestItemId, variantTestVariantId)
+	return c, rec
+}
+
+func TestAdminItemVariantController_CreateVariant(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockItemVariantUsecase)
+	controller := NewAdminItemVariantController(mockUsecase)
+
+	variant, item := createVariantTestFixture()
+	expectedReq := request.CreateItemVariantRequest{
+		ItemId:         variantTestItemId,
+		Sku:            "SOCK-M",
+		Options:        map[string]string{"size": "M"},
+		OnHandQuantity: 3,
+		PriceOverride:  "1200",
+	}
+	mockUsecase.On("CreateVariant", expectedReq).Return(variant, item, nil)
+
+	c, rec := newVariantContext(e, http.MethodPost, map[string]interface{}{
+		"sku":              "SOCK-M",
+		"options":          map[string]string{"size": "M"},
+		"on_hand_quantity": 3,
+		"price_override":   1200,
+	})
+	err := controller.CreateVariant(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	var response presenter.ItemVariantResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "SOCK-M", response.Sku)
+	assert.Equal(t, "1200", response.Price)
+	assert.Equal(t, 3, response.AvailableQuantity)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAdminItemVariantController_CreateVariant_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"item not found", usecase.ErrItemNotFound, http.StatusNotFound},
+		{"invalid variant", usecase.ErrInvalidVariant, http.StatusBadRequest},
+		{"invalid price", usecase.ErrInvalidPrice, http.StatusBadRequest},
+		{"duplicate options", usecase.ErrDuplicateVariant, http.StatusConflict},
+		{"unexpected", fmt.Errorf("database error"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &MockValidator{}
+			mockUsecase := new(MockItemVariantUsecase)
+			controller := NewAdminItemVariantController(mockUsecase)
+
+			mockUsecase.On("CreateVariant", mock.AnythingOfType("request.CreateItemVariantRequest")).
+				Return(nil, nil, fmt.Errorf("%w: detail", tt.err))
+
+			c, rec := newVariantContext(e, http.MethodPost, map[string]interface{}{
+				"sku":     "SOCK-M",
+				"options": map[string]string{"size": "M"},
+			})
+			err := controller.CreateVariant(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestAdminItemVariantController_UpdateVariant(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockItemVariantUsecase)
+	controller := NewAdminItemVariantController(mockUsecase)
+
+	variant, item := createVariantTestFixture()
+	expectedReq := request.UpdateItemVariantRequest{
+		ItemId:            variantTestItemId,
+		VariantId:         variantTestVariantId,
+		Sku:               "SOCK-M",
+		Options:           map[string]string{"size": "M"},
+		LowStockThreshold: 1,
+		PriceOverride:     "1200",
+	}
+	mockUsecase.On("UpdateVariant", expectedReq).Return(variant, item, nil)
+
+	c, rec := newVariantContext(e, http.MethodPut, map[string]interface{}{
+		"sku":                 "SOCK-M",
+		"options":             map[string]string{"size": "M"},
+		"on_hand_quantity":    3,
+		"low_stock_threshold": 1,
+		"price_override":      "1200",
+	})
+	err := controller.UpdateVariant(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAdminItemVariantController_GetVariants(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemVariantUsecase)
+	controller := NewAdminItemVariantController(mockUsecase)
+
+	_, item := createVariantTestFixture()
+	mockUsecase.On("GetVariants", variantTestItemId).Return(item, nil)
+
+	c, rec := newVariantContext(e, http.MethodGet, nil)
+	err := controller.GetVariants(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response presenter.ItemVariantListResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Len(t, response.Items, 1)
+}
+
+func TestAdminItemVariantController_DeleteVariant(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemVariantUsecase)
+	controller := NewAdminItemVariantController(mockUsecase)
+
+	mockUsecase.On("DeleteVariant", variantTestItemId, variantTestVariantId).Return(nil).Once()
+	mockUsecase.On("DeleteVariant", variantTestItemId, variantTestVariantId).Return(usecase.ErrVariantNotFound)
+
+	c, rec := newVariantContext(e, http.MethodDelete, nil)
+	assert.NoError(t, controller.DeleteVariant(c))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	c, rec = newVariantContext(e, http.MethodDelete, nil)
+	assert.NoError(t, controller.DeleteVariant(c))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
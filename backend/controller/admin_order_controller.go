@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IAdminOrderController interface {
+	TransitionOrder(c echo.Context) error
+}
+
+type adminOrderController struct {
+	ou usecase.IOrderUsecase
+	op presenter.IOrderPresenter
+}
+
+func NewAdminOrderController(ou usecase.IOrderUsecase) IAdminOrderController {
+	op := presenter.NewOrderPresenter()
+	return &adminOrderController{ou, op}
+}
+
+// TransitionOrder は注文を指定した状態に進める。現在の状態から移れない場合は 409 を返す
+func (aoc *adminOrderController) TransitionOrder(c echo.Context) error {
+	var req struct {
+		Status string `json:"status" validate:"required"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	userId, ok := c.Get("user_id").(string)
+	if !ok || userId == "" {
+		return c.JSON(http.StatusUnauthorized, "user_id not found in context")
+	}
+
+	order, err := aoc.ou.TransitionOrder(request.TransitionOrderRequest{
+		OrderId: c.Param("id"),
+		Status:  req.Status,
+		UserId:  userId,
+	})
+	if err != nil {
+		return orderErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, aoc.op.ToJSON(order))
+}
@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const orderTestAdminId = "f47ac10b-58cc-4372-a567-0e02b2c3db04"
+
+func TestAdminOrderController_TransitionOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "legal transition", err: nil, expected: http.StatusOK},
+		{name: "illegal transition", err: fmt.Errorf("%w: pending_payment -> shipped", usecase.ErrIllegalOrderTransition), expected: http.StatusConflict},
+		{name: "unknown status", err: fmt.Errorf("%w: lost", usecase.ErrInvalidOrderStatus), expected: http.StatusBadRequest},
+		{name: "missing order", err: usecase.ErrOrderNotFound, expected: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &MockValidator{}
+			mockUsecase := new(MockOrderUsecase)
+			controller := NewAdminOrderController(mockUsecase)
+			req := request.TransitionOrderRequest{OrderId: orderTestOrderId, Status: "shipped", UserId: orderTestAdminId}
+			if tt.err != nil {
+				mockUsecase.On("TransitionOrder", req).Return(nil, tt.err)
+			} else {
+				mockUsecase.On("TransitionOrder", req).Return(createOrderTestOrder(), nil)
+			}
+
+			c, rec := newOrderContext(e, http.MethodPost, map[string]interface{}{"status": "shipped"})
+			c.Set("user_id", orderTestAdminId)
+			c.SetParamNames("id")
+			c.SetParamValues(orderTestOrderId)
+			err := controller.TransitionOrder(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rec.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminOrderController_TransitionOrder_ValidationError(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{shouldFail: true}
+	mockUsecase := new(MockOrderUsecase)
+	controller := NewAdminOrderController(mockUsecase)
+
+	c, rec := newOrderContext(e, http.MethodPost, map[string]interface{}{})
+	c.Set("user_id", orderTestAdminId)
+	err := controller.TransitionOrder(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUsecase.AssertNotCalled(t, "TransitionOrder", mock.Anything)
+}
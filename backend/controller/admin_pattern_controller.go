@@ -0,0 +1,166 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IAdminPatternController interface {
+	CreatePattern(c echo.Context) error
+	UpdatePattern(c echo.Context) error
+	DeletePattern(c echo.Context) error
+	SetItemYarn(c echo.Context) error
+}
+
+type adminPatternController struct {
+	pu usecase.IPatternUsecase
+	pp presenter.IPatternPresenter
+	ip presenter.IItemPresenter
+}
+
+func NewAdminPatternController(pu usecase.IPatternUsecase) IAdminPatternController {
+	pp := presenter.NewPatternPresenter()
+	ip := presenter.NewItemPresenter()
+	return &adminPatternController{pu, pp, ip}
+}
+
+// patternSizeBody の Yardage はそのサイズを編むのに必要な糸の長さ（m）
+type patternSizeBody struct {
+	Label                string `json:"label"`
+	FinishedMeasurements string `json:"finished_measurements"`
+	Yardage              int    `json:"yardage"`
+}
+
+// patternBody の GaugeStitches・GaugeRows は10cm四方の目数と段数、NeedleSizes は針の太さ（mm）
+type patternBody struct {
+	Name          string            `json:"name" validate:"required"`
+	Description   string            `json:"description"`
+	Difficulty    string            `json:"difficulty" validate:"required"`
+	GaugeStitches float64           `json:"gauge_stitches"`
+	GaugeRows     float64           `json:"gauge_rows"`
+	NeedleSizes   []float64         `json:"needle_sizes"`
+	YarnWeight    string            `json:"yarn_weight" validate:"required"`
+	Sizes         []patternSizeBody `json:"sizes"`
+	ItemIds       []string          `json:"item_ids"`
+}
+
+func (b patternBody) sizes() []request.PatternSizeRequest {
+	sizes := make([]request.PatternSizeRequest, len(b.Sizes))
+	for i, size := range b.Sizes {
+		sizes[i] = request.PatternSizeRequest(size)
+	}
+	return sizes
+}
+
+func (apc *adminPatternController) CreatePattern(c echo.Context) error {
+	var req patternBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	pattern, err := apc.pu.CreatePattern(request.CreatePatternRequest{
+		Name:          req.Name,
+		Description:   req.Description,
+		Difficulty:    req.Difficulty,
+		GaugeStitches: req.GaugeStitches,
+		GaugeRows:     req.GaugeRows,
+		NeedleSizes:   req.NeedleSizes,
+		YarnWeight:    req.YarnWeight,
+		Sizes:         req.sizes(),
+		ItemIds:       req.ItemIds,
+	})
+	if err != nil {
+		return patternErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusCreated, apc.pp.ToJSON(pattern))
+}
+
+// UpdatePattern は仕上がりサイズと使える商品を含め、編み図の項目をすべて置き換える
+func (apc *adminPatternController) UpdatePattern(c echo.Context) error {
+	var req patternBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	pattern, err := apc.pu.UpdatePattern(request.UpdatePatternRequest{
+		PatternId:     c.Param("id"),
+		Name:          req.Name,
+		Description:   req.Description,
+		Difficulty:    req.Difficulty,
+		GaugeStitches: req.GaugeStitches,
+		GaugeRows:     req.GaugeRows,
+		NeedleSizes:   req.NeedleSizes,
+		YarnWeight:    req.YarnWeight,
+		Sizes:         req.sizes(),
+		ItemIds:       req.ItemIds,
+	})
+	if err != nil {
+		return patternErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, apc.pp.ToJSON(pattern))
+}
+
+func (apc *adminPatternController) DeletePattern(c echo.Context) error {
+	if err := apc.pu.DeletePattern(c.Param("id")); err != nil {
+		return patternErrorResponse(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// yarnBody は商品の毛糸の属性。needle_range・gauge は省略すると未登録になる
+type yarnBody struct {
+	Weight          string `json:"weight"`
+	YardagePerSkein int    `json:"yardage_per_skein"`
+	GramsPerSkein   int    `json:"grams_per_skein"`
+	Fibers          []struct {
+		Fiber      string `json:"fiber"`
+		Percentage int    `json:"percentage"`
+	} `json:"fibers"`
+	NeedleRange *struct {
+		Min float64 `json:"min"`
+		Max float64 `json:"max"`
+	} `json:"needle_range"`
+	Gauge *struct {
+		Stitches float64 `json:"stitches"`
+		Rows     float64 `json:"rows"`
+	} `json:"gauge"`
+}
+
+// SetItemYarn は商品の毛糸の属性を置き換える。weight を空にすると設定を外す
+func (apc *adminPatternController) SetItemYarn(c echo.Context) error {
+	var req yarnBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	yarnReq := request.SetItemYarnRequest{
+		ItemId:          c.Param("id"),
+		Weight:          req.Weight,
+		YardagePerSkein: req.YardagePerSkein,
+		GramsPerSkein:   req.GramsPerSkein,
+	}
+	for _, content := range req.Fibers {
+		yarnReq.Fibers = append(yarnReq.Fibers, request.FiberContentRequest(content))
+	}
+	if req.NeedleRange != nil {
+		yarnReq.NeedleMin, yarnReq.NeedleMax = req.NeedleRange.Min, req.NeedleRange.Max
+	}
+	if req.Gauge != nil {
+		yarnReq.GaugeStitches, yarnReq.GaugeRows = req.Gauge.Stitches, req.Gauge.Rows
+	}
+	item, err := apc.pu.SetItemYarn(yarnReq)
+	if err != nil {
+		return patternErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, apc.ip.ToJSON(item))
+}
@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newAdminPatternContext(e *echo.Echo, method string, path string, body map[string]interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	jsonBody, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, path, bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestAdminPatternController_CreatePattern(t *testing.T) {
+	body := map[string]interface{}{
+		"name":           "ラグランセーター",
+		"difficulty":     "easy",
+		"gauge_stitches": 22,
+		"gauge_rows":     30,
+		"needle_sizes":   []float64{4, 4.5},
+		"yarn_weight":    "medium",
+		"sizes":          []map[string]interface{}{{"label": "S", "finished_measurements": "胸囲 92cm", "yardage": 950}},
+		"item_ids":       []string{patternTestItemId},
+	}
+	expectedReq := request.CreatePatternRequest{
+		Name:          "ラグランセーター",
+		Difficulty:    "easy",
+		GaugeStitches: 22,
+		GaugeRows:     30,
+		NeedleSizes:   []float64{4, 4.5},
+		YarnWeight:    "medium",
+		Sizes:         []request.PatternSizeRequest{{Label: "S", FinishedMeasurements: "胸囲 92cm", Yardage: 950}},
+		ItemIds:       []string{patternTestItemId},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		e := echo.New()
+		e.Validator = &MockValidator{}
+		mockUsecase := new(MockPatternUsecase)
+		controller := NewAdminPatternController(mockUsecase)
+		mockUsecase.On("CreatePattern", expectedReq).Return(createTestPattern(), nil)
+
+		c, rec := newAdminPatternContext(e, http.MethodPost, "/v1/admin/patterns", body)
+		err := controller.CreatePattern(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var response presenter.PatternJSON
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, patternTestPatternId, response.PatternId)
+		assert.Equal(t, []string{patternTestItemId}, response.ItemIds)
+	})
+
+	t.Run("Invalid Pattern", func(t *testing.T) {
+		e := echo.New()
+		e.Validator = &MockValidator{}
+		mockUsecase := new(MockPatternUsecase)
+		controller := NewAdminPatternController(mockUsecase)
+		mockUsecase.On("CreatePattern", expectedReq).Return(nil, fmt.Errorf("%w: needle size must be a multiple of 0.25 mm: 4.1", usecase.ErrInvalidPattern))
+
+		c, rec := newAdminPatternContext(e, http.MethodPost, "/v1/admin/patterns", body)
+		err := controller.CreatePattern(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestAdminPatternController_SetItemYarn(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockPatternUsecase)
+		controller := NewAdminPatternController(mockUsecase)
+		mockUsecase.On("SetItemYarn", request.SetItemYarnRequest{ItemId: patternTestItemId, Weight: "medium", YardagePerSkein: 120}).
+			Return(createPatternTestItem(120), nil)
+
+		c, rec := newAdminPatternContext(e, http.MethodPut, "/v1/admin/items/"+patternTestItemId+"/yarn", map[string]interface{}{"weight": "medium", "yardage_per_skein": 120})
+		c.SetParamNames("id")
+		c.SetParamValues(patternTestItemId)
+		err := controller.SetItemYarn(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.ItemResponseJSON
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "medium", response.Yarn.Weight)
+	})
+
+	t.Run("Success With Details", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockPatternUsecase)
+		controller := NewAdminPatternController(mockUsecase)
+		mockUsecase.On("SetItemYarn", request.SetItemYarnRequest{
+			ItemId:          patternTestItemId,
+			Weight:          "light",
+			YardagePerSkein: 200,
+			GramsPerSkein:   50,
+			Fibers:          []request.FiberContentRequest{{Fiber: "merino", Percentage: 70}, {Fiber: "silk", Percentage: 30}},
+			NeedleMin:       3.5,
+			NeedleMax:       4.5,
+			GaugeStitches:   22,
+			GaugeRows:       30,
+		}).Return(createPatternTestItem(200), nil)
+
+		c, rec := newAdminPatternContext(e, http.MethodPut, "/v1/admin/items/"+patternTestItemId+"/yarn", map[string]interface{}{
+			"weight":            "light",
+			"yardage_per_skein": 200,
+			"grams_per_skein":   50,
+			"fibers":            []map[string]interface{}{{"fiber": "merino", "percentage": 70}, {"fiber": "silk", "percentage": 30}},
+			"needle_range":      map[string]interface{}{"min": 3.5, "max": 4.5},
+			"gauge":             map[string]interface{}{"stitches": 22, "rows": 30},
+		})
+		c.SetParamNames("id")
+		c.SetParamValues(patternTestItemId)
+		err := controller.SetItemYarn(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Fiber Composition", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockPatternUsecase)
+		controller := NewAdminPatternController(mockUsecase)
+		mockUsecase.On("SetItemYarn", mock.Anything).
+			Return(nil, fmt.Errorf("%w: fiber percentages must total 100: 80", usecase.ErrInvalidYarn))
+
+		c, rec := newAdminPatternContext(e, http.MethodPut, "/v1/admin/items/"+patternTestItemId+"/yarn", map[string]interface{}{
+			"weight":            "medium",
+			"yardage_per_skein": 120,
+			"fibers":            []map[string]interface{}{{"fiber": "wool", "percentage": 80}},
+		})
+		c.SetParamNames("id")
+		c.SetParamValues(patternTestItemId)
+		err := controller.SetItemYarn(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Item Not Found", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockPatternUsecase)
+		controller := NewAdminPatternController(mockUsecase)
+		mockUsecase.On("SetItemYarn", request.SetItemYarnRequest{ItemId: patternTestItemId}).
+			Return(nil, fmt.Errorf("%w: record not found", usecase.ErrItemNotFound))
+
+		c, rec := newAdminPatternContext(e, http.MethodPut, "/v1/admin/items/"+patternTestItemId+"/yarn", map[string]interface{}{})
+		c.SetParamNames("id")
+		c.SetParamValues(patternTestItemId)
+		err := controller.SetItemYarn(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IAdminPaymentController interface {
+	RefundOrder(c echo.Context) error
+}
+
+type adminPaymentController struct {
+	pu usecase.IPaymentUsecase
+	pp presenter.IPaymentPresenter
+}
+
+func NewAdminPaymentController(pu usecase.IPaymentUsecase) IAdminPaymentController {
+	pp := presenter.NewPaymentPresenter()
+	return &adminPaymentController{pu, pp}
+}
+
+// RefundOrder は注文の決済を返金し、注文を返金済みにする
+func (apc *adminPaymentController) RefundOrder(c echo.Context) error {
+	userId, ok := c.Get("user_id").(string)
+	if !ok || userId == "" {
+		return c.JSON(http.StatusUnauthorized, "user_id not found in context")
+	}
+
+	payment, err := apc.pu.RefundOrder(request.RefundOrderRequest{
+		OrderId: c.Param("id"),
+		UserId:  userId,
+	})
+	if err != nil {
+		return paymentErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, apc.pp.ToJSON(payment))
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+)
+
+type IAdminReceiptController interface {
+	GetReceipt(c echo.Context) error
+}
+
+type adminReceiptController struct {
+	ru usecase.IReceiptUsecase
+	rp presenter.IReceiptPresenter
+}
+
+func NewAdminReceiptController(ru usecase.IReceiptUsecase) IAdminReceiptController {
+	rp := presenter.NewReceiptPresenter()
+	return &adminReceiptController{ru, rp}
+}
+
+// GetReceipt は管理者に注文の領収書をダウンロードさせる。まだ発行していなければ発行する
+func (arc *adminReceiptController) GetReceipt(c echo.Context) error {
+	format, err := receiptFormat(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	receipt, err := arc.ru.GetReceiptAsAdmin(c.Param("id"))
+	if err != nil {
+		return receiptErrorResponse(c, err)
+	}
+	return receiptDownload(c, arc.rp.ToDocument(receipt, format))
+}
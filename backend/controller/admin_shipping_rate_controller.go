@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IAdminShippingRateController interface {
+	GetRates(c echo.Context) error
+	ReplaceRates(c echo.Context) error
+}
+
+type adminShippingRateController struct {
+	su usecase.IShippingUsecase
+	sp presenter.IShippingPresenter
+}
+
+func NewAdminShippingRateController(su usecase.IShippingUsecase) IAdminShippingRateController {
+	sp := presenter.NewShippingPresenter()
+	return &adminShippingRateController{su, sp}
+}
+
+type shippingRateBody struct {
+	Prefecture string `json:"prefecture" validate:"required"`
+	Fee        string `json:"fee" validate:"required"`
+}
+
+// shippingRatesBody の Rates に含まれない都道府県には配送しない
+// FreeShippingThreshold が空の場合は送料無料にせず、Currency が空の場合は JPY として扱う
+type shippingRatesBody struct {
+	Rates                 []shippingRateBody `json:"rates" validate:"dive"`
+	FreeShippingThreshold string             `json:"free_shipping_threshold"`
+	Currency              string             `json:"currency"`
+}
+
+func (ac *adminShippingRateController) GetRates(c echo.Context) error {
+	table, err := ac.su.GetRateTable()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, ac.sp.ToRateTableJSON(table))
+}
+
+// ReplaceRates は配送料の表をまとめて置き換える
+func (ac *adminShippingRateController) ReplaceRates(c echo.Context) error {
+	var req shippingRatesBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	rates := make([]request.ShippingRateRequest, len(req.Rates))
+	for i, rate := range req.Rates {
+		rates[i] = request.ShippingRateRequest{Prefecture: rate.Prefecture, Fee: rate.Fee}
+	}
+	table, err := ac.su.ReplaceRateTable(request.ReplaceShippingRatesRequest{
+		Rates:                 rates,
+		FreeShippingThreshold: req.FreeShippingThreshold,
+		Currency:              req.Currency,
+	})
+	if err != nil {
+		return shippingErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, ac.sp.ToRateTableJSON(table))
+}
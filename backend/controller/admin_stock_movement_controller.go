@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IAdminStockMovementController interface {
+	RecordMovement(c echo.Context) error
+	GetMovements(c echo.Context) error
+	Reconcile(c echo.Context) error
+}
+
+type adminStockMovementController struct {
+	smu usecase.IStockMovementUsecase
+	smp presenter.IStockMovementPresenter
+}
+
+func NewAdminStockMovementController(smu usecase.IStockMovementUsecase) IAdminStockMovementController {
+	smp := presenter.NewStockMovementPresenter()
+	return &adminStockMovementController{smu, smp}
+}
+
+func (asmc *adminStockMovementController) RecordMovement(c echo.Context) error {
+	var req struct {
+		VariantId     string `json:"variant_id"`
+		MovementType  string `json:"movement_type" validate:"required"`
+		QuantityDelta int    `json:"quantity_delta"`
+		Reason        string `json:"reason"`
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	userId, ok := c.Get("user_id").(string)
+	if !ok || userId == "" {
+		return c.JSON(http.StatusUnauthorized, "user_id not found in context")
+	}
+
+	recordReq := request.RecordStockMovementRequest{
+		ItemId:        c.Param("id"),
+		VariantId:     req.VariantId,
+		MovementType:  req.MovementType,
+		QuantityDelta: req.QuantityDelta,
+		Reason:        req.Reason,
+		UserId:        userId,
+	}
+
+	movement, item, err := asmc.smu.RecordMovement(recordReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrItemNotFound), errors.Is(err, usecase.ErrVariantNotFound):
+			return c.JSON(http.StatusNotFound, err.Error())
+		case errors.Is(err, usecase.ErrInvalidStockMovement):
+			return c.JSON(http.StatusBadRequest, err.Error())
+		case errors.Is(err, usecase.ErrInsufficientStock):
+			return c.JSON(http.StatusConflict, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	response := asmc.smp.ToRecordJSON(movement, item)
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (asmc *adminStockMovementController) GetMovements(c echo.Context) error {
+	movements, err := asmc.smu.GetMovements(c.Param("id"))
+	if err != nil {
+		if errors.Is(err, usecase.ErrItemNotFound) {
+			return c.JSON(http.StatusNotFound, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	response := asmc.smp.ToListJSON(movements)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (asmc *adminStockMovementController) Reconcile(c echo.Context) error {
+	drifts, err := asmc.smu.FindStockDrifts()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	response := asmc.smp.ToReconciliationJSON(drifts)
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,215 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockStockMovementUsecase struct {
+	mock.Mock
+}
+
+func (m *MockStockMovementUsecase) RecordMovement(req request.RecordStockMovementRequest) (*domain.StockMovement, *domain.Item, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.StockMovement), args.Get(1).(*domain.Item), args.Error(2)
+}
+
+func (m *MockStockMovementUsecase) GetMovements(itemId string) ([]*domain.StockMovement, error) {
+	args := m.Called(itemId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.StockMovement), args.Error(1)
+}
+
+func (m *MockStockMovementUsecase) FindStockDrifts() ([]*domain.StockDrift, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.StockDrift), args.Error(1)
+}
+
+const (
+	movementTestItemId  = "f47ac10b-58cc-4372-a567-0e02b2c3d701"
+	movementTestAdminId = "f47ac10b-58cc-4372-a567-0e02b2c3d700"
+)
+
+func newRecordMovementContext(e *echo.Echo, body map[string]interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	jsonBody, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/items/"+movementTestItemId+"/stock-movements", bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(movementTestItemId)
+	c.Set("user_id", movementTestAdminId)
+	return c, rec
+}
+
+func TestAdminStockMovementController_RecordMovement(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockStockMovementUsecase)
+	controller := NewAdminStockMovementController(mockUsecase)
+
+	itemId, _ := domain.NewItemId(movementTestItemId)
+	userId, _ := domain.NewUserId(movementTestAdminId)
+	movementType, _ := domain.NewStockMovementType(domain.StockMovementReceive)
+	movement, _ := domain.NewStockMovement(*itemId, *movementType, 3, "入荷", *userId)
+	itemName, _ := domain.NewItemName("Merino Wool")
+	stock, _ := domain.NewStock(5, 0, 0)
+	description, _ := domain.NewDescription("Test Description")
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
+
+	expectedReq := request.RecordStockMovementRequest{
+		ItemId:        movementTestItemId,
+		MovementType:  domain.StockMovementReceive,
+		QuantityDelta: 3,
+		Reason:        "入荷",
+		UserId:        movementTestAdminId,
+	}
+	mockUsecase.On("RecordMovement", expectedReq).Return(movement, item, nil)
+
+	c, rec := newRecordMovementContext(e, map[string]interface{}{
+		"movement_type":  "receive",
+		"quantity_delta": 3,
+		"reason":         "入荷",
+	})
+	err := controller.RecordMovement(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	var response presenter.RecordStockMovementResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, 3, response.Movement.QuantityDelta)
+	assert.Equal(t, 5, response.Item.OnHandQuantity)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAdminStockMovementController_RecordMovement_Variant(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockStockMovementUsecase)
+	controller := NewAdminStockMovementController(mockUsecase)
+
+	variantId := "f47ac10b-58cc-4372-a567-0e02b2c3d702"
+	itemId, _ := domain.NewItemId(movementTestItemId)
+	userId, _ := domain.NewUserId(movementTestAdminId)
+	movementType, _ := domain.NewStockMovementType(domain.StockMovementReceive)
+	movement, _ := domain.NewStockMovement(*itemId, *movementType, 5, "", *userId)
+	itemName, _ := domain.NewItemName("Merino Wool")
+	stock, _ := domain.NewStock(0, 0, 0)
+	description, _ := domain.NewDescription("Test Description")
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
+
+	mockUsecase.On("RecordMovement", request.RecordStockMovementRequest{
+		ItemId:        movementTestItemId,
+		VariantId:     variantId,
+		MovementType:  "receive",
+		QuantityDelta: 5,
+		UserId:        movementTestAdminId,
+	}).Return(movement.WithVariantId(variantId), item, nil)
+
+	c, rec := newRecordMovementContext(e, map[string]interface{}{
+		"variant_id":     variantId,
+		"movement_type":  "receive",
+		"quantity_delta": 5,
+	})
+	err := controller.RecordMovement(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	var response presenter.RecordStockMovementResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, variantId, response.Movement.VariantId)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAdminStockMovementController_RecordMovement_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"item not found", usecase.ErrItemNotFound, http.StatusNotFound},
+		{"variant not found", usecase.ErrVariantNotFound, http.StatusNotFound},
+		{"invalid movement", usecase.ErrInvalidStockMovement, http.StatusBadRequest},
+		{"insufficient stock", usecase.ErrInsufficientStock, http.StatusConflict},
+		{"unexpected", fmt.Errorf("database error"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &MockValidator{}
+			mockUsecase := new(MockStockMovementUsecase)
+			controller := NewAdminStockMovementController(mockUsecase)
+
+			mockUsecase.On("RecordMovement", mock.AnythingOfType("request.RecordStockMovementRequest")).
+				Return(nil, nil, fmt.Errorf("%w: detail", tt.err))
+
+			c, rec := newRecordMovementContext(e, map[string]interface{}{
+				"movement_type":  "sale",
+				"quantity_delta": -10,
+			})
+			err := controller.RecordMovement(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestAdminStockMovementController_GetMovements_NotFound(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockStockMovementUsecase)
+	controller := NewAdminStockMovementController(mockUsecase)
+
+	mockUsecase.On("GetMovements", movementTestItemId).Return(nil, usecase.ErrItemNotFound)
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin/items/"+movementTestItemId+"/stock-movements", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(movementTestItemId)
+
+	err := controller.GetMovements(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAdminStockMovementController_Reconcile(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockStockMovementUsecase)
+	controller := NewAdminStockMovementController(mockUsecase)
+
+	drifts := []*domain.StockDrift{domain.NewStockDrift(movementTestItemId, "", 4, 6)}
+	mockUsecase.On("FindStockDrifts").Return(drifts, nil)
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin/inventory/reconciliation", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := controller.Reconcile(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response presenter.StockReconciliationResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.False(t, response.Consistent)
+	assert.Equal(t, -2, response.Drifts[0].Difference)
+}
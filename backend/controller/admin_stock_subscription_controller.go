@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+)
+
+type IAdminStockSubscriptionController interface {
+	GetDemand(c echo.Context) error
+}
+
+type adminStockSubscriptionController struct {
+	su usecase.IStockSubscriptionUsecase
+	sp presenter.IStockSubscriptionPresenter
+}
+
+func NewAdminStockSubscriptionController(su usecase.IStockSubscriptionUsecase) IAdminStockSubscriptionController {
+	sp := presenter.NewStockSubscriptionPresenter()
+	return &adminStockSubscriptionController{su, sp}
+}
+
+// GetDemand は再入荷通知の申し込みのある商品を、申し込みの多い順に返す
+func (asc *adminStockSubscriptionController) GetDemand(c echo.Context) error {
+	demand, err := asc.su.GetDemand()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, asc.sp.ToDemandJSON(demand))
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IAdminTagController interface {
+	GetTags(c echo.Context) error
+	ReplaceItemTags(c echo.Context) error
+	DeleteTag(c echo.Context) error
+}
+
+type adminTagController struct {
+	tu usecase.ITagUsecase
+	tp presenter.ITagPresenter
+	ip presenter.IItemPresenter
+}
+
+func NewAdminTagController(tu usecase.ITagUsecase) IAdminTagController {
+	tp := presenter.NewTagPresenter()
+	ip := presenter.NewItemPresenter()
+	return &adminTagController{tu, tp, ip}
+}
+
+func (atc *adminTagController) GetTags(c echo.Context) error {
+	tags, err := atc.tu.GetTags()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	response := atc.tp.ToListJSON(tags)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (atc *adminTagController) ReplaceItemTags(c echo.Context) error {
+	var req struct {
+		Tags []string `json:"tags"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	item, err := atc.tu.ReplaceItemTags(request.ReplaceItemTagsRequest{
+		ItemId: c.Param("id"),
+		Tags:   req.Tags,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrItemNotFound):
+			return c.JSON(http.StatusNotFound, err.Error())
+		case errors.Is(err, usecase.ErrInvalidTag):
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	response := atc.ip.ToJSON(item)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (atc *adminTagController) DeleteTag(c echo.Context) error {
+	if err := atc.tu.DeleteTag(c.Param("id")); err != nil {
+		if errors.Is(err, usecase.ErrTagNotFound) {
+			return c.JSON(http.StatusNotFound, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type ICartController interface {
+	GetCart(c echo.Context) error
+	AddItem(c echo.Context) error
+	UpdateItem(c echo.Context) error
+	RemoveItem(c echo.Context) error
+}
+
+type cartController struct {
+	cu usecase.ICartUsecase
+	cp presenter.ICartPresenter
+}
+
+func NewCartController(cu usecase.ICartUsecase) ICartController {
+	cp := presenter.NewCartPresenter()
+	return &cartController{cu, cp}
+}
+
+// guestCartCookie はゲストのカートを識別する署名付きトークンを入れるクッキーの名前
+const guestCartCookie = "guest_cart"
+
+type addCartItemBody struct {
+	ItemId    string `json:"item_id" validate:"required"`
+	VariantId string `json:"variant_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+type updateCartItemBody struct {
+	Quantity int `json:"quantity"`
+}
+
+func (cc *cartController) GetCart(c echo.Context) error {
+	owner, err := cc.cartOwner(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	summary, err := cc.cu.GetCart(owner)
+	if err != nil {
+		return cartErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, cc.cp.ToJSON(summary))
+}
+
+func (cc *cartController) AddItem(c echo.Context) error {
+	var req addCartItemBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	owner, err := cc.cartOwner(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	summary, err := cc.cu.AddItem(request.AddCartItemRequest{
+		Owner:     owner,
+		ItemId:    req.ItemId,
+		VariantId: req.VariantId,
+		Quantity:  req.Quantity,
+	})
+	if err != nil {
+		return cartErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusCreated, cc.cp.ToJSON(summary))
+}
+
+func (cc *cartController) UpdateItem(c echo.Context) error {
+	var req updateCartItemBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	owner, err := cc.cartOwner(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	summary, err := cc.cu.UpdateItemQuantity(request.UpdateCartItemRequest{
+		Owner:    owner,
+		LineId:   c.Param("lineId"),
+		Quantity: req.Quantity,
+	})
+	if err != nil {
+		return cartErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, cc.cp.ToJSON(summary))
+}
+
+func (cc *cartController) RemoveItem(c echo.Context) error {
+	owner, err := cc.cartOwner(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if err := cc.cu.RemoveItem(owner, c.Param("lineId")); err != nil {
+		return cartErrorResponse(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// cartOwner はログイン中ならユーザーを、そうでなければクッキーで識別するゲストをカートの持ち主として返す
+// ゲストのクッキーがないか無効な場合は新しいゲストのカートを発行する。有効期限を延ばすためクッキーは毎回設定し直す
+func (cc *cartController) cartOwner(c echo.Context) (request.CartOwner, error) {
+	if userId, ok := c.Get("user_id").(string); ok && userId != "" {
+		return request.CartOwner{UserId: userId}, nil
+	}
+
+	guestCartId := ""
+	if cookie, err := c.Cookie(guestCartCookie); err == nil {
+		if verified, err := cc.cu.VerifyGuestCartToken(cookie.Value); err == nil {
+			guestCartId = verified
+		}
+	}
+	guestCartId, token, err := cc.cu.IssueGuestCartToken(guestCartId)
+	if err != nil {
+		return request.CartOwner{}, err
+	}
+	setGuestCartCookie(c, token, time.Now().Add(cc.cu.GuestCartTTL()))
+	return request.CartOwner{GuestCartId: guestCartId}, nil
+}
+
+func setGuestCartCookie(c echo.Context, token string, expires time.Time) {
+	cookie := new(http.Cookie)
+	cookie.Name = guestCartCookie
+	cookie.Value = token
+	cookie.Expires = expires
+	cookie.Path = "/"
+	cookie.Domain = os.Getenv("API_DOMAIN")
+	cookie.HttpOnly = true
+	cookie.SameSite = http.SameSiteNoneMode
+	c.SetCookie(cookie)
+}
+
+func cartErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrItemNotFound), errors.Is(err, usecase.ErrVariantNotFound), errors.Is(err, usecase.ErrCartLineNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidCartItem):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	case errors.Is(err, usecase.ErrInsufficientStock):
+		return c.JSON(http.StatusConflict, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
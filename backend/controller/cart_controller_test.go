@@ -0,0 +1,301 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCartUsecase struct {
+	mock.Mock
+}
+
+func (m *MockCartUsecase) GetCart(owner request.CartOwner) (*domain.CartSummary, error) {
+	args := m.Called(owner)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CartSummary), args.Error(1)
+}
+
+func (m *MockCartUsecase) AddItem(req request.AddCartItemRequest) (*domain.CartSummary, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CartSummary), args.Error(1)
+}
+
+func (m *MockCartUsecase) UpdateItemQuantity(req request.UpdateCartItemRequest) (*domain.CartSummary, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CartSummary), args.Error(1)
+}
+
+func (m *MockCartUsecase) RemoveItem(owner request.CartOwner, lineId string) error {
+	args := m.Called(owner, lineId)
+	return args.Error(0)
+}
+
+func (m *MockCartUsecase) IssueGuestCartToken(guestCartId string) (string, string, error) {
+	args := m.Called(guestCartId)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *MockCartUsecase) VerifyGuestCartToken(token string) (string, error) {
+	args := m.Called(token)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockCartUsecase) GuestCartTTL() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *MockCartUsecase) MergeGuestCart(guestCartId string, userId string) error {
+	args := m.Called(guestCartId, userId)
+	return args.Error(0)
+}
+
+func (m *MockCartUsecase) PurgeExpiredGuestCarts() (int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+const (
+	cartTestUserId  = "f47ac10b-58cc-4372-a567-0e02b2c3da01"
+	cartTestItemId  = "f47ac10b-58cc-4372-a567-0e02b2c3da02"
+	cartTestLineId  = "f47ac10b-58cc-4372-a567-0e02b2c3da03"
+	cartTestGuestId = "f47ac10b-58cc-4372-a567-0e02b2c3da04"
+)
+
+var cartTestOwner = request.CartOwner{UserId: cartTestUserId}
+
+// createCartTestSummary は1,000円の商品を quantity 個入れたカートを評価して返す
+func createCartTestSummary(quantity int) *domain.CartSummary {
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	userId, _ := domain.NewUserId(cartTestUserId)
+	itemName, _ := domain.NewItemName("Merino Wool")
+	stock, _ := domain.NewStock(10, 0, 0)
+	description, _ := domain.NewDescription("Test Description")
+	price, _ := domain.NewMoneyFromString("1000", domain.CurrencyJPY)
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, *price)
+
+	q, _ := domain.NewCartQuantity(quantity)
+	line := domain.RestoreCartLine(cartTestLineId, *itemId, "", *q, time.Now(), time.Now())
+	cart := domain.RestoreCart("cart-1", domain.UserCartOwner(*userId), []domain.CartLine{*line}, time.Now(), time.Now())
+	summary, _ := domain.PriceCart(cart, domain.Items{*item})
+	return summary
+}
+
+func newCartContext(e *echo.Echo, method string, body map[string]interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	jsonBody, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, "/v1/cart/items", bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", cartTestUserId)
+	c.SetParamNames("lineId")
+	c.SetParamValues(cartTestLineId)
+	return c, rec
+}
+
+func TestCartController_GetCart(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockCartUsecase)
+	controller := NewCartController(mockUsecase)
+
+	mockUsecase.On("GetCart", cartTestOwner).Return(createCartTestSummary(2), nil)
+
+	c, rec := newCartContext(e, http.MethodGet, nil)
+	err := controller.GetCart(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response presenter.CartResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Len(t, response.Items, 1)
+	assert.Equal(t, "2000", response.Subtotal)
+	assert.Equal(t, "2200", response.Total)
+	assert.True(t, response.CheckoutReady)
+}
+
+func TestCartController_GetCart_IssuesGuestCart(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockCartUsecase)
+	controller := NewCartController(mockUsecase)
+
+	mockUsecase.On("IssueGuestCartToken", "").Return(cartTestGuestId, "signed-token", nil)
+	mockUsecase.On("GuestCartTTL").Return(24 * time.Hour)
+	mockUsecase.On("GetCart", request.CartOwner{GuestCartId: cartTestGuestId}).Return(createCartTestSummary(1), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/cart/items", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := controller.GetCart(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	cookies := rec.Result().Cookies()
+	assert.Len(t, cookies, 1)
+	assert.Equal(t, "guest_cart", cookies[0].Name)
+	assert.Equal(t, "signed-token", cookies[0].Value)
+	assert.True(t, cookies[0].HttpOnly)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestCartController_GetCart_ExistingGuestCart(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockCartUsecase)
+	controller := NewCartController(mockUsecase)
+
+	mockUsecase.On("VerifyGuestCartToken", "existing-token").Return(cartTestGuestId, nil)
+	mockUsecase.On("IssueGuestCartToken", cartTestGuestId).Return(cartTestGuestId, "refreshed-token", nil)
+	mockUsecase.On("GuestCartTTL").Return(24 * time.Hour)
+	mockUsecase.On("GetCart", request.CartOwner{GuestCartId: cartTestGuestId}).Return(createCartTestSummary(1), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/cart/items", nil)
+	req.AddCookie(&http.Cookie{Name: "guest_cart", Value: "existing-token"})
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := controller.GetCart(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "refreshed-token", rec.Result().Cookies()[0].Value)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestCartController_GetCart_TamperedGuestCookie(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockCartUsecase)
+	controller := NewCartController(mockUsecase)
+
+	newGuestId := "f47ac10b-58cc-4372-a567-0e02b2c3da05"
+	mockUsecase.On("VerifyGuestCartToken", "tampered").Return("", usecase.ErrInvalidGuestCartToken)
+	mockUsecase.On("IssueGuestCartToken", "").Return(newGuestId, "new-token", nil)
+	mockUsecase.On("GuestCartTTL").Return(24 * time.Hour)
+	mockUsecase.On("GetCart", request.CartOwner{GuestCartId: newGuestId}).Return(createCartTestSummary(1), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/cart/items", nil)
+	req.AddCookie(&http.Cookie{Name: "guest_cart", Value: "tampered"})
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := controller.GetCart(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestCartController_AddItem(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockCartUsecase)
+	controller := NewCartController(mockUsecase)
+
+	expectedReq := request.AddCartItemRequest{Owner: cartTestOwner, ItemId: cartTestItemId, Quantity: 2}
+	mockUsecase.On("AddItem", expectedReq).Return(createCartTestSummary(2), nil)
+
+	c, rec := newCartContext(e, http.MethodPost, map[string]interface{}{
+		"item_id":  cartTestItemId,
+		"quantity": 2,
+	})
+	err := controller.AddItem(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestCartController_AddItem_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"item not found", usecase.ErrItemNotFound, http.StatusNotFound},
+		{"variant not found", usecase.ErrVariantNotFound, http.StatusNotFound},
+		{"invalid quantity", usecase.ErrInvalidCartItem, http.StatusBadRequest},
+		{"insufficient stock", usecase.ErrInsufficientStock, http.StatusConflict},
+		{"unexpected", fmt.Errorf("database error"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &MockValidator{}
+			mockUsecase := new(MockCartUsecase)
+			controller := NewCartController(mockUsecase)
+
+			mockUsecase.On("AddItem", mock.AnythingOfType("request.AddCartItemRequest")).
+				Return(nil, fmt.Errorf("%w: detail", tt.err))
+
+			c, rec := newCartContext(e, http.MethodPost, map[string]interface{}{
+				"item_id":  cartTestItemId,
+				"quantity": 1,
+			})
+			err := controller.AddItem(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestCartController_UpdateItem(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockCartUsecase)
+	controller := NewCartController(mockUsecase)
+
+	expectedReq := request.UpdateCartItemRequest{Owner: cartTestOwner, LineId: cartTestLineId, Quantity: 3}
+	mockUsecase.On("UpdateItemQuantity", expectedReq).Return(createCartTestSummary(3), nil)
+
+	c, rec := newCartContext(e, http.MethodPatch, map[string]interface{}{"quantity": 3})
+	err := controller.UpdateItem(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestCartController_RemoveItem(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockCartUsecase)
+	controller := NewCartController(mockUsecase)
+
+	mockUsecase.On("RemoveItem", cartTestOwner, cartTestLineId).Return(nil)
+
+	c, rec := newCartContext(e, http.MethodDelete, nil)
+	err := controller.RemoveItem(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestCartController_RemoveItem_NotFound(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockCartUsecase)
+	controller := NewCartController(mockUsecase)
+
+	mockUsecase.On("RemoveItem", cartTestOwner, cartTestLineId).Return(fmt.Errorf("%w: %s", usecase.ErrCartLineNotFound, cartTestLineId))
+
+	c, rec := newCartContext(e, http.MethodDelete, nil)
+	err := controller.RemoveItem(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
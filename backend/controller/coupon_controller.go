@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type ICouponController interface {
+	ValidateCoupon(c echo.Context) error
+}
+
+type couponController struct {
+	cu usecase.ICouponUsecase
+	cp presenter.ICouponPresenter
+}
+
+func NewCouponController(cu usecase.ICouponUsecase) ICouponController {
+	cp := presenter.NewCouponPresenter()
+	return &couponController{cu, cp}
+}
+
+// validateCouponBody の Items は注文と同じ形式で指定する
+type validateCouponBody struct {
+	Code  string               `json:"code" validate:"required"`
+	Items []placeOrderLineBody `json:"items" validate:"required,dive"`
+}
+
+// ValidateCoupon は注文する予定の明細にクーポンを使った場合の金額を返す
+// 使えない場合は 422 を返す。注文時の金額はその時点の価格と利用状況で計算し直す
+func (cc *couponController) ValidateCoupon(c echo.Context) error {
+	var req validateCouponBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	preview, err := cc.cu.ValidateCoupon(request.ValidateCouponRequest{
+		UserId: c.Get("user_id").(string),
+		Code:   req.Code,
+		Lines:  toPlaceOrderLines(req.Items),
+	})
+	if err != nil {
+		return couponErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, cc.cp.ToPreviewJSON(preview))
+}
@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCouponUsecase struct {
+	mock.Mock
+}
+
+func (m *MockCouponUsecase) GetCoupons() ([]*domain.Coupon, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Coupon), args.Error(1)
+}
+
+func (m *MockCouponUsecase) GetCoupon(couponId string) (*domain.Coupon, error) {
+	args := m.Called(couponId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Coupon), args.Error(1)
+}
+
+func (m *MockCouponUsecase) CreateCoupon(req request.CreateCouponRequest) (*domain.Coupon, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Coupon), args.Error(1)
+}
+
+func (m *MockCouponUsecase) UpdateCoupon(req request.UpdateCouponRequest) (*domain.Coupon, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Coupon), args.Error(1)
+}
+
+func (m *MockCouponUsecase) DeleteCoupon(couponId string) error {
+	args := m.Called(couponId)
+	return args.Error(0)
+}
+
+func (m *MockCouponUsecase) ValidateCoupon(req request.ValidateCouponRequest) (*domain.CouponPreview, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CouponPreview), args.Error(1)
+}
+
+const couponTestId = "f47ac10b-58cc-4372-a567-0e02b2c3d930"
+
+func createCouponTestCoupon() *domain.Coupon {
+	code, _ := domain.NewCouponCode("WELCOME10")
+	discount, _ := domain.NewPercentDiscount(10)
+	validity, _ := domain.NewCouponValidity(nil, nil)
+	limit, _ := domain.NewCouponUsageLimit(0, 1)
+	return domain.RestoreCoupon(couponTestId, *code, *discount, *validity, *limit, nil, nil, 0, time.Now(), time.Now())
+}
+
+func newCouponContext(e *echo.Echo, method string, body map[string]interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	jsonBody, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, "/v1/admin/coupons", bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", orderTestUserId)
+	return c, rec
+}
+
+func TestAdminCouponController_CreateCoupon(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockCouponUsecase)
+	controller := NewAdminCouponController(mockUsecase)
+
+	mockUsecase.On("CreateCoupon", request.CreateCouponRequest{
+		Code:         "WELCOME10",
+		DiscountType: "percent",
+		PercentOff:   10,
+		PerUserLimit: 1,
+		ItemIds:      []string{orderTestItemId},
+	}).Return(createCouponTestCoupon(), nil)
+
+	c, rec := newCouponContext(e, http.MethodPost, map[string]interface{}{
+		"code":           "WELCOME10",
+		"discount_type":  "percent",
+		"percent_off":    10,
+		"per_user_limit": 1,
+		"item_ids":       []string{orderTestItemId},
+	})
+	err := controller.CreateCoupon(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	var response presenter.CouponResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, couponTestId, response.CouponId)
+	assert.Equal(t, 10, *response.PercentOff)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAdminCouponController_CreateCoupon_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "invalid", err: fmt.Errorf("%w: percent off", usecase.ErrInvalidCoupon), expected: http.StatusBadRequest},
+		{name: "duplicate code", err: fmt.Errorf("%w: WELCOME10", usecase.ErrDuplicateCoupon), expected: http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &MockValidator{}
+			mockUsecase := new(MockCouponUsecase)
+			controller := NewAdminCouponController(mockUsecase)
+			mockUsecase.On("CreateCoupon", mock.Anything).Return(nil, tt.err)
+
+			c, rec := newCouponContext(e, http.MethodPost, map[string]interface{}{"code": "WELCOME10", "discount_type": "percent", "percent_off": 10})
+			err := controller.CreateCoupon(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
+
+func TestAdminCouponController_CreateCoupon_ValidationError(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{shouldFail: true}
+	mockUsecase := new(MockCouponUsecase)
+	controller := NewAdminCouponController(mockUsecase)
+
+	c, rec := newCouponContext(e, http.MethodPost, map[string]interface{}{})
+	err := controller.CreateCoupon(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUsecase.AssertNotCalled(t, "CreateCoupon", mock.Anything)
+}
+
+func TestAdminCouponController_UpdateCoupon_NotFound(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockCouponUsecase)
+	controller := NewAdminCouponController(mockUsecase)
+	mockUsecase.On("UpdateCoupon", mock.MatchedBy(func(req request.UpdateCouponRequest) bool {
+		return req.CouponId == couponTestId
+	})).Return(nil, usecase.ErrCouponNotFound)
+
+	c, rec := newCouponContext(e, http.MethodPut, map[string]interface{}{"code": "WELCOME10", "discount_type": "fixed", "amount_off": "500"})
+	c.SetParamNames("id")
+	c.SetParamValues(couponTestId)
+	err := controller.UpdateCoupon(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAdminCouponController_DeleteCoupon(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "never redeemed", err: nil, expected: http.StatusNoContent},
+		{name: "redeemed", err: fmt.Errorf("%w: 3 redemptions", usecase.ErrCouponInUse), expected: http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockUsecase := new(MockCouponUsecase)
+			controller := NewAdminCouponController(mockUsecase)
+			mockUsecase.On("DeleteCoupon", couponTestId).Return(tt.err)
+
+			c, rec := newCouponContext(e, http.MethodDelete, nil)
+			c.SetParamNames("id")
+			c.SetParamValues(couponTestId)
+			err := controller.DeleteCoupon(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
+
+func TestCouponController_ValidateCoupon(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockCouponUsecase)
+	controller := NewCouponController(mockUsecase)
+	price, _ := domain.NewMoneyFromString("1200", domain.CurrencyJPY)
+	line := domain.RestoreOrderLine(uuid.NewString(), orderTestItemId, "", "Hand-knit sweater", "", nil, *price, domain.TaxRateStandard, 2)
+	preview, _ := createCouponTestCoupon().Preview([]domain.OrderLine{*line}, 0, time.Now())
+	mockUsecase.On("ValidateCoupon", request.ValidateCouponRequest{
+		UserId: orderTestUserId,
+		Code:   "welcome10",
+		Lines:  []request.PlaceOrderLine{{ItemId: orderTestItemId, Quantity: 2}},
+	}).Return(preview, nil)
+
+	c, rec := newCouponContext(e, http.MethodPost, map[string]interface{}{
+		"code":  "welcome10",
+		"items": []map[string]interface{}{{"item_id": orderTestItemId, "quantity": 2}},
+	})
+	err := controller.ValidateCoupon(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response presenter.CouponPreviewResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "2400", response.Subtotal)
+	assert.Equal(t, "240", response.Discount)
+	assert.Equal(t, "2376", response.Total)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestCouponController_ValidateCoupon_NotApplicable(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockCouponUsecase)
+	controller := NewCouponController(mockUsecase)
+	mockUsecase.On("ValidateCoupon", mock.Anything).Return(nil, fmt.Errorf("%w: coupon expired", usecase.ErrCouponNotApplicable))
+
+	c, rec := newCouponContext(e, http.MethodPost, map[string]interface{}{
+		"code":  "WELCOME10",
+		"items": []map[string]interface{}{{"item_id": orderTestItemId, "quantity": 1}},
+	})
+	err := controller.ValidateCoupon(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
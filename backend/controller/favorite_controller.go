@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+)
+
+type IFavoriteController interface {
+	GetFavorites(c echo.Context) error
+	AddFavorite(c echo.Context) error
+	RemoveFavorite(c echo.Context) error
+}
+
+type favoriteController struct {
+	fu usecase.IFavoriteUsecase
+	fp presenter.IFavoritePresenter
+}
+
+func NewFavoriteController(fu usecase.IFavoriteUsecase) IFavoriteController {
+	fp := presenter.NewFavoritePresenter()
+	return &favoriteController{fu, fp}
+}
+
+func (fc *favoriteController) GetFavorites(c echo.Context) error {
+	favorites, err := fc.fu.GetFavorites(c.Get("user_id").(string))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, fc.fp.ToListJSON(favorites))
+}
+
+// AddFavorite は商品をお気に入りに登録する。登録済みの場合も成功として扱う
+func (fc *favoriteController) AddFavorite(c echo.Context) error {
+	if err := fc.fu.AddFavorite(c.Get("user_id").(string), c.Param("itemId")); err != nil {
+		if errors.Is(err, usecase.ErrItemNotFound) {
+			return c.JSON(http.StatusNotFound, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RemoveFavorite はお気に入りを解除する。登録していない場合も成功として扱う
+func (fc *favoriteController) RemoveFavorite(c echo.Context) error {
+	if err := fc.fu.RemoveFavorite(c.Get("user_id").(string), c.Param("itemId")); err != nil {
+		if errors.Is(err, usecase.ErrItemNotFound) {
+			return c.JSON(http.StatusNotFound, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// favoriteItemPresenter は認証済みのリクエストなら、表示する商品のお気に入り登録状況を返すプレゼンターを返す
+// 未認証のリクエストでは ip をそのまま返す
+func favoriteItemPresenter(c echo.Context, ip presenter.IItemPresenter, fu usecase.IFavoriteUsecase, items []*domain.Item) (presenter.IItemPresenter, error) {
+	userId, ok := c.Get("user_id").(string)
+	if !ok || userId == "" {
+		return ip, nil
+	}
+	itemIds := make([]string, len(items))
+	for i, item := range items {
+		itemIds[i] = item.ItemId()
+	}
+	favorited, err := fu.GetFavoritedItemIds(userId, itemIds)
+	if err != nil {
+		return nil, err
+	}
+	return ip.WithFavorited(favorited), nil
+}
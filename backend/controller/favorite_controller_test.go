@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockFavoriteUsecase struct {
+	mock.Mock
+}
+
+func (m *MockFavoriteUsecase) GetFavorites(userId string) ([]*domain.Favorite, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Favorite), args.Error(1)
+}
+
+func (m *MockFavoriteUsecase) GetFavoritedItemIds(userId string, itemIds []string) (map[string]bool, error) {
+	args := m.Called(userId, itemIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
+func (m *MockFavoriteUsecase) AddFavorite(userId string, itemId string) error {
+	args := m.Called(userId, itemId)
+	return args.Error(0)
+}
+
+func (m *MockFavoriteUsecase) RemoveFavorite(userId string, itemId string) error {
+	args := m.Called(userId, itemId)
+	return args.Error(0)
+}
+
+const favoriteTestUserId = "f47ac10b-58cc-4372-a567-0e02b2c3db01"
+
+func newFavoriteContext(e *echo.Echo, method string, itemId string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/v1/me/favorites/"+itemId, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("itemId")
+	c.SetParamValues(itemId)
+	c.Set("user_id", favoriteTestUserId)
+	return c, rec
+}
+
+func TestFavoriteController_GetFavorites(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockFavoriteUsecase)
+	controller := NewFavoriteController(mockUsecase)
+
+	userId, _ := domain.NewUserId(favoriteTestUserId)
+	itemName, _ := domain.NewItemName("メリノウール 並太")
+	stock, _ := domain.NewStock(3, 0, 0)
+	description, _ := domain.NewDescription("柔らかいメリノウールの並太毛糸")
+	item, _ := domain.NewItem(nil, *userId, *itemName, *stock, *description, domain.ZeroYen())
+	favoritedAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	favorites := []*domain.Favorite{domain.RestoreFavorite(*userId, *item.WithFavoriteCount(2), favoritedAt)}
+	mockUsecase.On("GetFavorites", favoriteTestUserId).Return(favorites, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/me/favorites", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", favoriteTestUserId)
+	err := controller.GetFavorites(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response presenter.FavoriteListResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Len(t, response.Items, 1)
+	assert.Equal(t, item.ItemId(), response.Items[0].ItemId)
+	assert.Equal(t, 2, response.Items[0].FavoriteCount)
+	assert.True(t, *response.Items[0].IsFavorited)
+	assert.True(t, favoritedAt.Equal(response.Items[0].FavoritedAt))
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestFavoriteController_AddFavorite(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockFavoriteUsecase)
+		controller := NewFavoriteController(mockUsecase)
+		mockUsecase.On("AddFavorite", favoriteTestUserId, "item-1").Return(nil)
+
+		c, rec := newFavoriteContext(e, http.MethodPut, "item-1")
+		err := controller.AddFavorite(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Item Not Found", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockFavoriteUsecase)
+		controller := NewFavoriteController(mockUsecase)
+		mockUsecase.On("AddFavorite", favoriteTestUserId, "item-1").Return(fmt.Errorf("%w: record not found", usecase.ErrItemNotFound))
+
+		c, rec := newFavoriteContext(e, http.MethodPut, "item-1")
+		err := controller.AddFavorite(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestFavoriteController_RemoveFavorite(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockFavoriteUsecase)
+	controller := NewFavoriteController(mockUsecase)
+	mockUsecase.On("RemoveFavorite", favoriteTestUserId, "item-1").Return(nil)
+
+	c, rec := newFavoriteContext(e, http.MethodDelete, "item-1")
+	err := controller.RemoveFavorite(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IGaugeController interface {
+	CalculateGauge(c echo.Context) error
+}
+
+type gaugeController struct {
+	gu usecase.IGaugeUsecase
+	gp presenter.IGaugePresenter
+}
+
+func NewGaugeController(gu usecase.IGaugeUsecase) IGaugeController {
+	gp := presenter.NewGaugePresenter()
+	return &gaugeController{gu, gp}
+}
+
+// gaugeBody の寸法はすべて unit（"cm" または "in"）で指定する。pattern_gauge は10cm または4インチあたりの目数と段数
+type gaugeBody struct {
+	Unit   string `json:"unit" validate:"required"`
+	Swatch struct {
+		Stitches float64 `json:"stitches"`
+		Rows     float64 `json:"rows"`
+		Width    float64 `json:"width"`
+		Height   float64 `json:"height"`
+	} `json:"swatch"`
+	Target struct {
+		Width  float64 `json:"width"`
+		Height float64 `json:"height"`
+	} `json:"target"`
+	PatternGauge *struct {
+		Stitches float64 `json:"stitches"`
+		Rows     float64 `json:"rows"`
+	} `json:"pattern_gauge"`
+}
+
+// CalculateGauge は試し編みのゲージから目標の寸法を編むための作り目の数と段数を計算する。結果は保存しない
+func (gc *gaugeController) CalculateGauge(c echo.Context) error {
+	var req gaugeBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	gaugeReq := request.CalculateGaugeRequest{
+		Unit:           req.Unit,
+		SwatchStitches: req.Swatch.Stitches,
+		SwatchRows:     req.Swatch.Rows,
+		SwatchWidth:    req.Swatch.Width,
+		SwatchHeight:   req.Swatch.Height,
+		TargetWidth:    req.Target.Width,
+		TargetHeight:   req.Target.Height,
+	}
+	if req.PatternGauge != nil {
+		gaugeReq.PatternStitches, gaugeReq.PatternRows = req.PatternGauge.Stitches, req.PatternGauge.Rows
+	}
+	calculation, err := gc.gu.CalculateGauge(gaugeReq)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidGaugeCalculation) {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, gc.gp.ToJSON(calculation))
+}
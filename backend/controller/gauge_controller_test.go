@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockGaugeUsecase struct {
+	mock.Mock
+}
+
+func (m *MockGaugeUsecase) CalculateGauge(req request.CalculateGaugeRequest) (*domain.GaugeCalculation, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GaugeCalculation), args.Error(1)
+}
+
+func newGaugeContext(e *echo.Echo, body map[string]interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	jsonBody, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/v1/tools/gauge", bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestGaugeController_CalculateGauge(t *testing.T) {
+	body := map[string]interface{}{
+		"unit":          "cm",
+		"swatch":        map[string]interface{}{"stitches": 24, "rows": 32, "width": 10, "height": 10},
+		"target":        map[string]interface{}{"width": 50, "height": 60},
+		"pattern_gauge": map[string]interface{}{"stitches": 22, "rows": 30},
+	}
+	expectedReq := request.CalculateGaugeRequest{
+		Unit:            "cm",
+		SwatchStitches:  24,
+		SwatchRows:      32,
+		SwatchWidth:     10,
+		SwatchHeight:    10,
+		TargetWidth:     50,
+		TargetHeight:    60,
+		PatternStitches: 22,
+		PatternRows:     30,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		e := echo.New()
+		e.Validator = &MockValidator{}
+		mockUsecase := new(MockGaugeUsecase)
+		controller := NewGaugeController(mockUsecase)
+		swatch, _ := domain.NewSpanGauge(24, 32, domain.LengthUnitCentimeter)
+		pattern, _ := domain.NewSpanGauge(22, 30, domain.LengthUnitCentimeter)
+		calculation, _ := domain.CalculateGauge(*swatch, 50, 60, pattern)
+		mockUsecase.On("CalculateGauge", expectedReq).Return(calculation, nil)
+
+		c, rec := newGaugeContext(e, body)
+		err := controller.CalculateGauge(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.GaugeCalculationJSON
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "cm", response.Unit)
+		assert.Equal(t, 10.0, response.GaugeSpan)
+		assert.Equal(t, 120, response.CastOnStitches)
+		assert.Equal(t, 192, response.Rows)
+		assert.Equal(t, 110, *response.PatternCastOnStitches)
+		assert.Equal(t, 180, *response.PatternRows)
+		assert.Equal(t, 9.1, *response.StitchDifferencePercentage)
+		assert.Equal(t, 6.7, *response.RowDifferencePercentage)
+	})
+
+	t.Run("Without Pattern Gauge", func(t *testing.T) {
+		e := echo.New()
+		e.Validator = &MockValidator{}
+		mockUsecase := new(MockGaugeUsecase)
+		controller := NewGaugeController(mockUsecase)
+		swatch, _ := domain.NewSpanGauge(24, 32, domain.LengthUnitCentimeter)
+		calculation, _ := domain.CalculateGauge(*swatch, 50, 60, nil)
+		withoutPattern := expectedReq
+		withoutPattern.PatternStitches, withoutPattern.PatternRows = 0, 0
+		mockUsecase.On("CalculateGauge", withoutPattern).Return(calculation, nil)
+
+		c, rec := newGaugeContext(e, map[string]interface{}{"unit": "cm", "swatch": body["swatch"], "target": body["target"]})
+		err := controller.CalculateGauge(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.GaugeCalculationJSON
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Nil(t, response.PatternGauge)
+		assert.Nil(t, response.PatternCastOnStitches)
+		assert.Nil(t, response.StitchDifferencePercentage)
+	})
+
+	t.Run("Invalid Measurements", func(t *testing.T) {
+		e := echo.New()
+		e.Validator = &MockValidator{}
+		mockUsecase := new(MockGaugeUsecase)
+		controller := NewGaugeController(mockUsecase)
+		mockUsecase.On("CalculateGauge", expectedReq).
+			Return(nil, fmt.Errorf("%w: swatch width must be greater than 0 and at most 1000: 0", usecase.ErrInvalidGaugeCalculation))
+
+		c, rec := newGaugeContext(e, body)
+		err := controller.CalculateGauge(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Validation Error", func(t *testing.T) {
+		e := echo.New()
+		e.Validator = &MockValidator{shouldFail: true}
+		mockUsecase := new(MockGaugeUsecase)
+		controller := NewGaugeController(mockUsecase)
+
+		c, rec := newGaugeContext(e, body)
+		err := controller.CalculateGauge(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "CalculateGauge", mock.Anything)
+	})
+}
@@ -2,9 +2,12 @@
 package controller
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
 	"github.com/posiposi/project/backend/presenter"
 	"github.com/posiposi/project/backend/usecase"
 	"github.com/posiposi/project/backend/usecase/request"
@@ -13,32 +16,56 @@ import (
 type IItemController interface {
 	GetAllItems(c echo.Context) error
 	CreateItem(c echo.Context) error
+	GetItemByID(c echo.Context) error
+	UpdateItem(c echo.Context) error
+	DeleteItem(c echo.Context) error
 }
 
 type itemController struct {
 	iu usecase.IItemUsecase
+	fu usecase.IFavoriteUsecase
 	ip presenter.IItemPresenter
 }
 
-func NewItemController(iu usecase.IItemUsecase) IItemController {
+// NewItemController の fu は認証済みのリクエストで is_favorited を返すために使う
+func NewItemController(iu usecase.IItemUsecase, fu usecase.IFavoriteUsecase) IItemController {
 	ip := presenter.NewItemPresenter()
-	return &itemController{iu, ip}
+	return &itemController{iu, fu, ip}
 }
 
 func (ic *itemController) GetAllItems(c echo.Context) error {
-	items, err := ic.iu.GetAllItems()
+	listReq, err := request.NewListItemsRequest(c.QueryParams())
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	page, err := ic.iu.GetAllItems(listReq)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidQuery) {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	items := make([]*domain.Item, len(page.Items()))
+	for i := range page.Items() {
+		items[i] = &page.Items()[i]
+	}
+	ip, err := favoriteItemPresenter(c, ic.ip, ic.fu, items)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
-	response := ic.ip.ToJSONList(items)
+	response := ip.ToPageJSON(page)
 	return c.JSON(http.StatusOK, response)
 }
 
 func (ic *itemController) CreateItem(c echo.Context) error {
 	var req struct {
-		ItemName    string `json:"item_name" validate:"required"`
-		Stock       bool   `json:"stock"`
-		Description string `json:"description"`
+		ItemName          string      `json:"item_name" validate:"required"`
+		OnHandQuantity    int         `json:"on_hand_quantity"`
+		LowStockThreshold int         `json:"low_stock_threshold"`
+		Description       string      `json:"description"`
+		Price             json.Number `json:"price"`
+		Currency          string      `json:"currency"`
 	}
 
 	if err := c.Bind(&req); err != nil {
@@ -54,16 +81,112 @@ func (ic *itemController) CreateItem(c echo.Context) error {
 	}
 
 	createReq := request.CreateItemRequest{
-		ItemName:    req.ItemName,
-		Stock:       req.Stock,
-		Description: req.Description,
-		UserId:      userId,
+		ItemName:          req.ItemName,
+		OnHandQuantity:    req.OnHandQuantity,
+		LowStockThreshold: req.LowStockThreshold,
+		Description:       req.Description,
+		Price:             req.Price.String(),
+		Currency:          req.Currency,
+		UserId:            userId,
 	}
 
 	createdItem, err := ic.iu.CreateItem(createReq)
 	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidPrice) {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
 	response := ic.ip.ToJSON(createdItem)
 	return c.JSON(http.StatusCreated, response)
 }
+
+func (ic *itemController) GetItemByID(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return c.JSON(http.StatusBadRequest, "ID is required")
+	}
+
+	userId, ok := c.Get("user_id").(string)
+	if !ok || userId == "" {
+		return c.JSON(http.StatusUnauthorized, "user_id not found in context")
+	}
+
+	item, err := ic.iu.GetOwnItemByID(id, userId)
+	if err != nil {
+		return ownItemErrorResponse(c, err)
+	}
+	ip, err := favoriteItemPresenter(c, ic.ip, ic.fu, []*domain.Item{item})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	response := ip.ToJSON(item)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ic *itemController) UpdateItem(c echo.Context) error {
+	id := c.Param("id")
+
+	var req struct {
+		ItemName          string      `json:"item_name" validate:"required"`
+		LowStockThreshold int         `json:"low_stock_threshold"`
+		Description       string      `json:"description"`
+		Price             json.Number `json:"price"`
+		Currency          string      `json:"currency"`
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	userId, ok := c.Get("user_id").(string)
+	if !ok || userId == "" {
+		return c.JSON(http.StatusUnauthorized, "user_id not found in context")
+	}
+
+	updateReq := request.UpdateItemRequest{
+		ItemId:            id,
+		ItemName:          req.ItemName,
+		LowStockThreshold: req.LowStockThreshold,
+		Description:       req.Description,
+		Price:             req.Price.String(),
+		Currency:          req.Currency,
+	}
+
+	updatedItem, err := ic.iu.UpdateOwnItem(updateReq, userId)
+	if err != nil {
+		return ownItemErrorResponse(c, err)
+	}
+	response := ic.ip.ToJSON(updatedItem)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ic *itemController) DeleteItem(c echo.Context) error {
+	id := c.Param("id")
+
+	userId, ok := c.Get("user_id").(string)
+	if !ok || userId == "" {
+		return c.JSON(http.StatusUnauthorized, "user_id not found in context")
+	}
+
+	if err := ic.iu.DeleteOwnItem(id, userId); err != nil {
+		return ownItemErrorResponse(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ownItemErrorResponse は出品者向けの商品操作のエラーをステータスコードに対応付ける
+func ownItemErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrItemNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrForbidden):
+		return c.JSON(http.StatusForbidden, err.Error())
+	case errors.Is(err, usecase.ErrInvalidItemId), errors.Is(err, usecase.ErrInvalidPrice):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
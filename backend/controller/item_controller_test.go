@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/posiposi/project/backend/domain"
 	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
 	"github.com/posiposi/project/backend/usecase/request"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -20,9 +22,12 @@ type MockItemUsecaseForUserController struct {
 	mock.Mock
 }
 
-func (m *MockItemUsecaseForUserController) GetAllItems() ([]*domain.Item, error) {
-	args := m.Called()
-	return args.Get(0).([]*domain.Item), args.Error(1)
+func (m *MockItemUsecaseForUserController) GetAllItems(req request.ListItemsRequest) (*domain.ItemPage, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemPage), args.Error(1)
 }
 
 func (m *MockItemUsecaseForUserController) GetItemByID(itemId string) (*domain.Item, error) {
@@ -54,6 +59,27 @@ func (m *MockItemUsecaseForUserController) DeleteItem(itemId string) error {
 	return args.Error(0)
 }
 
+func (m *MockItemUsecaseForUserController) GetOwnItemByID(itemId string, userId string) (*domain.Item, error) {
+	args := m.Called(itemId, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Item), args.Error(1)
+}
+
+func (m *MockItemUsecaseForUserController) UpdateOwnItem(req request.UpdateItemRequest, userId string) (*domain.Item, error) {
+	args := m.Called(req, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Item), args.Error(1)
+}
+
+func (m *MockItemUsecaseForUserController) DeleteOwnItem(itemId string, userId string) error {
+	args := m.Called(itemId, userId)
+	return args.Error(0)
+}
+
 type MockValidator struct {
 	shouldFail bool
 }
@@ -69,27 +95,27 @@ func TestCreateItem_Success(t *testing.T) {
 	e := echo.New()
 	e.Validator = &MockValidator{}
 	mockUsecase := new(MockItemUsecaseForUserController)
-	controller := NewItemController(mockUsecase)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
 
 	reqBody := map[string]interface{}{
-		"item_name":   "Test Item",
-		"stock":       true,
-		"description": "Test Description",
+		"item_name":        "Test Item",
+		"on_hand_quantity": 1,
+		"description":      "Test Description",
 	}
 	jsonBody, _ := json.Marshal(reqBody)
 
 	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
 	itemName, _ := domain.NewItemName("Test Item")
-	stock, _ := domain.NewStock(true)
+	stock, _ := domain.NewStock(1, 0, 0)
 	description, _ := domain.NewDescription("Test Description")
 	itemId, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
-	expectedDomainItem, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description)
+	expectedDomainItem, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
 
 	expectedReq := request.CreateItemRequest{
-		ItemName:    "Test Item",
-		Stock:       true,
-		Description: "Test Description",
-		UserId:      "f47ac10b-58cc-4372-a567-0e02b2c3d400",
+		ItemName:       "Test Item",
+		OnHandQuantity: 1,
+		Description:    "Test Description",
+		UserId:         "f47ac10b-58cc-4372-a567-0e02b2c3d400",
 	}
 	mockUsecase.On("CreateItem", expectedReq).Return(expectedDomainItem, nil)
 	req := httptest.NewRequest(http.MethodPost, "/v1/items", bytes.NewReader(jsonBody))
@@ -107,7 +133,7 @@ func TestCreateItem_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedDomainItem.ItemId(), response.ItemId)
 	assert.Equal(t, expectedDomainItem.ItemName(), response.ItemName)
-	assert.Equal(t, expectedDomainItem.Stock(), response.Stock)
+	assert.Equal(t, expectedDomainItem.InStock(), response.Stock)
 	assert.Equal(t, expectedDomainItem.Description(), response.Description)
 	mockUsecase.AssertExpectations(t)
 }
@@ -115,7 +141,7 @@ func TestCreateItem_Success(t *testing.T) {
 func TestCreateItem_InvalidJSON(t *testing.T) {
 	e := echo.New()
 	mockUsecase := new(MockItemUsecaseForUserController)
-	controller := NewItemController(mockUsecase)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
 
 	req := httptest.NewRequest(http.MethodPost, "/v1/items", bytes.NewReader([]byte("invalid json")))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
@@ -133,12 +159,12 @@ func TestCreateItem_ValidationError(t *testing.T) {
 	e := echo.New()
 	e.Validator = &MockValidator{shouldFail: true}
 	mockUsecase := new(MockItemUsecaseForUserController)
-	controller := NewItemController(mockUsecase)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
 
 	reqBody := map[string]interface{}{
-		"item_name":   "",
-		"stock":       true,
-		"description": "Test Description",
+		"item_name":        "",
+		"on_hand_quantity": 1,
+		"description":      "Test Description",
 	}
 	jsonBody, _ := json.Marshal(reqBody)
 
@@ -158,12 +184,12 @@ func TestCreateItem_MissingUserId(t *testing.T) {
 	e := echo.New()
 	e.Validator = &MockValidator{}
 	mockUsecase := new(MockItemUsecaseForUserController)
-	controller := NewItemController(mockUsecase)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
 
 	reqBody := map[string]interface{}{
-		"item_name":   "Test Item",
-		"stock":       true,
-		"description": "Test Description",
+		"item_name":        "Test Item",
+		"on_hand_quantity": 1,
+		"description":      "Test Description",
 	}
 	jsonBody, _ := json.Marshal(reqBody)
 
@@ -182,19 +208,19 @@ func TestCreateItem_UsecaseError(t *testing.T) {
 	e := echo.New()
 	e.Validator = &MockValidator{}
 	mockUsecase := new(MockItemUsecaseForUserController)
-	controller := NewItemController(mockUsecase)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
 
 	reqBody := map[string]interface{}{
-		"item_name":   "Test Item",
-		"stock":       true,
-		"description": "Test Description",
+		"item_name":        "Test Item",
+		"on_hand_quantity": 1,
+		"description":      "Test Description",
 	}
 	jsonBody, _ := json.Marshal(reqBody)
 	expectedReq := request.CreateItemRequest{
-		ItemName:    "Test Item",
-		Stock:       true,
-		Description: "Test Description",
-		UserId:      "f47ac10b-58cc-4372-a567-0e02b2c3d400",
+		ItemName:       "Test Item",
+		OnHandQuantity: 1,
+		Description:    "Test Description",
+		UserId:         "f47ac10b-58cc-4372-a567-0e02b2c3d400",
 	}
 	mockUsecase.On("CreateItem", expectedReq).Return(nil, errors.New("usecase error"))
 	req := httptest.NewRequest(http.MethodPost, "/v1/items", bytes.NewReader(jsonBody))
@@ -209,3 +235,303 @@ func TestCreateItem_UsecaseError(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), "usecase error")
 	mockUsecase.AssertExpectations(t)
 }
+
+func TestCreateItem_Price(t *testing.T) {
+	// 価格は文字列でも数値でも受け付け、10進数の文字列として usecase に渡す
+	for _, price := range []interface{}{"1980", 1980} {
+		e := echo.New()
+		e.Validator = &MockValidator{}
+		mockUsecase := new(MockItemUsecaseForUserController)
+		controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
+
+		reqBody := map[string]interface{}{
+			"item_name": "Test Item",
+			"price":     price,
+		}
+		jsonBody, _ := json.Marshal(reqBody)
+		expectedReq := request.CreateItemRequest{
+			ItemName: "Test Item",
+			Price:    "1980",
+			UserId:   "f47ac10b-58cc-4372-a567-0e02b2c3d400",
+		}
+		mockUsecase.On("CreateItem", expectedReq).Return(nil, fmt.Errorf("%w: amount must not be negative", usecase.ErrInvalidPrice))
+		req := httptest.NewRequest(http.MethodPost, "/v1/items", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", "f47ac10b-58cc-4372-a567-0e02b2c3d400")
+		err := controller.CreateItem(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestGetItemByID_Owner(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemUsecaseForUserController)
+	mockFavoriteUsecase := new(MockFavoriteUsecase)
+	controller := NewItemController(mockUsecase, mockFavoriteUsecase)
+
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+	itemName, _ := domain.NewItemName("Test Item")
+	stock, _ := domain.NewStock(1, 0, 0)
+	description, _ := domain.NewDescription("Test Description")
+	itemId, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
+
+	mockUsecase.On("GetOwnItemByID", itemId.Value(), userId.Value()).Return(item, nil)
+	mockFavoriteUsecase.On("GetFavoritedItemIds", userId.Value(), []string{itemId.Value()}).Return(map[string]bool{itemId.Value(): true}, nil)
+	req := httptest.NewRequest(http.MethodGet, "/v1/items/"+itemId.Value(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(itemId.Value())
+	c.Set("user_id", userId.Value())
+
+	err := controller.GetItemByID(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response presenter.ItemResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.True(t, *response.IsFavorited)
+	mockUsecase.AssertExpectations(t)
+	mockFavoriteUsecase.AssertExpectations(t)
+}
+
+func TestGetItemByID_Forbidden(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemUsecaseForUserController)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
+
+	itemId := "f47ac10b-58cc-4372-a567-0e02b2c3d401"
+	userId := "f47ac10b-58cc-4372-a567-0e02b2c3d402"
+	mockUsecase.On("GetOwnItemByID", itemId, userId).Return(nil, usecase.ErrForbidden)
+	req := httptest.NewRequest(http.MethodGet, "/v1/items/"+itemId, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(itemId)
+	c.Set("user_id", userId)
+
+	err := controller.GetItemByID(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestGetItemByID_ErrorStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"Not Found", fmt.Errorf("%w: record not found", usecase.ErrItemNotFound), http.StatusNotFound},
+		{"Invalid Item Id", fmt.Errorf("%w: invalid UUID", usecase.ErrInvalidItemId), http.StatusBadRequest},
+		{"Repository Error", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockUsecase := new(MockItemUsecaseForUserController)
+			controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
+
+			itemId := "f47ac10b-58cc-4372-a567-0e02b2c3d401"
+			userId := "f47ac10b-58cc-4372-a567-0e02b2c3d400"
+			mockUsecase.On("GetOwnItemByID", itemId, userId).Return(nil, tt.err)
+			req := httptest.NewRequest(http.MethodGet, "/v1/items/"+itemId, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(itemId)
+			c.Set("user_id", userId)
+
+			err := controller.GetItemByID(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestUpdateItem_NotFound(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockItemUsecaseForUserController)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
+
+	itemId := "f47ac10b-58cc-4372-a567-0e02b2c3d401"
+	userId := "f47ac10b-58cc-4372-a567-0e02b2c3d400"
+	jsonBody, _ := json.Marshal(map[string]interface{}{"item_name": "Updated Item"})
+	mockUsecase.On("UpdateOwnItem", request.UpdateItemRequest{ItemId: itemId, ItemName: "Updated Item"}, userId).
+		Return(nil, fmt.Errorf("%w: record not found", usecase.ErrItemNotFound))
+	req := httptest.NewRequest(http.MethodPut, "/v1/items/"+itemId, bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(itemId)
+	c.Set("user_id", userId)
+
+	err := controller.UpdateItem(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestDeleteItem_ErrorStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		itemId     string
+		err        error
+		wantStatus int
+	}{
+		{"Not Found", "f47ac10b-58cc-4372-a567-0e02b2c3d401", fmt.Errorf("%w: record not found", usecase.ErrItemNotFound), http.StatusNotFound},
+		{"Invalid Item Id", "invalid", fmt.Errorf("%w: invalid UUID", usecase.ErrInvalidItemId), http.StatusBadRequest},
+		{"Repository Error", "f47ac10b-58cc-4372-a567-0e02b2c3d401", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockUsecase := new(MockItemUsecaseForUserController)
+			controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
+
+			userId := "f47ac10b-58cc-4372-a567-0e02b2c3d400"
+			mockUsecase.On("DeleteOwnItem", tt.itemId, userId).Return(tt.err)
+			req := httptest.NewRequest(http.MethodDelete, "/v1/items/"+tt.itemId, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.itemId)
+			c.Set("user_id", userId)
+
+			err := controller.DeleteItem(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestUpdateItem_Forbidden(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockItemUsecaseForUserController)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
+
+	itemId := "f47ac10b-58cc-4372-a567-0e02b2c3d401"
+	userId := "f47ac10b-58cc-4372-a567-0e02b2c3d402"
+	reqBody := map[string]interface{}{
+		"item_name":   "Updated Item",
+		"description": "Updated Description",
+	}
+	jsonBody, _ := json.Marshal(reqBody)
+	expectedReq := request.UpdateItemRequest{
+		ItemId:      itemId,
+		ItemName:    "Updated Item",
+		Description: "Updated Description",
+	}
+	mockUsecase.On("UpdateOwnItem", expectedReq, userId).Return(nil, usecase.ErrForbidden)
+	req := httptest.NewRequest(http.MethodPut, "/v1/items/"+itemId, bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(itemId)
+	c.Set("user_id", userId)
+
+	err := controller.UpdateItem(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestDeleteItem_Owner(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemUsecaseForUserController)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
+
+	itemId := "f47ac10b-58cc-4372-a567-0e02b2c3d401"
+	userId := "f47ac10b-58cc-4372-a567-0e02b2c3d400"
+	mockUsecase.On("DeleteOwnItem", itemId, userId).Return(nil)
+	req := httptest.NewRequest(http.MethodDelete, "/v1/items/"+itemId, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(itemId)
+	c.Set("user_id", userId)
+
+	err := controller.DeleteItem(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestDeleteItem_MissingUserId(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemUsecaseForUserController)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/items/f47ac10b-58cc-4372-a567-0e02b2c3d401", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("f47ac10b-58cc-4372-a567-0e02b2c3d401")
+
+	err := controller.DeleteItem(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	mockUsecase.AssertNotCalled(t, "DeleteOwnItem")
+}
+
+func TestGetAllItems_InvalidLimit(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemUsecaseForUserController)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/items?limit=abc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := controller.GetAllItems(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUsecase.AssertNotCalled(t, "GetAllItems", mock.Anything)
+}
+
+func TestGetAllItems_UnknownQueryParameter(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemUsecaseForUserController)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/items?price[gt]=100", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := controller.GetAllItems(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "unknown query parameter")
+	mockUsecase.AssertNotCalled(t, "GetAllItems", mock.Anything)
+}
+
+func TestGetAllItems_WithFilterAndSort(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemUsecaseForUserController)
+	controller := NewItemController(mockUsecase, new(MockFavoriteUsecase))
+
+	inStock := true
+	expectedReq := request.ListItemsRequest{
+		Filter: request.ItemListFilter{Stock: &inStock, NameContains: "wool"},
+		Sort:   []request.ItemSortField{{Field: "created_at", Descending: true}, {Field: "item_name"}},
+	}
+	mockUsecase.On("GetAllItems", expectedReq).Return(domain.NewItemPage(nil, nil, nil), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/items?stock=true&name=wool&sort=-created_at,item_name", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := controller.GetAllItems(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
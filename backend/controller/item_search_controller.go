@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IItemSearchController interface {
+	SearchItems(c echo.Context) error
+}
+
+type itemSearchController struct {
+	isu usecase.IItemSearchUsecase
+	fu  usecase.IFavoriteUsecase
+	ip  presenter.IItemPresenter
+}
+
+// NewItemSearchController の fu は認証済みのリクエストで is_favorited を返すために使う
+func NewItemSearchController(isu usecase.IItemSearchUsecase, fu usecase.IFavoriteUsecase) IItemSearchController {
+	ip := presenter.NewItemPresenter()
+	return &itemSearchController{isu, fu, ip}
+}
+
+func (isc *itemSearchController) SearchItems(c echo.Context) error {
+	searchReq := request.SearchItemsRequest{
+		Query: c.QueryParam("q"),
+	}
+	if v := c.QueryParam("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, "limit must be an integer: "+v)
+		}
+		searchReq.Limit = limit
+	}
+
+	hits, err := isc.isu.SearchItems(searchReq)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidQuery) {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	items := make([]*domain.Item, len(hits))
+	for i, hit := range hits {
+		items[i] = hit.Item()
+	}
+	ip, err := favoriteItemPresenter(c, isc.ip, isc.fu, items)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	response := ip.ToSearchJSON(hits)
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockItemSearchUsecase struct {
+	mock.Mock
+}
+
+func (m *MockItemSearchUsecase) SearchItems(req request.SearchItemsRequest) ([]*domain.ItemSearchHit, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ItemSearchHit), args.Error(1)
+}
+
+func TestSearchItems_Success(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemSearchUsecase)
+	controller := NewItemSearchController(mockUsecase, new(MockFavoriteUsecase))
+
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+	itemName, _ := domain.NewItemName("メリノウールの毛糸")
+	stock, _ := domain.NewStock(1, 0, 0)
+	description, _ := domain.NewDescription("柔らかい毛糸です")
+	item, _ := domain.NewItem(nil, *userId, *itemName, *stock, *description, domain.ZeroYen())
+	query, _ := domain.NewSearchQuery("毛糸")
+	hits := []*domain.ItemSearchHit{domain.NewItemSearchHit(*item, 0.9, *query)}
+
+	mockUsecase.On("SearchItems", request.SearchItemsRequest{Query: "毛糸", Limit: 5}).Return(hits, nil)
+	req := httptest.NewRequest(http.MethodGet, "/v1/items/search?q=%E6%AF%9B%E7%B3%B8&limit=5", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := controller.SearchItems(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response presenter.ItemSearchResponseJSON
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response.Items, 1)
+	assert.Equal(t, item.ItemId(), response.Items[0].ItemId)
+	assert.Equal(t, 0.9, response.Items[0].Score)
+	assert.Equal(t, "メリノウールの<mark>毛糸</mark>", response.Items[0].Highlight.ItemName)
+	assert.Nil(t, response.Items[0].IsFavorited)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestSearchItems_Authenticated(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemSearchUsecase)
+	mockFavoriteUsecase := new(MockFavoriteUsecase)
+	controller := NewItemSearchController(mockUsecase, mockFavoriteUsecase)
+
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+	itemName, _ := domain.NewItemName("メリノウールの毛糸")
+	stock, _ := domain.NewStock(1, 0, 0)
+	description, _ := domain.NewDescription("柔らかい毛糸です")
+	item, _ := domain.NewItem(nil, *userId, *itemName, *stock, *description, domain.ZeroYen())
+	query, _ := domain.NewSearchQuery("毛糸")
+	hits := []*domain.ItemSearchHit{domain.NewItemSearchHit(*item.WithFavoriteCount(3), 0.9, *query)}
+
+	mockUsecase.On("SearchItems", request.SearchItemsRequest{Query: "毛糸"}).Return(hits, nil)
+	mockFavoriteUsecase.On("GetFavoritedItemIds", userId.Value(), []string{item.ItemId()}).Return(map[string]bool{}, nil)
+	req := httptest.NewRequest(http.MethodGet, "/v1/items/search?q=%E6%AF%9B%E7%B3%B8", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", userId.Value())
+
+	err := controller.SearchItems(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response presenter.ItemSearchResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, 3, response.Items[0].FavoriteCount)
+	assert.False(t, *response.Items[0].IsFavorited)
+	mockFavoriteUsecase.AssertExpectations(t)
+}
+
+func TestSearchItems_InvalidQuery(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemSearchUsecase)
+	controller := NewItemSearchController(mockUsecase, new(MockFavoriteUsecase))
+
+	mockUsecase.On("SearchItems", request.SearchItemsRequest{}).Return(nil, fmt.Errorf("%w: search query cannot be empty", usecase.ErrInvalidQuery))
+	req := httptest.NewRequest(http.MethodGet, "/v1/items/search", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := controller.SearchItems(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestSearchItems_InvalidLimit(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockItemSearchUsecase)
+	controller := NewItemSearchController(mockUsecase, new(MockFavoriteUsecase))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/items/search?q=wool&limit=many", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := controller.SearchItems(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUsecase.AssertNotCalled(t, "SearchItems", mock.Anything)
+}
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IOrderController interface {
+	PlaceOrder(c echo.Context) error
+	GetOrders(c echo.Context) error
+	GetOrder(c echo.Context) error
+	CancelOrder(c echo.Context) error
+}
+
+type orderController struct {
+	ou usecase.IOrderUsecase
+	op presenter.IOrderPresenter
+}
+
+func NewOrderController(ou usecase.IOrderUsecase) IOrderController {
+	op := presenter.NewOrderPresenter()
+	return &orderController{ou, op}
+}
+
+// placeOrderLineBody の VariantId はバリエーションのある商品でのみ指定する
+type placeOrderLineBody struct {
+	ItemId    string `json:"item_id" validate:"required"`
+	VariantId string `json:"variant_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+// placeOrderBody の CouponCode はクーポンを使う場合にだけ指定する
+type placeOrderBody struct {
+	Items      []placeOrderLineBody `json:"items" validate:"required,dive"`
+	CouponCode string               `json:"coupon_code"`
+}
+
+func (oc *orderController) PlaceOrder(c echo.Context) error {
+	var req placeOrderBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	order, err := oc.ou.PlaceOrder(request.PlaceOrderRequest{
+		UserId:     c.Get("user_id").(string),
+		Lines:      toPlaceOrderLines(req.Items),
+		CouponCode: req.CouponCode,
+	})
+	if err != nil {
+		return orderErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusCreated, oc.op.ToJSON(order))
+}
+
+func (oc *orderController) GetOrders(c echo.Context) error {
+	orders, err := oc.ou.GetOrders(c.Get("user_id").(string))
+	if err != nil {
+		return orderErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, oc.op.ToListJSON(orders))
+}
+
+func (oc *orderController) GetOrder(c echo.Context) error {
+	order, err := oc.ou.GetOrder(c.Get("user_id").(string), c.Param("id"))
+	if err != nil {
+		return orderErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, oc.op.ToJSON(order))
+}
+
+// CancelOrder は発送前の注文を取り消す。発送後は 409 を返す
+func (oc *orderController) CancelOrder(c echo.Context) error {
+	order, err := oc.ou.CancelOrder(c.Get("user_id").(string), c.Param("id"))
+	if err != nil {
+		return orderErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, oc.op.ToJSON(order))
+}
+
+func toPlaceOrderLines(items []placeOrderLineBody) []request.PlaceOrderLine {
+	lines := make([]request.PlaceOrderLine, len(items))
+	for i, item := range items {
+		lines[i] = request.PlaceOrderLine{
+			ItemId:    item.ItemId,
+			VariantId: item.VariantId,
+			Quantity:  item.Quantity,
+		}
+	}
+	return lines
+}
+
+func orderErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrOrderNotFound), errors.Is(err, usecase.ErrItemNotFound), errors.Is(err, usecase.ErrCouponNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrCouponNotApplicable):
+		return c.JSON(http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, usecase.ErrInvalidOrder), errors.Is(err, usecase.ErrInvalidOrderStatus):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	case errors.Is(err, usecase.ErrInsufficientStock), errors.Is(err, usecase.ErrIllegalOrderTransition):
+		return c.JSON(http.StatusConflict, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
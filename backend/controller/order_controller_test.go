@@ -0,0 +1,248 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOrderUsecase struct {
+	mock.Mock
+}
+
+func (m *MockOrderUsecase) PlaceOrder(req request.PlaceOrderRequest) (*domain.Order, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockOrderUsecase) GetOrders(userId string) ([]*domain.Order, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Order), args.Error(1)
+}
+
+func (m *MockOrderUsecase) CancelOrder(userId string, orderId string) (*domain.Order, error) {
+	args := m.Called(userId, orderId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockOrderUsecase) TransitionOrder(req request.TransitionOrderRequest) (*domain.Order, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockOrderUsecase) GetOrder(userId string, orderId string) (*domain.Order, error) {
+	args := m.Called(userId, orderId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+const (
+	orderTestUserId  = "f47ac10b-58cc-4372-a567-0e02b2c3db01"
+	orderTestItemId  = "f47ac10b-58cc-4372-a567-0e02b2c3db02"
+	orderTestOrderId = "f47ac10b-58cc-4372-a567-0e02b2c3db03"
+)
+
+// createOrderTestOrder は1,200円の商品を2個注文した注文を返す
+func createOrderTestOrder() *domain.Order {
+	userId, _ := domain.NewUserId(orderTestUserId)
+	unitPrice, _ := domain.NewMoneyFromString("1200", domain.CurrencyJPY)
+	line := domain.RestoreOrderLine("line-1", orderTestItemId, "", "Hand-knit sweater", "", nil, *unitPrice, domain.TaxRateStandard, 2)
+	subtotal, _ := domain.NewMoneyFromString("2400", domain.CurrencyJPY)
+	return domain.RestoreOrder(orderTestOrderId, *userId, domain.OrderStatusPendingPayment, []domain.OrderLine{*line}, *subtotal, domain.ZeroYen(), *subtotal.TaxAmount(), *subtotal.TaxIncluded(), "", nil, time.Now(), time.Now())
+}
+
+func newOrderContext(e *echo.Echo, method string, body map[string]interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	jsonBody, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, "/v1/orders", bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", orderTestUserId)
+	return c, rec
+}
+
+func TestOrderController_PlaceOrder(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockOrderUsecase)
+	controller := NewOrderController(mockUsecase)
+
+	mockUsecase.On("PlaceOrder", request.PlaceOrderRequest{
+		UserId: orderTestUserId,
+		Lines:  []request.PlaceOrderLine{{ItemId: orderTestItemId, Quantity: 2}},
+	}).Return(createOrderTestOrder(), nil)
+
+	c, rec := newOrderContext(e, http.MethodPost, map[string]interface{}{
+		"items": []map[string]interface{}{{"item_id": orderTestItemId, "quantity": 2}},
+	})
+	err := controller.PlaceOrder(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	var response presenter.OrderResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, orderTestOrderId, response.OrderId)
+	assert.Equal(t, "Hand-knit sweater", response.Items[0].ItemName)
+	assert.Equal(t, "2640", response.Total)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestOrderController_PlaceOrder_WithCoupon(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockOrderUsecase)
+	controller := NewOrderController(mockUsecase)
+
+	mockUsecase.On("PlaceOrder", request.PlaceOrderRequest{
+		UserId:     orderTestUserId,
+		Lines:      []request.PlaceOrderLine{{ItemId: orderTestItemId, Quantity: 2}},
+		CouponCode: "WELCOME10",
+	}).Return(createOrderTestOrder(), nil)
+
+	c, rec := newOrderContext(e, http.MethodPost, map[string]interface{}{
+		"items":       []map[string]interface{}{{"item_id": orderTestItemId, "quantity": 2}},
+		"coupon_code": "WELCOME10",
+	})
+	err := controller.PlaceOrder(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestOrderController_PlaceOrder_ValidationError(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{shouldFail: true}
+	mockUsecase := new(MockOrderUsecase)
+	controller := NewOrderController(mockUsecase)
+
+	c, rec := newOrderContext(e, http.MethodPost, map[string]interface{}{})
+	err := controller.PlaceOrder(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUsecase.AssertNotCalled(t, "PlaceOrder", mock.Anything)
+}
+
+func TestOrderController_PlaceOrder_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "sold out", err: fmt.Errorf("%w: item x", usecase.ErrInsufficientStock), expected: http.StatusConflict},
+		{name: "item not found", err: fmt.Errorf("%w: item x", usecase.ErrItemNotFound), expected: http.StatusNotFound},
+		{name: "invalid order", err: fmt.Errorf("%w: quantity", usecase.ErrInvalidOrder), expected: http.StatusBadRequest},
+		{name: "unknown coupon", err: fmt.Errorf("%w: WELCOME10", usecase.ErrCouponNotFound), expected: http.StatusNotFound},
+		{name: "coupon not applicable", err: fmt.Errorf("%w: coupon expired", usecase.ErrCouponNotApplicable), expected: http.StatusUnprocessableEntity},
+		{name: "unexpected", err: fmt.Errorf("connection refused"), expected: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &MockValidator{}
+			mockUsecase := new(MockOrderUsecase)
+			controller := NewOrderController(mockUsecase)
+			mockUsecase.On("PlaceOrder", mock.Anything).Return(nil, tt.err)
+
+			c, rec := newOrderContext(e, http.MethodPost, map[string]interface{}{
+				"items": []map[string]interface{}{{"item_id": orderTestItemId, "quantity": 1}},
+			})
+			err := controller.PlaceOrder(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
+
+func TestOrderController_GetOrders(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockOrderUsecase)
+	controller := NewOrderController(mockUsecase)
+
+	mockUsecase.On("GetOrders", orderTestUserId).Return([]*domain.Order{createOrderTestOrder()}, nil)
+
+	c, rec := newOrderContext(e, http.MethodGet, nil)
+	err := controller.GetOrders(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response presenter.OrderListResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Len(t, response.Items, 1)
+}
+
+func TestOrderController_GetOrder_NotFound(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockOrderUsecase)
+	controller := NewOrderController(mockUsecase)
+
+	mockUsecase.On("GetOrder", orderTestUserId, orderTestOrderId).Return(nil, usecase.ErrOrderNotFound)
+
+	c, rec := newOrderContext(e, http.MethodGet, nil)
+	c.SetParamNames("id")
+	c.SetParamValues(orderTestOrderId)
+	err := controller.GetOrder(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestOrderController_CancelOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "before shipment", err: nil, expected: http.StatusOK},
+		{name: "after shipment", err: fmt.Errorf("%w: shipped -> cancelled", usecase.ErrIllegalOrderTransition), expected: http.StatusConflict},
+		{name: "another user's order", err: usecase.ErrOrderNotFound, expected: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockUsecase := new(MockOrderUsecase)
+			controller := NewOrderController(mockUsecase)
+			if tt.err != nil {
+				mockUsecase.On("CancelOrder", orderTestUserId, orderTestOrderId).Return(nil, tt.err)
+			} else {
+				mockUsecase.On("CancelOrder", orderTestUserId, orderTestOrderId).Return(createOrderTestOrder(), nil)
+			}
+
+			c, rec := newOrderContext(e, http.MethodPost, nil)
+			c.SetParamNames("id")
+			c.SetParamValues(orderTestOrderId)
+			err := controller.CancelOrder(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
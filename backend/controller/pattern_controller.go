@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+)
+
+type IPatternController interface {
+	GetPatterns(c echo.Context) error
+	GetPattern(c echo.Context) error
+}
+
+type patternController struct {
+	pu usecase.IPatternUsecase
+	pp presenter.IPatternPresenter
+}
+
+func NewPatternController(pu usecase.IPatternUsecase) IPatternController {
+	pp := presenter.NewPatternPresenter()
+	return &patternController{pu, pp}
+}
+
+func (pc *patternController) GetPatterns(c echo.Context) error {
+	patterns, err := pc.pu.GetPatterns()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, pc.pp.ToListJSON(patterns))
+}
+
+// GetPattern は編み図と使える商品を返す。size を指定すると商品ごとに必要な玉数を計算する
+func (pc *patternController) GetPattern(c echo.Context) error {
+	guide, err := pc.pu.GetPattern(c.Param("id"), c.QueryParam("size"))
+	if err != nil {
+		return patternErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, pc.pp.ToDetailJSON(guide))
+}
+
+func patternErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrPatternNotFound), errors.Is(err, usecase.ErrItemNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidPattern), errors.Is(err, usecase.ErrInvalidPatternSize), errors.Is(err, usecase.ErrInvalidYarn):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
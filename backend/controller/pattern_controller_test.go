@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPatternUsecase struct {
+	mock.Mock
+}
+
+func (m *MockPatternUsecase) GetPatterns() ([]*domain.Pattern, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Pattern), args.Error(1)
+}
+
+func (m *MockPatternUsecase) GetPattern(patternId string, sizeLabel string) (*domain.PatternGuide, error) {
+	args := m.Called(patternId, sizeLabel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PatternGuide), args.Error(1)
+}
+
+func (m *MockPatternUsecase) CreatePattern(req request.CreatePatternRequest) (*domain.Pattern, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Pattern), args.Error(1)
+}
+
+func (m *MockPatternUsecase) UpdatePattern(req request.UpdatePatternRequest) (*domain.Pattern, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Pattern), args.Error(1)
+}
+
+func (m *MockPatternUsecase) DeletePattern(patternId string) error {
+	args := m.Called(patternId)
+	return args.Error(0)
+}
+
+func (m *MockPatternUsecase) SetItemYarn(req request.SetItemYarnRequest) (*domain.Item, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Item), args.Error(1)
+}
+
+const (
+	patternTestPatternId = "f47ac10b-58cc-4372-a567-0e02b2c3db11"
+	patternTestItemId    = "f47ac10b-58cc-4372-a567-0e02b2c3db12"
+)
+
+func createTestPattern() *domain.Pattern {
+	itemId, _ := domain.NewItemId(patternTestItemId)
+	gauge, _ := domain.NewGauge(22, 30)
+	needles, _ := domain.ParseNeedleSizes("4;4.5")
+	createdAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	return domain.RestorePattern(patternTestPatternId, domain.PatternDetails{
+		Name:        "ラグランセーター",
+		Difficulty:  domain.PatternDifficultyEasy,
+		Gauge:       *gauge,
+		NeedleSizes: needles,
+		YarnWeight:  domain.YarnWeightMedium,
+		Sizes:       []domain.PatternSize{{Label: "S", FinishedMeasurements: "胸囲 92cm", Yardage: 950}, {Label: "M", FinishedMeasurements: "胸囲 100cm", Yardage: 1100}},
+		ItemIds:     []domain.ItemId{*itemId},
+	}, createdAt, createdAt)
+}
+
+// createPatternTestItem は yardagePerSkein が0なら毛糸の属性のない商品を返す
+func createPatternTestItem(yardagePerSkein int) *domain.Item {
+	itemId, _ := domain.NewItemId(patternTestItemId)
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d900")
+	itemName, _ := domain.NewItemName("メリノウール並太")
+	stock, _ := domain.NewStock(10, 0, 0)
+	description, _ := domain.NewDescription("やわらかいメリノウール")
+	price, _ := domain.NewMoneyFromString("880", domain.CurrencyJPY)
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, *price)
+	if yardagePerSkein == 0 {
+		return item
+	}
+	yarn, _ := domain.NewYarnAttributes(domain.YarnDetails{Weight: domain.YarnWeightMedium, YardagePerSkein: yardagePerSkein})
+	return item.WithYarn(yarn)
+}
+
+func newPatternContext(e *echo.Echo, size string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/patterns/"+patternTestPatternId+"?size="+size, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(patternTestPatternId)
+	return c, rec
+}
+
+func TestPatternController_GetPattern(t *testing.T) {
+	t.Run("Estimates Skeins For Size", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockPatternUsecase)
+		controller := NewPatternController(mockUsecase)
+		guide, _ := domain.NewPatternGuide(createTestPattern(), domain.Items{*createPatternTestItem(120)}, "M")
+		mockUsecase.On("GetPattern", patternTestPatternId, "M").Return(guide, nil)
+
+		c, rec := newPatternContext(e, "M")
+		err := controller.GetPattern(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.PatternDetailJSON
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, []float64{4, 4.5}, response.NeedleSizes)
+		assert.Equal(t, 1100, response.SelectedSize.Yardage)
+		assert.Len(t, response.Items, 1)
+		assert.Equal(t, 120, response.Items[0].Yarn.YardagePerSkein)
+		assert.Equal(t, 10, *response.Items[0].SkeinsNeeded)
+	})
+
+	t.Run("Without Size", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockPatternUsecase)
+		controller := NewPatternController(mockUsecase)
+		guide, _ := domain.NewPatternGuide(createTestPattern(), domain.Items{*createPatternTestItem(0)}, "")
+		mockUsecase.On("GetPattern", patternTestPatternId, "").Return(guide, nil)
+
+		c, rec := newPatternContext(e, "")
+		err := controller.GetPattern(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.PatternDetailJSON
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Nil(t, response.SelectedSize)
+		assert.Nil(t, response.Items[0].Yarn)
+		assert.Nil(t, response.Items[0].SkeinsNeeded)
+	})
+
+	t.Run("Unknown Size", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockPatternUsecase)
+		controller := NewPatternController(mockUsecase)
+		mockUsecase.On("GetPattern", patternTestPatternId, "XL").Return(nil, fmt.Errorf("%w: unknown pattern size: \"XL\"", usecase.ErrInvalidPatternSize))
+
+		c, rec := newPatternContext(e, "XL")
+		err := controller.GetPattern(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Pattern Not Found", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockPatternUsecase)
+		controller := NewPatternController(mockUsecase)
+		mockUsecase.On("GetPattern", patternTestPatternId, "").Return(nil, fmt.Errorf("%w: record not found", usecase.ErrPatternNotFound))
+
+		c, rec := newPatternContext(e, "")
+		err := controller.GetPattern(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+const (
+	// PaymentSignatureHeader は Webhook の本文に対する HMAC-SHA256 の署名を送るヘッダー
+	PaymentSignatureHeader = "X-Payment-Signature"
+	// maxWebhookBytes は受け付ける Webhook の本文のサイズの上限
+	maxWebhookBytes = 64 << 10
+)
+
+type IPaymentController interface {
+	PayOrder(c echo.Context) error
+	GetPayment(c echo.Context) error
+	HandleWebhook(c echo.Context) error
+}
+
+type paymentController struct {
+	pu usecase.IPaymentUsecase
+	pp presenter.IPaymentPresenter
+}
+
+func NewPaymentController(pu usecase.IPaymentUsecase) IPaymentController {
+	pp := presenter.NewPaymentPresenter()
+	return &paymentController{pu, pp}
+}
+
+type payOrderBody struct {
+	PaymentToken string `json:"payment_token" validate:"required"`
+}
+
+// PayOrder は注文を支払う。売上が確定した場合は 201、本人確認を待っている場合は 202 を返す
+func (pc *paymentController) PayOrder(c echo.Context) error {
+	var req payOrderBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	payment, err := pc.pu.PayOrder(request.PayOrderRequest{
+		UserId:       c.Get("user_id").(string),
+		OrderId:      c.Param("id"),
+		PaymentToken: req.PaymentToken,
+	})
+	if err != nil {
+		return paymentErrorResponse(c, err)
+	}
+	if payment.Status() == domain.PaymentStatusRequiresAction {
+		return c.JSON(http.StatusAccepted, pc.pp.ToJSON(payment))
+	}
+	return c.JSON(http.StatusCreated, pc.pp.ToJSON(payment))
+}
+
+func (pc *paymentController) GetPayment(c echo.Context) error {
+	payment, err := pc.pu.GetPayment(c.Get("user_id").(string), c.Param("id"))
+	if err != nil {
+		return paymentErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, pc.pp.ToJSON(payment))
+}
+
+// HandleWebhook は決済代行サービスからの通知を受け取る。署名は本文をそのまま使って検証する
+func (pc *paymentController) HandleWebhook(c echo.Context) error {
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Response(), c.Request().Body, maxWebhookBytes))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := pc.pu.HandleWebhook(payload, c.Request().Header.Get(PaymentSignatureHeader)); err != nil {
+		return paymentErrorResponse(c, err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func paymentErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrOrderNotFound), errors.Is(err, usecase.ErrPaymentNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidWebhook):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	case errors.Is(err, usecase.ErrPaymentDeclined):
+		return c.JSON(http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, usecase.ErrOrderNotPayable), errors.Is(err, usecase.ErrPaymentNotRefundable), errors.Is(err, usecase.ErrIllegalOrderTransition):
+		return c.JSON(http.StatusConflict, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
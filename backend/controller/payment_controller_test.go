@@ -0,0 +1,203 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPaymentUsecase struct {
+	mock.Mock
+}
+
+func (m *MockPaymentUsecase) PayOrder(req request.PayOrderRequest) (*domain.Payment, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentUsecase) GetPayment(userId string, orderId string) (*domain.Payment, error) {
+	args := m.Called(userId, orderId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentUsecase) HandleWebhook(payload []byte, signature string) error {
+	args := m.Called(payload, signature)
+	return args.Error(0)
+}
+
+func (m *MockPaymentUsecase) RefundOrder(req request.RefundOrderRequest) (*domain.Payment, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func createPaymentTestPayment(status domain.PaymentStatus) *domain.Payment {
+	amount, _ := domain.NewMoneyFromString("2640", domain.CurrencyJPY)
+	return domain.RestorePayment("f47ac10b-58cc-4372-a567-0e02b2c3d910", orderTestOrderId, "fakepay_1", status, *amount, time.Now(), time.Now())
+}
+
+func TestPaymentController_PayOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		payment  *domain.Payment
+		err      error
+		expected int
+	}{
+		{name: "captured", payment: createPaymentTestPayment(domain.PaymentStatusCaptured), expected: http.StatusCreated},
+		{name: "requires action", payment: createPaymentTestPayment(domain.PaymentStatusRequiresAction), expected: http.StatusAccepted},
+		{name: "declined", err: fmt.Errorf("%w: card_declined", usecase.ErrPaymentDeclined), expected: http.StatusPaymentRequired},
+		{name: "already paid", err: fmt.Errorf("%w: order is paid", usecase.ErrOrderNotPayable), expected: http.StatusConflict},
+		{name: "another user's order", err: usecase.ErrOrderNotFound, expected: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &MockValidator{}
+			mockUsecase := new(MockPaymentUsecase)
+			controller := NewPaymentController(mockUsecase)
+			req := request.PayOrderRequest{UserId: orderTestUserId, OrderId: orderTestOrderId, PaymentToken: "tok_succeed"}
+			if tt.err != nil {
+				mockUsecase.On("PayOrder", req).Return(nil, tt.err)
+			} else {
+				mockUsecase.On("PayOrder", req).Return(tt.payment, nil)
+			}
+
+			c, rec := newOrderContext(e, http.MethodPost, map[string]interface{}{"payment_token": "tok_succeed"})
+			c.SetParamNames("id")
+			c.SetParamValues(orderTestOrderId)
+			err := controller.PayOrder(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rec.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPaymentController_PayOrder_ValidationError(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{shouldFail: true}
+	mockUsecase := new(MockPaymentUsecase)
+	controller := NewPaymentController(mockUsecase)
+
+	c, rec := newOrderContext(e, http.MethodPost, map[string]interface{}{})
+	err := controller.PayOrder(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUsecase.AssertNotCalled(t, "PayOrder", mock.Anything)
+}
+
+func TestPaymentController_GetPayment(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockPaymentUsecase)
+	controller := NewPaymentController(mockUsecase)
+	mockUsecase.On("GetPayment", orderTestUserId, orderTestOrderId).Return(createPaymentTestPayment(domain.PaymentStatusCaptured), nil)
+
+	c, rec := newOrderContext(e, http.MethodGet, nil)
+	c.SetParamNames("id")
+	c.SetParamValues(orderTestOrderId)
+	err := controller.GetPayment(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"status":"captured"`)
+}
+
+func TestPaymentController_HandleWebhook(t *testing.T) {
+	payload := []byte(`{"id":"evt_1","type":"payment.captured","payment_id":"fakepay_1"}`)
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "applied", err: nil, expected: http.StatusOK},
+		{name: "bad signature", err: fmt.Errorf("%w: invalid webhook signature", usecase.ErrInvalidWebhook), expected: http.StatusBadRequest},
+		{name: "unknown payment", err: usecase.ErrPaymentNotFound, expected: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockUsecase := new(MockPaymentUsecase)
+			controller := NewPaymentController(mockUsecase)
+			mockUsecase.On("HandleWebhook", payload, "abc123").Return(tt.err)
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/payments/webhook", bytes.NewReader(payload))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(PaymentSignatureHeader, "abc123")
+			rec := httptest.NewRecorder()
+			err := controller.HandleWebhook(e.NewContext(req, rec))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rec.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminPaymentController_RefundOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "refunded", err: nil, expected: http.StatusOK},
+		{name: "not captured", err: fmt.Errorf("%w: payment is declined", usecase.ErrPaymentNotRefundable), expected: http.StatusConflict},
+		{name: "shipped order", err: fmt.Errorf("%w: shipped -> refunded", usecase.ErrIllegalOrderTransition), expected: http.StatusConflict},
+		{name: "no payment", err: usecase.ErrPaymentNotFound, expected: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockUsecase := new(MockPaymentUsecase)
+			controller := NewAdminPaymentController(mockUsecase)
+			req := request.RefundOrderRequest{OrderId: orderTestOrderId, UserId: orderTestAdminId}
+			if tt.err != nil {
+				mockUsecase.On("RefundOrder", req).Return(nil, tt.err)
+			} else {
+				mockUsecase.On("RefundOrder", req).Return(createPaymentTestPayment(domain.PaymentStatusRefunded), nil)
+			}
+
+			c, rec := newOrderContext(e, http.MethodPost, nil)
+			c.Set("user_id", orderTestAdminId)
+			c.SetParamNames("id")
+			c.SetParamValues(orderTestOrderId)
+			err := controller.RefundOrder(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
+
+func TestAdminPaymentController_RefundOrder_NoUserId(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockPaymentUsecase)
+	controller := NewAdminPaymentController(mockUsecase)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/orders/x/refund", nil)
+	rec := httptest.NewRecorder()
+	err := controller.RefundOrder(e.NewContext(req, rec))
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	mockUsecase.AssertNotCalled(t, "RefundOrder", mock.Anything)
+}
@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IProjectController interface {
+	GetProjects(c echo.Context) error
+	GetProject(c echo.Context) error
+	CreateProject(c echo.Context) error
+	UpdateProject(c echo.Context) error
+	DeleteProject(c echo.Context) error
+	GetHistory(c echo.Context) error
+}
+
+type projectController struct {
+	pu usecase.IProjectUsecase
+	pp presenter.IProjectPresenter
+}
+
+func NewProjectController(pu usecase.IProjectUsecase) IProjectController {
+	pp := presenter.NewProjectPresenter()
+	return &projectController{pu, pp}
+}
+
+// projectBody の ItemId・PatternId は使っている商品・編み図で、省略すると結び付けない
+type projectBody struct {
+	Name               string `json:"name" validate:"required"`
+	ItemId             string `json:"item_id"`
+	PatternId          string `json:"pattern_id"`
+	ProgressPercentage int    `json:"progress_percentage"`
+	RowCount           int    `json:"row_count"`
+	Notes              string `json:"notes"`
+}
+
+func (pc *projectController) GetProjects(c echo.Context) error {
+	projects, err := pc.pu.GetProjects(c.Get("user_id").(string))
+	if err != nil {
+		return projectErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, pc.pp.ToListJSON(projects))
+}
+
+func (pc *projectController) GetProject(c echo.Context) error {
+	project, err := pc.pu.GetProject(c.Get("user_id").(string), c.Param("id"))
+	if err != nil {
+		return projectErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, pc.pp.ToJSON(project))
+}
+
+func (pc *projectController) CreateProject(c echo.Context) error {
+	var req projectBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	project, err := pc.pu.CreateProject(request.CreateProjectRequest{
+		UserId:             c.Get("user_id").(string),
+		Name:               req.Name,
+		ItemId:             req.ItemId,
+		PatternId:          req.PatternId,
+		ProgressPercentage: req.ProgressPercentage,
+		RowCount:           req.RowCount,
+		Notes:              req.Notes,
+	})
+	if err != nil {
+		return projectErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusCreated, pc.pp.ToJSON(project))
+}
+
+// UpdateProject は作品の項目をすべて置き換える。進捗率か段数が変わった場合は履歴に記録する
+func (pc *projectController) UpdateProject(c echo.Context) error {
+	var req projectBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	project, err := pc.pu.UpdateProject(request.UpdateProjectRequest{
+		UserId:             c.Get("user_id").(string),
+		ProjectId:          c.Param("id"),
+		Name:               req.Name,
+		ItemId:             req.ItemId,
+		PatternId:          req.PatternId,
+		ProgressPercentage: req.ProgressPercentage,
+		RowCount:           req.RowCount,
+		Notes:              req.Notes,
+	})
+	if err != nil {
+		return projectErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, pc.pp.ToJSON(project))
+}
+
+func (pc *projectController) DeleteProject(c echo.Context) error {
+	if err := pc.pu.DeleteProject(c.Get("user_id").(string), c.Param("id")); err != nil {
+		return projectErrorResponse(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetHistory は進捗のグラフを描くため、作品の進捗率と段数の履歴を古い順に返す
+func (pc *projectController) GetHistory(c echo.Context) error {
+	snapshots, err := pc.pu.GetHistory(c.Get("user_id").(string), c.Param("id"))
+	if err != nil {
+		return projectErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, pc.pp.ToHistoryJSON(c.Param("id"), snapshots))
+}
+
+func projectErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrProjectNotFound), errors.Is(err, usecase.ErrItemNotFound), errors.Is(err, usecase.ErrPatternNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidProject):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
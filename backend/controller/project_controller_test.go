@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockProjectUsecase struct {
+	mock.Mock
+}
+
+func (m *MockProjectUsecase) GetProjects(userId string) ([]*domain.Project, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Project), args.Error(1)
+}
+
+func (m *MockProjectUsecase) GetProject(userId string, projectId string) (*domain.Project, error) {
+	args := m.Called(userId, projectId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Project), args.Error(1)
+}
+
+func (m *MockProjectUsecase) CreateProject(req request.CreateProjectRequest) (*domain.Project, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Project), args.Error(1)
+}
+
+func (m *MockProjectUsecase) UpdateProject(req request.UpdateProjectRequest) (*domain.Project, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Project), args.Error(1)
+}
+
+func (m *MockProjectUsecase) DeleteProject(userId string, projectId string) error {
+	args := m.Called(userId, projectId)
+	return args.Error(0)
+}
+
+func (m *MockProjectUsecase) GetHistory(userId string, projectId string) ([]*domain.ProjectSnapshot, error) {
+	args := m.Called(userId, projectId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ProjectSnapshot), args.Error(1)
+}
+
+const (
+	projectTestUserId    = "f47ac10b-58cc-4372-a567-0e02b2c3db01"
+	projectTestProjectId = "f47ac10b-58cc-4372-a567-0e02b2c3db09"
+)
+
+func createTestProject(progress int) *domain.Project {
+	userId, _ := domain.NewUserId(projectTestUserId)
+	value, _ := domain.NewProgressPercentage(progress)
+	createdAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	details := domain.ProjectDetails{Name: "ラグランセーター", Progress: *value, RowCount: 42, Notes: "袖を編み中"}
+	return domain.RestoreProject(projectTestProjectId, *userId, details, createdAt, createdAt)
+}
+
+func newProjectContext(e *echo.Echo, method string, body map[string]interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	jsonBody, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, "/v1/me/projects/"+projectTestProjectId, bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(projectTestProjectId)
+	c.Set("user_id", projectTestUserId)
+	return c, rec
+}
+
+func TestProjectController_CreateProject(t *testing.T) {
+	body := map[string]interface{}{"name": "ラグランセーター", "progress_percentage": 30, "row_count": 42, "notes": "袖を編み中"}
+	expectedReq := request.CreateProjectRequest{UserId: projectTestUserId, Name: "ラグランセーター", ProgressPercentage: 30, RowCount: 42, Notes: "袖を編み中"}
+
+	t.Run("Success", func(t *testing.T) {
+		e := echo.New()
+		e.Validator = &MockValidator{}
+		mockUsecase := new(MockProjectUsecase)
+		controller := NewProjectController(mockUsecase)
+		mockUsecase.On("CreateProject", expectedReq).Return(createTestProject(30), nil)
+
+		c, rec := newProjectContext(e, http.MethodPost, body)
+		err := controller.CreateProject(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var response presenter.ProjectJSON
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, 30, response.ProgressPercentage)
+		assert.Nil(t, response.ItemId)
+	})
+
+	t.Run("Invalid Project", func(t *testing.T) {
+		e := echo.New()
+		e.Validator = &MockValidator{}
+		mockUsecase := new(MockProjectUsecase)
+		controller := NewProjectController(mockUsecase)
+		mockUsecase.On("CreateProject", expectedReq).Return(nil, fmt.Errorf("%w: progress percentage must be between 0 and 100: 120", usecase.ErrInvalidProject))
+
+		c, rec := newProjectContext(e, http.MethodPost, body)
+		err := controller.CreateProject(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestProjectController_UpdateProject(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockProjectUsecase)
+	controller := NewProjectController(mockUsecase)
+	mockUsecase.On("UpdateProject", request.UpdateProjectRequest{UserId: projectTestUserId, ProjectId: projectTestProjectId, Name: "ラグランセーター", ProgressPercentage: 60, RowCount: 42}).
+		Return(nil, fmt.Errorf("%w: record not found", usecase.ErrProjectNotFound))
+
+	c, rec := newProjectContext(e, http.MethodPut, map[string]interface{}{"name": "ラグランセーター", "progress_percentage": 60, "row_count": 42})
+	err := controller.UpdateProject(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestProjectController_GetHistory(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockProjectUsecase)
+	controller := NewProjectController(mockUsecase)
+	first := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	zero, _ := domain.NewProgressPercentage(0)
+	half, _ := domain.NewProgressPercentage(50)
+	snapshots := []*domain.ProjectSnapshot{
+		domain.RestoreProjectSnapshot("snapshot-1", projectTestProjectId, *zero, 0, first),
+		domain.RestoreProjectSnapshot("snapshot-2", projectTestProjectId, *half, 90, first.Add(48*time.Hour)),
+	}
+	mockUsecase.On("GetHistory", projectTestUserId, projectTestProjectId).Return(snapshots, nil)
+
+	c, rec := newProjectContext(e, http.MethodGet, nil)
+	err := controller.GetHistory(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response presenter.ProjectHistoryResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, projectTestProjectId, response.ProjectId)
+	assert.Len(t, response.Snapshots, 2)
+	assert.Equal(t, 50, response.Snapshots[1].ProgressPercentage)
+	assert.Equal(t, 90, response.Snapshots[1].RowCount)
+}
+
+func TestProjectController_DeleteProject(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockProjectUsecase)
+	controller := NewProjectController(mockUsecase)
+	mockUsecase.On("DeleteProject", projectTestUserId, projectTestProjectId).Return(nil)
+
+	c, rec := newProjectContext(e, http.MethodDelete, nil)
+	err := controller.DeleteProject(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+)
+
+type IReceiptController interface {
+	GetReceipt(c echo.Context) error
+}
+
+type receiptController struct {
+	ru usecase.IReceiptUsecase
+	rp presenter.IReceiptPresenter
+}
+
+func NewReceiptController(ru usecase.IReceiptUsecase) IReceiptController {
+	rp := presenter.NewReceiptPresenter()
+	return &receiptController{ru, rp}
+}
+
+// GetReceipt は注文の領収書をダウンロードさせる。まだ発行していなければ発行する
+// format クエリに html（既定）または text を指定する
+func (rc *receiptController) GetReceipt(c echo.Context) error {
+	format, err := receiptFormat(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	receipt, err := rc.ru.GetReceipt(c.Get("user_id").(string), c.Param("id"))
+	if err != nil {
+		return receiptErrorResponse(c, err)
+	}
+	return receiptDownload(c, rc.rp.ToDocument(receipt, format))
+}
+
+func receiptFormat(c echo.Context) (string, error) {
+	switch format := c.QueryParam("format"); format {
+	case "", presenter.ReceiptFormatHTML:
+		return presenter.ReceiptFormatHTML, nil
+	case presenter.ReceiptFormatText:
+		return format, nil
+	default:
+		return "", fmt.Errorf("format must be html or text: %s", format)
+	}
+}
+
+// receiptDownload は領収書を添付ファイルとして返す。発行後は内容が変わらないが、購入者の情報を含むため共有キャッシュには載せない
+func receiptDownload(c echo.Context, document presenter.ReceiptDocument) error {
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", document.Filename))
+	c.Response().Header().Set("Cache-Control", "private, no-cache")
+	return c.Blob(http.StatusOK, document.ContentType, document.Body)
+}
+
+func receiptErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrOrderNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrReceiptNotIssuable):
+		return c.JSON(http.StatusConflict, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
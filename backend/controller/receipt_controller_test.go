@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReceiptUsecase struct {
+	mock.Mock
+}
+
+func (m *MockReceiptUsecase) GetReceipt(userId string, orderId string) (*domain.Receipt, error) {
+	args := m.Called(userId, orderId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Receipt), args.Error(1)
+}
+
+func (m *MockReceiptUsecase) GetReceiptAsAdmin(orderId string) (*domain.Receipt, error) {
+	args := m.Called(orderId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Receipt), args.Error(1)
+}
+
+func createReceiptTestReceipt() *domain.Receipt {
+	userId, _ := domain.NewUserId(orderTestUserId)
+	total, _ := domain.NewMoneyFromString("2640", domain.CurrencyJPY)
+	return domain.RestoreReceipt("receipt-1", "R20261018-F47AC10B", orderTestOrderId, *userId, "株式会社サンプル毛糸店", "T7000012050002", *total, "<p>領収書</p>", "領収書", time.Now())
+}
+
+func newReceiptContext(e *echo.Echo, query string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders/"+orderTestOrderId+"/receipt"+query, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(orderTestOrderId)
+	c.Set("user_id", orderTestUserId)
+	return c, rec
+}
+
+func TestReceiptController_GetReceipt(t *testing.T) {
+	t.Run("HTML By Default", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockReceiptUsecase)
+		controller := NewReceiptController(mockUsecase)
+		mockUsecase.On("GetReceipt", orderTestUserId, orderTestOrderId).Return(createReceiptTestReceipt(), nil)
+
+		c, rec := newReceiptContext(e, "")
+		err := controller.GetReceipt(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/html; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, `attachment; filename="receipt-R20261018-F47AC10B.html"`, rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Equal(t, "private, no-cache", rec.Header().Get("Cache-Control"))
+		assert.Equal(t, "<p>領収書</p>", rec.Body.String())
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockReceiptUsecase)
+		controller := NewReceiptController(mockUsecase)
+		mockUsecase.On("GetReceipt", orderTestUserId, orderTestOrderId).Return(createReceiptTestReceipt(), nil)
+
+		c, rec := newReceiptContext(e, "?format=text")
+		err := controller.GetReceipt(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/plain; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, `attachment; filename="receipt-R20261018-F47AC10B.txt"`, rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Equal(t, "領収書", rec.Body.String())
+	})
+
+	t.Run("Invalid Format", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockReceiptUsecase)
+		controller := NewReceiptController(mockUsecase)
+
+		c, rec := newReceiptContext(e, "?format=pdf")
+		err := controller.GetReceipt(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUsecase.AssertNotCalled(t, "GetReceipt", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Order Not Found", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockReceiptUsecase)
+		controller := NewReceiptController(mockUsecase)
+		mockUsecase.On("GetReceipt", orderTestUserId, orderTestOrderId).Return(nil, fmt.Errorf("%w: %s", usecase.ErrOrderNotFound, orderTestOrderId))
+
+		c, rec := newReceiptContext(e, "")
+		err := controller.GetReceipt(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Not Issuable", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockReceiptUsecase)
+		controller := NewReceiptController(mockUsecase)
+		mockUsecase.On("GetReceipt", orderTestUserId, orderTestOrderId).Return(nil, fmt.Errorf("%w: order is pending_payment", usecase.ErrReceiptNotIssuable))
+
+		c, rec := newReceiptContext(e, "")
+		err := controller.GetReceipt(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+func TestAdminReceiptController_GetReceipt(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockReceiptUsecase)
+	controller := NewAdminReceiptController(mockUsecase)
+	mockUsecase.On("GetReceiptAsAdmin", orderTestOrderId).Return(createReceiptTestReceipt(), nil)
+
+	c, rec := newReceiptContext(e, "?format=text")
+	err := controller.GetReceipt(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "領収書", rec.Body.String())
+	mockUsecase.AssertExpectations(t)
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IReviewController interface {
+	GetReviews(c echo.Context) error
+	CreateReview(c echo.Context) error
+	UpdateReview(c echo.Context) error
+}
+
+type reviewController struct {
+	ru usecase.IReviewUsecase
+	rp presenter.IReviewPresenter
+}
+
+func NewReviewController(ru usecase.IReviewUsecase) IReviewController {
+	rp := presenter.NewReviewPresenter()
+	return &reviewController{ru, rp}
+}
+
+// reviewBody の Rating は1〜5の評価
+type reviewBody struct {
+	Rating int    `json:"rating" validate:"required"`
+	Title  string `json:"title" validate:"required"`
+	Body   string `json:"body" validate:"required"`
+}
+
+func (rc *reviewController) GetReviews(c echo.Context) error {
+	reviews, err := rc.ru.GetReviews(c.Param("id"))
+	if err != nil {
+		return reviewErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, rc.rp.ToListJSON(reviews))
+}
+
+func (rc *reviewController) CreateReview(c echo.Context) error {
+	var req reviewBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	review, err := rc.ru.CreateReview(request.CreateReviewRequest{
+		UserId: c.Get("user_id").(string),
+		ItemId: c.Param("id"),
+		Rating: req.Rating,
+		Title:  req.Title,
+		Body:   req.Body,
+	})
+	if err != nil {
+		return reviewErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusCreated, rc.rp.ToJSON(review))
+}
+
+// UpdateReview は自分のレビューの評価・タイトル・本文をすべて置き換える
+func (rc *reviewController) UpdateReview(c echo.Context) error {
+	var req reviewBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	review, err := rc.ru.UpdateReview(request.UpdateReviewRequest{
+		UserId:   c.Get("user_id").(string),
+		ItemId:   c.Param("id"),
+		ReviewId: c.Param("reviewId"),
+		Rating:   req.Rating,
+		Title:    req.Title,
+		Body:     req.Body,
+	})
+	if err != nil {
+		return reviewErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, rc.rp.ToJSON(review))
+}
+
+func reviewErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrItemNotFound), errors.Is(err, usecase.ErrReviewNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidReview):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	case errors.Is(err, usecase.ErrDuplicateReview):
+		return c.JSON(http.StatusConflict, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
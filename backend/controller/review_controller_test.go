@@ -0,0 +1,180 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReviewUsecase struct {
+	mock.Mock
+}
+
+func (m *MockReviewUsecase) GetReviews(itemId string) ([]*domain.Review, error) {
+	args := m.Called(itemId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Review), args.Error(1)
+}
+
+func (m *MockReviewUsecase) CreateReview(req request.CreateReviewRequest) (*domain.Review, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+func (m *MockReviewUsecase) UpdateReview(req request.UpdateReviewRequest) (*domain.Review, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+const (
+	reviewTestUserId   = "f47ac10b-58cc-4372-a567-0e02b2c3db01"
+	reviewTestItemId   = "f47ac10b-58cc-4372-a567-0e02b2c3db05"
+	reviewTestReviewId = "f47ac10b-58cc-4372-a567-0e02b2c3db07"
+)
+
+func createTestReview(rating int) *domain.Review {
+	userId, _ := domain.NewUserId(reviewTestUserId)
+	itemId, _ := domain.NewItemId(reviewTestItemId)
+	value, _ := domain.NewRating(rating)
+	content := domain.ReviewContent{Rating: *value, Title: "編みやすい", Body: "発色がきれいです"}
+	createdAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	return domain.RestoreReview(reviewTestReviewId, *userId, "買い手", *itemId, content, createdAt, createdAt)
+}
+
+func newReviewContext(e *echo.Echo, method string, body map[string]interface{}, reviewId string) (echo.Context, *httptest.ResponseRecorder) {
+	jsonBody, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, "/v1/items/"+reviewTestItemId+"/reviews/"+reviewId, bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id", "reviewId")
+	c.SetParamValues(reviewTestItemId, reviewId)
+	c.Set("user_id", reviewTestUserId)
+	return c, rec
+}
+
+func TestReviewController_GetReviews(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockReviewUsecase)
+		controller := NewReviewController(mockUsecase)
+		mockUsecase.On("GetReviews", reviewTestItemId).Return([]*domain.Review{createTestReview(5)}, nil)
+
+		c, rec := newReviewContext(e, http.MethodGet, nil, "")
+		err := controller.GetReviews(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.ReviewListResponseJSON
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Len(t, response.Reviews, 1)
+		assert.Equal(t, 5, response.Reviews[0].Rating)
+		assert.Equal(t, "買い手", response.Reviews[0].AuthorName)
+	})
+
+	t.Run("Item Not Found", func(t *testing.T) {
+		e := echo.New()
+		mockUsecase := new(MockReviewUsecase)
+		controller := NewReviewController(mockUsecase)
+		mockUsecase.On("GetReviews", reviewTestItemId).Return(nil, fmt.Errorf("%w: record not found", usecase.ErrItemNotFound))
+
+		c, rec := newReviewContext(e, http.MethodGet, nil, "")
+		err := controller.GetReviews(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestReviewController_CreateReview(t *testing.T) {
+	body := map[string]interface{}{"rating": 5, "title": "編みやすい", "body": "発色がきれいです"}
+	expectedReq := request.CreateReviewRequest{UserId: reviewTestUserId, ItemId: reviewTestItemId, Rating: 5, Title: "編みやすい", Body: "発色がきれいです"}
+
+	tests := []struct {
+		name   string
+		review *domain.Review
+		err    error
+		code   int
+	}{
+		{"Success", createTestReview(5), nil, http.StatusCreated},
+		{"Invalid Review", nil, fmt.Errorf("%w: rating must be between 1 and 5: 6", usecase.ErrInvalidReview), http.StatusBadRequest},
+		{"Duplicate", nil, fmt.Errorf("%w: review already exists", usecase.ErrDuplicateReview), http.StatusConflict},
+		{"Item Not Found", nil, fmt.Errorf("%w: record not found", usecase.ErrItemNotFound), http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &MockValidator{}
+			mockUsecase := new(MockReviewUsecase)
+			controller := NewReviewController(mockUsecase)
+			if tt.review != nil {
+				mockUsecase.On("CreateReview", expectedReq).Return(tt.review, nil)
+			} else {
+				mockUsecase.On("CreateReview", expectedReq).Return(nil, tt.err)
+			}
+
+			c, rec := newReviewContext(e, http.MethodPost, body, "")
+			err := controller.CreateReview(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, rec.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewController_UpdateReview(t *testing.T) {
+	body := map[string]interface{}{"rating": 2, "title": "毛玉ができやすい", "body": "洗濯すると毛玉が目立ちます"}
+	expectedReq := request.UpdateReviewRequest{UserId: reviewTestUserId, ItemId: reviewTestItemId, ReviewId: reviewTestReviewId, Rating: 2, Title: "毛玉ができやすい", Body: "洗濯すると毛玉が目立ちます"}
+
+	t.Run("Success", func(t *testing.T) {
+		e := echo.New()
+		e.Validator = &MockValidator{}
+		mockUsecase := new(MockReviewUsecase)
+		controller := NewReviewController(mockUsecase)
+		mockUsecase.On("UpdateReview", expectedReq).Return(createTestReview(2), nil)
+
+		c, rec := newReviewContext(e, http.MethodPut, body, reviewTestReviewId)
+		err := controller.UpdateReview(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response presenter.ReviewJSON
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, 2, response.Rating)
+	})
+
+	t.Run("Written By Another User", func(t *testing.T) {
+		e := echo.New()
+		e.Validator = &MockValidator{}
+		mockUsecase := new(MockReviewUsecase)
+		controller := NewReviewController(mockUsecase)
+		mockUsecase.On("UpdateReview", expectedReq).Return(nil, fmt.Errorf("%w: %s", usecase.ErrReviewNotFound, reviewTestReviewId))
+
+		c, rec := newReviewContext(e, http.MethodPut, body, reviewTestReviewId)
+		err := controller.UpdateReview(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IShippingAddressController interface {
+	GetAddresses(c echo.Context) error
+	GetAddress(c echo.Context) error
+	CreateAddress(c echo.Context) error
+	UpdateAddress(c echo.Context) error
+	DeleteAddress(c echo.Context) error
+}
+
+type shippingAddressController struct {
+	su usecase.IShippingAddressUsecase
+	sp presenter.IShippingAddressPresenter
+}
+
+func NewShippingAddressController(su usecase.IShippingAddressUsecase) IShippingAddressController {
+	sp := presenter.NewShippingAddressPresenter()
+	return &shippingAddressController{su, sp}
+}
+
+// shippingAddressBody の Prefecture は "東京都" のような正式な都道府県名で指定する
+type shippingAddressBody struct {
+	RecipientName string `json:"recipient_name" validate:"required"`
+	PostalCode    string `json:"postal_code" validate:"required"`
+	Prefecture    string `json:"prefecture" validate:"required"`
+	City          string `json:"city" validate:"required"`
+	AddressLine1  string `json:"address_line1" validate:"required"`
+	AddressLine2  string `json:"address_line2"`
+	PhoneNumber   string `json:"phone_number" validate:"required"`
+}
+
+func (sc *shippingAddressController) GetAddresses(c echo.Context) error {
+	addresses, err := sc.su.GetAddresses(c.Get("user_id").(string))
+	if err != nil {
+		return shippingErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, sc.sp.ToListJSON(addresses))
+}
+
+func (sc *shippingAddressController) GetAddress(c echo.Context) error {
+	address, err := sc.su.GetAddress(c.Get("user_id").(string), c.Param("id"))
+	if err != nil {
+		return shippingErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, sc.sp.ToJSON(address))
+}
+
+func (sc *shippingAddressController) CreateAddress(c echo.Context) error {
+	var req shippingAddressBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	address, err := sc.su.CreateAddress(request.CreateShippingAddressRequest{
+		UserId:        c.Get("user_id").(string),
+		RecipientName: req.RecipientName,
+		PostalCode:    req.PostalCode,
+		Prefecture:    req.Prefecture,
+		City:          req.City,
+		AddressLine1:  req.AddressLine1,
+		AddressLine2:  req.AddressLine2,
+		PhoneNumber:   req.PhoneNumber,
+	})
+	if err != nil {
+		return shippingErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusCreated, sc.sp.ToJSON(address))
+}
+
+// UpdateAddress は宛名と住所をすべて置き換える
+func (sc *shippingAddressController) UpdateAddress(c echo.Context) error {
+	var req shippingAddressBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	address, err := sc.su.UpdateAddress(request.UpdateShippingAddressRequest{
+		UserId:        c.Get("user_id").(string),
+		AddressId:     c.Param("id"),
+		RecipientName: req.RecipientName,
+		PostalCode:    req.PostalCode,
+		Prefecture:    req.Prefecture,
+		City:          req.City,
+		AddressLine1:  req.AddressLine1,
+		AddressLine2:  req.AddressLine2,
+		PhoneNumber:   req.PhoneNumber,
+	})
+	if err != nil {
+		return shippingErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, sc.sp.ToJSON(address))
+}
+
+func (sc *shippingAddressController) DeleteAddress(c echo.Context) error {
+	if err := sc.su.DeleteAddress(c.Get("user_id").(string), c.Param("id")); err != nil {
+		return shippingErrorResponse(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IShippingController interface {
+	QuoteShipping(c echo.Context) error
+}
+
+type shippingController struct {
+	su usecase.IShippingUsecase
+	sp presenter.IShippingPresenter
+}
+
+func NewShippingController(su usecase.IShippingUsecase) IShippingController {
+	sp := presenter.NewShippingPresenter()
+	return &shippingController{su, sp}
+}
+
+// quoteShippingBody の Items は注文と同じ形式で指定する
+type quoteShippingBody struct {
+	AddressId string               `json:"address_id" validate:"required"`
+	Items     []placeOrderLineBody `json:"items" validate:"required,dive"`
+}
+
+// QuoteShipping は保存した配送先へ明細の商品を配送する場合の配送料を返す
+// 配送料が設定されていない都道府県の場合は 422 を返す
+func (sc *shippingController) QuoteShipping(c echo.Context) error {
+	var req quoteShippingBody
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	quote, err := sc.su.QuoteShipping(request.QuoteShippingRequest{
+		UserId:    c.Get("user_id").(string),
+		AddressId: req.AddressId,
+		Lines:     toPlaceOrderLines(req.Items),
+	})
+	if err != nil {
+		return shippingErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, sc.sp.ToQuoteJSON(quote))
+}
+
+func shippingErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrShippingAddressNotFound), errors.Is(err, usecase.ErrItemNotFound):
+		return c.JSON(http.StatusNotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidShippingAddress), errors.Is(err, usecase.ErrInvalidShippingRate), errors.Is(err, usecase.ErrInvalidOrder):
+		return c.JSON(http.StatusBadRequest, err.Error())
+	case errors.Is(err, usecase.ErrShippingUnavailable):
+		return c.JSON(http.StatusUnprocessableEntity, err.Error())
+	}
+	return c.JSON(http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,300 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockShippingAddressUsecase struct {
+	mock.Mock
+}
+
+func (m *MockShippingAddressUsecase) GetAddresses(userId string) ([]*domain.ShippingAddress, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ShippingAddress), args.Error(1)
+}
+
+func (m *MockShippingAddressUsecase) GetAddress(userId string, addressId string) (*domain.ShippingAddress, error) {
+	args := m.Called(userId, addressId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShippingAddress), args.Error(1)
+}
+
+func (m *MockShippingAddressUsecase) CreateAddress(req request.CreateShippingAddressRequest) (*domain.ShippingAddress, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShippingAddress), args.Error(1)
+}
+
+func (m *MockShippingAddressUsecase) UpdateAddress(req request.UpdateShippingAddressRequest) (*domain.ShippingAddress, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShippingAddress), args.Error(1)
+}
+
+func (m *MockShippingAddressUsecase) DeleteAddress(userId string, addressId string) error {
+	args := m.Called(userId, addressId)
+	return args.Error(0)
+}
+
+type MockShippingUsecase struct {
+	mock.Mock
+}
+
+func (m *MockShippingUsecase) GetRateTable() (*domain.ShippingRateTable, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShippingRateTable), args.Error(1)
+}
+
+func (m *MockShippingUsecase) ReplaceRateTable(req request.ReplaceShippingRatesRequest) (*domain.ShippingRateTable, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShippingRateTable), args.Error(1)
+}
+
+func (m *MockShippingUsecase) QuoteShipping(req request.QuoteShippingRequest) (*domain.ShippingQuote, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShippingQuote), args.Error(1)
+}
+
+const shippingTestAddressId = "f47ac10b-58cc-4372-a567-0e02b2c3db20"
+
+func createShippingTestAddress() *domain.ShippingAddress {
+	userId, _ := domain.NewUserId(orderTestUserId)
+	postalCode, _ := domain.NewPostalCode("100-0001")
+	prefecture, _ := domain.NewPrefecture("東京都")
+	return domain.RestoreShippingAddress(shippingTestAddressId, *userId, domain.ShippingAddressLines{
+		RecipientName: "山田 花子",
+		PostalCode:    *postalCode,
+		Prefecture:    *prefecture,
+		City:          "千代田区",
+		AddressLine1:  "千代田1-1",
+		PhoneNumber:   "0312345678",
+	}, time.Now(), time.Now())
+}
+
+// createShippingTestQuote は東京都へ800円の表で、小計1,200円の商品を配送する見積もりを返す
+func createShippingTestQuote() *domain.ShippingQuote {
+	tokyo, _ := domain.NewPrefecture("東京都")
+	fee, _ := domain.NewMoneyFromString("800", domain.CurrencyJPY)
+	table, _ := domain.NewShippingRateTable([]domain.ShippingRate{*domain.NewShippingRate(*tokyo, *fee)}, nil)
+	subtotal, _ := domain.NewMoneyFromString("1200", domain.CurrencyJPY)
+	quote, _ := table.Quote(*tokyo, *subtotal)
+	return quote
+}
+
+func newShippingContext(e *echo.Echo, method string, body map[string]interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	jsonBody, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, "/v1/addresses", bytes.NewReader(jsonBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", orderTestUserId)
+	return c, rec
+}
+
+func TestShippingAddressController_CreateAddress(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockShippingAddressUsecase)
+	controller := NewShippingAddressController(mockUsecase)
+
+	mockUsecase.On("CreateAddress", request.CreateShippingAddressRequest{
+		UserId:        orderTestUserId,
+		RecipientName: "山田 花子",
+		PostalCode:    "1000001",
+		Prefecture:    "東京都",
+		City:          "千代田区",
+		AddressLine1:  "千代田1-1",
+		PhoneNumber:   "03-1234-5678",
+	}).Return(createShippingTestAddress(), nil)
+
+	c, rec := newShippingContext(e, http.MethodPost, map[string]interface{}{
+		"recipient_name": "山田 花子",
+		"postal_code":    "1000001",
+		"prefecture":     "東京都",
+		"city":           "千代田区",
+		"address_line1":  "千代田1-1",
+		"phone_number":   "03-1234-5678",
+	})
+	err := controller.CreateAddress(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	var response presenter.ShippingAddressResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "100-0001", response.PostalCode)
+	assert.Equal(t, 13, response.PrefectureCode)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestShippingAddressController_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "invalid address", err: fmt.Errorf("%w: unknown prefecture", usecase.ErrInvalidShippingAddress), expected: http.StatusBadRequest},
+		{name: "another user's address", err: usecase.ErrShippingAddressNotFound, expected: http.StatusNotFound},
+		{name: "unexpected", err: fmt.Errorf("connection refused"), expected: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &MockValidator{}
+			mockUsecase := new(MockShippingAddressUsecase)
+			controller := NewShippingAddressController(mockUsecase)
+			mockUsecase.On("UpdateAddress", mock.Anything).Return(nil, tt.err)
+
+			c, rec := newShippingContext(e, http.MethodPut, map[string]interface{}{"recipient_name": "山田 花子"})
+			c.SetParamNames("id")
+			c.SetParamValues(shippingTestAddressId)
+			err := controller.UpdateAddress(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
+
+func TestShippingAddressController_DeleteAddress(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockShippingAddressUsecase)
+	controller := NewShippingAddressController(mockUsecase)
+	mockUsecase.On("DeleteAddress", orderTestUserId, shippingTestAddressId).Return(nil)
+
+	c, rec := newShippingContext(e, http.MethodDelete, nil)
+	c.SetParamNames("id")
+	c.SetParamValues(shippingTestAddressId)
+	err := controller.DeleteAddress(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestShippingController_QuoteShipping(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockShippingUsecase)
+	controller := NewShippingController(mockUsecase)
+
+	mockUsecase.On("QuoteShipping", request.QuoteShippingRequest{
+		UserId:    orderTestUserId,
+		AddressId: shippingTestAddressId,
+		Lines:     []request.PlaceOrderLine{{ItemId: orderTestItemId, Quantity: 1}},
+	}).Return(createShippingTestQuote(), nil)
+
+	c, rec := newShippingContext(e, http.MethodPost, map[string]interface{}{
+		"address_id": shippingTestAddressId,
+		"items":      []map[string]interface{}{{"item_id": orderTestItemId, "quantity": 1}},
+	})
+	err := controller.QuoteShipping(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response presenter.ShippingQuoteResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "800", response.Fee)
+	assert.Equal(t, "東京都", response.Prefecture)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestShippingController_QuoteShipping_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "unknown address", err: usecase.ErrShippingAddressNotFound, expected: http.StatusNotFound},
+		{name: "unknown item", err: fmt.Errorf("%w: item x", usecase.ErrItemNotFound), expected: http.StatusNotFound},
+		{name: "invalid quantity", err: fmt.Errorf("%w: quantity", usecase.ErrInvalidOrder), expected: http.StatusBadRequest},
+		{name: "no rate for prefecture", err: fmt.Errorf("%w: no shipping rate for 沖縄県", usecase.ErrShippingUnavailable), expected: http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &MockValidator{}
+			mockUsecase := new(MockShippingUsecase)
+			controller := NewShippingController(mockUsecase)
+			mockUsecase.On("QuoteShipping", mock.Anything).Return(nil, tt.err)
+
+			c, rec := newShippingContext(e, http.MethodPost, map[string]interface{}{
+				"address_id": shippingTestAddressId,
+				"items":      []map[string]interface{}{{"item_id": orderTestItemId, "quantity": 1}},
+			})
+			err := controller.QuoteShipping(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
+
+func TestAdminShippingRateController_ReplaceRates(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockShippingUsecase)
+	controller := NewAdminShippingRateController(mockUsecase)
+	table := domain.RestoreShippingRateTable(nil, nil, time.Now())
+
+	mockUsecase.On("ReplaceRateTable", request.ReplaceShippingRatesRequest{
+		Rates:                 []request.ShippingRateRequest{{Prefecture: "東京都", Fee: "800"}},
+		FreeShippingThreshold: "5000",
+	}).Return(table, nil)
+
+	c, rec := newShippingContext(e, http.MethodPut, map[string]interface{}{
+		"rates":                   []map[string]interface{}{{"prefecture": "東京都", "fee": "800"}},
+		"free_shipping_threshold": "5000",
+	})
+	err := controller.ReplaceRates(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAdminShippingRateController_ReplaceRates_Invalid(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockShippingUsecase)
+	controller := NewAdminShippingRateController(mockUsecase)
+	mockUsecase.On("ReplaceRateTable", mock.Anything).Return(nil, fmt.Errorf("%w: duplicate shipping rate for 東京都", usecase.ErrInvalidShippingRate))
+
+	c, rec := newShippingContext(e, http.MethodPut, map[string]interface{}{
+		"rates": []map[string]interface{}{{"prefecture": "東京都", "fee": "800"}, {"prefecture": "東京都", "fee": "900"}},
+	})
+	err := controller.ReplaceRates(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
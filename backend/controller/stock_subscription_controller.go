@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+)
+
+type IStockSubscriptionController interface {
+	GetSubscriptions(c echo.Context) error
+	Subscribe(c echo.Context) error
+	Unsubscribe(c echo.Context) error
+}
+
+type stockSubscriptionController struct {
+	su usecase.IStockSubscriptionUsecase
+	sp presenter.IStockSubscriptionPresenter
+}
+
+func NewStockSubscriptionController(su usecase.IStockSubscriptionUsecase) IStockSubscriptionController {
+	sp := presenter.NewStockSubscriptionPresenter()
+	return &stockSubscriptionController{su, sp}
+}
+
+func (sc *stockSubscriptionController) GetSubscriptions(c echo.Context) error {
+	subscriptions, err := sc.su.GetSubscriptions(c.Get("user_id").(string))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, sc.sp.ToListJSON(subscriptions))
+}
+
+// Subscribe は在庫切れの商品の再入荷通知を申し込む。申し込み済みの場合も成功として扱う
+func (sc *stockSubscriptionController) Subscribe(c echo.Context) error {
+	if err := sc.su.Subscribe(c.Get("user_id").(string), c.Param("itemId")); err != nil {
+		if errors.Is(err, usecase.ErrItemNotFound) {
+			return c.JSON(http.StatusNotFound, err.Error())
+		}
+		if errors.Is(err, usecase.ErrItemInStock) {
+			return c.JSON(http.StatusConflict, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Unsubscribe は申し込みを取り消す。申し込んでいない場合も成功として扱う
+func (sc *stockSubscriptionController) Unsubscribe(c echo.Context) error {
+	if err := sc.su.Unsubscribe(c.Get("user_id").(string), c.Param("itemId")); err != nil {
+		if errors.Is(err, usecase.ErrItemNotFound) {
+			return c.JSON(http.StatusNotFound, err.Error())
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
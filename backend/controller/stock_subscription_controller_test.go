@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/presenter"
+	"github.com/posiposi/project/backend/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockStockSubscriptionUsecase struct {
+	mock.Mock
+}
+
+func (m *MockStockSubscriptionUsecase) Subscribe(userId string, itemId string) error {
+	args := m.Called(userId, itemId)
+	return args.Error(0)
+}
+
+func (m *MockStockSubscriptionUsecase) Unsubscribe(userId string, itemId string) error {
+	args := m.Called(userId, itemId)
+	return args.Error(0)
+}
+
+func (m *MockStockSubscriptionUsecase) GetSubscriptions(userId string) ([]*domain.StockSubscription, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.StockSubscription), args.Error(1)
+}
+
+func (m *MockStockSubscriptionUsecase) GetDemand() ([]*domain.ItemDemand, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ItemDemand), args.Error(1)
+}
+
+func (m *MockStockSubscriptionUsecase) DispatchNotifications() (int, error) {
+	args := m.Called()
+	return args.Int(0), args.Error(1)
+}
+
+const (
+	stockSubscriptionTestUserId = "f47ac10b-58cc-4372-a567-0e02b2c3db01"
+	stockSubscriptionTestItemId = "f47ac10b-58cc-4372-a567-0e02b2c3db05"
+)
+
+func newStockSubscriptionContext(e *echo.Echo, method string, itemId string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/v1/me/stock-subscriptions/"+itemId, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("itemId")
+	c.SetParamValues(itemId)
+	c.Set("user_id", stockSubscriptionTestUserId)
+	return c, rec
+}
+
+func TestStockSubscriptionController_GetSubscriptions(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockStockSubscriptionUsecase)
+	controller := NewStockSubscriptionController(mockUsecase)
+
+	userId, _ := domain.NewUserId(stockSubscriptionTestUserId)
+	itemId, _ := domain.NewItemId(stockSubscriptionTestItemId)
+	subscribedAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	subscriptions := []*domain.StockSubscription{domain.RestoreStockSubscription(*userId, *itemId, "メリノウール 並太", subscribedAt)}
+	mockUsecase.On("GetSubscriptions", stockSubscriptionTestUserId).Return(subscriptions, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/me/stock-subscriptions", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", stockSubscriptionTestUserId)
+	err := controller.GetSubscriptions(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response presenter.StockSubscriptionListResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Len(t, response.Subscriptions, 1)
+	assert.Equal(t, stockSubscriptionTestItemId, response.Subscriptions[0].ItemId)
+	assert.Equal(t, "メリノウール 並太", response.Subscriptions[0].ItemName)
+	assert.True(t, subscribedAt.Equal(response.Subscriptions[0].SubscribedAt))
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestStockSubscriptionController_Subscribe(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"Item Not Found", fmt.Errorf("%w: record not found", usecase.ErrItemNotFound), http.StatusNotFound},
+		{"In Stock", fmt.Errorf("%w: 3 available", usecase.ErrItemInStock), http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockUsecase := new(MockStockSubscriptionUsecase)
+			controller := NewStockSubscriptionController(mockUsecase)
+			mockUsecase.On("Subscribe", stockSubscriptionTestUserId, stockSubscriptionTestItemId).Return(tt.err)
+
+			c, rec := newStockSubscriptionContext(e, http.MethodPut, stockSubscriptionTestItemId)
+			err := controller.Subscribe(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, rec.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStockSubscriptionController_Unsubscribe(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockStockSubscriptionUsecase)
+	controller := NewStockSubscriptionController(mockUsecase)
+	mockUsecase.On("Unsubscribe", stockSubscriptionTestUserId, stockSubscriptionTestItemId).Return(nil)
+
+	c, rec := newStockSubscriptionContext(e, http.MethodDelete, stockSubscriptionTestItemId)
+	err := controller.Unsubscribe(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAdminStockSubscriptionController_GetDemand(t *testing.T) {
+	e := echo.New()
+	mockUsecase := new(MockStockSubscriptionUsecase)
+	controller := NewAdminStockSubscriptionController(mockUsecase)
+
+	itemId, _ := domain.NewItemId(stockSubscriptionTestItemId)
+	first := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	latest := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	demand := []*domain.ItemDemand{domain.RestoreItemDemand(*itemId, "メリノウール 並太", 12, first, latest)}
+	mockUsecase.On("GetDemand").Return(demand, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin/stock-subscriptions", nil)
+	rec := httptest.NewRecorder()
+	err := controller.GetDemand(e.NewContext(req, rec))
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response presenter.ItemDemandListResponseJSON
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Len(t, response.Items, 1)
+	assert.Equal(t, 12, response.Items[0].SubscriberCount)
+	assert.True(t, first.Equal(response.Items[0].FirstSubscribedAt))
+	mockUsecase.AssertExpectations(t)
+}
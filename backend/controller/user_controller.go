@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"log"
 	"net/http"
 	"os"
 	"time"
@@ -20,12 +21,13 @@ type IUserController interface {
 
 type userController struct {
 	uu usecase.IUserUsecase
+	cu usecase.ICartUsecase
 	up presenter.IUserPresenter
 }
 
-func NewUserController(uu usecase.IUserUsecase) IUserController {
+func NewUserController(uu usecase.IUserUsecase, cu usecase.ICartUsecase) IUserController {
 	up := presenter.NewUserPresenter()
-	return &userController{uu, up}
+	return &userController{uu, cu, up}
 }
 
 func (uc *userController) SignUp(c echo.Context) error {
@@ -89,6 +91,7 @@ func (uc *userController) LogIn(c echo.Context) error {
 	cookie.HttpOnly = true
 	cookie.SameSite = http.SameSiteNoneMode
 	c.SetCookie(cookie)
+	uc.mergeGuestCart(c, user.Id().Value())
 	
 	response := uc.up.ToLoginJSON(tokenString, user)
 	return c.JSON(http.StatusOK, response)
@@ -121,3 +124,20 @@ func (uc *userController) CheckAuth(c echo.Context) error {
 	response := uc.up.ToAuthCheckJSON(user)
 	return c.JSON(http.StatusOK, response)
 }
+
+// mergeGuestCart はゲストのカートをログインしたユーザーのカートに取り込み、ゲストのクッキーを削除する
+// 取り込みに失敗してもログインは成功させ、ゲストのカートは有効期限まで残す
+func (uc *userController) mergeGuestCart(c echo.Context, userId string) {
+	cookie, err := c.Cookie(guestCartCookie)
+	if err != nil {
+		return
+	}
+	guestCartId, err := uc.cu.VerifyGuestCartToken(cookie.Value)
+	if err == nil {
+		if err := uc.cu.MergeGuestCart(guestCartId, userId); err != nil {
+			log.Printf("failed to merge guest cart %s into user %s: %v", guestCartId, userId, err)
+			return
+		}
+	}
+	setGuestCartCookie(c, "", time.Now())
+}
@@ -1,8 +1,10 @@
 package controller
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -43,7 +45,7 @@ func (m *MockUserUsecase) GetUserById(userId string) (*domain.User, error) {
 func TestCheckAuth_Success(t *testing.T) {
 	e := echo.New()
 	mockUsecase := new(MockUserUsecase)
-	controller := NewUserController(mockUsecase)
+	controller := NewUserController(mockUsecase, new(MockCartUsecase))
 
 	userID := "f47ac10b-58cc-4372-a567-0e02b2c3d500"
 	userId, _ := domain.NewUserId(userID)
@@ -65,4 +67,93 @@ func TestCheckAuth_Success(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), `"authenticated":true`)
 	assert.Contains(t, rec.Body.String(), `"is_admin":true`)
 	mockUsecase.AssertExpectations(t)
-}
\ No newline at end of file
+}
+func newLoginTestUser() *domain.User {
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d501")
+	email, _ := domain.NewEmail("user@example.com")
+	password, _ := domain.NewPassword("password123")
+	role, _ := domain.NewRole("ADMINISTRATOR")
+	user, _ := domain.NewUserWithRole(userId, "Test User", email, password, role)
+	return user
+}
+
+func newLoginContext(e *echo.Echo, guestCookie string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/v1/login", strings.NewReader(`{"email":"user@example.com","password":"password123"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if guestCookie != "" {
+		req.AddCookie(&http.Cookie{Name: "guest_cart", Value: guestCookie})
+	}
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func findCookie(rec *httptest.ResponseRecorder, name string) *http.Cookie {
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	return nil
+}
+
+func TestLogIn_MergesGuestCart(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockUserUsecase)
+	mockCartUsecase := new(MockCartUsecase)
+	controller := NewUserController(mockUsecase, mockCartUsecase)
+
+	user := newLoginTestUser()
+	mockUsecase.On("Login", request.LogInRequest{Email: "user@example.com", Password: "password123"}).Return("jwt-token", user, nil)
+	mockCartUsecase.On("VerifyGuestCartToken", "guest-token").Return(cartTestGuestId, nil)
+	mockCartUsecase.On("MergeGuestCart", cartTestGuestId, user.Id().Value()).Return(nil)
+
+	c, rec := newLoginContext(e, "guest-token")
+	err := controller.LogIn(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	guestCookie := findCookie(rec, "guest_cart")
+	assert.NotNil(t, guestCookie)
+	assert.Empty(t, guestCookie.Value)
+	mockCartUsecase.AssertExpectations(t)
+}
+
+func TestLogIn_WithoutGuestCart(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockUserUsecase)
+	mockCartUsecase := new(MockCartUsecase)
+	controller := NewUserController(mockUsecase, mockCartUsecase)
+
+	mockUsecase.On("Login", mock.AnythingOfType("request.LogInRequest")).Return("jwt-token", newLoginTestUser(), nil)
+
+	c, rec := newLoginContext(e, "")
+	err := controller.LogIn(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Nil(t, findCookie(rec, "guest_cart"))
+	mockCartUsecase.AssertNotCalled(t, "MergeGuestCart", mock.Anything, mock.Anything)
+}
+
+func TestLogIn_MergeFailureDoesNotFailLogin(t *testing.T) {
+	e := echo.New()
+	e.Validator = &MockValidator{}
+	mockUsecase := new(MockUserUsecase)
+	mockCartUsecase := new(MockCartUsecase)
+	controller := NewUserController(mockUsecase, mockCartUsecase)
+
+	user := newLoginTestUser()
+	mockUsecase.On("Login", mock.AnythingOfType("request.LogInRequest")).Return("jwt-token", user, nil)
+	mockCartUsecase.On("VerifyGuestCartToken", "guest-token").Return(cartTestGuestId, nil)
+	mockCartUsecase.On("MergeGuestCart", cartTestGuestId, user.Id().Value()).Return(fmt.Errorf("database error"))
+
+	c, rec := newLoginContext(e, "guest-token")
+	err := controller.LogIn(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	// 取り込みに失敗した場合は、次回のログインで再び取り込めるようクッキーを残す
+	assert.Nil(t, findCookie(rec, "guest_cart"))
+}
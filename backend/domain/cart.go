@@ -0,0 +1,264 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxCartLineQuantity は1行に入れられる数量の上限
+	MaxCartLineQuantity = 99
+	// MaxCartLines はカートに入れられる行数の上限
+	MaxCartLines = 50
+)
+
+// ErrTooManyCartLines はカートの行数が上限に達している場合に返す
+var ErrTooManyCartLines = errors.New("too many cart lines")
+
+// CartQuantity はカートの1行に入れる数量
+type CartQuantity struct {
+	value int
+}
+
+func NewCartQuantity(value int) (*CartQuantity, error) {
+	if value < 1 || value > MaxCartLineQuantity {
+		return nil, fmt.Errorf("cart quantity must be between 1 and %d: %d", MaxCartLineQuantity, value)
+	}
+	return &CartQuantity{value: value}, nil
+}
+
+func (q CartQuantity) Value() int {
+	return q.value
+}
+
+// CartLine はカートの1行。同じ商品でもバリエーションが異なれば別の行になる
+// バリエーションのない商品では variantId は空
+type CartLine struct {
+	lineId    string
+	itemId    ItemId
+	variantId string
+	quantity  CartQuantity
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewCartLine(itemId ItemId, variantId string, quantity CartQuantity) *CartLine {
+	now := time.Now()
+	return RestoreCartLine(uuid.NewString(), itemId, variantId, quantity, now, now)
+}
+
+// RestoreCartLine は永続化済みのカートの行を復元する
+func RestoreCartLine(lineId string, itemId ItemId, variantId string, quantity CartQuantity, createdAt time.Time, updatedAt time.Time) *CartLine {
+	return &CartLine{
+		lineId:    lineId,
+		itemId:    itemId,
+		variantId: variantId,
+		quantity:  quantity,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (l *CartLine) LineId() string {
+	return l.lineId
+}
+
+func (l *CartLine) ItemId() string {
+	return l.itemId.Value()
+}
+
+// VariantId はバリエーション ID を返す。バリエーションのない商品では空
+func (l *CartLine) VariantId() string {
+	return l.variantId
+}
+
+func (l *CartLine) Quantity() int {
+	return l.quantity.Value()
+}
+
+func (l *CartLine) CreatedAt() time.Time {
+	return l.createdAt
+}
+
+func (l *CartLine) UpdatedAt() time.Time {
+	return l.updatedAt
+}
+
+// WithQuantity は数量を変更した行のコピーを返す
+func (l *CartLine) WithQuantity(quantity CartQuantity) *CartLine {
+	line := *l
+	line.quantity = quantity
+	line.updatedAt = time.Now()
+	return &line
+}
+
+// CartOwner はカートの持ち主。ログイン中のユーザーか、クッキーで識別するゲストのどちらか
+type CartOwner struct {
+	userId      string
+	guestCartId string
+}
+
+func UserCartOwner(userId UserId) CartOwner {
+	return CartOwner{userId: userId.Value()}
+}
+
+// GuestCartOwner はゲストのカートの持ち主を返す。ゲストのカートはこの ID をカート ID としてそのまま使う
+func GuestCartOwner(guestCartId string) (CartOwner, error) {
+	if _, err := uuid.Parse(guestCartId); err != nil {
+		return CartOwner{}, fmt.Errorf("invalid guest cart id: %w", err)
+	}
+	return CartOwner{guestCartId: guestCartId}, nil
+}
+
+func (o CartOwner) IsGuest() bool {
+	return o.userId == ""
+}
+
+// UserId はユーザー ID を返す。ゲストの場合は空
+func (o CartOwner) UserId() string {
+	return o.userId
+}
+
+// GuestCartId はゲストのカート ID を返す。ユーザーの場合は空
+func (o CartOwner) GuestCartId() string {
+	return o.guestCartId
+}
+
+// Cart はユーザーまたはゲストごとに1つ持つカート。行は追加した順に並んでいる
+type Cart struct {
+	cartId    string
+	owner     CartOwner
+	lines     []CartLine
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewCart は行のない新しいカートを返す。カートは最初の行を追加したときに永続化する
+func NewCart(owner CartOwner) *Cart {
+	cartId := owner.guestCartId
+	if !owner.IsGuest() {
+		cartId = uuid.NewString()
+	}
+	now := time.Now()
+	return RestoreCart(cartId, owner, nil, now, now)
+}
+
+// RestoreCart は永続化済みのカートを復元する
+func RestoreCart(cartId string, owner CartOwner, lines []CartLine, createdAt time.Time, updatedAt time.Time) *Cart {
+	copied := make([]CartLine, len(lines))
+	copy(copied, lines)
+	return &Cart{
+		cartId:    cartId,
+		owner:     owner,
+		lines:     copied,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (c *Cart) CartId() string {
+	return c.cartId
+}
+
+func (c *Cart) Owner() CartOwner {
+	return c.owner
+}
+
+// UserId はユーザー ID を返す。ゲストのカートでは空
+func (c *Cart) UserId() string {
+	return c.owner.userId
+}
+
+func (c *Cart) Lines() []CartLine {
+	lines := make([]CartLine, len(c.lines))
+	copy(lines, c.lines)
+	return lines
+}
+
+func (c *Cart) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt は最後に行を変更した日時を返す
+func (c *Cart) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// IsExpired はゲストのカートが ttl の間変更されていない場合に true を返す。ユーザーのカートは期限切れにならない
+func (c *Cart) IsExpired(now time.Time, ttl time.Duration) bool {
+	return c.owner.IsGuest() && c.updatedAt.Add(ttl).Before(now)
+}
+
+// FindLine は ID が一致する行を返す。見つからない場合は nil
+func (c *Cart) FindLine(lineId string) *CartLine {
+	for i := range c.lines {
+		if c.lines[i].lineId == lineId {
+			line := c.lines[i]
+			return &line
+		}
+	}
+	return nil
+}
+
+// AddLine は商品をカートに入れたときの行を返す。同じ商品・バリエーションの行があれば数量を合算する
+// カート自体は変更しないため、返した行を保存する必要がある
+func (c *Cart) AddLine(itemId ItemId, variantId string, quantity int) (*CartLine, error) {
+	if existing := c.findLineFor(itemId, variantId); existing != nil {
+		merged, err := NewCartQuantity(existing.Quantity() + quantity)
+		if err != nil {
+			return nil, err
+		}
+		return existing.WithQuantity(*merged), nil
+	}
+	if len(c.lines) >= MaxCartLines {
+		return nil, fmt.Errorf("%w: up to %d lines per cart", ErrTooManyCartLines, MaxCartLines)
+	}
+	q, err := NewCartQuantity(quantity)
+	if err != nil {
+		return nil, err
+	}
+	return NewCartLine(itemId, variantId, *q), nil
+}
+
+// MergeLines はゲストのカートの行をこのカートに取り込むときに保存する行と、取り込めなかった行の数を返す
+//   - 同じ商品・バリエーションの行は数量を合算し、1行の上限（MaxCartLineQuantity）で打ち切る
+//   - それ以外の行はゲストのカートに入れた順に追加し、行数の上限（MaxCartLines）を超える分は取り込まない
+//
+// 在庫はここでは確認しない。取り込んだ行も他の行と同じく取得時に在庫と照らし合わせる
+func (c *Cart) MergeLines(guest *Cart) ([]*CartLine, int) {
+	merged := make([]*CartLine, 0, len(guest.lines))
+	lineCount := len(c.lines)
+	dropped := 0
+	for _, guestLine := range guest.lines {
+		existing := c.findLineFor(guestLine.itemId, guestLine.variantId)
+		if existing != nil {
+			quantity := existing.Quantity() + guestLine.Quantity()
+			if quantity > MaxCartLineQuantity {
+				quantity = MaxCartLineQuantity
+			}
+			q, _ := NewCartQuantity(quantity)
+			merged = append(merged, existing.WithQuantity(*q))
+			continue
+		}
+		if lineCount >= MaxCartLines {
+			dropped++
+			continue
+		}
+		merged = append(merged, NewCartLine(guestLine.itemId, guestLine.variantId, guestLine.quantity))
+		lineCount++
+	}
+	return merged, dropped
+}
+
+func (c *Cart) findLineFor(itemId ItemId, variantId string) *CartLine {
+	for i := range c.lines {
+		if c.lines[i].itemId.Value() == itemId.Value() && c.lines[i].variantId == variantId {
+			line := c.lines[i]
+			return &line
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package domain
+
+import "fmt"
+
+// CartLineStatus はカートの行を現在の商品の状態と照らし合わせた結果
+type CartLineStatus string
+
+const (
+	// CartLineAvailable は数量分の在庫があり購入できる
+	CartLineAvailable CartLineStatus = "available"
+	// CartLineInsufficientStock は在庫はあるが数量に足りない
+	CartLineInsufficientStock CartLineStatus = "insufficient_stock"
+	// CartLineOutOfStock は販売可能数が0
+	CartLineOutOfStock CartLineStatus = "out_of_stock"
+	// CartLineUnavailable は商品またはバリエーションが削除されている
+	CartLineUnavailable CartLineStatus = "unavailable"
+)
+
+// PricedCartLine は現在の商品の状態で評価したカートの行
+// 商品が削除されている場合、Item・UnitPrice・LineTotal は nil になる
+type PricedCartLine struct {
+	line      CartLine
+	item      *Item
+	variant   *ItemVariant
+	status    CartLineStatus
+	available int
+	unitPrice *Money
+}
+
+func (l *PricedCartLine) Line() *CartLine {
+	line := l.line
+	return &line
+}
+
+func (l *PricedCartLine) Item() *Item {
+	return l.item
+}
+
+// Variant はバリエーションを返す。バリエーションのない商品の行や、バリエーションが削除された行では nil
+func (l *PricedCartLine) Variant() *ItemVariant {
+	return l.variant
+}
+
+func (l *PricedCartLine) Status() CartLineStatus {
+	return l.status
+}
+
+// Available は現在の販売可能数を返す
+func (l *PricedCartLine) Available() int {
+	return l.available
+}
+
+// UnitPrice は税抜の単価を返す。バリエーションの上書き価格があればそれを使う
+func (l *PricedCartLine) UnitPrice() *Money {
+	return l.unitPrice
+}
+
+// LineTotal は税抜の単価 × 数量を返す
+func (l *PricedCartLine) LineTotal() *Money {
+	if l.unitPrice == nil {
+		return nil
+	}
+	return l.unitPrice.Multiply(l.line.Quantity())
+}
+
+// CartSummary はカートを現在の商品の状態で評価した結果
+// 合計には購入できる行だけを含める。消費税は行ごとではなく小計に対して税率ごとに計算する
+type CartSummary struct {
+	cart      Cart
+	lines     []PricedCartLine
+	subtotal  Money
+	breakdown TaxBreakdown
+}
+
+// PriceCart はカートの各行を商品の現在の在庫・価格・削除状態で評価する
+// items には削除されていない商品だけを渡す。含まれない商品の行は購入できない行として扱う
+func PriceCart(cart *Cart, items Items) (*CartSummary, error) {
+	itemsById := make(map[string]*Item, len(items))
+	for i := range items {
+		itemsById[items[i].ItemId()] = &items[i]
+	}
+
+	summary := &CartSummary{cart: *cart, subtotal: ZeroYen()}
+	purchasable := 0
+	var amounts []taxableAmount
+	for _, line := range cart.lines {
+		priced := priceCartLine(line, itemsById[line.ItemId()])
+		if priced.status == CartLineAvailable {
+			if purchasable == 0 {
+				summary.subtotal = Money{currency: priced.unitPrice.currency}
+			}
+			subtotal, err := summary.subtotal.Add(*priced.LineTotal())
+			if err != nil {
+				return nil, fmt.Errorf("cart mixes currencies: %w", err)
+			}
+			summary.subtotal = *subtotal
+			amounts = append(amounts, taxableAmount{rate: priced.item.TaxRate(), amount: *priced.LineTotal()})
+			purchasable++
+		}
+		summary.lines = append(summary.lines, priced)
+	}
+	breakdown, err := newTaxBreakdown(amounts, Money{currency: summary.subtotal.currency})
+	if err != nil {
+		return nil, err
+	}
+	summary.breakdown = *breakdown
+	return summary, nil
+}
+
+func priceCartLine(line CartLine, item *Item) PricedCartLine {
+	priced := PricedCartLine{line: line, status: CartLineUnavailable}
+	if item == nil {
+		return priced
+	}
+	priced.item = item
+
+	stock := item.Stock()
+	price := item.Price()
+	if line.variantId != "" {
+		variant := item.Variants().FindByID(line.variantId)
+		if variant == nil {
+			return priced
+		}
+		priced.variant = variant
+		stock = variant.Stock()
+		price = variant.EffectivePrice(*item.Price())
+	} else if len(item.Variants()) > 0 {
+		// バリエーションが後から追加された商品は、どのバリエーションを買うか決まらない
+		return priced
+	}
+
+	priced.unitPrice = price
+	priced.available = stock.Available()
+	switch {
+	case stock.Available() == 0:
+		priced.status = CartLineOutOfStock
+	case stock.Available() < line.Quantity():
+		priced.status = CartLineInsufficientStock
+	default:
+		priced.status = CartLineAvailable
+	}
+	return priced
+}
+
+func (s *CartSummary) Cart() *Cart {
+	cart := s.cart
+	return &cart
+}
+
+func (s *CartSummary) Lines() []PricedCartLine {
+	lines := make([]PricedCartLine, len(s.lines))
+	copy(lines, s.lines)
+	return lines
+}
+
+// ItemCount は購入できる行の数量の合計を返す
+func (s *CartSummary) ItemCount() int {
+	count := 0
+	for _, line := range s.lines {
+		if line.status == CartLineAvailable {
+			count += line.line.Quantity()
+		}
+	}
+	return count
+}
+
+// Subtotal は購入できる行の税抜合計を返す
+func (s *CartSummary) Subtotal() *Money {
+	subtotal := s.subtotal
+	return &subtotal
+}
+
+// Tax は購入できる行の消費税額を返す。税率ごとに計算した消費税額の合計
+func (s *CartSummary) Tax() *Money {
+	return s.breakdown.Tax()
+}
+
+// Total は購入できる行の税込合計を返す
+func (s *CartSummary) Total() *Money {
+	return s.breakdown.Total()
+}
+
+// CheckoutReady はカートが空でなく、すべての行が購入できる場合に true を返す
+func (s *CartSummary) CheckoutReady() bool {
+	if len(s.lines) == 0 {
+		return false
+	}
+	for _, line := range s.lines {
+		if line.status != CartLineAvailable {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCartTestItem(t *testing.T, onHand int, reserved int, price string) *Item {
+	t.Helper()
+	userId, _ := NewUserId(uuid.NewString())
+	itemName, _ := NewItemName("Merino Wool")
+	stock, err := NewStock(onHand, reserved, 0)
+	if err != nil {
+		t.Fatalf("Failed to create stock: %v", err)
+	}
+	description, _ := NewDescription("Soft yarn")
+	money, _ := NewMoneyFromString(price, CurrencyJPY)
+	item, err := NewItem(nil, *userId, *itemName, *stock, *description, *money)
+	if err != nil {
+		t.Fatalf("Failed to create item: %v", err)
+	}
+	return item
+}
+
+func newTestCart(lines ...CartLine) *Cart {
+	userId, _ := NewUserId(uuid.NewString())
+	cart := NewCart(UserCartOwner(*userId))
+	cart.lines = lines
+	return cart
+}
+
+func newTestCartLine(t *testing.T, item *Item, variantId string, quantity int) CartLine {
+	t.Helper()
+	itemId, _ := NewItemId(item.ItemId())
+	q, err := NewCartQuantity(quantity)
+	if err != nil {
+		t.Fatalf("Failed to create quantity: %v", err)
+	}
+	return *NewCartLine(*itemId, variantId, *q)
+}
+
+func TestNewCartQuantity(t *testing.T) {
+	for _, value := range []int{1, MaxCartLineQuantity} {
+		_, err := NewCartQuantity(value)
+		assert.NoError(t, err)
+	}
+	for _, value := range []int{-1, 0, MaxCartLineQuantity + 1} {
+		_, err := NewCartQuantity(value)
+		assert.Error(t, err)
+	}
+}
+
+func TestCart_AddLine(t *testing.T) {
+	item := newCartTestItem(t, 10, 0, "500")
+	existing := newTestCartLine(t, item, "", 2)
+	cart := newTestCart(existing)
+	itemId, _ := NewItemId(item.ItemId())
+
+	merged, err := cart.AddLine(*itemId, "", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, existing.LineId(), merged.LineId())
+	assert.Equal(t, 5, merged.Quantity())
+
+	variantLine, err := cart.AddLine(*itemId, "variant-1", 1)
+	assert.NoError(t, err)
+	assert.NotEqual(t, existing.LineId(), variantLine.LineId())
+
+	_, err = cart.AddLine(*itemId, "", MaxCartLineQuantity)
+	assert.Error(t, err)
+
+	full := newTestCart()
+	for i := 0; i < MaxCartLines; i++ {
+		full.lines = append(full.lines, newTestCartLine(t, newCartTestItem(t, 1, 0, "100"), "", 1))
+	}
+	_, err = full.AddLine(*itemId, "", 1)
+	assert.True(t, errors.Is(err, ErrTooManyCartLines))
+}
+
+func TestGuestCartOwner(t *testing.T) {
+	guestCartId := uuid.NewString()
+	owner, err := GuestCartOwner(guestCartId)
+	assert.NoError(t, err)
+	assert.True(t, owner.IsGuest())
+
+	cart := NewCart(owner)
+	assert.Equal(t, guestCartId, cart.CartId())
+	assert.Empty(t, cart.UserId())
+
+	_, err = GuestCartOwner("not-a-uuid")
+	assert.Error(t, err)
+}
+
+func TestCart_IsExpired(t *testing.T) {
+	now := time.Now()
+	owner, _ := GuestCartOwner(uuid.NewString())
+	guest := RestoreCart(owner.GuestCartId(), owner, nil, now.Add(-48*time.Hour), now.Add(-25*time.Hour))
+	assert.True(t, guest.IsExpired(now, 24*time.Hour))
+	assert.False(t, guest.IsExpired(now, 48*time.Hour))
+
+	user := newTestCart()
+	assert.False(t, user.IsExpired(now.Add(365*24*time.Hour), time.Hour))
+}
+
+func TestCart_MergeLines(t *testing.T) {
+	yarn := newCartTestItem(t, 10, 0, "500")
+	needles := newCartTestItem(t, 10, 0, "300")
+	existing := newTestCartLine(t, yarn, "", 90)
+	userCart := newTestCart(existing)
+
+	guestOwner, _ := GuestCartOwner(uuid.NewString())
+	guestCart := NewCart(guestOwner)
+	guestCart.lines = []CartLine{
+		newTestCartLine(t, yarn, "", 20),
+		newTestCartLine(t, needles, "", 2),
+	}
+
+	lines, dropped := userCart.MergeLines(guestCart)
+
+	assert.Equal(t, 0, dropped)
+	assert.Len(t, lines, 2)
+	// 同じ商品の行は既存の行に合算し、1行の上限で打ち切る
+	assert.Equal(t, existing.LineId(), lines[0].LineId())
+	assert.Equal(t, MaxCartLineQuantity, lines[0].Quantity())
+	// ゲストのカートにだけある行は新しい行として追加する
+	assert.Equal(t, needles.ItemId(), lines[1].ItemId())
+	assert.Equal(t, 2, lines[1].Quantity())
+	assert.NotEqual(t, guestCart.lines[1].LineId(), lines[1].LineId())
+}
+
+func TestCart_MergeLines_DropsLinesOverLimit(t *testing.T) {
+	yarn := newCartTestItem(t, 10, 0, "500")
+	full := newTestCart(newTestCartLine(t, yarn, "", 1))
+	for i := 1; i < MaxCartLines-1; i++ {
+		full.lines = append(full.lines, newTestCartLine(t, newCartTestItem(t, 1, 0, "100"), "", 1))
+	}
+	guestOwner, _ := GuestCartOwner(uuid.NewString())
+	guestCart := NewCart(guestOwner)
+	guestCart.lines = []CartLine{
+		newTestCartLine(t, newCartTestItem(t, 1, 0, "100"), "", 1),
+		newTestCartLine(t, newCartTestItem(t, 1, 0, "100"), "", 1),
+		newTestCartLine(t, yarn, "", 1),
+	}
+
+	lines, dropped := full.MergeLines(guestCart)
+
+	// 行数の上限に達した後も、既存の行への合算は取り込む
+	assert.Equal(t, 1, dropped)
+	assert.Len(t, lines, 2)
+	assert.Equal(t, full.lines[0].LineId(), lines[1].LineId())
+	assert.Equal(t, 2, lines[1].Quantity())
+}
+
+func TestPriceCart(t *testing.T) {
+	available := newCartTestItem(t, 5, 0, "1000")
+	short := newCartTestItem(t, 3, 1, "300")
+	soldOut := newCartTestItem(t, 2, 2, "800")
+	deleted := newCartTestItem(t, 5, 0, "1200")
+
+	withVariants := newCartTestItem(t, 0, 0, "2000")
+	withVariantsId, _ := NewItemId(withVariants.ItemId())
+	sku, _ := NewSkuCode("SOCK-L")
+	options, _ := NewVariantOptions(map[string]string{"size": "L"})
+	variantStock, _ := NewStock(4, 0, 0)
+	override, _ := NewMoneyFromString("2500", CurrencyJPY)
+	variant := NewItemVariant(*withVariantsId, *sku, *options, *variantStock, override)
+	withVariants, _ = withVariants.WithVariants(ItemVariants{*variant})
+
+	cart := newTestCart(
+		newTestCartLine(t, available, "", 2),
+		newTestCartLine(t, short, "", 3),
+		newTestCartLine(t, soldOut, "", 1),
+		newTestCartLine(t, deleted, "", 1),
+		newTestCartLine(t, withVariants, variant.VariantId(), 1),
+		newTestCartLine(t, withVariants, "removed-variant", 1),
+		newTestCartLine(t, withVariants, "", 1),
+	)
+
+	summary, err := PriceCart(cart, Items{*available, *short, *soldOut, *withVariants})
+
+	assert.NoError(t, err)
+	lines := summary.Lines()
+	statuses := make([]CartLineStatus, len(lines))
+	for i := range lines {
+		statuses[i] = lines[i].Status()
+	}
+	assert.Equal(t, []CartLineStatus{
+		CartLineAvailable,
+		CartLineInsufficientStock,
+		CartLineOutOfStock,
+		CartLineUnavailable,
+		CartLineAvailable,
+		CartLineUnavailable,
+		CartLineUnavailable,
+	}, statuses)
+
+	assert.Equal(t, "2000", lines[0].LineTotal().String())
+	assert.Equal(t, 2, lines[1].Available())
+	assert.Nil(t, lines[3].Item())
+	assert.Nil(t, lines[3].LineTotal())
+	assert.Equal(t, "2500", lines[4].UnitPrice().String())
+
+	assert.Equal(t, 3, summary.ItemCount())
+	assert.Equal(t, "4500", summary.Subtotal().String())
+	assert.Equal(t, "4950", summary.Subtotal().TaxIncluded().String())
+	assert.False(t, summary.CheckoutReady())
+}
+
+func TestPriceCart_Empty(t *testing.T) {
+	summary, err := PriceCart(newTestCart(), nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0", summary.Subtotal().String())
+	assert.Equal(t, CurrencyJPY, summary.Subtotal().Currency())
+	assert.False(t, summary.CheckoutReady())
+}
+
+func TestPriceCart_CheckoutReady(t *testing.T) {
+	item := newCartTestItem(t, 5, 0, "1000")
+	summary, err := PriceCart(newTestCart(newTestCartLine(t, item, "", 5)), Items{*item})
+
+	assert.NoError(t, err)
+	assert.True(t, summary.CheckoutReady())
+}
@@ -0,0 +1,73 @@
+package domain
+
+import "fmt"
+
+// Categories は全カテゴリの一覧。ツリーの組み立てや親子関係の検証に使う
+type Categories []Category
+
+// CategoryNode はカテゴリツリーの1ノード
+type CategoryNode struct {
+	Category Category
+	Children []*CategoryNode
+}
+
+// Tree は親子関係に従ってカテゴリを木構造に並べ、根のノードの一覧を返す
+// 兄弟の並びは一覧の順序を保つ。親が見つからないカテゴリは根として扱う
+func (cs Categories) Tree() []*CategoryNode {
+	nodes := make(map[string]*CategoryNode, len(cs))
+	for _, category := range cs {
+		nodes[category.CategoryId()] = &CategoryNode{Category: category, Children: []*CategoryNode{}}
+	}
+
+	roots := []*CategoryNode{}
+	for _, category := range cs {
+		node := nodes[category.CategoryId()]
+		parent, ok := nodes[category.ParentId()]
+		if category.IsRoot() || !ok {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+	return roots
+}
+
+func (cs Categories) FindByID(categoryId string) *Category {
+	for i := range cs {
+		if cs[i].CategoryId() == categoryId {
+			category := cs[i]
+			return &category
+		}
+	}
+	return nil
+}
+
+// CheckParent は categoryId のカテゴリを parentId の下に置けるかを確認する
+// 親に自身や子孫を指定すると循環するため受け付けない
+func (cs Categories) CheckParent(categoryId string, parentId string) error {
+	if parentId == "" {
+		return nil
+	}
+	if cs.FindByID(parentId) == nil {
+		return fmt.Errorf("parent category %s not found", parentId)
+	}
+
+	// 親から根に向かってたどり、途中で自身に行き着いたら循環している
+	visited := map[string]bool{}
+	for current := parentId; current != ""; {
+		if current == categoryId {
+			return fmt.Errorf("%w: %s", ErrCategoryCycle, categoryId)
+		}
+		if visited[current] {
+			return fmt.Errorf("%w: %s", ErrCategoryCycle, current)
+		}
+		visited[current] = true
+
+		category := cs.FindByID(current)
+		if category == nil {
+			return nil
+		}
+		current = category.ParentId()
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrCategoryCycle は親カテゴリに自身または子孫を指定した場合に返す
+	ErrCategoryCycle = errors.New("category cannot be moved under itself or its descendants")
+	// ErrCategoryInUse は商品が登録されているカテゴリを削除しようとした場合に返す
+	ErrCategoryInUse = errors.New("category still has items")
+	// ErrCategoryHasChildren は子カテゴリを持つカテゴリを削除しようとした場合に返す
+	ErrCategoryHasChildren = errors.New("category still has child categories")
+	// ErrDuplicateCategorySlug はスラッグが他のカテゴリで使われている場合に返す
+	ErrDuplicateCategorySlug = errors.New("duplicate category slug")
+)
+
+type CategoryId struct {
+	value string
+}
+
+func NewCategoryId(value string) (*CategoryId, error) {
+	if uuid.Validate(value) != nil {
+		return nil, fmt.Errorf("invalid UUID: %s", value)
+	}
+	categoryId := new(CategoryId)
+	categoryId.value = value
+	return categoryId, nil
+}
+
+func (categoryId *CategoryId) Value() string {
+	return categoryId.value
+}
+
+var categorySlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// CategorySlug は URL やクエリパラメータでカテゴリを指定するための識別子（例: wool, finished-goods）
+type CategorySlug struct {
+	value string
+}
+
+func NewCategorySlug(value string) (*CategorySlug, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if len(value) == 0 {
+		return nil, fmt.Errorf("category slug must not be empty")
+	}
+	if len(value) > 64 {
+		return nil, fmt.Errorf("category slug must be 64 characters or less")
+	}
+	if !categorySlugPattern.MatchString(value) {
+		return nil, fmt.Errorf("category slug must consist of lowercase letters, digits and single hyphens: %q", value)
+	}
+	slug := new(CategorySlug)
+	slug.value = value
+	return slug, nil
+}
+
+func (slug *CategorySlug) Value() string {
+	return slug.value
+}
+
+// Category は商品を分類するカテゴリ。親を持たないカテゴリがツリーの根になる
+type Category struct {
+	categoryId CategoryId
+	parentId   *CategoryId
+	name       string
+	slug       CategorySlug
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+func NewCategory(parentId *CategoryId, name string, slug CategorySlug) (*Category, error) {
+	now := time.Now()
+	return RestoreCategory(CategoryId{value: uuid.NewString()}, parentId, name, slug, now, now)
+}
+
+// RestoreCategory は永続化済みのカテゴリを復元する
+func RestoreCategory(categoryId CategoryId, parentId *CategoryId, name string, slug CategorySlug, createdAt time.Time, updatedAt time.Time) (*Category, error) {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		return nil, fmt.Errorf("category name must not be empty")
+	}
+	if utf8.RuneCountInString(name) > 64 {
+		return nil, fmt.Errorf("category name must be 64 characters or less")
+	}
+	if parentId != nil && parentId.Value() == categoryId.Value() {
+		return nil, ErrCategoryCycle
+	}
+
+	var parent *CategoryId
+	if parentId != nil {
+		id := *parentId
+		parent = &id
+	}
+	return &Category{
+		categoryId: categoryId,
+		parentId:   parent,
+		name:       name,
+		slug:       slug,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}, nil
+}
+
+func (c *Category) CategoryId() string {
+	return c.categoryId.Value()
+}
+
+// ParentId は親カテゴリの ID を返す。根のカテゴリでは空文字
+func (c *Category) ParentId() string {
+	if c.parentId == nil {
+		return ""
+	}
+	return c.parentId.Value()
+}
+
+func (c *Category) IsRoot() bool {
+	return c.parentId == nil
+}
+
+func (c *Category) Name() string {
+	return c.name
+}
+
+func (c *Category) Slug() string {
+	return c.slug.Value()
+}
+
+func (c *Category) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Category) UpdatedAt() time.Time {
+	return c.updatedAt
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCategory(t *testing.T, parent *Category, slug string) *Category {
+	t.Helper()
+	var parentId *CategoryId
+	if parent != nil {
+		parentId, _ = NewCategoryId(parent.CategoryId())
+	}
+	categorySlug, err := NewCategorySlug(slug)
+	if err != nil {
+		t.Fatalf("Failed to create slug: %v", err)
+	}
+	category, err := NewCategory(parentId, slug, *categorySlug)
+	if err != nil {
+		t.Fatalf("Failed to create category: %v", err)
+	}
+	return category
+}
+
+func TestNewCategorySlug(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"lowercase", "wool", "wool", false},
+		{"hyphenated", "finished-goods", "finished-goods", false},
+		{"normalized", " Fingering ", "fingering", false},
+		{"empty", "", "", true},
+		{"double hyphen", "finished--goods", "", true},
+		{"trailing hyphen", "wool-", "", true},
+		{"underscore", "finished_goods", "", true},
+		{"multibyte", "毛糸", "", true},
+		{"too long", strings.Repeat("a", 65), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slug, err := NewCategorySlug(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, slug)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, slug.Value())
+		})
+	}
+}
+
+func TestNewCategory_InvalidName(t *testing.T) {
+	slug, _ := NewCategorySlug("wool")
+
+	_, err := NewCategory(nil, "  ", *slug)
+	assert.Error(t, err)
+
+	_, err = NewCategory(nil, strings.Repeat("毛", 65), *slug)
+	assert.Error(t, err)
+}
+
+func TestRestoreCategory_SelfParent(t *testing.T) {
+	categoryId, _ := NewCategoryId(uuid.NewString())
+	slug, _ := NewCategorySlug("wool")
+
+	_, err := RestoreCategory(*categoryId, categoryId, "Wool", *slug, time.Now(), time.Now())
+	assert.True(t, errors.Is(err, ErrCategoryCycle))
+}
+
+func TestCategoriesTree(t *testing.T) {
+	yarn := newTestCategory(t, nil, "yarn")
+	wool := newTestCategory(t, yarn, "wool")
+	fingering := newTestCategory(t, wool, "fingering")
+	cotton := newTestCategory(t, yarn, "cotton")
+	goods := newTestCategory(t, nil, "finished-goods")
+
+	roots := Categories{*yarn, *wool, *fingering, *cotton, *goods}.Tree()
+
+	assert.Len(t, roots, 2)
+	assert.Equal(t, "yarn", roots[0].Category.Slug())
+	assert.Equal(t, "finished-goods", roots[1].Category.Slug())
+	assert.Len(t, roots[0].Children, 2)
+	assert.Equal(t, "wool", roots[0].Children[0].Category.Slug())
+	assert.Equal(t, "cotton", roots[0].Children[1].Category.Slug())
+	assert.Equal(t, "fingering", roots[0].Children[0].Children[0].Category.Slug())
+	assert.Empty(t, roots[1].Children)
+}
+
+func TestCategoriesCheckParent(t *testing.T) {
+	yarn := newTestCategory(t, nil, "yarn")
+	wool := newTestCategory(t, yarn, "wool")
+	fingering := newTestCategory(t, wool, "fingering")
+	goods := newTestCategory(t, nil, "finished-goods")
+	categories := Categories{*yarn, *wool, *fingering, *goods}
+
+	assert.NoError(t, categories.CheckParent(wool.CategoryId(), goods.CategoryId()))
+	assert.NoError(t, categories.CheckParent(wool.CategoryId(), ""))
+	assert.True(t, errors.Is(categories.CheckParent(yarn.CategoryId(), fingering.CategoryId()), ErrCategoryCycle))
+	assert.True(t, errors.Is(categories.CheckParent(wool.CategoryId(), wool.CategoryId()), ErrCategoryCycle))
+	assert.Error(t, categories.CheckParent(wool.CategoryId(), uuid.NewString()))
+}
+
+func TestNewTagNames(t *testing.T) {
+	names, err := NewTagNames([]string{" Hand  Knit ", "hand knit", "ウール"})
+	assert.NoError(t, err)
+	assert.Len(t, names, 2)
+	assert.Equal(t, "hand knit", names[0].Value())
+	assert.Equal(t, "ウール", names[1].Value())
+
+	_, err = NewTagNames([]string{"wool,cotton"})
+	assert.Error(t, err)
+
+	_, err = NewTagNames([]string{" "})
+	assert.Error(t, err)
+
+	tooMany := make([]string, 21)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("tag%d", i)
+	}
+	_, err = NewTagNames(tooMany)
+	assert.Error(t, err)
+}
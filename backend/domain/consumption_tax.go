@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// StandardTaxRate は消費税の標準税率 10%
+var StandardTaxRate = decimal.RequireFromString("0.10")
+
+// 商品の価格は税抜で保持し、表示時に税込価格を計算する
+// 1円未満の端数は切り捨てる
+
+// TaxAmount は税抜価格に対する標準税率の消費税額を返す
+func (m *Money) TaxAmount() *Money {
+	return m.TaxAmountAt(TaxRateStandard)
+}
+
+// TaxIncluded は税抜価格から標準税率の税込価格を返す
+func (m *Money) TaxIncluded() *Money {
+	return m.TaxIncludedAt(TaxRateStandard)
+}
+
+// TaxIncludedDisplay は総額表示用の文字列を返す（例: "¥1,100（税込）"）
+func (m *Money) TaxIncludedDisplay() string {
+	return fmt.Sprintf("%s（税込）", m.TaxIncluded().display())
+}
+
+// TaxIncludedAmountDisplay は税込の金額をそのまま総額表示用の文字列にする。割引後の合計など、計算済みの税込金額に使う
+func (m *Money) TaxIncludedAmountDisplay() string {
+	return fmt.Sprintf("%s（税込）", m.display())
+}
+
+// TaxExcludedDisplay は税抜価格の表示用文字列を返す（例: "¥1,000（税抜）"）
+func (m *Money) TaxExcludedDisplay() string {
+	return fmt.Sprintf("%s（税抜）", m.display())
+}
+
+// Display は税込・税抜の区別を付けずに金額を表示する。領収書の内訳のように、見出しで区別が分かる箇所に使う
+func (m *Money) Display() string {
+	return m.display()
+}
+
+// display は通貨記号と3桁区切りを付けた金額を返す
+func (m *Money) display() string {
+	integer, fraction, hasFraction := strings.Cut(m.String(), ".")
+	if hasFraction {
+		fraction = "." + fraction
+	}
+
+	var grouped []byte
+	for i := range len(integer) {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped = append(grouped, ',')
+		}
+		grouped = append(grouped, integer[i])
+	}
+	return currencySymbol(m.currency) + string(grouped) + fraction
+}
+
+func currencySymbol(currency string) string {
+	switch currency {
+	case CurrencyJPY:
+		return "¥"
+	case "USD":
+		return "$"
+	case "EUR":
+		return "€"
+	}
+	return currency + " "
+}
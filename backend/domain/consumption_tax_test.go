@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyTaxIncluded(t *testing.T) {
+	tests := []struct {
+		price       string
+		currency    string
+		wantTax     string
+		wantWithTax string
+	}{
+		{"1000", CurrencyJPY, "100", "1100"},
+		// 1円未満は切り捨て
+		{"1234", CurrencyJPY, "123", "1357"},
+		{"9", CurrencyJPY, "0", "9"},
+		{"0", CurrencyJPY, "0", "0"},
+		{"10.05", "USD", "1.00", "11.05"},
+	}
+	for _, tt := range tests {
+		price, _ := NewMoneyFromString(tt.price, tt.currency)
+		assert.Equal(t, tt.wantTax, price.TaxAmount().String(), tt.price)
+		assert.Equal(t, tt.wantWithTax, price.TaxIncluded().String(), tt.price)
+	}
+}
+
+func TestMoneyTaxDisplay(t *testing.T) {
+	price, _ := NewMoneyFromString("1000", CurrencyJPY)
+	assert.Equal(t, "¥1,100（税込）", price.TaxIncludedDisplay())
+	assert.Equal(t, "¥1,000（税抜）", price.TaxExcludedDisplay())
+	assert.Equal(t, "¥1,000（税込）", price.TaxIncludedAmountDisplay())
+
+	price, _ = NewMoneyFromString("1234567", CurrencyJPY)
+	assert.Equal(t, "¥1,234,567（税抜）", price.TaxExcludedDisplay())
+
+	price, _ = NewMoneyFromString("980", CurrencyJPY)
+	assert.Equal(t, "¥1,078（税込）", price.TaxIncludedDisplay())
+
+	price, _ = NewMoneyFromString("1234.5", "USD")
+	assert.Equal(t, "$1,234.50（税抜）", price.TaxExcludedDisplay())
+}
@@ -0,0 +1,370 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrCouponNotFound は指定したコードのクーポンが存在しない場合に返す
+	ErrCouponNotFound = errors.New("coupon not found")
+	// ErrCouponNotApplicable は有効期間外、利用回数の上限に達した、最低購入金額に満たないなどの理由でクーポンを使えない場合に返す
+	ErrCouponNotApplicable = errors.New("coupon not applicable")
+	// ErrDuplicateCouponCode はコードが他のクーポンで使われている場合に返す
+	ErrDuplicateCouponCode = errors.New("duplicate coupon code")
+	// ErrCouponInUse は利用されたことのあるクーポンを削除しようとした場合に返す
+	ErrCouponInUse = errors.New("coupon has been redeemed")
+)
+
+var couponCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{2,31}$`)
+
+// CouponCode は購入者が入力するクーポンのコード。大文字小文字を区別しないよう大文字に揃える
+type CouponCode struct {
+	value string
+}
+
+func NewCouponCode(value string) (*CouponCode, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if !couponCodePattern.MatchString(value) {
+		return nil, fmt.Errorf("coupon code must be 3 to 32 letters, digits, hyphens or underscores: %q", value)
+	}
+	return &CouponCode{value: value}, nil
+}
+
+func (c *CouponCode) Value() string {
+	return c.value
+}
+
+// DiscountType は割引の種類
+type DiscountType string
+
+const (
+	// DiscountPercent は対象金額の一定割合を割り引く
+	DiscountPercent DiscountType = "percent"
+	// DiscountFixed は一定額を割り引く。対象金額を超える分は割り引かない
+	DiscountFixed DiscountType = "fixed"
+)
+
+// Discount はクーポンの割引内容
+type Discount struct {
+	discountType DiscountType
+	percentOff   int
+	amountOff    Money
+}
+
+// NewPercentDiscount は 1〜100% の割引を作る
+func NewPercentDiscount(percentOff int) (*Discount, error) {
+	if percentOff < 1 || percentOff > 100 {
+		return nil, fmt.Errorf("percent off must be between 1 and 100: %d", percentOff)
+	}
+	return &Discount{discountType: DiscountPercent, percentOff: percentOff}, nil
+}
+
+func NewFixedDiscount(amountOff Money) (*Discount, error) {
+	if !amountOff.amount.IsPositive() {
+		return nil, fmt.Errorf("amount off must be positive")
+	}
+	return &Discount{discountType: DiscountFixed, amountOff: amountOff}, nil
+}
+
+func (d *Discount) Type() DiscountType {
+	return d.discountType
+}
+
+// PercentOff は割引率を返す。定額の割引では 0
+func (d *Discount) PercentOff() int {
+	return d.percentOff
+}
+
+// AmountOff は割引額を返す。割合の割引では nil
+func (d *Discount) AmountOff() *Money {
+	if d.discountType != DiscountFixed {
+		return nil
+	}
+	amountOff := d.amountOff
+	return &amountOff
+}
+
+// amountFor は対象金額に対する割引額を返す。1円未満の端数は切り捨て、対象金額を超えない
+func (d *Discount) amountFor(eligible Money) (*Money, error) {
+	if d.discountType == DiscountPercent {
+		return eligible.roundDown(eligible.amount.Mul(decimal.NewFromInt(int64(d.percentOff))).Div(decimal.NewFromInt(100))), nil
+	}
+	if d.amountOff.currency != eligible.currency {
+		return nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, d.amountOff.currency, eligible.currency)
+	}
+	if d.amountOff.amount.GreaterThan(eligible.amount) {
+		return &eligible, nil
+	}
+	amountOff := d.amountOff
+	return &amountOff, nil
+}
+
+// CouponValidity はクーポンの有効期間。開始・終了は省略でき、終了日時ちょうどからは使えない
+type CouponValidity struct {
+	startsAt *time.Time
+	endsAt   *time.Time
+}
+
+func NewCouponValidity(startsAt *time.Time, endsAt *time.Time) (*CouponValidity, error) {
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		return nil, fmt.Errorf("coupon must end after it starts")
+	}
+	return &CouponValidity{startsAt: startsAt, endsAt: endsAt}, nil
+}
+
+func (v *CouponValidity) StartsAt() *time.Time {
+	return v.startsAt
+}
+
+func (v *CouponValidity) EndsAt() *time.Time {
+	return v.endsAt
+}
+
+func (v *CouponValidity) check(now time.Time) error {
+	if v.startsAt != nil && now.Before(*v.startsAt) {
+		return fmt.Errorf("%w: coupon is valid from %s", ErrCouponNotApplicable, v.startsAt.Format(time.RFC3339))
+	}
+	if v.endsAt != nil && !now.Before(*v.endsAt) {
+		return fmt.Errorf("%w: coupon expired at %s", ErrCouponNotApplicable, v.endsAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// CouponUsageLimit は全体と1ユーザーあたりの利用回数の上限。0 は無制限
+type CouponUsageLimit struct {
+	total   int
+	perUser int
+}
+
+func NewCouponUsageLimit(total int, perUser int) (*CouponUsageLimit, error) {
+	if total < 0 || perUser < 0 {
+		return nil, fmt.Errorf("usage limits must not be negative")
+	}
+	return &CouponUsageLimit{total: total, perUser: perUser}, nil
+}
+
+func (l *CouponUsageLimit) Total() int {
+	return l.total
+}
+
+func (l *CouponUsageLimit) PerUser() int {
+	return l.perUser
+}
+
+// Coupon は注文の割引に使うクーポン
+// 対象商品を指定した場合は、その商品の明細の合計だけを割引と最低購入金額の対象にする
+type Coupon struct {
+	couponId     string
+	code         CouponCode
+	discount     Discount
+	validity     CouponValidity
+	limit        CouponUsageLimit
+	minimumSpend *Money
+	itemIds      []ItemId
+	usedCount    int
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// NewCoupon はクーポンを作る。minimumSpend が nil の場合は最低購入金額を設けず、itemIds が空の場合はすべての商品を対象にする
+func NewCoupon(code CouponCode, discount Discount, validity CouponValidity, limit CouponUsageLimit, minimumSpend *Money, itemIds []ItemId) *Coupon {
+	now := time.Now()
+	return RestoreCoupon(uuid.NewString(), code, discount, validity, limit, minimumSpend, itemIds, 0, now, now)
+}
+
+// RestoreCoupon は永続化済みのクーポンを復元する
+func RestoreCoupon(couponId string, code CouponCode, discount Discount, validity CouponValidity, limit CouponUsageLimit, minimumSpend *Money, itemIds []ItemId, usedCount int, createdAt time.Time, updatedAt time.Time) *Coupon {
+	copiedItemIds := make([]ItemId, len(itemIds))
+	copy(copiedItemIds, itemIds)
+	return &Coupon{
+		couponId:     couponId,
+		code:         code,
+		discount:     discount,
+		validity:     validity,
+		limit:        limit,
+		minimumSpend: minimumSpend,
+		itemIds:      copiedItemIds,
+		usedCount:    usedCount,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+func (c *Coupon) CouponId() string {
+	return c.couponId
+}
+
+func (c *Coupon) Code() string {
+	return c.code.Value()
+}
+
+func (c *Coupon) Discount() *Discount {
+	discount := c.discount
+	return &discount
+}
+
+func (c *Coupon) Validity() *CouponValidity {
+	validity := c.validity
+	return &validity
+}
+
+func (c *Coupon) UsageLimit() *CouponUsageLimit {
+	limit := c.limit
+	return &limit
+}
+
+// MinimumSpend は最低購入金額（税抜）を返す。設けていない場合は nil
+func (c *Coupon) MinimumSpend() *Money {
+	if c.minimumSpend == nil {
+		return nil
+	}
+	minimumSpend := *c.minimumSpend
+	return &minimumSpend
+}
+
+// ItemIds は対象商品の ID を返す。すべての商品が対象の場合は空
+func (c *Coupon) ItemIds() []string {
+	itemIds := make([]string, len(c.itemIds))
+	for i, itemId := range c.itemIds {
+		itemIds[i] = itemId.Value()
+	}
+	return itemIds
+}
+
+// UsedCount はこれまでに利用された回数を返す
+func (c *Coupon) UsedCount() int {
+	return c.usedCount
+}
+
+func (c *Coupon) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Coupon) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// AppliesTo は商品が割引の対象かを返す
+func (c *Coupon) AppliesTo(itemId string) bool {
+	if len(c.itemIds) == 0 {
+		return true
+	}
+	for _, id := range c.itemIds {
+		if id.Value() == itemId {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate は明細に対する割引額を返す。userRedemptions は購入者がこれまでにこのクーポンを利用した回数
+// 使えない場合は理由を添えて ErrCouponNotApplicable を返す
+func (c *Coupon) Evaluate(lines []OrderLine, userRedemptions int, now time.Time) (*Money, error) {
+	if err := c.validity.check(now); err != nil {
+		return nil, err
+	}
+	if c.limit.total > 0 && c.usedCount >= c.limit.total {
+		return nil, fmt.Errorf("%w: coupon has reached its usage limit", ErrCouponNotApplicable)
+	}
+	if c.limit.perUser > 0 && userRedemptions >= c.limit.perUser {
+		return nil, fmt.Errorf("%w: coupon can be used %d times per user", ErrCouponNotApplicable, c.limit.perUser)
+	}
+
+	var eligible *Money
+	for _, line := range lines {
+		if !c.AppliesTo(line.ItemId()) {
+			continue
+		}
+		if eligible == nil {
+			eligible = line.LineTotal()
+			continue
+		}
+		sum, err := eligible.Add(*line.LineTotal())
+		if err != nil {
+			return nil, err
+		}
+		eligible = sum
+	}
+	if eligible == nil {
+		return nil, fmt.Errorf("%w: no items in the order are eligible for the coupon", ErrCouponNotApplicable)
+	}
+	if c.minimumSpend != nil {
+		if c.minimumSpend.currency != eligible.currency {
+			return nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, c.minimumSpend.currency, eligible.currency)
+		}
+		if eligible.amount.LessThan(c.minimumSpend.amount) {
+			return nil, fmt.Errorf("%w: spend at least %s on eligible items", ErrCouponNotApplicable, c.minimumSpend.TaxExcludedDisplay())
+		}
+	}
+	discount, err := c.discount.amountFor(*eligible)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCouponNotApplicable, err)
+	}
+	return discount, nil
+}
+
+// Redeem は利用回数を1回増やしたクーポンを返す
+func (c *Coupon) Redeem() *Coupon {
+	return RestoreCoupon(c.couponId, c.code, c.discount, c.validity, c.limit, c.minimumSpend, c.itemIds, c.usedCount+1, c.createdAt, time.Now())
+}
+
+// Preview は明細にクーポンを使った場合の金額を、在庫を引き当てずに計算する
+func (c *Coupon) Preview(lines []OrderLine, userRedemptions int, now time.Time) (*CouponPreview, error) {
+	subtotal, err := sumOrderLines(lines)
+	if err != nil {
+		return nil, err
+	}
+	discount, err := c.Evaluate(lines, userRedemptions, now)
+	if err != nil {
+		return nil, err
+	}
+	breakdown, err := orderTaxBreakdown(lines, *discount)
+	if err != nil {
+		return nil, err
+	}
+	return &CouponPreview{code: c.Code(), subtotal: *subtotal, discount: *discount, tax: *breakdown.Tax(), total: *breakdown.Total()}, nil
+}
+
+// CouponPreview は注文前にクーポンを使った場合の金額。注文時の金額はその時点の価格と利用状況で計算し直す
+type CouponPreview struct {
+	code     string
+	subtotal Money
+	discount Money
+	tax      Money
+	total    Money
+}
+
+func (p *CouponPreview) Code() string {
+	return p.code
+}
+
+// Subtotal は税抜の合計を返す
+func (p *CouponPreview) Subtotal() *Money {
+	subtotal := p.subtotal
+	return &subtotal
+}
+
+// Discount は税抜の割引額を返す
+func (p *CouponPreview) Discount() *Money {
+	discount := p.discount
+	return &discount
+}
+
+// Tax は割引後の小計に対する消費税額を返す
+func (p *CouponPreview) Tax() *Money {
+	tax := p.tax
+	return &tax
+}
+
+// Total は割引後の税込の合計を返す
+func (p *CouponPreview) Total() *Money {
+	total := p.total
+	return &total
+}
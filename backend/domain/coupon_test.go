@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCouponCode(t *testing.T) CouponCode {
+	t.Helper()
+	code, err := NewCouponCode("WELCOME10")
+	if err != nil {
+		t.Fatalf("Failed to create coupon code: %v", err)
+	}
+	return *code
+}
+
+func newTestPercentCoupon(t *testing.T, percentOff int, limit CouponUsageLimit, minimumSpend *Money, itemIds ...ItemId) *Coupon {
+	t.Helper()
+	discount, err := NewPercentDiscount(percentOff)
+	if err != nil {
+		t.Fatalf("Failed to create discount: %v", err)
+	}
+	validity, _ := NewCouponValidity(nil, nil)
+	return NewCoupon(newTestCouponCode(t), *discount, *validity, limit, minimumSpend, itemIds)
+}
+
+func newTestOrderLineFor(t *testing.T, item *Item, quantity int) OrderLine {
+	t.Helper()
+	line, err := PriceOrderLine(item, newTestOrderRequestLine(t, item, "", quantity))
+	if err != nil {
+		t.Fatalf("Failed to price order line: %v", err)
+	}
+	return *line
+}
+
+func TestNewCouponCode(t *testing.T) {
+	code, err := NewCouponCode("  summer-sale_2026 ")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUMMER-SALE_2026", code.Value())
+
+	for _, value := range []string{"", "AB", "-SALE", "SUMMER SALE", "セール", "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456"} {
+		_, err := NewCouponCode(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestNewDiscount(t *testing.T) {
+	for _, percentOff := range []int{0, -5, 101} {
+		_, err := NewPercentDiscount(percentOff)
+		assert.Error(t, err, percentOff)
+	}
+	_, err := NewFixedDiscount(ZeroYen())
+	assert.Error(t, err)
+
+	amountOff, _ := NewMoneyFromString("500", CurrencyJPY)
+	discount, err := NewFixedDiscount(*amountOff)
+	assert.NoError(t, err)
+	assert.Equal(t, DiscountFixed, discount.Type())
+	assert.Equal(t, "500", discount.AmountOff().String())
+	assert.Zero(t, discount.PercentOff())
+}
+
+func TestNewCouponValidity(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	_, err := NewCouponValidity(&later, &now)
+	assert.Error(t, err)
+	_, err = NewCouponValidity(&now, &now)
+	assert.Error(t, err)
+
+	_, err = NewCouponUsageLimit(-1, 0)
+	assert.Error(t, err)
+}
+
+func TestCoupon_Evaluate(t *testing.T) {
+	unlimited, _ := NewCouponUsageLimit(0, 0)
+
+	t.Run("Percent Discount Rounds Down", func(t *testing.T) {
+		item := newCartTestItem(t, 10, 0, "999")
+		coupon := newTestPercentCoupon(t, 15, *unlimited, nil)
+
+		discount, err := coupon.Evaluate([]OrderLine{newTestOrderLineFor(t, item, 1)}, 0, time.Now())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "149", discount.String())
+	})
+
+	t.Run("Fixed Discount Is Capped At Eligible Amount", func(t *testing.T) {
+		item := newCartTestItem(t, 10, 0, "300")
+		amountOff, _ := NewMoneyFromString("500", CurrencyJPY)
+		discount, _ := NewFixedDiscount(*amountOff)
+		validity, _ := NewCouponValidity(nil, nil)
+		coupon := NewCoupon(newTestCouponCode(t), *discount, *validity, *unlimited, nil, nil)
+
+		amount, err := coupon.Evaluate([]OrderLine{newTestOrderLineFor(t, item, 1)}, 0, time.Now())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "300", amount.String())
+	})
+
+	t.Run("Restricted To Items", func(t *testing.T) {
+		yarn := newCartTestItem(t, 10, 0, "1000")
+		needles := newCartTestItem(t, 10, 0, "2000")
+		needlesId, _ := NewItemId(needles.ItemId())
+		coupon := newTestPercentCoupon(t, 10, *unlimited, nil, *needlesId)
+
+		discount, err := coupon.Evaluate([]OrderLine{newTestOrderLineFor(t, yarn, 1), newTestOrderLineFor(t, needles, 2)}, 0, time.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, "400", discount.String())
+
+		_, err = coupon.Evaluate([]OrderLine{newTestOrderLineFor(t, yarn, 1)}, 0, time.Now())
+		assert.True(t, errors.Is(err, ErrCouponNotApplicable))
+	})
+
+	t.Run("Minimum Spend Counts Eligible Items Only", func(t *testing.T) {
+		yarn := newCartTestItem(t, 10, 0, "3000")
+		needles := newCartTestItem(t, 10, 0, "1000")
+		needlesId, _ := NewItemId(needles.ItemId())
+		minimumSpend, _ := NewMoneyFromString("2000", CurrencyJPY)
+		coupon := newTestPercentCoupon(t, 10, *unlimited, minimumSpend, *needlesId)
+
+		_, err := coupon.Evaluate([]OrderLine{newTestOrderLineFor(t, yarn, 1), newTestOrderLineFor(t, needles, 1)}, 0, time.Now())
+		assert.True(t, errors.Is(err, ErrCouponNotApplicable))
+
+		_, err = coupon.Evaluate([]OrderLine{newTestOrderLineFor(t, needles, 2)}, 0, time.Now())
+		assert.NoError(t, err)
+	})
+
+	t.Run("Validity Window", func(t *testing.T) {
+		item := newCartTestItem(t, 10, 0, "1000")
+		startsAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		endsAt := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+		discount, _ := NewPercentDiscount(10)
+		validity, _ := NewCouponValidity(&startsAt, &endsAt)
+		coupon := NewCoupon(newTestCouponCode(t), *discount, *validity, *unlimited, nil, nil)
+		lines := []OrderLine{newTestOrderLineFor(t, item, 1)}
+
+		_, err := coupon.Evaluate(lines, 0, startsAt.Add(-time.Second))
+		assert.True(t, errors.Is(err, ErrCouponNotApplicable))
+		_, err = coupon.Evaluate(lines, 0, startsAt)
+		assert.NoError(t, err)
+		_, err = coupon.Evaluate(lines, 0, endsAt)
+		assert.True(t, errors.Is(err, ErrCouponNotApplicable))
+	})
+
+	t.Run("Usage Limits", func(t *testing.T) {
+		item := newCartTestItem(t, 10, 0, "1000")
+		lines := []OrderLine{newTestOrderLineFor(t, item, 1)}
+		limit, _ := NewCouponUsageLimit(2, 1)
+		coupon := newTestPercentCoupon(t, 10, *limit, nil)
+
+		_, err := coupon.Evaluate(lines, 0, time.Now())
+		assert.NoError(t, err)
+		_, err = coupon.Evaluate(lines, 1, time.Now())
+		assert.True(t, errors.Is(err, ErrCouponNotApplicable))
+
+		redeemed := coupon.Redeem().Redeem()
+		assert.Equal(t, 2, redeemed.UsedCount())
+		_, err = redeemed.Evaluate(lines, 0, time.Now())
+		assert.True(t, errors.Is(err, ErrCouponNotApplicable))
+	})
+}
+
+func TestOrder_ApplyCoupon(t *testing.T) {
+	unlimited, _ := NewCouponUsageLimit(0, 0)
+	item := newCartTestItem(t, 10, 0, "1000")
+	line, _, _ := ReserveOrderLine(item, newTestOrderRequestLine(t, item, "", 3))
+	userId, _ := NewUserId(item.UserId())
+	order, _ := NewOrder(*userId, []OrderLine{*line})
+	coupon := newTestPercentCoupon(t, 10, *unlimited, nil)
+
+	discounted, err := order.ApplyCoupon(coupon, 0, time.Now())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "3000", discounted.Subtotal().String())
+	assert.Equal(t, "300", discounted.Discount().String())
+	assert.Equal(t, "270", discounted.Tax().String())
+	assert.Equal(t, "2970", discounted.Total().String())
+	assert.Equal(t, "WELCOME10", discounted.CouponCode())
+	assert.Equal(t, "0", order.Discount().String())
+	assert.Empty(t, order.CouponCode())
+
+	preview, err := coupon.Preview(order.Lines(), 0, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, discounted.Total().String(), preview.Total().String())
+}
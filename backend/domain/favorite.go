@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Favorite はユーザーがお気に入りに登録した商品
+type Favorite struct {
+	userId    UserId
+	item      Item
+	createdAt time.Time
+}
+
+// RestoreFavorite は永続化済みのお気に入りを、登録した商品ごと復元する
+func RestoreFavorite(userId UserId, item Item, createdAt time.Time) *Favorite {
+	return &Favorite{userId: userId, item: item, createdAt: createdAt}
+}
+
+func (f *Favorite) UserId() string {
+	return f.userId.Value()
+}
+
+func (f *Favorite) Item() *Item {
+	item := f.item
+	return &item
+}
+
+// CreatedAt はお気に入りに登録した日時を返す
+func (f *Favorite) CreatedAt() time.Time {
+	return f.createdAt
+}
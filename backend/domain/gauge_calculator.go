@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	centimetersPerInch = 2.54
+	// maxGaugeMeasurement は試し編みの目数・段数と寸法、目標の寸法の上限
+	maxGaugeMeasurement = 1000
+)
+
+// LengthUnit は寸法の単位
+type LengthUnit string
+
+const (
+	LengthUnitCentimeter LengthUnit = "cm"
+	LengthUnitInch       LengthUnit = "in"
+)
+
+func NewLengthUnit(value string) (LengthUnit, error) {
+	switch unit := LengthUnit(value); unit {
+	case LengthUnitCentimeter, LengthUnitInch:
+		return unit, nil
+	}
+	return "", fmt.Errorf("unknown length unit: %q", value)
+}
+
+// GaugeSpan はゲージを数える基準の長さを返す。cm では10cm、インチでは4インチ
+func (u LengthUnit) GaugeSpan() float64 {
+	if u == LengthUnitInch {
+		return 4
+	}
+	return 10
+}
+
+func (u LengthUnit) centimeters(length float64) float64 {
+	if u == LengthUnitInch {
+		return length * centimetersPerInch
+	}
+	return length
+}
+
+// KnittingGauge は試し編みや編み図のゲージ。単位が違っても比べられるよう1cmあたりの目数と段数で持つ
+type KnittingGauge struct {
+	stitchesPerCm float64
+	rowsPerCm     float64
+	unit          LengthUnit
+}
+
+// MeasureSwatch は幅 width・高さ height の試し編みに stitches 目・rows 段あった場合のゲージを求める
+func MeasureSwatch(stitches float64, rows float64, width float64, height float64, unit LengthUnit) (*KnittingGauge, error) {
+	return measureGauge("swatch", stitches, rows, width, height, unit)
+}
+
+// NewSpanGauge は基準の長さ（10cm または4インチ）あたりの目数と段数からゲージを作る。編み図のゲージに使う
+func NewSpanGauge(stitches float64, rows float64, unit LengthUnit) (*KnittingGauge, error) {
+	span := unit.GaugeSpan()
+	return measureGauge("gauge", stitches, rows, span, span, unit)
+}
+
+// measureGauge は kind をエラーメッセージに使い、目数・段数と寸法が正で上限以下であることを確かめる
+func measureGauge(kind string, stitches float64, rows float64, width float64, height float64, unit LengthUnit) (*KnittingGauge, error) {
+	for _, value := range []struct {
+		name  string
+		value float64
+	}{{"stitches", stitches}, {"rows", rows}, {"width", width}, {"height", height}} {
+		if !(value.value > 0 && value.value <= maxGaugeMeasurement) {
+			return nil, fmt.Errorf("%s %s must be greater than 0 and at most %d: %v", kind, value.name, maxGaugeMeasurement, value.value)
+		}
+	}
+	return &KnittingGauge{
+		stitchesPerCm: stitches / unit.centimeters(width),
+		rowsPerCm:     rows / unit.centimeters(height),
+		unit:          unit,
+	}, nil
+}
+
+func (g KnittingGauge) Unit() LengthUnit {
+	return g.unit
+}
+
+// SpanStitches は基準の長さあたりの目数を小数第1位まで返す
+func (g KnittingGauge) SpanStitches() float64 {
+	return roundToTenth(g.stitchesPerCm * g.unit.centimeters(g.unit.GaugeSpan()))
+}
+
+// SpanRows は基準の長さあたりの段数を小数第1位まで返す
+func (g KnittingGauge) SpanRows() float64 {
+	return roundToTenth(g.rowsPerCm * g.unit.centimeters(g.unit.GaugeSpan()))
+}
+
+// StitchesFor は幅 width（ゲージの単位）を編むのに必要な目数を返す。端数は四捨五入する
+func (g KnittingGauge) StitchesFor(width float64) int {
+	return int(math.Round(g.stitchesPerCm * g.unit.centimeters(width)))
+}
+
+// RowsFor は高さ height（ゲージの単位）を編むのに必要な段数を返す。端数は四捨五入する
+func (g KnittingGauge) RowsFor(height float64) int {
+	return int(math.Round(g.rowsPerCm * g.unit.centimeters(height)))
+}
+
+// DifferenceFrom は pattern のゲージに対する目数と段数の差（%）を小数第1位まで返す
+// 正の値は pattern より目が詰まっている（同じ長さの目数・段数が多い）ことを表す
+func (g KnittingGauge) DifferenceFrom(pattern KnittingGauge) (stitches float64, rows float64) {
+	stitches = roundToTenth((g.stitchesPerCm - pattern.stitchesPerCm) / pattern.stitchesPerCm * 100)
+	rows = roundToTenth((g.rowsPerCm - pattern.rowsPerCm) / pattern.rowsPerCm * 100)
+	return stitches, rows
+}
+
+// GaugeCalculation は試し編みのゲージで目標の寸法に編むための作り目の数と段数
+// 編み図のゲージを指定した場合は、編み図どおりのゲージで編んだ場合の目数・段数とゲージの差も求める
+type GaugeCalculation struct {
+	swatch  KnittingGauge
+	pattern *KnittingGauge
+	width   float64
+	height  float64
+}
+
+// CalculateGauge は試し編みのゲージで幅 width・高さ height を編む計算をする。寸法は試し編みと同じ単位で指定する
+// pattern は編み図のゲージで、nil でもよい
+func CalculateGauge(swatch KnittingGauge, width float64, height float64, pattern *KnittingGauge) (*GaugeCalculation, error) {
+	for _, value := range []struct {
+		name  string
+		value float64
+	}{{"width", width}, {"height", height}} {
+		if !(value.value > 0 && value.value <= maxGaugeMeasurement) {
+			return nil, fmt.Errorf("target %s must be greater than 0 and at most %d: %v", value.name, maxGaugeMeasurement, value.value)
+		}
+	}
+	calculation := &GaugeCalculation{swatch: swatch, width: width, height: height}
+	if pattern != nil {
+		p := *pattern
+		calculation.pattern = &p
+	}
+	return calculation, nil
+}
+
+func (c *GaugeCalculation) Swatch() KnittingGauge {
+	return c.swatch
+}
+
+// Pattern は編み図のゲージを返す。指定していなければ nil
+func (c *GaugeCalculation) Pattern() *KnittingGauge {
+	if c.pattern == nil {
+		return nil
+	}
+	pattern := *c.pattern
+	return &pattern
+}
+
+func (c *GaugeCalculation) Width() float64 {
+	return c.width
+}
+
+func (c *GaugeCalculation) Height() float64 {
+	return c.height
+}
+
+// CastOnStitches は試し編みのゲージで目標の幅を編むための作り目の数を返す
+func (c *GaugeCalculation) CastOnStitches() int {
+	return c.swatch.StitchesFor(c.width)
+}
+
+// Rows は試し編みのゲージで目標の高さを編むための段数を返す
+func (c *GaugeCalculation) Rows() int {
+	return c.swatch.RowsFor(c.height)
+}
+
+// PatternCounts は編み図のゲージで目標の寸法を編む場合の目数と段数を返す。編み図のゲージがなければ ok は false
+// 目標の寸法は試し編みの単位なので、編み図のゲージの単位が違っても cm に直して数える
+func (c *GaugeCalculation) PatternCounts() (stitches int, rows int, ok bool) {
+	if c.pattern == nil {
+		return 0, 0, false
+	}
+	width, height := c.swatch.unit.centimeters(c.width), c.swatch.unit.centimeters(c.height)
+	return int(math.Round(c.pattern.stitchesPerCm * width)), int(math.Round(c.pattern.rowsPerCm * height)), true
+}
+
+// Difference は編み図のゲージに対する試し編みのゲージの差（%）を返す。編み図のゲージがなければ ok は false
+func (c *GaugeCalculation) Difference() (stitches float64, rows float64, ok bool) {
+	if c.pattern == nil {
+		return 0, 0, false
+	}
+	stitches, rows = c.swatch.DifferenceFrom(*c.pattern)
+	return stitches, rows, true
+}
+
+func roundToTenth(value float64) float64 {
+	return math.Round(value*10) / 10
+}
@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLengthUnit(t *testing.T) {
+	tests := []struct {
+		value string
+		want  LengthUnit
+		span  float64
+	}{
+		{value: "cm", want: LengthUnitCentimeter, span: 10},
+		{value: "in", want: LengthUnitInch, span: 4},
+	}
+	for _, tt := range tests {
+		unit, err := NewLengthUnit(tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, unit)
+		assert.Equal(t, tt.span, unit.GaugeSpan())
+	}
+
+	for _, value := range []string{"", "mm", "inch", "CM"} {
+		_, err := NewLengthUnit(value)
+		assert.Error(t, err)
+	}
+}
+
+func TestMeasureSwatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		stitches     float64
+		rows         float64
+		width        float64
+		height       float64
+		unit         LengthUnit
+		spanStitches float64
+		spanRows     float64
+	}{
+		{"10cm Square", 22, 30, 10, 10, LengthUnitCentimeter, 22, 30},
+		{"Larger Swatch", 33, 36, 15, 12, LengthUnitCentimeter, 22, 30},
+		{"Fractional Stitches", 21.5, 29, 10, 10, LengthUnitCentimeter, 21.5, 29},
+		{"4 Inch Square", 20, 28, 4, 4, LengthUnitInch, 20, 28},
+		{"6 Inch Swatch", 27, 36, 6, 6, LengthUnitInch, 18, 24},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gauge, err := MeasureSwatch(tt.stitches, tt.rows, tt.width, tt.height, tt.unit)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.unit, gauge.Unit())
+			assert.Equal(t, tt.spanStitches, gauge.SpanStitches())
+			assert.Equal(t, tt.spanRows, gauge.SpanRows())
+		})
+	}
+}
+
+func TestMeasureSwatch_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		stitches float64
+		rows     float64
+		width    float64
+		height   float64
+	}{
+		{"Zero Stitches", 0, 30, 10, 10},
+		{"Negative Rows", 22, -1, 10, 10},
+		{"Zero Width", 22, 30, 0, 10},
+		{"Negative Height", 22, 30, 10, -10},
+		{"Too Many Stitches", 1001, 30, 10, 10},
+		{"Too Wide", 22, 30, 1001, 10},
+		{"Not A Number", math.NaN(), 30, 10, 10},
+		{"Infinite Height", 22, 30, 10, math.Inf(1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := MeasureSwatch(tt.stitches, tt.rows, tt.width, tt.height, LengthUnitCentimeter)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCalculateGauge(t *testing.T) {
+	type gauge struct {
+		stitches float64
+		rows     float64
+		width    float64
+		height   float64
+		unit     LengthUnit
+	}
+	tests := []struct {
+		name             string
+		swatch           gauge
+		pattern          *gauge
+		width            float64
+		height           float64
+		castOn           int
+		rows             int
+		patternCastOn    int
+		patternRows      int
+		stitchDifference float64
+		rowDifference    float64
+	}{
+		{
+			name:    "Tighter Than Pattern",
+			swatch:  gauge{24, 32, 10, 10, LengthUnitCentimeter},
+			pattern: &gauge{22, 30, 10, 10, LengthUnitCentimeter},
+			width:   50, height: 60,
+			castOn: 120, rows: 192,
+			patternCastOn: 110, patternRows: 180,
+			stitchDifference: 9.1, rowDifference: 6.7,
+		},
+		{
+			name:    "Looser Than Pattern",
+			swatch:  gauge{20, 28, 10, 10, LengthUnitCentimeter},
+			pattern: &gauge{22, 30, 10, 10, LengthUnitCentimeter},
+			width:   45.5, height: 30,
+			castOn: 91, rows: 84,
+			patternCastOn: 100, patternRows: 90,
+			stitchDifference: -9.1, rowDifference: -6.7,
+		},
+		{
+			name:    "Matches Pattern With Larger Swatch",
+			swatch:  gauge{33, 36, 15, 12, LengthUnitCentimeter},
+			pattern: &gauge{22, 30, 10, 10, LengthUnitCentimeter},
+			width:   50, height: 60,
+			castOn: 110, rows: 180,
+			patternCastOn: 110, patternRows: 180,
+			stitchDifference: 0, rowDifference: 0,
+		},
+		{
+			name:    "Inches",
+			swatch:  gauge{20, 28, 4, 4, LengthUnitInch},
+			pattern: &gauge{18, 24, 4, 4, LengthUnitInch},
+			width:   20, height: 24,
+			castOn: 100, rows: 168,
+			patternCastOn: 90, patternRows: 144,
+			stitchDifference: 11.1, rowDifference: 16.7,
+		},
+		{
+			name:    "Pattern Gauge In Other Unit",
+			swatch:  gauge{22, 30, 10, 10, LengthUnitCentimeter},
+			pattern: &gauge{22, 30, 4, 4, LengthUnitInch},
+			width:   50, height: 60,
+			castOn: 110, rows: 180,
+			patternCastOn: 108, patternRows: 177,
+			stitchDifference: 1.6, rowDifference: 1.6,
+		},
+		{
+			name:   "Rounds To Nearest Stitch",
+			swatch: gauge{21, 29, 10, 10, LengthUnitCentimeter},
+			width:  12.5, height: 12.5,
+			castOn: 26, rows: 36,
+		},
+		{
+			name:   "Without Pattern Gauge",
+			swatch: gauge{24, 32, 10, 10, LengthUnitCentimeter},
+			width:  50, height: 60,
+			castOn: 120, rows: 192,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			swatch, err := MeasureSwatch(tt.swatch.stitches, tt.swatch.rows, tt.swatch.width, tt.swatch.height, tt.swatch.unit)
+			assert.NoError(t, err)
+			var pattern *KnittingGauge
+			if tt.pattern != nil {
+				pattern, err = MeasureSwatch(tt.pattern.stitches, tt.pattern.rows, tt.pattern.width, tt.pattern.height, tt.pattern.unit)
+				assert.NoError(t, err)
+			}
+
+			calculation, err := CalculateGauge(*swatch, tt.width, tt.height, pattern)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.castOn, calculation.CastOnStitches())
+			assert.Equal(t, tt.rows, calculation.Rows())
+
+			patternCastOn, patternRows, ok := calculation.PatternCounts()
+			assert.Equal(t, tt.pattern != nil, ok)
+			assert.Equal(t, tt.patternCastOn, patternCastOn)
+			assert.Equal(t, tt.patternRows, patternRows)
+
+			stitchDifference, rowDifference, ok := calculation.Difference()
+			assert.Equal(t, tt.pattern != nil, ok)
+			assert.Equal(t, tt.stitchDifference, stitchDifference)
+			assert.Equal(t, tt.rowDifference, rowDifference)
+		})
+	}
+}
+
+func TestCalculateGauge_InvalidTarget(t *testing.T) {
+	swatch, _ := NewSpanGauge(22, 30, LengthUnitCentimeter)
+
+	tests := []struct {
+		name   string
+		width  float64
+		height float64
+	}{
+		{"Zero Width", 0, 60},
+		{"Negative Height", 50, -1},
+		{"Too Tall", 50, 1001},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CalculateGauge(*swatch, tt.width, tt.height, nil)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var invoiceRegistrationNumberPattern = regexp.MustCompile(`^T[0-9]{13}$`)
+
+// InvoiceRegistrationNumber は適格請求書発行事業者の登録番号。"T" に続けて13桁の数字を書く
+type InvoiceRegistrationNumber struct {
+	value string
+}
+
+// NewInvoiceRegistrationNumber は "T1234567890123" の形式の登録番号を作る。ハイフンと空白は取り除く
+// 13桁の数字の先頭は法人番号と同じ規則のチェックデジットで、一致しない場合はエラーを返す
+func NewInvoiceRegistrationNumber(value string) (*InvoiceRegistrationNumber, error) {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(value)))
+	if !invoiceRegistrationNumberPattern.MatchString(normalized) {
+		return nil, fmt.Errorf("invoice registration number must be T followed by 13 digits: %q", value)
+	}
+	digits := normalized[1:]
+	if int(digits[0]-'0') != registrationCheckDigit(digits[1:]) {
+		return nil, fmt.Errorf("invoice registration number has an invalid check digit: %q", value)
+	}
+	return &InvoiceRegistrationNumber{value: normalized}, nil
+}
+
+// registrationCheckDigit は12桁の基礎番号からチェックデジットを計算する
+// 下の桁から数えて奇数桁は1倍、偶数桁は2倍した合計を9で割った余りを9から引く
+func registrationCheckDigit(base string) int {
+	sum := 0
+	for n := 1; n <= len(base); n++ {
+		digit := int(base[len(base)-n] - '0')
+		if n%2 == 0 {
+			digit *= 2
+		}
+		sum += digit
+	}
+	return 9 - sum%9
+}
+
+func (n *InvoiceRegistrationNumber) Value() string {
+	return n.value
+}
+
+func (n *InvoiceRegistrationNumber) String() string {
+	return n.value
+}
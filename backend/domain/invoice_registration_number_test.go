@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInvoiceRegistrationNumber(t *testing.T) {
+	for _, value := range []string{"T7000012050002", " t7000012050002 ", "T7-0000-1205-0002"} {
+		number, err := NewInvoiceRegistrationNumber(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, "T7000012050002", number.Value(), value)
+	}
+}
+
+func TestNewInvoiceRegistrationNumberInvalidError(t *testing.T) {
+	// T8000012050002 はチェックデジットが一致しない
+	for _, value := range []string{"", "7000012050002", "T700001205000", "T70000120500021", "TA000012050002", "T8000012050002"} {
+		_, err := NewInvoiceRegistrationNumber(value)
+		assert.Error(t, err, value)
+	}
+}
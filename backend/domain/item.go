@@ -1,23 +1,33 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Item struct {
-	itemId      ItemId
-	userId      UserId
-	itemName    ItemName
-	stock       Stock
-	description Description
-	createdAt   time.Time
-	updatedAt   time.Time
-	deletedAt   time.Time
+	itemId        ItemId
+	userId        UserId
+	itemName      ItemName
+	stock         Stock
+	description   Description
+	price         Money
+	taxRate       TaxRate
+	favoriteCount int
+	rating        RatingSummary
+	yarn          *YarnAttributes
+	variants      ItemVariants
+	category      *Category
+	tags          []Tag
+	images        ItemImages
+	createdAt     time.Time
+	updatedAt     time.Time
+	deletedAt     time.Time
 }
 
-func NewItem(itemId *ItemId, userId UserId, itemName ItemName, stock Stock, description Description) (*Item, error) {
+func NewItem(itemId *ItemId, userId UserId, itemName ItemName, stock Stock, description Description, price Money) (*Item, error) {
 	var id ItemId
 	if itemId == nil {
 		newId, err := NewItemId(uuid.NewString())
@@ -34,12 +44,25 @@ func NewItem(itemId *ItemId, userId UserId, itemName ItemName, stock Stock, desc
 		itemName:    itemName,
 		stock:       stock,
 		description: description,
+		price:       price,
+		taxRate:     TaxRateStandard,
 		createdAt:   time.Now(),
 		updatedAt:   time.Now(),
 	}
 	return item, nil
 }
 
+// NewItemWithTimestamps は永続化済みの商品を作成日時・更新日時ごと復元する
+func NewItemWithTimestamps(itemId *ItemId, userId UserId, itemName ItemName, stock Stock, description Description, price Money, createdAt time.Time, updatedAt time.Time) (*Item, error) {
+	item, err := NewItem(itemId, userId, itemName, stock, description, price)
+	if err != nil {
+		return nil, err
+	}
+	item.createdAt = createdAt
+	item.updatedAt = updatedAt
+	return item, nil
+}
+
 func (i *Item) ItemId() string {
 	return i.itemId.Value()
 }
@@ -52,14 +75,195 @@ func (i *Item) ItemName() string {
 	return i.itemName.Value()
 }
 
-func (i *Item) Stock() bool {
-	return i.stock.Value()
+func (i *Item) Stock() *Stock {
+	stock := i.stock
+	return &stock
+}
+
+// WithStock は在庫を置き換えた商品のコピーを返す
+func (i *Item) WithStock(stock Stock) *Item {
+	item := *i
+	item.stock = stock
+	return &item
+}
+
+// WithVariantStock は variantId のバリエーションの在庫を置き換えた商品のコピーを返す
+func (i *Item) WithVariantStock(variantId string, stock Stock) (*Item, error) {
+	variants := i.Variants()
+	for j := range variants {
+		if variants[j].variantId == variantId {
+			variants[j].stock = stock
+			item := *i
+			item.variants = variants
+			return &item, nil
+		}
+	}
+	return nil, fmt.Errorf("variant %s not found in item %s", variantId, i.ItemId())
+}
+
+// SellableStock は販売できる在庫を返す。バリエーションのある商品はバリエーションごとに在庫を持つため、その合計を返す
+// 在庫僅少の閾値は商品の値を使う
+func (i *Item) SellableStock() *Stock {
+	if len(i.variants) == 0 {
+		return i.Stock()
+	}
+	stock := Stock{lowStockThreshold: i.stock.lowStockThreshold}
+	for _, variant := range i.variants {
+		stock.onHand += variant.stock.onHand
+		stock.reserved += variant.stock.reserved
+	}
+	return &stock
+}
+
+// InStock は販売可能な在庫があるかを返す。バリエーションのある商品ではいずれかのバリエーションに在庫があれば true
+func (i *Item) InStock() bool {
+	return i.SellableStock().InStock()
 }
 
 func (i *Item) Description() string {
 	return i.description.Value()
 }
 
+// Price は税抜の販売価格を返す
+func (i *Item) Price() *Money {
+	price := i.price
+	return &price
+}
+
+// TaxRate は商品に適用する消費税率の区分を返す。指定しない場合は標準税率
+func (i *Item) TaxRate() TaxRate {
+	return i.taxRate
+}
+
+// PriceTaxIncluded は商品の税率で計算した税込の販売価格を返す
+func (i *Item) PriceTaxIncluded() *Money {
+	return i.price.TaxIncludedAt(i.taxRate)
+}
+
+// WithTaxRate は消費税率の区分を設定した商品のコピーを返す
+func (i *Item) WithTaxRate(rate TaxRate) *Item {
+	item := *i
+	item.taxRate = rate
+	return &item
+}
+
+// FavoriteCount は商品をお気に入りに登録したユーザーの数を返す
+func (i *Item) FavoriteCount() int {
+	return i.favoriteCount
+}
+
+// WithFavoriteCount はお気に入りの登録数を設定した商品のコピーを返す
+func (i *Item) WithFavoriteCount(count int) *Item {
+	item := *i
+	item.favoriteCount = count
+	return &item
+}
+
+// Rating はレビューの件数と評価の平均を返す
+func (i *Item) Rating() RatingSummary {
+	return i.rating
+}
+
+// WithRating はレビューの件数と評価の合計を設定した商品のコピーを返す
+func (i *Item) WithRating(rating RatingSummary) *Item {
+	item := *i
+	item.rating = rating
+	return &item
+}
+
+// Yarn は毛糸の属性を返す。毛糸でない商品では nil
+func (i *Item) Yarn() *YarnAttributes {
+	if i.yarn == nil {
+		return nil
+	}
+	yarn := *i.yarn
+	return &yarn
+}
+
+// WithYarn は毛糸の属性を設定した商品のコピーを返す。nil を渡すと未設定になる
+func (i *Item) WithYarn(yarn *YarnAttributes) *Item {
+	item := *i
+	item.yarn = nil
+	if yarn != nil {
+		y := *yarn
+		item.yarn = &y
+	}
+	return &item
+}
+
+// Variants は商品に属するバリエーションを返す。バリエーションのない商品では空になる
+func (i *Item) Variants() ItemVariants {
+	variants := make(ItemVariants, len(i.variants))
+	copy(variants, i.variants)
+	return variants
+}
+
+// WithVariants はバリエーションを持たせた商品のコピーを返す
+// 他の商品のバリエーションや、オプション・SKU が重複するバリエーションは受け付けない
+func (i *Item) WithVariants(variants ItemVariants) (*Item, error) {
+	for j := range variants {
+		if variants[j].ItemId() != i.ItemId() {
+			return nil, fmt.Errorf("variant %s belongs to another item", variants[j].VariantId())
+		}
+		if err := variants[:j].CheckConflict(&variants[j]); err != nil {
+			return nil, err
+		}
+	}
+	item := *i
+	item.variants = make(ItemVariants, len(variants))
+	copy(item.variants, variants)
+	return &item, nil
+}
+
+// Category は商品のカテゴリを返す。カテゴリ未設定の場合は nil
+func (i *Item) Category() *Category {
+	if i.category == nil {
+		return nil
+	}
+	category := *i.category
+	return &category
+}
+
+// WithCategory はカテゴリを設定した商品のコピーを返す。nil を渡すと未設定になる
+func (i *Item) WithCategory(category *Category) *Item {
+	item := *i
+	item.category = nil
+	if category != nil {
+		c := *category
+		item.category = &c
+	}
+	return &item
+}
+
+func (i *Item) Tags() []Tag {
+	tags := make([]Tag, len(i.tags))
+	copy(tags, i.tags)
+	return tags
+}
+
+// WithTags はタグを付けた商品のコピーを返す
+func (i *Item) WithTags(tags []Tag) *Item {
+	item := *i
+	item.tags = make([]Tag, len(tags))
+	copy(item.tags, tags)
+	return &item
+}
+
+// Images は商品画像を表示順に返す
+func (i *Item) Images() ItemImages {
+	images := make(ItemImages, len(i.images))
+	copy(images, i.images)
+	return images
+}
+
+// WithImages は画像を持たせた商品のコピーを返す。images は表示順に並んでいる必要がある
+func (i *Item) WithImages(images ItemImages) *Item {
+	item := *i
+	item.images = make(ItemImages, len(images))
+	copy(item.images, images)
+	return &item
+}
+
 func (i *Item) CreatedAt() time.Time {
 	return i.createdAt
 }
@@ -68,3 +272,6 @@ func (i *Item) UpdatedAt() time.Time {
 	return i.updatedAt
 }
 
+func (i *Item) IsOwnedBy(userId string) bool {
+	return i.userId.Value() == userId
+}
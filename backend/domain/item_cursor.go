@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ItemCursor は商品一覧の続きを取得するための位置情報を保持する
+// クライアントには Encode した不透明な文字列のみを渡す
+type ItemCursor struct {
+	sort      string
+	createdAt time.Time
+	itemName  string
+	itemId    ItemId
+}
+
+type itemCursorPayload struct {
+	Sort      string    `json:"s"`
+	CreatedAt time.Time `json:"c"`
+	ItemName  string    `json:"n"`
+	ItemId    string    `json:"i"`
+}
+
+// NewItemCursor は指定の並び順で item の直後から取得するためのカーソルを作る
+func NewItemCursor(item *Item, sort ItemSort) *ItemCursor {
+	return &ItemCursor{
+		sort:      sort.String(),
+		createdAt: item.createdAt,
+		itemName:  item.itemName.Value(),
+		itemId:    item.itemId,
+	}
+}
+
+func DecodeItemCursor(token string) (*ItemCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %s", token)
+	}
+
+	var payload itemCursorPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %s", token)
+	}
+
+	itemId, err := NewItemId(payload.ItemId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %s", token)
+	}
+
+	return &ItemCursor{
+		sort:      payload.Sort,
+		createdAt: payload.CreatedAt,
+		itemName:  payload.ItemName,
+		itemId:    *itemId,
+	}, nil
+}
+
+func (c *ItemCursor) Encode() string {
+	raw, _ := json.Marshal(itemCursorPayload{
+		Sort:      c.sort,
+		CreatedAt: c.createdAt,
+		ItemName:  c.itemName,
+		ItemId:    c.itemId.Value(),
+	})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// MatchesSort はカーソル発行時と同じ並び順で使われているかを判定する
+func (c *ItemCursor) MatchesSort(sort ItemSort) bool {
+	return c.sort == sort.String()
+}
+
+// Value は並び替えキーに対応するカーソル位置の値を返す
+func (c *ItemCursor) Value(field string) any {
+	switch field {
+	case ItemSortCreatedAt:
+		return c.createdAt
+	case ItemSortItemName:
+		return c.itemName
+	}
+	return nil
+}
+
+func (c *ItemCursor) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *ItemCursor) ItemName() string {
+	return c.itemName
+}
+
+func (c *ItemCursor) ItemId() string {
+	return c.itemId.Value()
+}
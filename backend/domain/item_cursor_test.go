@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func defaultItemSort() ItemSort {
+	sort, _ := NewItemSort(nil)
+	return *sort
+}
+
+func TestItemCursorEncodeDecode(t *testing.T) {
+	item, _, _ := createTestItem()
+	cursor := NewItemCursor(item, defaultItemSort())
+
+	decoded, err := DecodeItemCursor(cursor.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, item.ItemId(), decoded.ItemId())
+	assert.Equal(t, item.ItemName(), decoded.ItemName())
+	assert.True(t, item.CreatedAt().Equal(decoded.CreatedAt()))
+	assert.True(t, decoded.MatchesSort(defaultItemSort()))
+}
+
+func TestItemCursorMatchesSort(t *testing.T) {
+	item, _, _ := createTestItem()
+	cursor := NewItemCursor(item, defaultItemSort())
+
+	byName, _ := NewItemSortKey(ItemSortItemName, true)
+	otherSort, _ := NewItemSort([]ItemSortKey{*byName})
+	assert.False(t, cursor.MatchesSort(*otherSort))
+}
+
+func TestItemCursorValue(t *testing.T) {
+	item, _, _ := createTestItem()
+	cursor := NewItemCursor(item, defaultItemSort())
+
+	assert.Equal(t, item.CreatedAt(), cursor.Value(ItemSortCreatedAt))
+	assert.Equal(t, item.ItemName(), cursor.Value(ItemSortItemName))
+	assert.Nil(t, cursor.Value("unknown"))
+}
+
+func TestDecodeItemCursorInvalidBase64(t *testing.T) {
+	_, err := DecodeItemCursor("!!!")
+	assert.Error(t, err)
+}
+
+func TestDecodeItemCursorInvalidItemId(t *testing.T) {
+	_, err := DecodeItemCursor("eyJjIjoiMjAyNS0wNy0wMVQwMDowMDowMFoiLCJpIjoieCJ9")
+	assert.Error(t, err)
+}
+
+func TestItemPageHasNext(t *testing.T) {
+	item, _, _ := createTestItem()
+	page := NewItemPage(Items{*item}, NewItemCursor(item, defaultItemSort()), nil)
+	assert.True(t, page.HasNext())
+	assert.Equal(t, item.ItemId(), page.NextCursor().ItemId())
+
+	lastPage := NewItemPage(nil, nil, nil)
+	assert.False(t, lastPage.HasNext())
+	assert.NotNil(t, lastPage.Items())
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxItemImages は1つの商品に登録できる画像の上限
+const MaxItemImages = 10
+
+// ErrTooManyItemImages は商品の画像が既に上限まで登録されている場合に返す
+var ErrTooManyItemImages = errors.New("too many item images")
+
+// imageExtensions はアップロードを受け付ける画像の MIME タイプと保存時の拡張子
+var imageExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// ImageContentType はアップロードを受け付ける画像の MIME タイプ
+type ImageContentType struct {
+	value string
+}
+
+func NewImageContentType(value string) (*ImageContentType, error) {
+	if _, ok := imageExtensions[value]; !ok {
+		return nil, fmt.Errorf("unsupported image type: %s", value)
+	}
+	return &ImageContentType{value: value}, nil
+}
+
+func (t ImageContentType) Value() string {
+	return t.value
+}
+
+// Extension は保存するファイルの拡張子を返す
+func (t ImageContentType) Extension() string {
+	return imageExtensions[t.value]
+}
+
+// ThumbnailType はサムネイルの形式を返す。GIF はアニメーションを保てないため PNG で書き出す
+func (t ImageContentType) ThumbnailType() ImageContentType {
+	if t.value == "image/gif" {
+		return ImageContentType{value: "image/png"}
+	}
+	return t
+}
+
+// StoredImage はストレージに保存したファイルのキーと公開 URL
+type StoredImage struct {
+	Key string
+	URL string
+}
+
+// ItemImage は商品画像。元画像とサムネイルをストレージに保存し、position の昇順で表示する
+type ItemImage struct {
+	imageId     string
+	itemId      ItemId
+	position    int
+	contentType ImageContentType
+	width       int
+	height      int
+	original    StoredImage
+	thumbnail   StoredImage
+	createdAt   time.Time
+}
+
+// NewItemImage は新しい画像を作る。表示順は登録時にリポジトリが末尾に割り当てる
+func NewItemImage(itemId ItemId, contentType ImageContentType, width int, height int) (*ItemImage, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("image size must be positive: %dx%d", width, height)
+	}
+	return &ItemImage{
+		imageId:     uuid.NewString(),
+		itemId:      itemId,
+		contentType: contentType,
+		width:       width,
+		height:      height,
+		createdAt:   time.Now(),
+	}, nil
+}
+
+// RestoreItemImage は永続化済みの画像を復元する
+func RestoreItemImage(imageId string, itemId ItemId, position int, contentType ImageContentType, width int, height int, original StoredImage, thumbnail StoredImage, createdAt time.Time) *ItemImage {
+	return &ItemImage{
+		imageId:     imageId,
+		itemId:      itemId,
+		position:    position,
+		contentType: contentType,
+		width:       width,
+		height:      height,
+		original:    original,
+		thumbnail:   thumbnail,
+		createdAt:   createdAt,
+	}
+}
+
+// OriginalKey は元画像の保存先のキーを返す
+func (i *ItemImage) OriginalKey() string {
+	return fmt.Sprintf("items/%s/%s.%s", i.itemId.Value(), i.imageId, i.contentType.Extension())
+}
+
+// ThumbnailKey はサムネイルの保存先のキーを返す
+func (i *ItemImage) ThumbnailKey() string {
+	return fmt.Sprintf("items/%s/%s_thumb.%s", i.itemId.Value(), i.imageId, i.contentType.ThumbnailType().Extension())
+}
+
+// WithStoredFiles はストレージに保存した元画像とサムネイルを記録した画像のコピーを返す
+func (i *ItemImage) WithStoredFiles(original StoredImage, thumbnail StoredImage) *ItemImage {
+	image := *i
+	image.original = original
+	image.thumbnail = thumbnail
+	return &image
+}
+
+func (i *ItemImage) ImageId() string {
+	return i.imageId
+}
+
+func (i *ItemImage) ItemId() string {
+	return i.itemId.Value()
+}
+
+func (i *ItemImage) Position() int {
+	return i.position
+}
+
+func (i *ItemImage) ContentType() string {
+	return i.contentType.Value()
+}
+
+func (i *ItemImage) Width() int {
+	return i.width
+}
+
+func (i *ItemImage) Height() int {
+	return i.height
+}
+
+func (i *ItemImage) Original() StoredImage {
+	return i.original
+}
+
+func (i *ItemImage) Thumbnail() StoredImage {
+	return i.thumbnail
+}
+
+func (i *ItemImage) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+// ItemImages は1つの商品に属する画像の一覧。表示順に並んでいる
+type ItemImages []ItemImage
+
+// FindByID は ID が一致する画像を返す。見つからない場合は nil
+func (is ItemImages) FindByID(imageId string) *ItemImage {
+	for i := range is {
+		if is[i].imageId == imageId {
+			image := is[i]
+			return &image
+		}
+	}
+	return nil
+}
+
+// CheckOrder は imageIds が登録済みの画像をちょうど1回ずつ並べたものかを確認する
+func (is ItemImages) CheckOrder(imageIds []string) error {
+	if len(imageIds) != len(is) {
+		return fmt.Errorf("image order must list all %d images, got %d", len(is), len(imageIds))
+	}
+	seen := make(map[string]bool, len(imageIds))
+	for _, imageId := range imageIds {
+		if is.FindByID(imageId) == nil {
+			return fmt.Errorf("image %s does not belong to the item", imageId)
+		}
+		if seen[imageId] {
+			return fmt.Errorf("image %s is listed more than once", imageId)
+		}
+		seen[imageId] = true
+	}
+	return nil
+}
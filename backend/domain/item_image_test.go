@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewImageContentType(t *testing.T) {
+	tests := []struct {
+		value         string
+		wantErr       bool
+		wantExtension string
+		wantThumbnail string
+	}{
+		{"image/jpeg", false, "jpg", "image/jpeg"},
+		{"image/png", false, "png", "image/png"},
+		{"image/gif", false, "gif", "image/png"},
+		{"image/webp", true, "", ""},
+		{"image/svg+xml", true, "", ""},
+		{"application/pdf", true, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			contentType, err := NewImageContentType(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantExtension, contentType.Extension())
+			assert.Equal(t, tt.wantThumbnail, contentType.ThumbnailType().Value())
+		})
+	}
+}
+
+func TestItemImage_Keys(t *testing.T) {
+	itemId, _ := NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d740")
+	gif, _ := NewImageContentType("image/gif")
+	image, err := NewItemImage(*itemId, *gif, 10, 10)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "items/f47ac10b-58cc-4372-a567-0e02b2c3d740/"+image.ImageId()+".gif", image.OriginalKey())
+	assert.Equal(t, "items/f47ac10b-58cc-4372-a567-0e02b2c3d740/"+image.ImageId()+"_thumb.png", image.ThumbnailKey())
+
+	_, err = NewItemImage(*itemId, *gif, 0, 10)
+	assert.Error(t, err)
+}
+
+func TestItemImages_CheckOrder(t *testing.T) {
+	itemId, _ := NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d740")
+	png, _ := NewImageContentType("image/png")
+	images := ItemImages{
+		*RestoreItemImage("a", *itemId, 1, *png, 10, 10, StoredImage{}, StoredImage{}, time.Time{}),
+		*RestoreItemImage("b", *itemId, 2, *png, 10, 10, StoredImage{}, StoredImage{}, time.Time{}),
+	}
+
+	assert.NoError(t, images.CheckOrder([]string{"b", "a"}))
+	assert.Error(t, images.CheckOrder([]string{"a"}))
+	assert.Error(t, images.CheckOrder([]string{"a", "a"}))
+	assert.Error(t, images.CheckOrder([]string{"a", "c"}))
+}
@@ -0,0 +1,35 @@
+package domain
+
+// ItemPage は商品一覧の1ページ分と、次ページ取得用のカーソルを保持する
+type ItemPage struct {
+	items      Items
+	nextCursor *ItemCursor
+	total      *int64
+}
+
+func NewItemPage(items Items, nextCursor *ItemCursor, total *int64) *ItemPage {
+	if items == nil {
+		items = Items{}
+	}
+	return &ItemPage{
+		items:      items,
+		nextCursor: nextCursor,
+		total:      total,
+	}
+}
+
+func (p *ItemPage) Items() Items {
+	return p.items
+}
+
+func (p *ItemPage) NextCursor() *ItemCursor {
+	return p.nextCursor
+}
+
+func (p *ItemPage) HasNext() bool {
+	return p.nextCursor != nil
+}
+
+func (p *ItemPage) Total() *int64 {
+	return p.total
+}
@@ -0,0 +1,34 @@
+package domain
+
+// ItemSearchHit は全文検索で一致した商品と、その関連度・ハイライトを保持する
+type ItemSearchHit struct {
+	item                 Item
+	score                float64
+	itemNameHighlight    string
+	descriptionHighlight string
+}
+
+func NewItemSearchHit(item Item, score float64, query SearchQuery) *ItemSearchHit {
+	return &ItemSearchHit{
+		item:                 item,
+		score:                score,
+		itemNameHighlight:    Highlight(item.ItemName(), query.Terms()),
+		descriptionHighlight: Highlight(item.Description(), query.Terms()),
+	}
+}
+
+func (h *ItemSearchHit) Item() *Item {
+	return &h.item
+}
+
+func (h *ItemSearchHit) Score() float64 {
+	return h.score
+}
+
+func (h *ItemSearchHit) ItemNameHighlight() string {
+	return h.itemNameHighlight
+}
+
+func (h *ItemSearchHit) DescriptionHighlight() string {
+	return h.descriptionHighlight
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	ItemSortCreatedAt = "created_at"
+	ItemSortItemName  = "item_name"
+)
+
+var sortableItemFields = []string{ItemSortCreatedAt, ItemSortItemName}
+
+type ItemSortKey struct {
+	field      string
+	descending bool
+}
+
+func NewItemSortKey(field string, descending bool) (*ItemSortKey, error) {
+	for _, sortable := range sortableItemFields {
+		if field == sortable {
+			return &ItemSortKey{field: field, descending: descending}, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported sort field: %s", field)
+}
+
+func (k ItemSortKey) Field() string {
+	return k.field
+}
+
+func (k ItemSortKey) Descending() bool {
+	return k.descending
+}
+
+func (k ItemSortKey) String() string {
+	if k.descending {
+		return "-" + k.field
+	}
+	return k.field
+}
+
+// ItemSort は商品一覧の並び順を表す
+// 同じ値の行が複数あっても順序が一意に定まるよう、最後に item_id の昇順が常に適用される
+type ItemSort struct {
+	keys []ItemSortKey
+}
+
+// NewItemSort はキーが空の場合、作成日時の昇順を既定とする
+func NewItemSort(keys []ItemSortKey) (*ItemSort, error) {
+	if len(keys) == 0 {
+		return &ItemSort{keys: []ItemSortKey{{field: ItemSortCreatedAt}}}, nil
+	}
+
+	seen := map[string]bool{}
+	for _, key := range keys {
+		if seen[key.field] {
+			return nil, fmt.Errorf("duplicate sort field: %s", key.field)
+		}
+		seen[key.field] = true
+	}
+	return &ItemSort{keys: keys}, nil
+}
+
+func (s *ItemSort) Keys() []ItemSortKey {
+	return s.keys
+}
+
+func (s *ItemSort) String() string {
+	tokens := make([]string, len(s.keys))
+	for i, key := range s.keys {
+		tokens[i] = key.String()
+	}
+	return strings.Join(tokens, ",")
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewItemSortKey(t *testing.T) {
+	key, err := NewItemSortKey(ItemSortCreatedAt, true)
+	assert.NoError(t, err)
+	assert.Equal(t, ItemSortCreatedAt, key.Field())
+	assert.True(t, key.Descending())
+	assert.Equal(t, "-created_at", key.String())
+}
+
+func TestNewItemSortKeyUnsupportedField(t *testing.T) {
+	_, err := NewItemSortKey("password", false)
+	assert.Error(t, err)
+}
+
+func TestNewItemSortDefault(t *testing.T) {
+	sort, err := NewItemSort(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "created_at", sort.String())
+}
+
+func TestNewItemSortMultipleKeys(t *testing.T) {
+	createdAt, _ := NewItemSortKey(ItemSortCreatedAt, true)
+	itemName, _ := NewItemSortKey(ItemSortItemName, false)
+	sort, err := NewItemSort([]ItemSortKey{*createdAt, *itemName})
+	assert.NoError(t, err)
+	assert.Equal(t, "-created_at,item_name", sort.String())
+	assert.Len(t, sort.Keys(), 2)
+}
+
+func TestNewItemSortDuplicateField(t *testing.T) {
+	asc, _ := NewItemSortKey(ItemSortItemName, false)
+	desc, _ := NewItemSortKey(ItemSortItemName, true)
+	_, err := NewItemSort([]ItemSortKey{*asc, *desc})
+	assert.Error(t, err)
+}
@@ -10,10 +10,10 @@ import (
 func createTestItem() (*Item, ItemId, error) {
 	userId, _ := NewUserId(uuid.NewString())
 	itemName, _ := NewItemName("Test Item")
-	stock, _ := NewStock(true)
+	stock, _ := NewStock(3, 1, 1)
 	description, _ := NewDescription("This is a test item.")
 
-	item, err := NewItem(nil, *userId, *itemName, *stock, *description)
+	item, err := NewItem(nil, *userId, *itemName, *stock, *description, ZeroYen())
 	return item, item.itemId, err
 }
 
@@ -47,10 +47,26 @@ func TestItemName(t *testing.T) {
 
 func TestStock(t *testing.T) {
 	item, _, _ := createTestItem()
-	assert.Equal(t, item.stock.Value(), item.Stock())
+	assert.Equal(t, item.stock, *item.Stock())
+	assert.True(t, item.InStock())
 }
 
 func TestDescription(t *testing.T) {
 	item, _, _ := createTestItem()
 	assert.Equal(t, item.description.Value(), item.Description())
 }
+
+func TestIsOwnedBy(t *testing.T) {
+	item, _, _ := createTestItem()
+	assert.True(t, item.IsOwnedBy(item.UserId()))
+	assert.False(t, item.IsOwnedBy(uuid.NewString()))
+}
+
+func TestFavoriteCount(t *testing.T) {
+	item, _, _ := createTestItem()
+	assert.Equal(t, 0, item.FavoriteCount())
+
+	favorited := item.WithFavoriteCount(5)
+	assert.Equal(t, 5, favorited.FavoriteCount())
+	assert.Equal(t, 0, item.FavoriteCount())
+}
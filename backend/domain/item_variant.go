@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrDuplicateVariantOptions は同じ商品に同じオプションの組み合わせのバリエーションが既にある場合に返す
+	ErrDuplicateVariantOptions = errors.New("duplicate variant options")
+	// ErrDuplicateSkuCode は SKU コードが他のバリエーションで使われている場合に返す
+	ErrDuplicateSkuCode = errors.New("duplicate sku code")
+)
+
+// ItemVariant は商品の色・サイズ違いなど、SKU 単位で在庫を持つバリエーション
+// 価格を上書きしない場合は商品の価格で販売する
+type ItemVariant struct {
+	variantId     string
+	itemId        ItemId
+	sku           SkuCode
+	options       VariantOptions
+	stock         Stock
+	priceOverride *Money
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func NewItemVariant(itemId ItemId, sku SkuCode, options VariantOptions, stock Stock, priceOverride *Money) *ItemVariant {
+	now := time.Now()
+	return RestoreItemVariant(uuid.NewString(), itemId, sku, options, stock, priceOverride, now, now)
+}
+
+// RestoreItemVariant は永続化済みのバリエーションを復元する
+func RestoreItemVariant(variantId string, itemId ItemId, sku SkuCode, options VariantOptions, stock Stock, priceOverride *Money, createdAt time.Time, updatedAt time.Time) *ItemVariant {
+	var override *Money
+	if priceOverride != nil {
+		price := *priceOverride
+		override = &price
+	}
+	return &ItemVariant{
+		variantId:     variantId,
+		itemId:        itemId,
+		sku:           sku,
+		options:       options,
+		stock:         stock,
+		priceOverride: override,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+func (v *ItemVariant) VariantId() string {
+	return v.variantId
+}
+
+func (v *ItemVariant) ItemId() string {
+	return v.itemId.Value()
+}
+
+func (v *ItemVariant) Sku() string {
+	return v.sku.Value()
+}
+
+func (v *ItemVariant) Options() *VariantOptions {
+	options := v.options
+	return &options
+}
+
+func (v *ItemVariant) Stock() *Stock {
+	stock := v.stock
+	return &stock
+}
+
+// PriceOverride は上書き価格（税抜）を返す。上書きしていない場合は nil
+func (v *ItemVariant) PriceOverride() *Money {
+	if v.priceOverride == nil {
+		return nil
+	}
+	price := *v.priceOverride
+	return &price
+}
+
+// EffectivePrice は上書き価格があればそれを、なければ商品の価格を返す
+func (v *ItemVariant) EffectivePrice(itemPrice Money) *Money {
+	if v.priceOverride != nil {
+		return v.PriceOverride()
+	}
+	return &itemPrice
+}
+
+func (v *ItemVariant) CreatedAt() time.Time {
+	return v.createdAt
+}
+
+func (v *ItemVariant) UpdatedAt() time.Time {
+	return v.updatedAt
+}
+
+// ItemVariants は1つの商品に属するバリエーションの一覧
+type ItemVariants []ItemVariant
+
+// FindByID は ID が一致するバリエーションを返す。見つからない場合は nil
+func (vs ItemVariants) FindByID(variantId string) *ItemVariant {
+	for i := range vs {
+		if vs[i].variantId == variantId {
+			variant := vs[i]
+			return &variant
+		}
+	}
+	return nil
+}
+
+// CheckConflict は variant を追加・更新したときに、自身以外のバリエーションと
+// オプションの組み合わせまたは SKU コードが重複しないかを確認する
+func (vs ItemVariants) CheckConflict(variant *ItemVariant) error {
+	for _, other := range vs {
+		if other.variantId == variant.variantId {
+			continue
+		}
+		if other.options.Equals(variant.options) {
+			return fmt.Errorf("%w: %s", ErrDuplicateVariantOptions, variant.options.Key())
+		}
+		if other.sku.Value() == variant.sku.Value() {
+			return fmt.Errorf("%w: %s", ErrDuplicateSkuCode, variant.sku.Value())
+		}
+	}
+	return nil
+}
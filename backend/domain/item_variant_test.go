@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestItemVariant(t *testing.T, itemId ItemId, sku string, options map[string]string) *ItemVariant {
+	t.Helper()
+	skuCode, err := NewSkuCode(sku)
+	if err != nil {
+		t.Fatalf("Failed to create sku code: %v", err)
+	}
+	variantOptions, err := NewVariantOptions(options)
+	if err != nil {
+		t.Fatalf("Failed to create variant options: %v", err)
+	}
+	stock, _ := NewStock(2, 0, 0)
+	return NewItemVariant(itemId, *skuCode, *variantOptions, *stock, nil)
+}
+
+func TestNewSkuCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"upper case", "SOCK-M-RED", "SOCK-M-RED", false},
+		{"normalized to upper case", " yarn_01 ", "YARN_01", false},
+		{"empty", "", "", true},
+		{"space inside", "SOCK M", "", true},
+		{"leading hyphen", "-SOCK", "", true},
+		{"multibyte", "靴下-M", "", true},
+		{"too long", strings.Repeat("A", 65), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			skuCode, err := NewSkuCode(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, skuCode)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, skuCode.Value())
+		})
+	}
+}
+
+func TestNewVariantOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]string
+		wantKey string
+		wantErr bool
+	}{
+		{"single option", map[string]string{"color": "生成り"}, "color=生成り", false},
+		{"sorted by name", map[string]string{"size": "M", "color": "Red"}, "color=red;size=m", false},
+		{"names are normalized", map[string]string{" Color ": " Red "}, "color=red", false},
+		{"empty", map[string]string{}, "", true},
+		{"too many options", map[string]string{"a": "1", "b": "2", "c": "3", "d": "4"}, "", true},
+		{"empty value", map[string]string{"color": " "}, "", true},
+		{"empty name", map[string]string{"": "red"}, "", true},
+		{"name collides after normalization", map[string]string{"color": "red", "Color": "blue"}, "", true},
+		{"separator in value", map[string]string{"color": "red;blue"}, "", true},
+		{"value too long", map[string]string{"color": strings.Repeat("あ", 51)}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options, err := NewVariantOptions(tt.values)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, options)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantKey, options.Key())
+		})
+	}
+}
+
+func TestVariantOptionsKeepsValueCase(t *testing.T) {
+	options, _ := NewVariantOptions(map[string]string{"Size": "M"})
+	assert.Equal(t, map[string]string{"size": "M"}, options.Values())
+}
+
+func TestItemVariantEffectivePrice(t *testing.T) {
+	itemId, _ := NewItemId(uuid.NewString())
+	itemPrice, _ := NewMoneyFromString("1000", CurrencyJPY)
+
+	variant := newTestItemVariant(t, *itemId, "SOCK-M", map[string]string{"size": "M"})
+	assert.Nil(t, variant.PriceOverride())
+	assert.Equal(t, "1000", variant.EffectivePrice(*itemPrice).String())
+
+	override, _ := NewMoneyFromString("1200", CurrencyJPY)
+	skuCode, _ := NewSkuCode("SOCK-L")
+	options, _ := NewVariantOptions(map[string]string{"size": "L"})
+	stock, _ := NewStock(1, 0, 0)
+	overridden := NewItemVariant(*itemId, *skuCode, *options, *stock, override)
+	assert.Equal(t, "1200", overridden.PriceOverride().String())
+	assert.Equal(t, "1200", overridden.EffectivePrice(*itemPrice).String())
+}
+
+func TestItemVariantsCheckConflict(t *testing.T) {
+	itemId, _ := NewItemId(uuid.NewString())
+	existing := newTestItemVariant(t, *itemId, "SOCK-M-RED", map[string]string{"color": "Red", "size": "M"})
+	variants := ItemVariants{*existing}
+
+	sameOptions := newTestItemVariant(t, *itemId, "SOCK-M-RED-2", map[string]string{"size": "m", "color": "red"})
+	err := variants.CheckConflict(sameOptions)
+	assert.True(t, errors.Is(err, ErrDuplicateVariantOptions))
+
+	sameSku := newTestItemVariant(t, *itemId, "sock-m-red", map[string]string{"color": "Blue", "size": "M"})
+	err = variants.CheckConflict(sameSku)
+	assert.True(t, errors.Is(err, ErrDuplicateSkuCode))
+
+	other := newTestItemVariant(t, *itemId, "SOCK-L-RED", map[string]string{"color": "Red", "size": "L"})
+	assert.NoError(t, variants.CheckConflict(other))
+
+	// 自分自身との重複は更新時に無視する
+	assert.NoError(t, variants.CheckConflict(existing))
+}
+
+func TestItemWithVariants(t *testing.T) {
+	itemId, _ := NewItemId(uuid.NewString())
+	userId, _ := NewUserId(uuid.NewString())
+	itemName, _ := NewItemName("Hand-knit socks")
+	stock, _ := NewStock(0, 0, 0)
+	description, _ := NewDescription("Wool socks")
+	item, _ := NewItem(itemId, *userId, *itemName, *stock, *description, ZeroYen())
+
+	medium := newTestItemVariant(t, *itemId, "SOCK-M", map[string]string{"size": "M"})
+	large := newTestItemVariant(t, *itemId, "SOCK-L", map[string]string{"size": "L"})
+	withVariants, err := item.WithVariants(ItemVariants{*medium, *large})
+	assert.NoError(t, err)
+	assert.Len(t, withVariants.Variants(), 2)
+	assert.Empty(t, item.Variants())
+	assert.Equal(t, "SOCK-L", withVariants.Variants().FindByID(large.VariantId()).Sku())
+
+	duplicate := newTestItemVariant(t, *itemId, "SOCK-M-2", map[string]string{"size": "m"})
+	_, err = item.WithVariants(ItemVariants{*medium, *duplicate})
+	assert.True(t, errors.Is(err, ErrDuplicateVariantOptions))
+
+	otherItemId, _ := NewItemId(uuid.NewString())
+	foreign := newTestItemVariant(t, *otherItemId, "SOCK-S", map[string]string{"size": "S"})
+	_, err = item.WithVariants(ItemVariants{*foreign})
+	assert.Error(t, err)
+}
+
+func TestItemSellableStock_Variants(t *testing.T) {
+	itemId, _ := NewItemId(uuid.NewString())
+	userId, _ := NewUserId(uuid.NewString())
+	itemName, _ := NewItemName("Hand-knit socks")
+	stock, _ := NewStock(0, 0, 3)
+	description, _ := NewDescription("Wool socks")
+	item, _ := NewItem(itemId, *userId, *itemName, *stock, *description, ZeroYen())
+	assert.False(t, item.InStock())
+
+	medium := newTestItemVariant(t, *itemId, "SOCK-M", map[string]string{"size": "M"})
+	large := newTestItemVariant(t, *itemId, "SOCK-L", map[string]string{"size": "L"})
+	withVariants, err := item.WithVariants(ItemVariants{*medium, *large})
+	assert.NoError(t, err)
+
+	// 商品自体に在庫がなくても、バリエーションの在庫の合計で販売できる
+	sellable := withVariants.SellableStock()
+	assert.Equal(t, 4, sellable.OnHand())
+	assert.Equal(t, 4, sellable.Available())
+	assert.Equal(t, 3, sellable.LowStockThreshold())
+	assert.True(t, withVariants.InStock())
+	assert.Equal(t, 0, withVariants.Stock().OnHand())
+
+	soldOut, _ := NewStock(1, 1, 0)
+	partly, err := withVariants.WithVariantStock(medium.VariantId(), *soldOut)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, partly.SellableStock().Available())
+	assert.Equal(t, 2, withVariants.Variants().FindByID(medium.VariantId()).Stock().OnHand())
+
+	allSoldOut, err := partly.WithVariantStock(large.VariantId(), *soldOut)
+	assert.NoError(t, err)
+	assert.False(t, allSoldOut.InStock())
+
+	_, err = withVariants.WithVariantStock(uuid.NewString(), *soldOut)
+	assert.Error(t, err)
+}
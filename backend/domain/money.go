@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+const CurrencyJPY = "JPY"
+
+// currencyScales は対応する ISO 4217 通貨と、その通貨の小数点以下の桁数
+var currencyScales = map[string]int32{
+	CurrencyJPY: 0,
+	"USD":       2,
+	"EUR":       2,
+}
+
+// ErrCurrencyMismatch は異なる通貨の金額同士を計算しようとした場合に返す
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// Money は通貨付きの金額を表す。誤差を避けるため float は使わない
+type Money struct {
+	amount   decimal.Decimal
+	currency string
+}
+
+func NewMoney(amount decimal.Decimal, currency string) (*Money, error) {
+	if currency == "" {
+		currency = CurrencyJPY
+	}
+	scale, ok := currencyScales[currency]
+	if !ok {
+		return nil, fmt.Errorf("unsupported currency: %s", currency)
+	}
+	if amount.IsNegative() {
+		return nil, fmt.Errorf("amount must not be negative")
+	}
+	if !amount.Equal(amount.Truncate(scale)) {
+		return nil, fmt.Errorf("amount must not have more than %d decimal places for %s", scale, currency)
+	}
+	return &Money{amount: amount, currency: currency}, nil
+}
+
+// NewMoneyFromString は "1000" や "12.50" のような10進数の文字列から金額を作成する
+func NewMoneyFromString(amount string, currency string) (*Money, error) {
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount: %s", amount)
+	}
+	return NewMoney(value, currency)
+}
+
+// ZeroYen は0円を返す。価格未設定の商品の初期値に使う
+func ZeroYen() Money {
+	return Money{amount: decimal.Zero, currency: CurrencyJPY}
+}
+
+func (m *Money) Amount() decimal.Decimal {
+	return m.amount
+}
+
+func (m *Money) Currency() string {
+	return m.currency
+}
+
+// String は通貨の桁数に揃えた金額の文字列を返す。JSON にはこの形式で出力する
+func (m *Money) String() string {
+	return m.amount.StringFixed(currencyScales[m.currency])
+}
+
+func (m *Money) Equals(other *Money) bool {
+	if other == nil {
+		return false
+	}
+	return m.currency == other.currency && m.amount.Equal(other.amount)
+}
+
+func (m *Money) Add(other Money) (*Money, error) {
+	if m.currency != other.currency {
+		return nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	return &Money{amount: m.amount.Add(other.amount), currency: m.currency}, nil
+}
+
+// Subtract は差額を返す。金額は負にならないため、other の方が大きい場合はエラーを返す
+func (m *Money) Subtract(other Money) (*Money, error) {
+	if m.currency != other.currency {
+		return nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	if other.amount.GreaterThan(m.amount) {
+		return nil, fmt.Errorf("cannot subtract %s from %s", other.String(), m.String())
+	}
+	return &Money{amount: m.amount.Sub(other.amount), currency: m.currency}, nil
+}
+
+func (m *Money) Multiply(quantity int) *Money {
+	return &Money{amount: m.amount.Mul(decimal.NewFromInt(int64(quantity))), currency: m.currency}
+}
+
+// roundDown は通貨の最小単位未満を切り捨てる
+func (m *Money) roundDown(amount decimal.Decimal) *Money {
+	return &Money{amount: amount.Truncate(currencyScales[m.currency]), currency: m.currency}
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMoneyFromString(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		want     string
+		wantErr  bool
+	}{
+		{"yen", "1000", CurrencyJPY, "1000", false},
+		{"default currency is yen", "500", "", "500", false},
+		{"dollar with cents", "12.5", "USD", "12.50", false},
+		{"zero", "0", CurrencyJPY, "0", false},
+		{"fractional yen", "100.5", CurrencyJPY, "", true},
+		{"too many decimal places", "1.234", "USD", "", true},
+		{"negative", "-1", CurrencyJPY, "", true},
+		{"not a number", "abc", CurrencyJPY, "", true},
+		{"unsupported currency", "100", "XXX", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			money, err := NewMoneyFromString(tt.amount, tt.currency)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, money)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, money.String())
+		})
+	}
+}
+
+func TestMoneyDefaultsToYen(t *testing.T) {
+	money, _ := NewMoney(decimal.NewFromInt(100), "")
+	assert.Equal(t, CurrencyJPY, money.Currency())
+}
+
+func TestMoneyAdd(t *testing.T) {
+	a, _ := NewMoneyFromString("1000", CurrencyJPY)
+	b, _ := NewMoneyFromString("250", CurrencyJPY)
+	sum, err := a.Add(*b)
+	assert.NoError(t, err)
+	assert.Equal(t, "1250", sum.String())
+
+	usd, _ := NewMoneyFromString("1.00", "USD")
+	_, err = a.Add(*usd)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestMoneySubtract(t *testing.T) {
+	a, _ := NewMoneyFromString("1000", CurrencyJPY)
+	b, _ := NewMoneyFromString("250", CurrencyJPY)
+	diff, err := a.Subtract(*b)
+	assert.NoError(t, err)
+	assert.Equal(t, "750", diff.String())
+
+	_, err = b.Subtract(*a)
+	assert.Error(t, err)
+	usd, _ := NewMoneyFromString("1.00", "USD")
+	_, err = a.Subtract(*usd)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestMoneyMultiply(t *testing.T) {
+	price, _ := NewMoneyFromString("12.25", "USD")
+	assert.Equal(t, "36.75", price.Multiply(3).String())
+}
+
+func TestMoneyEquals(t *testing.T) {
+	a, _ := NewMoneyFromString("1000", CurrencyJPY)
+	b, _ := NewMoneyFromString("1000.00", "")
+	assert.True(t, a.Equals(b))
+	assert.False(t, a.Equals(nil))
+}
+
+func TestZeroYen(t *testing.T) {
+	zero := ZeroYen()
+	assert.Equal(t, "0", zero.String())
+	assert.Equal(t, CurrencyJPY, zero.Currency())
+}
@@ -0,0 +1,464 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxOrderLineQuantity は1明細で注文できる数量の上限
+	MaxOrderLineQuantity = 99
+	// MaxOrderLines は1回の注文に含められる明細数の上限
+	MaxOrderLines = 50
+)
+
+var (
+	// ErrInvalidOrderLine は注文明細の数量やバリエーションの指定が不正な場合に返す
+	ErrInvalidOrderLine = errors.New("invalid order line")
+	// ErrOrderItemUnavailable は注文する商品またはバリエーションが存在しない（削除済みを含む）場合に返す
+	ErrOrderItemUnavailable = errors.New("order item unavailable")
+)
+
+// OrderRequestLine は注文する商品・バリエーションと数量。バリエーションのない商品では variantId は空
+type OrderRequestLine struct {
+	itemId    ItemId
+	variantId string
+	quantity  int
+}
+
+func NewOrderRequestLine(itemId ItemId, variantId string, quantity int) (*OrderRequestLine, error) {
+	if quantity < 1 || quantity > MaxOrderLineQuantity {
+		return nil, fmt.Errorf("%w: quantity must be between 1 and %d: %d", ErrInvalidOrderLine, MaxOrderLineQuantity, quantity)
+	}
+	return &OrderRequestLine{itemId: itemId, variantId: variantId, quantity: quantity}, nil
+}
+
+func (l *OrderRequestLine) ItemId() string {
+	return l.itemId.Value()
+}
+
+func (l *OrderRequestLine) VariantId() string {
+	return l.variantId
+}
+
+func (l *OrderRequestLine) Quantity() int {
+	return l.quantity
+}
+
+// OrderRequest は1回の注文で購入する明細の一覧
+type OrderRequest struct {
+	lines      []OrderRequestLine
+	couponCode *CouponCode
+}
+
+// NewOrderRequest は明細が空・上限超え、または同じ商品・バリエーションが重複する場合にエラーを返す
+func NewOrderRequest(lines []OrderRequestLine) (*OrderRequest, error) {
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: order must have at least one line", ErrInvalidOrderLine)
+	}
+	if len(lines) > MaxOrderLines {
+		return nil, fmt.Errorf("%w: up to %d lines per order", ErrInvalidOrderLine, MaxOrderLines)
+	}
+	seen := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		key := line.ItemId() + "/" + line.variantId
+		if seen[key] {
+			return nil, fmt.Errorf("%w: duplicate line for item %s", ErrInvalidOrderLine, line.ItemId())
+		}
+		seen[key] = true
+	}
+	copied := make([]OrderRequestLine, len(lines))
+	copy(copied, lines)
+	return &OrderRequest{lines: copied}, nil
+}
+
+func (r *OrderRequest) Lines() []OrderRequestLine {
+	lines := make([]OrderRequestLine, len(r.lines))
+	copy(lines, r.lines)
+	return lines
+}
+
+// ItemIds は明細の商品 ID を重複なく昇順で返す
+// 行ロックは常にこの順で取り、複数の商品を含む注文が並行してもデッドロックしないようにする
+func (r *OrderRequest) ItemIds() []string {
+	seen := make(map[string]bool, len(r.lines))
+	ids := make([]string, 0, len(r.lines))
+	for _, line := range r.lines {
+		if !seen[line.ItemId()] {
+			seen[line.ItemId()] = true
+			ids = append(ids, line.ItemId())
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// WithCouponCode はクーポンを使う注文を返す
+func (r *OrderRequest) WithCouponCode(code CouponCode) *OrderRequest {
+	return &OrderRequest{lines: r.Lines(), couponCode: &code}
+}
+
+// CouponCode は使うクーポンのコードを返す。クーポンを使わない場合は空
+func (r *OrderRequest) CouponCode() string {
+	if r.couponCode == nil {
+		return ""
+	}
+	return r.couponCode.Value()
+}
+
+// OrderLine は注文明細。商品名・SKU・オプション・単価・税率は注文時点の値を保持し、後から商品を変更しても書き換えない
+type OrderLine struct {
+	lineId    string
+	itemId    string
+	variantId string
+	itemName  string
+	sku       string
+	options   map[string]string
+	unitPrice Money
+	taxRate   TaxRate
+	quantity  int
+}
+
+// PriceOrderLine は在庫を引き当てずに、商品の現在の状態から注文明細を作る
+func PriceOrderLine(item *Item, req OrderRequestLine) (*OrderLine, error) {
+	line := &OrderLine{
+		lineId:    uuid.NewString(),
+		itemId:    item.ItemId(),
+		variantId: req.variantId,
+		itemName:  item.ItemName(),
+		unitPrice: *item.Price(),
+		taxRate:   item.TaxRate(),
+		quantity:  req.quantity,
+	}
+	switch {
+	case req.variantId != "":
+		variant := item.Variants().FindByID(req.variantId)
+		if variant == nil {
+			return nil, fmt.Errorf("%w: variant %s of item %s", ErrOrderItemUnavailable, req.variantId, item.ItemId())
+		}
+		line.sku = variant.Sku()
+		line.options = variant.Options().Values()
+		line.unitPrice = *variant.EffectivePrice(*item.Price())
+	case len(item.Variants()) > 0:
+		return nil, fmt.Errorf("%w: variant_id is required for item %s", ErrInvalidOrderLine, item.ItemId())
+	}
+	return line, nil
+}
+
+// ReserveOrderLine は商品の現在の状態から注文明細を作り、引当後の在庫を返す
+// バリエーションのある商品ではバリエーションの在庫を、ない商品では商品の在庫を引き当てる
+func ReserveOrderLine(item *Item, req OrderRequestLine) (*OrderLine, *Stock, error) {
+	line, err := PriceOrderLine(item, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	stock := item.Stock()
+	if req.variantId != "" {
+		stock = item.Variants().FindByID(req.variantId).Stock()
+	}
+
+	reserved, err := stock.Reserve(req.quantity)
+	if err != nil {
+		return nil, nil, fmt.Errorf("item %s: %w", item.ItemId(), err)
+	}
+	return line, reserved, nil
+}
+
+// RestoreOrderLine は永続化済みの注文明細を復元する
+func RestoreOrderLine(lineId string, itemId string, variantId string, itemName string, sku string, options map[string]string, unitPrice Money, taxRate TaxRate, quantity int) *OrderLine {
+	return &OrderLine{
+		lineId:    lineId,
+		itemId:    itemId,
+		variantId: variantId,
+		itemName:  itemName,
+		sku:       sku,
+		options:   options,
+		unitPrice: unitPrice,
+		taxRate:   taxRate,
+		quantity:  quantity,
+	}
+}
+
+func (l *OrderLine) LineId() string {
+	return l.lineId
+}
+
+func (l *OrderLine) ItemId() string {
+	return l.itemId
+}
+
+// VariantId はバリエーション ID を返す。バリエーションのない商品では空
+func (l *OrderLine) VariantId() string {
+	return l.variantId
+}
+
+// ItemName は注文時点の商品名を返す
+func (l *OrderLine) ItemName() string {
+	return l.itemName
+}
+
+// Sku は注文時点の SKU コードを返す。バリエーションのない商品では空
+func (l *OrderLine) Sku() string {
+	return l.sku
+}
+
+// Options は注文時点のバリエーションのオプションを返す。バリエーションのない商品では空
+func (l *OrderLine) Options() map[string]string {
+	options := make(map[string]string, len(l.options))
+	for name, value := range l.options {
+		options[name] = value
+	}
+	return options
+}
+
+// UnitPrice は注文時点の税抜単価を返す
+func (l *OrderLine) UnitPrice() *Money {
+	price := l.unitPrice
+	return &price
+}
+
+// TaxRate は注文時点の消費税率の区分を返す
+func (l *OrderLine) TaxRate() TaxRate {
+	return l.taxRate
+}
+
+func (l *OrderLine) Quantity() int {
+	return l.quantity
+}
+
+// LineTotal は税抜の単価 × 数量を返す
+func (l *OrderLine) LineTotal() *Money {
+	return l.unitPrice.Multiply(l.quantity)
+}
+
+// OrderTransition は注文の状態遷移の記録。注文の作成は遷移元が空の遷移として記録する
+type OrderTransition struct {
+	transitionId string
+	from         OrderStatus
+	to           OrderStatus
+	actor        UserId
+	createdAt    time.Time
+}
+
+func newOrderTransition(from OrderStatus, to OrderStatus, actor UserId, now time.Time) OrderTransition {
+	return OrderTransition{transitionId: uuid.NewString(), from: from, to: to, actor: actor, createdAt: now}
+}
+
+// RestoreOrderTransition は永続化済みの状態遷移を復元する
+func RestoreOrderTransition(transitionId string, from OrderStatus, to OrderStatus, actor UserId, createdAt time.Time) *OrderTransition {
+	return &OrderTransition{transitionId: transitionId, from: from, to: to, actor: actor, createdAt: createdAt}
+}
+
+func (t *OrderTransition) TransitionId() string {
+	return t.transitionId
+}
+
+// From は遷移元の状態を返す。注文の作成では空
+func (t *OrderTransition) From() OrderStatus {
+	return t.from
+}
+
+func (t *OrderTransition) To() OrderStatus {
+	return t.to
+}
+
+// ActorId は遷移させたユーザーの ID を返す
+func (t *OrderTransition) ActorId() string {
+	return t.actor.Value()
+}
+
+func (t *OrderTransition) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// ReleasesReservation は出庫せずに引当を解除する遷移（発送前の取り消し・返金）かを返す
+func (t *OrderTransition) ReleasesReservation() bool {
+	return t.from.holdsReservation() && (t.to == OrderStatusCancelled || t.to == OrderStatusRefunded)
+}
+
+// FulfilsReservation は引き当てた在庫を出庫する遷移（発送）かを返す
+func (t *OrderTransition) FulfilsReservation() bool {
+	return t.from.holdsReservation() && t.to == OrderStatusShipped
+}
+
+// Order は注文。金額は注文時点で確定させ、消費税は割引後の小計に対して税率ごとに1回だけ計算する
+type Order struct {
+	orderId     string
+	userId      UserId
+	status      OrderStatus
+	lines       []OrderLine
+	subtotal    Money
+	discount    Money
+	tax         Money
+	total       Money
+	couponCode  string
+	transitions []OrderTransition
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewOrder は引き当て済みの明細から注文を作る。明細の通貨が揃っていない場合はエラーを返す
+func NewOrder(userId UserId, lines []OrderLine) (*Order, error) {
+	subtotal, err := sumOrderLines(lines)
+	if err != nil {
+		return nil, err
+	}
+	discount := Money{currency: subtotal.currency}
+	breakdown, err := orderTaxBreakdown(lines, discount)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	created := newOrderTransition("", OrderStatusPendingPayment, userId, now)
+	return RestoreOrder(uuid.NewString(), userId, OrderStatusPendingPayment, lines, *subtotal, discount, *breakdown.Tax(), *breakdown.Total(), "", []OrderTransition{created}, now, now), nil
+}
+
+// RestoreOrder は永続化済みの注文を復元する。クーポンを使っていない注文では discount は0、couponCode は空で渡す
+// transitions は古い順に渡す
+func RestoreOrder(orderId string, userId UserId, status OrderStatus, lines []OrderLine, subtotal Money, discount Money, tax Money, total Money, couponCode string, transitions []OrderTransition, createdAt time.Time, updatedAt time.Time) *Order {
+	copiedLines := make([]OrderLine, len(lines))
+	copy(copiedLines, lines)
+	copiedTransitions := make([]OrderTransition, len(transitions))
+	copy(copiedTransitions, transitions)
+	return &Order{
+		orderId:     orderId,
+		userId:      userId,
+		status:      status,
+		lines:       copiedLines,
+		subtotal:    subtotal,
+		discount:    discount,
+		tax:         tax,
+		total:       total,
+		couponCode:  couponCode,
+		transitions: copiedTransitions,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// TransitionTo は to に遷移させた注文と、その遷移の記録を返す
+// 現在の状態から移れない場合は *IllegalOrderTransitionError を返す
+func (o *Order) TransitionTo(to OrderStatus, actor UserId) (*Order, *OrderTransition, error) {
+	if !o.status.CanTransitionTo(to) {
+		return nil, nil, &IllegalOrderTransitionError{From: o.status, To: to}
+	}
+	now := time.Now()
+	transition := newOrderTransition(o.status, to, actor, now)
+	next := RestoreOrder(o.orderId, o.userId, to, o.lines, o.subtotal, o.discount, o.tax, o.total, o.couponCode, append(o.Transitions(), transition), o.createdAt, now)
+	return next, &transition, nil
+}
+
+// ApplyCoupon はクーポンの割引を反映した注文を返す。userRedemptions は購入者がこれまでにこのクーポンを利用した回数
+// 使えない場合は ErrCouponNotApplicable を返す
+func (o *Order) ApplyCoupon(coupon *Coupon, userRedemptions int, now time.Time) (*Order, error) {
+	discount, err := coupon.Evaluate(o.lines, userRedemptions, now)
+	if err != nil {
+		return nil, err
+	}
+	breakdown, err := orderTaxBreakdown(o.lines, *discount)
+	if err != nil {
+		return nil, err
+	}
+	return RestoreOrder(o.orderId, o.userId, o.status, o.lines, o.subtotal, *discount, *breakdown.Tax(), *breakdown.Total(), coupon.Code(), o.transitions, o.createdAt, o.updatedAt), nil
+}
+
+func (o *Order) OrderId() string {
+	return o.orderId
+}
+
+func (o *Order) UserId() string {
+	return o.userId.Value()
+}
+
+func (o *Order) Status() OrderStatus {
+	return o.status
+}
+
+func (o *Order) Lines() []OrderLine {
+	lines := make([]OrderLine, len(o.lines))
+	copy(lines, o.lines)
+	return lines
+}
+
+// Subtotal は税抜の合計を返す
+func (o *Order) Subtotal() *Money {
+	subtotal := o.subtotal
+	return &subtotal
+}
+
+// Discount はクーポンによる税抜の割引額を返す。クーポンを使っていない場合は0
+func (o *Order) Discount() *Money {
+	discount := o.discount
+	return &discount
+}
+
+// CouponCode は使ったクーポンのコードを返す。クーポンを使っていない場合は空
+func (o *Order) CouponCode() string {
+	return o.couponCode
+}
+
+// Tax は割引後の小計に対する消費税額を返す。税率ごとの消費税額の合計と一致する
+func (o *Order) Tax() *Money {
+	tax := o.tax
+	return &tax
+}
+
+// Total は税込の合計を返す
+func (o *Order) Total() *Money {
+	total := o.total
+	return &total
+}
+
+// TaxBreakdown は明細と割引額から税率ごとの合計と消費税額を返す
+func (o *Order) TaxBreakdown() (*TaxBreakdown, error) {
+	return orderTaxBreakdown(o.lines, o.discount)
+}
+
+// Transitions は状態遷移の記録を古い順に返す
+func (o *Order) Transitions() []OrderTransition {
+	transitions := make([]OrderTransition, len(o.transitions))
+	copy(transitions, o.transitions)
+	return transitions
+}
+
+func (o *Order) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+func (o *Order) UpdatedAt() time.Time {
+	return o.updatedAt
+}
+
+// IsPlacedBy は注文したユーザーかを返す
+func (o *Order) IsPlacedBy(userId string) bool {
+	return o.userId.Value() == userId
+}
+
+// orderTaxBreakdown は明細を税率ごとに合計し、割引額を按分して消費税額を計算する
+func orderTaxBreakdown(lines []OrderLine, discount Money) (*TaxBreakdown, error) {
+	amounts := make([]taxableAmount, len(lines))
+	for i, line := range lines {
+		amounts[i] = taxableAmount{rate: line.taxRate, amount: *line.LineTotal()}
+	}
+	return newTaxBreakdown(amounts, discount)
+}
+
+// sumOrderLines は明細の税抜の合計を返す。明細の通貨が揃っていない場合はエラーを返す
+func sumOrderLines(lines []OrderLine) (*Money, error) {
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: order must have at least one line", ErrInvalidOrderLine)
+	}
+	subtotal := Money{currency: lines[0].unitPrice.currency}
+	for _, line := range lines {
+		sum, err := subtotal.Add(*line.LineTotal())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidOrderLine, err)
+		}
+		subtotal = *sum
+	}
+	return &subtotal, nil
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OrderStatus は注文の状態
+type OrderStatus string
+
+const (
+	// OrderStatusPendingPayment は注文を受け付けて在庫を引き当て、支払いを待っている状態
+	OrderStatusPendingPayment OrderStatus = "pending_payment"
+	// OrderStatusPaid は支払いが済んだ状態
+	OrderStatusPaid OrderStatus = "paid"
+	// OrderStatusPreparing は発送の準備をしている状態
+	OrderStatusPreparing OrderStatus = "preparing"
+	// OrderStatusShipped は発送した状態。引き当てた在庫はこのとき出庫する
+	OrderStatusShipped OrderStatus = "shipped"
+	// OrderStatusDelivered は配達が済んだ状態
+	OrderStatusDelivered OrderStatus = "delivered"
+	// OrderStatusCancelled は発送前に取り消した状態
+	OrderStatusCancelled OrderStatus = "cancelled"
+	// OrderStatusRefunded は返金した状態
+	OrderStatusRefunded OrderStatus = "refunded"
+)
+
+// ErrIllegalOrderTransition は現在の状態から移れない状態を指定した場合に返す
+var ErrIllegalOrderTransition = errors.New("illegal order transition")
+
+// IllegalOrderTransitionError は許可されていない状態遷移の遷移元と遷移先を持つ
+// errors.Is で ErrIllegalOrderTransition と一致する
+type IllegalOrderTransitionError struct {
+	From OrderStatus
+	To   OrderStatus
+}
+
+func (e *IllegalOrderTransitionError) Error() string {
+	return fmt.Sprintf("%s: %s -> %s", ErrIllegalOrderTransition, e.From, e.To)
+}
+
+func (e *IllegalOrderTransitionError) Is(target error) bool {
+	return target == ErrIllegalOrderTransition
+}
+
+// orderTransitions は状態ごとに移れる状態の一覧
+// 取り消しは発送前まで、返金は支払い後の発送前と配達後に行える。取り消し・返金の後はどこにも移れない
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPendingPayment: {OrderStatusPaid, OrderStatusCancelled},
+	OrderStatusPaid:           {OrderStatusPreparing, OrderStatusCancelled, OrderStatusRefunded},
+	OrderStatusPreparing:      {OrderStatusShipped, OrderStatusCancelled, OrderStatusRefunded},
+	OrderStatusShipped:        {OrderStatusDelivered},
+	OrderStatusDelivered:      {OrderStatusRefunded},
+	OrderStatusCancelled:      {},
+	OrderStatusRefunded:       {},
+}
+
+func NewOrderStatus(value string) (OrderStatus, error) {
+	status := OrderStatus(value)
+	if _, ok := orderTransitions[status]; !ok {
+		return "", fmt.Errorf("invalid order status: %s", value)
+	}
+	return status, nil
+}
+
+// CanTransitionTo は to に移れるかを返す
+func (s OrderStatus) CanTransitionTo(to OrderStatus) bool {
+	for _, next := range orderTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// holdsReservation は在庫を引き当てたまま、まだ出庫していない状態かを返す
+func (s OrderStatus) holdsReservation() bool {
+	return s == OrderStatusPendingPayment || s == OrderStatusPaid || s == OrderStatusPreparing
+}
+
+// IsReceiptIssuable は領収書を発行できる状態かを返す。支払い後で、取り消し・返金していない注文に限る
+func (s OrderStatus) IsReceiptIssuable() bool {
+	return s == OrderStatusPaid || s == OrderStatusPreparing || s == OrderStatusShipped || s == OrderStatusDelivered
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestOrder(t *testing.T, status OrderStatus) *Order {
+	t.Helper()
+	item := newCartTestItem(t, 10, 0, "500")
+	line, _, err := ReserveOrderLine(item, newTestOrderRequestLine(t, item, "", 1))
+	if err != nil {
+		t.Fatalf("Failed to reserve order line: %v", err)
+	}
+	userId, _ := NewUserId(uuid.NewString())
+	order, err := NewOrder(*userId, []OrderLine{*line})
+	if err != nil {
+		t.Fatalf("Failed to create order: %v", err)
+	}
+	order.status = status
+	return order
+}
+
+func TestNewOrderStatus(t *testing.T) {
+	status, err := NewOrderStatus("shipped")
+	assert.NoError(t, err)
+	assert.Equal(t, OrderStatusShipped, status)
+
+	for _, value := range []string{"", "pending", "SHIPPED"} {
+		_, err := NewOrderStatus(value)
+		assert.Error(t, err)
+	}
+}
+
+func TestOrderStatus_CanTransitionTo(t *testing.T) {
+	allowed := map[OrderStatus][]OrderStatus{
+		OrderStatusPendingPayment: {OrderStatusPaid, OrderStatusCancelled},
+		OrderStatusPaid:           {OrderStatusPreparing, OrderStatusCancelled, OrderStatusRefunded},
+		OrderStatusPreparing:      {OrderStatusShipped, OrderStatusCancelled, OrderStatusRefunded},
+		OrderStatusShipped:        {OrderStatusDelivered},
+		OrderStatusDelivered:      {OrderStatusRefunded},
+		OrderStatusCancelled:      {},
+		OrderStatusRefunded:       {},
+	}
+	statuses := []OrderStatus{
+		OrderStatusPendingPayment, OrderStatusPaid, OrderStatusPreparing, OrderStatusShipped,
+		OrderStatusDelivered, OrderStatusCancelled, OrderStatusRefunded,
+	}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			expected := false
+			for _, next := range allowed[from] {
+				if next == to {
+					expected = true
+				}
+			}
+			assert.Equal(t, expected, from.CanTransitionTo(to), "%s -> %s", from, to)
+		}
+	}
+}
+
+func TestOrder_TransitionTo(t *testing.T) {
+	order := newTestOrder(t, OrderStatusPendingPayment)
+	admin, _ := NewUserId(uuid.NewString())
+
+	paid, transition, err := order.TransitionTo(OrderStatusPaid, *admin)
+
+	assert.NoError(t, err)
+	assert.Equal(t, OrderStatusPaid, paid.Status())
+	assert.Equal(t, OrderStatusPendingPayment, transition.From())
+	assert.Equal(t, OrderStatusPaid, transition.To())
+	assert.Equal(t, admin.Value(), transition.ActorId())
+	assert.Len(t, paid.Transitions(), 2)
+	// 元の注文は変更しない
+	assert.Equal(t, OrderStatusPendingPayment, order.Status())
+	assert.Len(t, order.Transitions(), 1)
+}
+
+func TestOrder_TransitionTo_Illegal(t *testing.T) {
+	order := newTestOrder(t, OrderStatusShipped)
+	customer, _ := NewUserId(order.UserId())
+
+	_, _, err := order.TransitionTo(OrderStatusCancelled, *customer)
+
+	assert.True(t, errors.Is(err, ErrIllegalOrderTransition))
+	var illegal *IllegalOrderTransitionError
+	assert.True(t, errors.As(err, &illegal))
+	assert.Equal(t, OrderStatusShipped, illegal.From)
+	assert.Equal(t, OrderStatusCancelled, illegal.To)
+}
+
+func TestOrderTransition_StockEffects(t *testing.T) {
+	tests := []struct {
+		from     OrderStatus
+		to       OrderStatus
+		releases bool
+		fulfils  bool
+	}{
+		{from: OrderStatusPendingPayment, to: OrderStatusPaid},
+		{from: OrderStatusPendingPayment, to: OrderStatusCancelled, releases: true},
+		{from: OrderStatusPaid, to: OrderStatusRefunded, releases: true},
+		{from: OrderStatusPreparing, to: OrderStatusCancelled, releases: true},
+		{from: OrderStatusPreparing, to: OrderStatusShipped, fulfils: true},
+		{from: OrderStatusShipped, to: OrderStatusDelivered},
+		{from: OrderStatusDelivered, to: OrderStatusRefunded},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			order := newTestOrder(t, tt.from)
+			actor, _ := NewUserId(uuid.NewString())
+
+			_, transition, err := order.TransitionTo(tt.to, *actor)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.releases, transition.ReleasesReservation())
+			assert.Equal(t, tt.fulfils, transition.FulfilsReservation())
+		})
+	}
+}
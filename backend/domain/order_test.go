@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestOrderRequestLine(t *testing.T, item *Item, variantId string, quantity int) OrderRequestLine {
+	t.Helper()
+	itemId, _ := NewItemId(item.ItemId())
+	line, err := NewOrderRequestLine(*itemId, variantId, quantity)
+	if err != nil {
+		t.Fatalf("Failed to create order request line: %v", err)
+	}
+	return *line
+}
+
+func TestNewOrderRequestLine(t *testing.T) {
+	itemId, _ := NewItemId(uuid.NewString())
+	for _, quantity := range []int{1, MaxOrderLineQuantity} {
+		_, err := NewOrderRequestLine(*itemId, "", quantity)
+		assert.NoError(t, err)
+	}
+	for _, quantity := range []int{-1, 0, MaxOrderLineQuantity + 1} {
+		_, err := NewOrderRequestLine(*itemId, "", quantity)
+		assert.True(t, errors.Is(err, ErrInvalidOrderLine))
+	}
+}
+
+func TestNewOrderRequest(t *testing.T) {
+	yarn := newCartTestItem(t, 10, 0, "500")
+	needles := newCartTestItem(t, 10, 0, "300")
+
+	req, err := NewOrderRequest([]OrderRequestLine{
+		newTestOrderRequestLine(t, yarn, "", 1),
+		newTestOrderRequestLine(t, needles, "", 1),
+		newTestOrderRequestLine(t, yarn, "variant-1", 1),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, req.Lines(), 3)
+	assert.Len(t, req.ItemIds(), 2)
+	assert.True(t, req.ItemIds()[0] < req.ItemIds()[1])
+
+	_, err = NewOrderRequest(nil)
+	assert.True(t, errors.Is(err, ErrInvalidOrderLine))
+
+	_, err = NewOrderRequest([]OrderRequestLine{
+		newTestOrderRequestLine(t, yarn, "", 1),
+		newTestOrderRequestLine(t, yarn, "", 2),
+	})
+	assert.True(t, errors.Is(err, ErrInvalidOrderLine))
+}
+
+func TestReserveOrderLine(t *testing.T) {
+	item := newCartTestItem(t, 3, 1, "1000")
+
+	line, stock, err := ReserveOrderLine(item, newTestOrderRequestLine(t, item, "", 2))
+
+	assert.NoError(t, err)
+	assert.Equal(t, item.ItemId(), line.ItemId())
+	assert.Equal(t, item.ItemName(), line.ItemName())
+	assert.Equal(t, "1000", line.UnitPrice().String())
+	assert.Equal(t, "2000", line.LineTotal().String())
+	assert.Equal(t, 3, stock.Reserved())
+	assert.Equal(t, 0, stock.Available())
+
+	_, _, err = ReserveOrderLine(item, newTestOrderRequestLine(t, item, "", 3))
+	assert.True(t, errors.Is(err, ErrInsufficientStock))
+}
+
+func TestReserveOrderLine_Variant(t *testing.T) {
+	item := newCartTestItem(t, 0, 0, "2000")
+	itemId, _ := NewItemId(item.ItemId())
+	sku, _ := NewSkuCode("SOCK-L")
+	options, _ := NewVariantOptions(map[string]string{"size": "L"})
+	variantStock, _ := NewStock(4, 0, 0)
+	override, _ := NewMoneyFromString("2500", CurrencyJPY)
+	variant := NewItemVariant(*itemId, *sku, *options, *variantStock, override)
+	item, _ = item.WithVariants(ItemVariants{*variant})
+
+	line, stock, err := ReserveOrderLine(item, newTestOrderRequestLine(t, item, variant.VariantId(), 4))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SOCK-L", line.Sku())
+	assert.Equal(t, map[string]string{"size": "L"}, line.Options())
+	assert.Equal(t, "2500", line.UnitPrice().String())
+	assert.Equal(t, 4, stock.Reserved())
+
+	_, _, err = ReserveOrderLine(item, newTestOrderRequestLine(t, item, "", 1))
+	assert.True(t, errors.Is(err, ErrInvalidOrderLine))
+
+	_, _, err = ReserveOrderLine(item, newTestOrderRequestLine(t, item, "removed-variant", 1))
+	assert.True(t, errors.Is(err, ErrOrderItemUnavailable))
+}
+
+func TestNewOrder(t *testing.T) {
+	yarn := newCartTestItem(t, 10, 0, "500")
+	needles := newCartTestItem(t, 10, 0, "333")
+	yarnLine, _, _ := ReserveOrderLine(yarn, newTestOrderRequestLine(t, yarn, "", 3))
+	needlesLine, _, _ := ReserveOrderLine(needles, newTestOrderRequestLine(t, needles, "", 1))
+	userId, _ := NewUserId(uuid.NewString())
+
+	order, err := NewOrder(*userId, []OrderLine{*yarnLine, *needlesLine})
+
+	assert.NoError(t, err)
+	assert.Equal(t, OrderStatusPendingPayment, order.Status())
+	assert.Len(t, order.Transitions(), 1)
+	assert.Equal(t, OrderStatus(""), order.Transitions()[0].From())
+	assert.Equal(t, userId.Value(), order.Transitions()[0].ActorId())
+	assert.True(t, order.IsPlacedBy(userId.Value()))
+	assert.Equal(t, "1833", order.Subtotal().String())
+	assert.Equal(t, "183", order.Tax().String())
+	assert.Equal(t, "2016", order.Total().String())
+
+	_, err = NewOrder(*userId, nil)
+	assert.True(t, errors.Is(err, ErrInvalidOrderLine))
+}
+
+func TestNewOrder_MixedTaxRates(t *testing.T) {
+	yarn := newCartTestItem(t, 10, 0, "1000")
+	tea := newCartTestItem(t, 10, 0, "540").WithTaxRate(TaxRateReduced)
+	yarnLine, _, _ := ReserveOrderLine(yarn, newTestOrderRequestLine(t, yarn, "", 2))
+	teaLine, _, _ := ReserveOrderLine(tea, newTestOrderRequestLine(t, tea, "", 1))
+	userId, _ := NewUserId(uuid.NewString())
+
+	order, err := NewOrder(*userId, []OrderLine{*yarnLine, *teaLine})
+
+	assert.NoError(t, err)
+	assert.Equal(t, TaxRateStandard, order.Lines()[0].TaxRate())
+	assert.Equal(t, TaxRateReduced, order.Lines()[1].TaxRate())
+	assert.Equal(t, "2540", order.Subtotal().String())
+	// 2000 × 10% = 200、540 × 8% = 43.2 を切り捨てて 43
+	assert.Equal(t, "243", order.Tax().String())
+	assert.Equal(t, "2783", order.Total().String())
+
+	breakdown, err := order.TaxBreakdown()
+	assert.NoError(t, err)
+	assert.Len(t, breakdown.Totals(), 2)
+	assert.True(t, breakdown.Tax().Equals(order.Tax()))
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"fmt"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+type PageLimit struct {
+	value int
+}
+
+// NewPageLimit は 0 を未指定として扱い、既定の件数を返す
+func NewPageLimit(value int) (*PageLimit, error) {
+	if value == 0 {
+		value = DefaultPageLimit
+	}
+
+	if value < 0 {
+		return nil, fmt.Errorf("limit must be greater than 0")
+	}
+
+	if value > MaxPageLimit {
+		return nil, fmt.Errorf("limit must be less than or equal to %d", MaxPageLimit)
+	}
+
+	pageLimit := new(PageLimit)
+	pageLimit.value = value
+	return pageLimit, nil
+}
+
+func (pageLimit *PageLimit) Value() int {
+	return pageLimit.value
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestNewPageLimitDefault(t *testing.T) {
+	pageLimit, err := NewPageLimit(0)
+	if err != nil {
+		t.Fatalf("NewPageLimit() returned an unexpected error: %v", err)
+	}
+	if pageLimit.Value() != DefaultPageLimit {
+		t.Errorf("Value() returned %d, expected %d", pageLimit.Value(), DefaultPageLimit)
+	}
+}
+
+func TestNewPageLimitValue(t *testing.T) {
+	pageLimit, _ := NewPageLimit(50)
+	if pageLimit.Value() != 50 {
+		t.Errorf("Value() returned %d, expected %d", pageLimit.Value(), 50)
+	}
+}
+
+func TestNewPageLimitNegativeError(t *testing.T) {
+	_, err := NewPageLimit(-1)
+	if err == nil {
+		t.Errorf("NewPageLimit() should return an error for negative value")
+	}
+}
+
+func TestNewPageLimitOverMaxError(t *testing.T) {
+	_, err := NewPageLimit(MaxPageLimit + 1)
+	if err == nil {
+		t.Errorf("NewPageLimit() should return an error for value over %d", MaxPageLimit)
+	}
+}
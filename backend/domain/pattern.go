@@ -0,0 +1,381 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ErrUnknownPatternSize はパターンにないサイズで玉数を計算しようとした場合に返す
+var ErrUnknownPatternSize = errors.New("unknown pattern size")
+
+const (
+	maxNeedleSizes      = 5
+	maxPatternSizes     = 10
+	needleSizeSeparator = ";"
+)
+
+// PatternDifficulty はパターンの難易度。Craft Yarn Council の4段階に合わせる
+type PatternDifficulty string
+
+const (
+	PatternDifficultyBeginner     PatternDifficulty = "beginner"
+	PatternDifficultyEasy         PatternDifficulty = "easy"
+	PatternDifficultyIntermediate PatternDifficulty = "intermediate"
+	PatternDifficultyExperienced  PatternDifficulty = "experienced"
+)
+
+func NewPatternDifficulty(value string) (PatternDifficulty, error) {
+	switch difficulty := PatternDifficulty(value); difficulty {
+	case PatternDifficultyBeginner, PatternDifficultyEasy, PatternDifficultyIntermediate, PatternDifficultyExperienced:
+		return difficulty, nil
+	}
+	return "", fmt.Errorf("unknown pattern difficulty: %q", value)
+}
+
+// Gauge は10cm四方の目数と段数。小数第1位まで持つ
+type Gauge struct {
+	stitches float64
+	rows     float64
+}
+
+func NewGauge(stitches float64, rows float64) (*Gauge, error) {
+	for _, value := range []struct {
+		name  string
+		count float64
+	}{{"stitches", stitches}, {"rows", rows}} {
+		if value.count <= 0 || value.count >= 100 {
+			return nil, fmt.Errorf("gauge %s must be greater than 0 and less than 100: %v", value.name, value.count)
+		}
+	}
+	return &Gauge{stitches: math.Round(stitches*10) / 10, rows: math.Round(rows*10) / 10}, nil
+}
+
+// Stitches は10cmあたりの目数を返す
+func (g Gauge) Stitches() float64 {
+	return g.stitches
+}
+
+// Rows は10cmあたりの段数を返す
+func (g Gauge) Rows() float64 {
+	return g.rows
+}
+
+// NeedleSize は針の太さ（mm）。0.25mm 刻みで 1.0〜25.0mm
+type NeedleSize struct {
+	millimeters float64
+}
+
+func NewNeedleSize(millimeters float64) (*NeedleSize, error) {
+	if millimeters < 1 || millimeters > 25 {
+		return nil, fmt.Errorf("needle size must be between 1.0 and 25.0 mm: %v", millimeters)
+	}
+	if math.Mod(millimeters*4, 1) != 0 {
+		return nil, fmt.Errorf("needle size must be a multiple of 0.25 mm: %v", millimeters)
+	}
+	return &NeedleSize{millimeters: millimeters}, nil
+}
+
+func (n NeedleSize) Millimeters() float64 {
+	return n.millimeters
+}
+
+// NeedleSizes はパターンで使う針の太さを細い順に重複なく並べたもの
+type NeedleSizes []NeedleSize
+
+func NewNeedleSizes(sizes []NeedleSize) (NeedleSizes, error) {
+	if len(sizes) == 0 {
+		return nil, fmt.Errorf("pattern must have at least one needle size")
+	}
+	seen := make(map[float64]bool, len(sizes))
+	normalized := make(NeedleSizes, 0, len(sizes))
+	for _, size := range sizes {
+		if seen[size.millimeters] {
+			continue
+		}
+		seen[size.millimeters] = true
+		normalized = append(normalized, size)
+	}
+	if len(normalized) > maxNeedleSizes {
+		return nil, fmt.Errorf("pattern must have at most %d needle sizes", maxNeedleSizes)
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i].millimeters < normalized[j].millimeters })
+	return normalized, nil
+}
+
+// ParseNeedleSizes は Key() で保存した針の太さを復元する
+func ParseNeedleSizes(key string) (NeedleSizes, error) {
+	sizes := []NeedleSize{}
+	for _, value := range strings.Split(key, needleSizeSeparator) {
+		millimeters, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed needle size %q: %w", value, err)
+		}
+		size, err := NewNeedleSize(millimeters)
+		if err != nil {
+			return nil, err
+		}
+		sizes = append(sizes, *size)
+	}
+	return NewNeedleSizes(sizes)
+}
+
+// Key は針の太さを "3.5;4" の形式で返す
+func (s NeedleSizes) Key() string {
+	values := make([]string, len(s))
+	for i, size := range s {
+		values[i] = strconv.FormatFloat(size.millimeters, 'f', -1, 64)
+	}
+	return strings.Join(values, needleSizeSeparator)
+}
+
+// PatternSize はパターンの仕上がりサイズと、そのサイズを編むのに必要な糸の長さ（m）
+type PatternSize struct {
+	Label                string
+	FinishedMeasurements string
+	Yardage              int
+}
+
+// PatternDetails は管理者が編集できるパターンの項目
+// YarnWeight は指定の糸の太さ、ItemIds はパターンを編むのに使える商品
+type PatternDetails struct {
+	Name        string
+	Description string
+	Difficulty  PatternDifficulty
+	Gauge       Gauge
+	NeedleSizes NeedleSizes
+	YarnWeight  YarnWeight
+	Sizes       []PatternSize
+	ItemIds     []ItemId
+}
+
+// Pattern は販売している編み図。サイズごとに必要な糸の長さを持ち、使える商品の玉数を計算できる
+type Pattern struct {
+	patternId string
+	details   PatternDetails
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewPattern(details PatternDetails) (*Pattern, error) {
+	normalized, err := normalizePatternDetails(details)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return RestorePattern(uuid.NewString(), *normalized, now, now), nil
+}
+
+// RestorePattern は永続化済みのパターンを復元する
+func RestorePattern(patternId string, details PatternDetails, createdAt time.Time, updatedAt time.Time) *Pattern {
+	return &Pattern{
+		patternId: patternId,
+		details:   copyPatternDetails(details),
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// Edit は項目をすべて置き換えたパターンを返す
+func (p *Pattern) Edit(details PatternDetails) (*Pattern, error) {
+	normalized, err := normalizePatternDetails(details)
+	if err != nil {
+		return nil, err
+	}
+	return RestorePattern(p.patternId, *normalized, p.createdAt, time.Now()), nil
+}
+
+func (p *Pattern) PatternId() string {
+	return p.patternId
+}
+
+func (p *Pattern) Name() string {
+	return p.details.Name
+}
+
+func (p *Pattern) Description() string {
+	return p.details.Description
+}
+
+func (p *Pattern) Difficulty() PatternDifficulty {
+	return p.details.Difficulty
+}
+
+func (p *Pattern) Gauge() Gauge {
+	return p.details.Gauge
+}
+
+func (p *Pattern) NeedleSizes() NeedleSizes {
+	sizes := make(NeedleSizes, len(p.details.NeedleSizes))
+	copy(sizes, p.details.NeedleSizes)
+	return sizes
+}
+
+func (p *Pattern) YarnWeight() YarnWeight {
+	return p.details.YarnWeight
+}
+
+// Sizes は仕上がりサイズを登録順に返す
+func (p *Pattern) Sizes() []PatternSize {
+	sizes := make([]PatternSize, len(p.details.Sizes))
+	copy(sizes, p.details.Sizes)
+	return sizes
+}
+
+// Size はラベルが一致するサイズを返す。なければ domain.ErrUnknownPatternSize を返す
+func (p *Pattern) Size(label string) (*PatternSize, error) {
+	for _, size := range p.details.Sizes {
+		if size.Label == label {
+			s := size
+			return &s, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownPatternSize, label)
+}
+
+// ItemIds はパターンを編むのに使える商品の ID を返す
+func (p *Pattern) ItemIds() []string {
+	itemIds := make([]string, len(p.details.ItemIds))
+	for i, itemId := range p.details.ItemIds {
+		itemIds[i] = itemId.Value()
+	}
+	return itemIds
+}
+
+func (p *Pattern) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *Pattern) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// PatternGuide はパターンと使える商品、選んだサイズを編むのに必要な玉数の目安
+type PatternGuide struct {
+	pattern *Pattern
+	items   Items
+	size    *PatternSize
+}
+
+// NewPatternGuide は使える商品ごとに玉数を計算できるようにする。sizeLabel が空の場合はサイズを選ばない
+func NewPatternGuide(pattern *Pattern, items Items, sizeLabel string) (*PatternGuide, error) {
+	guide := &PatternGuide{pattern: pattern, items: items}
+	if sizeLabel == "" {
+		return guide, nil
+	}
+	size, err := pattern.Size(sizeLabel)
+	if err != nil {
+		return nil, err
+	}
+	guide.size = size
+	return guide, nil
+}
+
+func (g *PatternGuide) Pattern() *Pattern {
+	return g.pattern
+}
+
+// Items はパターンを編むのに使える商品のうち、削除されていないものを返す
+func (g *PatternGuide) Items() Items {
+	return g.items
+}
+
+// Size は選んだサイズを返す。サイズを選んでいなければ nil
+func (g *PatternGuide) Size() *PatternSize {
+	return g.size
+}
+
+// Skeins は選んだサイズを商品の糸で編むのに必要な玉数を返す
+// サイズを選んでいないか、商品に毛糸の属性がなければ false を返す
+func (g *PatternGuide) Skeins(item *Item) (int, bool) {
+	if g.size == nil || item.Yarn() == nil {
+		return 0, false
+	}
+	return item.Yarn().SkeinsFor(g.size.Yardage), true
+}
+
+func normalizePatternDetails(details PatternDetails) (*PatternDetails, error) {
+	normalized := copyPatternDetails(details)
+	normalized.Name = strings.TrimSpace(details.Name)
+	if normalized.Name == "" {
+		return nil, fmt.Errorf("name must not be empty")
+	}
+	if utf8.RuneCountInString(normalized.Name) > 100 {
+		return nil, fmt.Errorf("name must be 100 characters or less")
+	}
+	normalized.Description = strings.TrimSpace(details.Description)
+	if utf8.RuneCountInString(normalized.Description) > 2000 {
+		return nil, fmt.Errorf("description must be 2000 characters or less")
+	}
+	if normalized.Difficulty == "" {
+		return nil, fmt.Errorf("difficulty must not be empty")
+	}
+	if normalized.Gauge.stitches == 0 {
+		return nil, fmt.Errorf("gauge must not be empty")
+	}
+	if len(normalized.NeedleSizes) == 0 {
+		return nil, fmt.Errorf("pattern must have at least one needle size")
+	}
+	if normalized.YarnWeight == "" {
+		return nil, fmt.Errorf("yarn weight must not be empty")
+	}
+
+	if len(normalized.Sizes) == 0 {
+		return nil, fmt.Errorf("pattern must have at least one size")
+	}
+	if len(normalized.Sizes) > maxPatternSizes {
+		return nil, fmt.Errorf("pattern must have at most %d sizes", maxPatternSizes)
+	}
+	labels := make(map[string]bool, len(normalized.Sizes))
+	for i := range normalized.Sizes {
+		size := &normalized.Sizes[i]
+		size.Label = strings.TrimSpace(size.Label)
+		size.FinishedMeasurements = strings.TrimSpace(size.FinishedMeasurements)
+		if size.Label == "" {
+			return nil, fmt.Errorf("size label must not be empty")
+		}
+		if utf8.RuneCountInString(size.Label) > 20 {
+			return nil, fmt.Errorf("size label must be 20 characters or less")
+		}
+		if labels[size.Label] {
+			return nil, fmt.Errorf("size label %q is duplicated", size.Label)
+		}
+		labels[size.Label] = true
+		if utf8.RuneCountInString(size.FinishedMeasurements) > 100 {
+			return nil, fmt.Errorf("finished measurements must be 100 characters or less")
+		}
+		if size.Yardage <= 0 {
+			return nil, fmt.Errorf("yardage of size %q must be positive: %d", size.Label, size.Yardage)
+		}
+	}
+
+	seen := make(map[string]bool, len(normalized.ItemIds))
+	itemIds := make([]ItemId, 0, len(normalized.ItemIds))
+	for _, itemId := range normalized.ItemIds {
+		if seen[itemId.Value()] {
+			continue
+		}
+		seen[itemId.Value()] = true
+		itemIds = append(itemIds, itemId)
+	}
+	normalized.ItemIds = itemIds
+	return &normalized, nil
+}
+
+func copyPatternDetails(details PatternDetails) PatternDetails {
+	copied := details
+	copied.NeedleSizes = make(NeedleSizes, len(details.NeedleSizes))
+	copy(copied.NeedleSizes, details.NeedleSizes)
+	copied.Sizes = make([]PatternSize, len(details.Sizes))
+	copy(copied.Sizes, details.Sizes)
+	copied.ItemIds = make([]ItemId, len(details.ItemIds))
+	copy(copied.ItemIds, details.ItemIds)
+	return copied
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPatternDetails(t *testing.T) PatternDetails {
+	t.Helper()
+	gauge, err := NewGauge(22, 30)
+	assert.NoError(t, err)
+	small, _ := NewNeedleSize(4)
+	large, _ := NewNeedleSize(4.5)
+	needles, err := NewNeedleSizes([]NeedleSize{*large, *small})
+	assert.NoError(t, err)
+	return PatternDetails{
+		Name:        "ラグランセーター",
+		Difficulty:  PatternDifficultyEasy,
+		Gauge:       *gauge,
+		NeedleSizes: needles,
+		YarnWeight:  YarnWeightMedium,
+		Sizes: []PatternSize{
+			{Label: "S", FinishedMeasurements: "胸囲 92cm", Yardage: 950},
+			{Label: "M", FinishedMeasurements: "胸囲 100cm", Yardage: 1100},
+		},
+	}
+}
+
+func TestNewGauge(t *testing.T) {
+	gauge, err := NewGauge(22.46, 30)
+	assert.NoError(t, err)
+	assert.Equal(t, 22.5, gauge.Stitches())
+	assert.Equal(t, 30.0, gauge.Rows())
+
+	for _, values := range [][2]float64{{0, 30}, {22, -1}, {100, 30}} {
+		_, err := NewGauge(values[0], values[1])
+		assert.Error(t, err)
+	}
+}
+
+func TestNeedleSizes(t *testing.T) {
+	t.Run("Sorted And Deduplicated", func(t *testing.T) {
+		sizes, err := ParseNeedleSizes("4.5;3.75;4.5")
+		assert.NoError(t, err)
+		assert.Equal(t, "3.75;4.5", sizes.Key())
+	})
+
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{"Not A Number", "4mm"},
+		{"Too Thin", "0.75"},
+		{"Too Thick", "25.5"},
+		{"Not A Quarter", "4.1"},
+		{"Too Many", "2;2.5;3;3.5;4;4.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseNeedleSizes(tt.key)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewPattern(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		details := newTestPatternDetails(t)
+		itemId, _ := NewItemId(uuid.NewString())
+		details.Name = " ラグランセーター "
+		details.ItemIds = []ItemId{*itemId, *itemId}
+
+		pattern, err := NewPattern(details)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ラグランセーター", pattern.Name())
+		assert.Equal(t, "4;4.5", pattern.NeedleSizes().Key())
+		assert.Equal(t, []string{itemId.Value()}, pattern.ItemIds())
+		assert.Len(t, pattern.Sizes(), 2)
+	})
+
+	tests := []struct {
+		name   string
+		modify func(details *PatternDetails)
+	}{
+		{"Empty Name", func(d *PatternDetails) { d.Name = " " }},
+		{"Name Too Long", func(d *PatternDetails) { d.Name = strings.Repeat("編", 101) }},
+		{"Description Too Long", func(d *PatternDetails) { d.Description = strings.Repeat("編", 2001) }},
+		{"Without Difficulty", func(d *PatternDetails) { d.Difficulty = "" }},
+		{"Without Gauge", func(d *PatternDetails) { d.Gauge = Gauge{} }},
+		{"Without Needle Sizes", func(d *PatternDetails) { d.NeedleSizes = nil }},
+		{"Without Yarn Weight", func(d *PatternDetails) { d.YarnWeight = "" }},
+		{"Without Sizes", func(d *PatternDetails) { d.Sizes = nil }},
+		{"Empty Size Label", func(d *PatternDetails) { d.Sizes[0].Label = " " }},
+		{"Duplicate Size Label", func(d *PatternDetails) { d.Sizes[1].Label = "S" }},
+		{"Zero Yardage", func(d *PatternDetails) { d.Sizes[0].Yardage = 0 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			details := newTestPatternDetails(t)
+			tt.modify(&details)
+			_, err := NewPattern(details)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPatternGuide_Skeins(t *testing.T) {
+	pattern, err := NewPattern(newTestPatternDetails(t))
+	assert.NoError(t, err)
+	userId, _ := NewUserId(uuid.NewString())
+	itemName, _ := NewItemName("メリノウール並太")
+	stock, _ := NewStock(10, 0, 0)
+	description, _ := NewDescription("やわらかいメリノウール")
+	price, _ := NewMoneyFromString("880", CurrencyJPY)
+	item, _ := NewItem(nil, *userId, *itemName, *stock, *description, *price)
+	yarn, _ := NewYarnAttributes(YarnDetails{Weight: YarnWeightMedium, YardagePerSkein: 100})
+	yarnItem := item.WithYarn(yarn)
+
+	t.Run("With Size", func(t *testing.T) {
+		guide, err := NewPatternGuide(pattern, Items{*yarnItem, *item}, "M")
+		assert.NoError(t, err)
+		assert.Equal(t, "M", guide.Size().Label)
+
+		skeins, ok := guide.Skeins(yarnItem)
+		assert.True(t, ok)
+		assert.Equal(t, 11, skeins)
+
+		_, ok = guide.Skeins(item)
+		assert.False(t, ok)
+	})
+
+	t.Run("Without Size", func(t *testing.T) {
+		guide, err := NewPatternGuide(pattern, Items{*yarnItem}, "")
+		assert.NoError(t, err)
+		assert.Nil(t, guide.Size())
+		_, ok := guide.Skeins(yarnItem)
+		assert.False(t, ok)
+	})
+
+	t.Run("Unknown Size", func(t *testing.T) {
+		_, err := NewPatternGuide(pattern, Items{*yarnItem}, "XL")
+		assert.True(t, errors.Is(err, ErrUnknownPatternSize))
+	})
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentStatus は決済の状態
+type PaymentStatus string
+
+const (
+	// PaymentStatusPending は決済代行サービスにオーソリを依頼する前の状態
+	PaymentStatusPending PaymentStatus = "pending"
+	// PaymentStatusRequiresAction は購入者の本人確認を待っている状態
+	PaymentStatusRequiresAction PaymentStatus = "requires_action"
+	// PaymentStatusAuthorized は与信枠を確保し、売上確定を待っている状態
+	PaymentStatusAuthorized PaymentStatus = "authorized"
+	// PaymentStatusCaptured は売上が確定した状態。注文はこのとき支払い済みになる
+	PaymentStatusCaptured PaymentStatus = "captured"
+	// PaymentStatusDeclined は拒否された状態。同じ注文を改めて支払える
+	PaymentStatusDeclined PaymentStatus = "declined"
+	// PaymentStatusRefunded は返金した状態
+	PaymentStatusRefunded PaymentStatus = "refunded"
+)
+
+var (
+	// ErrOrderNotPayable は支払い待ちでない注文や、既に決済が進んでいる注文を支払おうとした場合に返す
+	ErrOrderNotPayable = errors.New("order is not payable")
+	// ErrIllegalPaymentTransition は現在の状態から移れない状態に決済を遷移させようとした場合に返す
+	ErrIllegalPaymentTransition = errors.New("illegal payment transition")
+)
+
+var paymentTransitions = map[PaymentStatus][]PaymentStatus{
+	PaymentStatusPending:        {PaymentStatusRequiresAction, PaymentStatusAuthorized, PaymentStatusDeclined},
+	PaymentStatusRequiresAction: {PaymentStatusAuthorized, PaymentStatusDeclined},
+	PaymentStatusAuthorized:     {PaymentStatusCaptured},
+	PaymentStatusCaptured:       {PaymentStatusRefunded},
+	PaymentStatusDeclined:       {},
+	PaymentStatusRefunded:       {},
+}
+
+// CanTransitionTo は to に移れるかを返す
+func (s PaymentStatus) CanTransitionTo(to PaymentStatus) bool {
+	for _, next := range paymentTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Payment は注文に対する1回の決済。拒否された場合は同じ注文に新しい決済を作る
+type Payment struct {
+	paymentId         string
+	orderId           string
+	providerPaymentId string
+	status            PaymentStatus
+	amount            Money
+	createdAt         time.Time
+	updatedAt         time.Time
+}
+
+// NewPayment は注文の税込合計を支払う決済を作る。支払い待ちでない注文はエラーを返す
+func NewPayment(order *Order) (*Payment, error) {
+	if order.Status() != OrderStatusPendingPayment {
+		return nil, fmt.Errorf("%w: order is %s", ErrOrderNotPayable, order.Status())
+	}
+	now := time.Now()
+	return RestorePayment(uuid.NewString(), order.OrderId(), "", PaymentStatusPending, *order.Total(), now, now), nil
+}
+
+// RestorePayment は永続化済みの決済を復元する
+func RestorePayment(paymentId string, orderId string, providerPaymentId string, status PaymentStatus, amount Money, createdAt time.Time, updatedAt time.Time) *Payment {
+	return &Payment{
+		paymentId:         paymentId,
+		orderId:           orderId,
+		providerPaymentId: providerPaymentId,
+		status:            status,
+		amount:            amount,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+	}
+}
+
+// TransitionTo は to に遷移させた決済を返す。現在の状態から移れない場合はエラーを返す
+func (p *Payment) TransitionTo(to PaymentStatus) (*Payment, error) {
+	if !p.status.CanTransitionTo(to) {
+		return nil, fmt.Errorf("%w: %s -> %s", ErrIllegalPaymentTransition, p.status, to)
+	}
+	return RestorePayment(p.paymentId, p.orderId, p.providerPaymentId, to, p.amount, p.createdAt, time.Now()), nil
+}
+
+// Authorize はオーソリの結果を反映した決済を返す
+func (p *Payment) Authorize(providerPaymentId string, to PaymentStatus) (*Payment, error) {
+	if p.status != PaymentStatusPending {
+		return nil, fmt.Errorf("%w: %s is already sent to the provider", ErrIllegalPaymentTransition, p.paymentId)
+	}
+	next, err := p.TransitionTo(to)
+	if err != nil {
+		return nil, err
+	}
+	next.providerPaymentId = providerPaymentId
+	return next, nil
+}
+
+func (p *Payment) PaymentId() string {
+	return p.paymentId
+}
+
+func (p *Payment) OrderId() string {
+	return p.orderId
+}
+
+// ProviderPaymentId は決済代行サービスでの決済 ID を返す。オーソリを依頼する前は空
+func (p *Payment) ProviderPaymentId() string {
+	return p.providerPaymentId
+}
+
+func (p *Payment) Status() PaymentStatus {
+	return p.status
+}
+
+func (p *Payment) Amount() *Money {
+	amount := p.amount
+	return &amount
+}
+
+func (p *Payment) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *Payment) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// IsActive は注文の支払いとして有効な決済（拒否・返金されていない）かを返す
+// 有効な決済がある注文には新しい決済を作らない
+func (p *Payment) IsActive() bool {
+	return p.status != PaymentStatusDeclined && p.status != PaymentStatusRefunded
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPayment(t *testing.T) {
+	order := newTestOrder(t, OrderStatusPendingPayment)
+
+	payment, err := NewPayment(order)
+
+	assert.NoError(t, err)
+	assert.Equal(t, order.OrderId(), payment.OrderId())
+	assert.Equal(t, PaymentStatusPending, payment.Status())
+	assert.Equal(t, order.Total().String(), payment.Amount().String())
+	assert.Empty(t, payment.ProviderPaymentId())
+	assert.True(t, payment.IsActive())
+
+	_, err = NewPayment(newTestOrder(t, OrderStatusPaid))
+	assert.True(t, errors.Is(err, ErrOrderNotPayable))
+}
+
+func TestPaymentStatus_CanTransitionTo(t *testing.T) {
+	legal := map[PaymentStatus][]PaymentStatus{
+		PaymentStatusPending:        {PaymentStatusRequiresAction, PaymentStatusAuthorized, PaymentStatusDeclined},
+		PaymentStatusRequiresAction: {PaymentStatusAuthorized, PaymentStatusDeclined},
+		PaymentStatusAuthorized:     {PaymentStatusCaptured},
+		PaymentStatusCaptured:       {PaymentStatusRefunded},
+	}
+	statuses := []PaymentStatus{PaymentStatusPending, PaymentStatusRequiresAction, PaymentStatusAuthorized, PaymentStatusCaptured, PaymentStatusDeclined, PaymentStatusRefunded}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			expected := false
+			for _, next := range legal[from] {
+				expected = expected || next == to
+			}
+			assert.Equal(t, expected, from.CanTransitionTo(to), "%s -> %s", from, to)
+		}
+	}
+}
+
+func TestPayment_Authorize(t *testing.T) {
+	payment, _ := NewPayment(newTestOrder(t, OrderStatusPendingPayment))
+
+	authorized, err := payment.Authorize("fakepay_1", PaymentStatusRequiresAction)
+	assert.NoError(t, err)
+	assert.Equal(t, "fakepay_1", authorized.ProviderPaymentId())
+	assert.Equal(t, PaymentStatusRequiresAction, authorized.Status())
+	assert.Equal(t, PaymentStatusPending, payment.Status())
+
+	_, err = authorized.Authorize("fakepay_2", PaymentStatusAuthorized)
+	assert.True(t, errors.Is(err, ErrIllegalPaymentTransition))
+	_, err = payment.Authorize("fakepay_1", PaymentStatusCaptured)
+	assert.True(t, errors.Is(err, ErrIllegalPaymentTransition))
+}
+
+func TestPayment_TransitionTo(t *testing.T) {
+	payment, _ := NewPayment(newTestOrder(t, OrderStatusPendingPayment))
+	authorized, _ := payment.Authorize("fakepay_1", PaymentStatusAuthorized)
+
+	captured, err := authorized.TransitionTo(PaymentStatusCaptured)
+	assert.NoError(t, err)
+	assert.Equal(t, "fakepay_1", captured.ProviderPaymentId())
+	assert.True(t, captured.IsActive())
+
+	refunded, err := captured.TransitionTo(PaymentStatusRefunded)
+	assert.NoError(t, err)
+	assert.False(t, refunded.IsActive())
+
+	_, err = refunded.TransitionTo(PaymentStatusCaptured)
+	assert.True(t, errors.Is(err, ErrIllegalPaymentTransition))
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var postalCodePattern = regexp.MustCompile(`^[0-9]{3}-?[0-9]{4}$`)
+
+// postalCodeReplacer は全角の数字・ハイフンと郵便記号を半角に揃える
+var postalCodeReplacer = strings.NewReplacer(
+	"〒", "",
+	"０", "0", "１", "1", "２", "2", "３", "3", "４", "4",
+	"５", "5", "６", "6", "７", "7", "８", "8", "９", "9",
+	"－", "-", "ー", "-", "‐", "-", "−", "-",
+)
+
+// PostalCode は日本の郵便番号。"123-4567" の形式に揃える
+type PostalCode struct {
+	value string
+}
+
+// NewPostalCode は "1234567"・"123-4567"・"〒１２３－４５６７" のような入力を受け付ける
+func NewPostalCode(value string) (*PostalCode, error) {
+	normalized := strings.TrimSpace(postalCodeReplacer.Replace(strings.TrimSpace(value)))
+	if !postalCodePattern.MatchString(normalized) {
+		return nil, fmt.Errorf("postal code must be 7 digits such as 123-4567: %q", value)
+	}
+	digits := strings.ReplaceAll(normalized, "-", "")
+	return &PostalCode{value: digits[:3] + "-" + digits[3:]}, nil
+}
+
+func (p *PostalCode) Value() string {
+	return p.value
+}
+
+func (p *PostalCode) String() string {
+	return p.value
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPostalCode(t *testing.T) {
+	for _, value := range []string{"100-0001", "1000001", " 〒100-0001 ", "〒１００－０００１", "１００ー０００１"} {
+		postalCode, err := NewPostalCode(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, "100-0001", postalCode.Value(), value)
+	}
+}
+
+func TestNewPostalCodeInvalidFormatError(t *testing.T) {
+	for _, value := range []string{"", "100", "100-001", "10000011", "1000-001", "abc-defg", "100--0001"} {
+		_, err := NewPostalCode(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// prefectureNames は JIS X 0401 の都道府県コード順の都道府県名
+var prefectureNames = [...]string{
+	"北海道", "青森県", "岩手県", "宮城県", "秋田県", "山形県", "福島県",
+	"茨城県", "栃木県", "群馬県", "埼玉県", "千葉県", "東京都", "神奈川県",
+	"新潟県", "富山県", "石川県", "福井県", "山梨県", "長野県", "岐阜県",
+	"静岡県", "愛知県", "三重県", "滋賀県", "京都府", "大阪府", "兵庫県",
+	"奈良県", "和歌山県", "鳥取県", "島根県", "岡山県", "広島県", "山口県",
+	"徳島県", "香川県", "愛媛県", "高知県", "福岡県", "佐賀県", "長崎県",
+	"熊本県", "大分県", "宮崎県", "鹿児島県", "沖縄県",
+}
+
+// Prefecture は都道府県。コードは JIS X 0401 の 1〜47
+type Prefecture struct {
+	code int
+}
+
+// NewPrefecture は "東京都" のような正式な都道府県名から都道府県を作る
+func NewPrefecture(name string) (*Prefecture, error) {
+	name = strings.TrimSpace(name)
+	for i, prefectureName := range prefectureNames {
+		if prefectureName == name {
+			return &Prefecture{code: i + 1}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown prefecture: %q", name)
+}
+
+// NewPrefectureFromCode は都道府県コードから都道府県を作る
+func NewPrefectureFromCode(code int) (*Prefecture, error) {
+	if code < 1 || code > len(prefectureNames) {
+		return nil, fmt.Errorf("prefecture code must be between 1 and %d: %d", len(prefectureNames), code)
+	}
+	return &Prefecture{code: code}, nil
+}
+
+// Prefectures はすべての都道府県をコード順に返す
+func Prefectures() []Prefecture {
+	prefectures := make([]Prefecture, len(prefectureNames))
+	for i := range prefectureNames {
+		prefectures[i] = Prefecture{code: i + 1}
+	}
+	return prefectures
+}
+
+func (p *Prefecture) Code() int {
+	return p.code
+}
+
+func (p *Prefecture) Name() string {
+	return prefectureNames[p.code-1]
+}
+
+func (p *Prefecture) String() string {
+	return p.Name()
+}
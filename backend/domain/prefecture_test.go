@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPrefecture(t *testing.T) {
+	prefecture, err := NewPrefecture(" 東京都 ")
+	assert.NoError(t, err)
+	assert.Equal(t, 13, prefecture.Code())
+	assert.Equal(t, "東京都", prefecture.Name())
+
+	for _, name := range []string{"", "東京", "Tokyo", "大阪都"} {
+		_, err := NewPrefecture(name)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestNewPrefectureFromCode(t *testing.T) {
+	hokkaido, err := NewPrefectureFromCode(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "北海道", hokkaido.Name())
+	okinawa, err := NewPrefectureFromCode(47)
+	assert.NoError(t, err)
+	assert.Equal(t, "沖縄県", okinawa.Name())
+
+	for _, code := range []int{0, 48, -1} {
+		_, err := NewPrefectureFromCode(code)
+		assert.Error(t, err, code)
+	}
+}
+
+func TestPrefectures(t *testing.T) {
+	prefectures := Prefectures()
+	assert.Len(t, prefectures, 47)
+	for i, prefecture := range prefectures {
+		assert.Equal(t, i+1, prefecture.Code())
+		restored, err := NewPrefecture(prefecture.Name())
+		assert.NoError(t, err)
+		assert.Equal(t, prefecture.Code(), restored.Code())
+	}
+}
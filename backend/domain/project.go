@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ProgressPercentage は編み物の進捗率（0〜100）
+type ProgressPercentage struct {
+	value int
+}
+
+func NewProgressPercentage(value int) (*ProgressPercentage, error) {
+	if value < 0 || value > 100 {
+		return nil, fmt.Errorf("progress percentage must be between 0 and 100: %d", value)
+	}
+	return &ProgressPercentage{value: value}, nil
+}
+
+func (p ProgressPercentage) Value() int {
+	return p.value
+}
+
+// ProjectDetails はユーザーが編集できる編み物の作品の項目
+// ItemId は使っている商品で、商品と結び付けない作品では nil。PatternId は使っている編み図で、結び付けない作品では空
+type ProjectDetails struct {
+	Name      string
+	ItemId    *ItemId
+	PatternId string
+	Progress  ProgressPercentage
+	RowCount  int
+	Notes     string
+}
+
+// Project はユーザーが編んでいる作品。進捗率と段数が変わるたびにスナップショットを記録する
+type Project struct {
+	projectId string
+	userId    UserId
+	details   ProjectDetails
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewProject(userId UserId, details ProjectDetails) (*Project, error) {
+	normalized, err := normalizeProjectDetails(details)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return RestoreProject(uuid.NewString(), userId, *normalized, now, now), nil
+}
+
+// RestoreProject は永続化済みの作品を復元する
+func RestoreProject(projectId string, userId UserId, details ProjectDetails, createdAt time.Time, updatedAt time.Time) *Project {
+	return &Project{
+		projectId: projectId,
+		userId:    userId,
+		details:   details,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// Edit は項目をすべて置き換えた作品を返す
+func (p *Project) Edit(details ProjectDetails) (*Project, error) {
+	normalized, err := normalizeProjectDetails(details)
+	if err != nil {
+		return nil, err
+	}
+	return RestoreProject(p.projectId, p.userId, *normalized, p.createdAt, time.Now()), nil
+}
+
+// Snapshot は現在の進捗率と段数を記録したスナップショットを作る
+func (p *Project) Snapshot() *ProjectSnapshot {
+	return RestoreProjectSnapshot(uuid.NewString(), p.projectId, p.details.Progress, p.details.RowCount, p.updatedAt)
+}
+
+func (p *Project) ProjectId() string {
+	return p.projectId
+}
+
+func (p *Project) UserId() string {
+	return p.userId.Value()
+}
+
+func (p *Project) Name() string {
+	return p.details.Name
+}
+
+// ItemId は使っている商品の ID を返す。商品と結び付けていなければ空
+func (p *Project) ItemId() string {
+	if p.details.ItemId == nil {
+		return ""
+	}
+	return p.details.ItemId.Value()
+}
+
+// PatternId は使っている編み図の ID を返す。編み図と結び付けていなければ空
+func (p *Project) PatternId() string {
+	return p.details.PatternId
+}
+
+func (p *Project) Progress() int {
+	return p.details.Progress.value
+}
+
+// RowCount は段数カウンターの値を返す
+func (p *Project) RowCount() int {
+	return p.details.RowCount
+}
+
+func (p *Project) Notes() string {
+	return p.details.Notes
+}
+
+func (p *Project) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *Project) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// ProjectSnapshot はある時点の作品の進捗率と段数。進捗のグラフに使う
+type ProjectSnapshot struct {
+	snapshotId string
+	projectId  string
+	progress   ProgressPercentage
+	rowCount   int
+	recordedAt time.Time
+}
+
+// RestoreProjectSnapshot は永続化済みのスナップショットを復元する
+func RestoreProjectSnapshot(snapshotId string, projectId string, progress ProgressPercentage, rowCount int, recordedAt time.Time) *ProjectSnapshot {
+	return &ProjectSnapshot{
+		snapshotId: snapshotId,
+		projectId:  projectId,
+		progress:   progress,
+		rowCount:   rowCount,
+		recordedAt: recordedAt,
+	}
+}
+
+func (s *ProjectSnapshot) SnapshotId() string {
+	return s.snapshotId
+}
+
+func (s *ProjectSnapshot) ProjectId() string {
+	return s.projectId
+}
+
+func (s *ProjectSnapshot) Progress() int {
+	return s.progress.value
+}
+
+func (s *ProjectSnapshot) RowCount() int {
+	return s.rowCount
+}
+
+func (s *ProjectSnapshot) RecordedAt() time.Time {
+	return s.recordedAt
+}
+
+func normalizeProjectDetails(details ProjectDetails) (*ProjectDetails, error) {
+	normalized := details
+	normalized.Name = strings.TrimSpace(details.Name)
+	if normalized.Name == "" {
+		return nil, fmt.Errorf("name must not be empty")
+	}
+	if utf8.RuneCountInString(normalized.Name) > 100 {
+		return nil, fmt.Errorf("name must be 100 characters or less")
+	}
+	if details.RowCount < 0 {
+		return nil, fmt.Errorf("row count must not be negative: %d", details.RowCount)
+	}
+	normalized.Notes = strings.TrimSpace(details.Notes)
+	if utf8.RuneCountInString(normalized.Notes) > 2000 {
+		return nil, fmt.Errorf("notes must be 2000 characters or less")
+	}
+	return &normalized, nil
+}
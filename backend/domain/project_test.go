@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProgressPercentage(t *testing.T) {
+	for _, value := range []int{0, 50, 100} {
+		progress, err := NewProgressPercentage(value)
+		assert.NoError(t, err)
+		assert.Equal(t, value, progress.Value())
+	}
+	for _, value := range []int{-1, 101} {
+		_, err := NewProgressPercentage(value)
+		assert.Error(t, err)
+	}
+}
+
+func TestNewProject(t *testing.T) {
+	userId, _ := NewUserId(uuid.NewString())
+	itemId, _ := NewItemId(uuid.NewString())
+	progress, _ := NewProgressPercentage(30)
+
+	t.Run("Success", func(t *testing.T) {
+		project, err := NewProject(*userId, ProjectDetails{Name: " ラグランセーター ", ItemId: itemId, Progress: *progress, RowCount: 42, Notes: " 袖を編み中 "})
+		assert.NoError(t, err)
+		assert.Equal(t, "ラグランセーター", project.Name())
+		assert.Equal(t, itemId.Value(), project.ItemId())
+		assert.Equal(t, 30, project.Progress())
+		assert.Equal(t, 42, project.RowCount())
+		assert.Equal(t, "袖を編み中", project.Notes())
+	})
+
+	t.Run("Without Item", func(t *testing.T) {
+		project, err := NewProject(*userId, ProjectDetails{Name: "ラグランセーター"})
+		assert.NoError(t, err)
+		assert.Equal(t, "", project.ItemId())
+		assert.Equal(t, 0, project.Progress())
+	})
+
+	tests := []struct {
+		name    string
+		details ProjectDetails
+	}{
+		{"Empty Name", ProjectDetails{Name: " "}},
+		{"Name Too Long", ProjectDetails{Name: strings.Repeat("編", 101)}},
+		{"Negative Row Count", ProjectDetails{Name: "ラグランセーター", RowCount: -1}},
+		{"Notes Too Long", ProjectDetails{Name: "ラグランセーター", Notes: strings.Repeat("編", 2001)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewProject(*userId, tt.details)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestProject_Snapshot(t *testing.T) {
+	userId, _ := NewUserId(uuid.NewString())
+	project, _ := NewProject(*userId, ProjectDetails{Name: "ラグランセーター"})
+	progress, _ := NewProgressPercentage(60)
+
+	edited, err := project.Edit(ProjectDetails{Name: "ラグランセーター", Progress: *progress, RowCount: 120})
+	assert.NoError(t, err)
+	snapshot := edited.Snapshot()
+
+	assert.Equal(t, project.ProjectId(), snapshot.ProjectId())
+	assert.Equal(t, 60, snapshot.Progress())
+	assert.Equal(t, 120, snapshot.RowCount())
+	assert.Equal(t, edited.UpdatedAt(), snapshot.RecordedAt())
+}
@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ErrReceiptNotIssuable は支払い前の注文や、取り消し・返金した注文の領収書を発行しようとした場合に返す
+var ErrReceiptNotIssuable = errors.New("receipt not issuable")
+
+// ReceiptIssuer は領収書の発行者。適格請求書発行事業者として登録した名称と登録番号
+type ReceiptIssuer struct {
+	name               string
+	registrationNumber InvoiceRegistrationNumber
+}
+
+func NewReceiptIssuer(name string, registrationNumber InvoiceRegistrationNumber) (*ReceiptIssuer, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("issuer name must not be empty")
+	}
+	if utf8.RuneCountInString(name) > 100 {
+		return nil, fmt.Errorf("issuer name must be 100 characters or less")
+	}
+	return &ReceiptIssuer{name: name, registrationNumber: registrationNumber}, nil
+}
+
+func (i *ReceiptIssuer) Name() string {
+	return i.name
+}
+
+func (i *ReceiptIssuer) RegistrationNumber() string {
+	return i.registrationNumber.Value()
+}
+
+// ReceiptContent は適格請求書として領収書に記載する事項
+// 明細ごとの税率、税率ごとの合計と消費税額は注文時点の明細から計算する
+type ReceiptContent struct {
+	receiptNumber string
+	issuer        ReceiptIssuer
+	recipientName string
+	order         Order
+	breakdown     TaxBreakdown
+	issuedAt      time.Time
+}
+
+// NewReceiptContent は注文の領収書に記載する事項を作る。recipientName は宛名で、空の場合は宛名を記載しない
+// 支払い前の注文や、取り消し・返金した注文では ErrReceiptNotIssuable を返す
+func NewReceiptContent(order *Order, issuer ReceiptIssuer, recipientName string, issuedAt time.Time) (*ReceiptContent, error) {
+	if !order.status.IsReceiptIssuable() {
+		return nil, fmt.Errorf("%w: order is %s", ErrReceiptNotIssuable, order.status)
+	}
+	breakdown, err := order.TaxBreakdown()
+	if err != nil {
+		return nil, err
+	}
+	if !breakdown.Tax().Equals(order.Tax()) {
+		return nil, fmt.Errorf("tax of order %s does not match the per-rate breakdown: %s and %s", order.orderId, order.tax.String(), breakdown.Tax().String())
+	}
+	return &ReceiptContent{
+		receiptNumber: receiptNumber(order.orderId, issuedAt),
+		issuer:        issuer,
+		recipientName: strings.TrimSpace(recipientName),
+		order:         *order,
+		breakdown:     *breakdown,
+		issuedAt:      issuedAt,
+	}, nil
+}
+
+// receiptNumber は発行日と注文 ID の先頭8文字から領収書番号を作る（例: "R20261018-1A2B3C4D"）
+// 領収書は1つの注文に1枚だけ発行するため、注文 ID から作れば重複しない
+func receiptNumber(orderId string, issuedAt time.Time) string {
+	suffix := strings.ToUpper(strings.ReplaceAll(orderId, "-", ""))
+	if len(suffix) > 8 {
+		suffix = suffix[:8]
+	}
+	return fmt.Sprintf("R%s-%s", issuedAt.Format("20060102"), suffix)
+}
+
+func (c *ReceiptContent) ReceiptNumber() string {
+	return c.receiptNumber
+}
+
+func (c *ReceiptContent) Issuer() *ReceiptIssuer {
+	issuer := c.issuer
+	return &issuer
+}
+
+// RecipientName は宛名を返す。宛名を記載しない場合は空
+func (c *ReceiptContent) RecipientName() string {
+	return c.recipientName
+}
+
+func (c *ReceiptContent) Order() *Order {
+	order := c.order
+	return &order
+}
+
+// TaxBreakdown は税率ごとの合計と消費税額を返す
+func (c *ReceiptContent) TaxBreakdown() *TaxBreakdown {
+	breakdown := c.breakdown
+	return &breakdown
+}
+
+func (c *ReceiptContent) IssuedAt() time.Time {
+	return c.issuedAt
+}
+
+// Receipt は発行済みの領収書。発行時に作った HTML とテキストをそのまま保持し、発行後は書き換えない
+type Receipt struct {
+	receiptId          string
+	receiptNumber      string
+	orderId            string
+	userId             UserId
+	issuerName         string
+	registrationNumber string
+	total              Money
+	html               string
+	text               string
+	issuedAt           time.Time
+}
+
+// NewReceipt は記載事項と、それを HTML とテキストにしたものから領収書を作る
+func NewReceipt(content *ReceiptContent, html string, text string) *Receipt {
+	return RestoreReceipt(
+		uuid.NewString(),
+		content.receiptNumber,
+		content.order.orderId,
+		content.order.userId,
+		content.issuer.name,
+		content.issuer.RegistrationNumber(),
+		content.order.total,
+		html,
+		text,
+		content.issuedAt,
+	)
+}
+
+// RestoreReceipt は永続化済みの領収書を復元する
+func RestoreReceipt(receiptId string, receiptNumber string, orderId string, userId UserId, issuerName string, registrationNumber string, total Money, html string, text string, issuedAt time.Time) *Receipt {
+	return &Receipt{
+		receiptId:          receiptId,
+		receiptNumber:      receiptNumber,
+		orderId:            orderId,
+		userId:             userId,
+		issuerName:         issuerName,
+		registrationNumber: registrationNumber,
+		total:              total,
+		html:               html,
+		text:               text,
+		issuedAt:           issuedAt,
+	}
+}
+
+func (r *Receipt) ReceiptId() string {
+	return r.receiptId
+}
+
+func (r *Receipt) ReceiptNumber() string {
+	return r.receiptNumber
+}
+
+func (r *Receipt) OrderId() string {
+	return r.orderId
+}
+
+func (r *Receipt) UserId() string {
+	return r.userId.Value()
+}
+
+// IssuerName は発行時点の発行者の名称を返す
+func (r *Receipt) IssuerName() string {
+	return r.issuerName
+}
+
+// RegistrationNumber は発行時点の登録番号を返す
+func (r *Receipt) RegistrationNumber() string {
+	return r.registrationNumber
+}
+
+// Total は税込の合計を返す
+func (r *Receipt) Total() *Money {
+	total := r.total
+	return &total
+}
+
+// HTML は発行時に作った HTML の領収書を返す
+func (r *Receipt) HTML() string {
+	return r.html
+}
+
+// Text は発行時に作ったテキストの領収書を返す
+func (r *Receipt) Text() string {
+	return r.text
+}
+
+func (r *Receipt) IssuedAt() time.Time {
+	return r.issuedAt
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestReceiptIssuer(t *testing.T) *ReceiptIssuer {
+	t.Helper()
+	number, err := NewInvoiceRegistrationNumber("T7000012050002")
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer, err := NewReceiptIssuer("Posiposi Yarn", *number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return issuer
+}
+
+func newTestReceiptOrder(t *testing.T, status OrderStatus) *Order {
+	t.Helper()
+	yarn := newCartTestItem(t, 10, 0, "1000")
+	tea := newCartTestItem(t, 10, 0, "540").WithTaxRate(TaxRateReduced)
+	yarnLine, _, _ := ReserveOrderLine(yarn, newTestOrderRequestLine(t, yarn, "", 2))
+	teaLine, _, _ := ReserveOrderLine(tea, newTestOrderRequestLine(t, tea, "", 1))
+	userId, _ := NewUserId(uuid.NewString())
+	order, err := NewOrder(*userId, []OrderLine{*yarnLine, *teaLine})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return RestoreOrder("3f2a9c1e-7b4d-4e8a-9f10-2c3d4e5f6a7b", *userId, status, order.Lines(), *order.Subtotal(), *order.Discount(), *order.Tax(), *order.Total(), "", order.Transitions(), order.CreatedAt(), order.UpdatedAt())
+}
+
+func TestNewReceiptIssuer(t *testing.T) {
+	number, _ := NewInvoiceRegistrationNumber("T7000012050002")
+	issuer, err := NewReceiptIssuer("  Posiposi Yarn ", *number)
+	assert.NoError(t, err)
+	assert.Equal(t, "Posiposi Yarn", issuer.Name())
+	assert.Equal(t, "T7000012050002", issuer.RegistrationNumber())
+
+	_, err = NewReceiptIssuer(" ", *number)
+	assert.Error(t, err)
+}
+
+func TestNewReceiptContent(t *testing.T) {
+	issuedAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	for _, status := range []OrderStatus{OrderStatusPaid, OrderStatusPreparing, OrderStatusShipped, OrderStatusDelivered} {
+		content, err := NewReceiptContent(newTestReceiptOrder(t, status), *newTestReceiptIssuer(t), " Hanako ", issuedAt)
+		assert.NoError(t, err, status)
+		assert.Equal(t, "R20261018-3F2A9C1E", content.ReceiptNumber(), status)
+		assert.Equal(t, "Hanako", content.RecipientName(), status)
+		assert.Len(t, content.TaxBreakdown().Totals(), 2, status)
+	}
+
+	for _, status := range []OrderStatus{OrderStatusPendingPayment, OrderStatusCancelled, OrderStatusRefunded} {
+		_, err := NewReceiptContent(newTestReceiptOrder(t, status), *newTestReceiptIssuer(t), "Hanako", issuedAt)
+		assert.True(t, errors.Is(err, ErrReceiptNotIssuable), status)
+	}
+}
+
+func TestNewReceipt(t *testing.T) {
+	order := newTestReceiptOrder(t, OrderStatusPaid)
+	content, _ := NewReceiptContent(order, *newTestReceiptIssuer(t), "Hanako", time.Now())
+
+	receipt := NewReceipt(content, "<p>receipt</p>", "receipt")
+
+	assert.NotEmpty(t, receipt.ReceiptId())
+	assert.Equal(t, content.ReceiptNumber(), receipt.ReceiptNumber())
+	assert.Equal(t, order.OrderId(), receipt.OrderId())
+	assert.Equal(t, order.UserId(), receipt.UserId())
+	assert.Equal(t, "Posiposi Yarn", receipt.IssuerName())
+	assert.Equal(t, "T7000012050002", receipt.RegistrationNumber())
+	assert.Equal(t, "2783", receipt.Total().String())
+	assert.Equal(t, "<p>receipt</p>", receipt.HTML())
+	assert.Equal(t, "receipt", receipt.Text())
+}
@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ErrDuplicateReview は同じ商品にレビューを2件書こうとした場合に返す
+var ErrDuplicateReview = errors.New("duplicate review")
+
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+// Rating は1〜5の評価
+type Rating struct {
+	value int
+}
+
+func NewRating(value int) (*Rating, error) {
+	if value < MinRating || value > MaxRating {
+		return nil, fmt.Errorf("rating must be between %d and %d: %d", MinRating, MaxRating, value)
+	}
+	return &Rating{value: value}, nil
+}
+
+func (r Rating) Value() int {
+	return r.value
+}
+
+// RatingSummary は商品のレビューの件数と評価の合計。レビューの追加・編集と同じトランザクションで更新する
+type RatingSummary struct {
+	count int
+	total int
+}
+
+func NewRatingSummary(count int, total int) RatingSummary {
+	return RatingSummary{count: count, total: total}
+}
+
+// Count はレビューの件数を返す
+func (s RatingSummary) Count() int {
+	return s.count
+}
+
+// Average は評価の平均を小数第1位に丸めて返す。レビューがなければ 0
+func (s RatingSummary) Average() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return math.Round(float64(s.total)/float64(s.count)*10) / 10
+}
+
+// ReviewContent はレビューの評価・タイトル・本文
+type ReviewContent struct {
+	Rating Rating
+	Title  string
+	Body   string
+}
+
+// Review はユーザーが購入した商品などに書いたレビュー。1人のユーザーが書けるのは1商品につき1件
+type Review struct {
+	reviewId   string
+	userId     UserId
+	authorName string
+	itemId     ItemId
+	content    ReviewContent
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+func NewReview(userId UserId, itemId ItemId, content ReviewContent) (*Review, error) {
+	normalized, err := normalizeReviewContent(content)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return RestoreReview(uuid.NewString(), userId, "", itemId, *normalized, now, now), nil
+}
+
+// RestoreReview は永続化済みのレビューを復元する。authorName は書いたユーザーの現在の名前
+func RestoreReview(reviewId string, userId UserId, authorName string, itemId ItemId, content ReviewContent, createdAt time.Time, updatedAt time.Time) *Review {
+	return &Review{
+		reviewId:   reviewId,
+		userId:     userId,
+		authorName: authorName,
+		itemId:     itemId,
+		content:    content,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// Edit は評価・タイトル・本文を置き換えたレビューを返す
+func (r *Review) Edit(content ReviewContent) (*Review, error) {
+	normalized, err := normalizeReviewContent(content)
+	if err != nil {
+		return nil, err
+	}
+	return RestoreReview(r.reviewId, r.userId, r.authorName, r.itemId, *normalized, r.createdAt, time.Now()), nil
+}
+
+// IsWrittenBy はレビューを書いたユーザーかを返す
+func (r *Review) IsWrittenBy(userId string) bool {
+	return r.userId.Value() == userId
+}
+
+func (r *Review) ReviewId() string {
+	return r.reviewId
+}
+
+func (r *Review) UserId() string {
+	return r.userId.Value()
+}
+
+// AuthorName はレビューを書いたユーザーの名前を返す
+func (r *Review) AuthorName() string {
+	return r.authorName
+}
+
+func (r *Review) ItemId() string {
+	return r.itemId.Value()
+}
+
+func (r *Review) Rating() int {
+	return r.content.Rating.value
+}
+
+func (r *Review) Title() string {
+	return r.content.Title
+}
+
+func (r *Review) Body() string {
+	return r.content.Body
+}
+
+func (r *Review) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *Review) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+func normalizeReviewContent(content ReviewContent) (*ReviewContent, error) {
+	if content.Rating.value == 0 {
+		return nil, fmt.Errorf("rating must not be empty")
+	}
+	normalized := content
+	fields := []struct {
+		name  string
+		value *string
+		max   int
+	}{
+		{name: "title", value: &normalized.Title, max: 100},
+		{name: "body", value: &normalized.Body, max: 2000},
+	}
+	for _, field := range fields {
+		*field.value = strings.TrimSpace(*field.value)
+		if *field.value == "" {
+			return nil, fmt.Errorf("%s must not be empty", field.name)
+		}
+		if utf8.RuneCountInString(*field.value) > field.max {
+			return nil, fmt.Errorf("%s must be %d characters or less", field.name, field.max)
+		}
+	}
+	return &normalized, nil
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRating(t *testing.T) {
+	for _, value := range []int{1, 3, 5} {
+		rating, err := NewRating(value)
+		assert.NoError(t, err)
+		assert.Equal(t, value, rating.Value())
+	}
+	for _, value := range []int{0, 6, -1} {
+		_, err := NewRating(value)
+		assert.Error(t, err)
+	}
+}
+
+func TestRatingSummary_Average(t *testing.T) {
+	assert.Equal(t, 0.0, NewRatingSummary(0, 0).Average())
+	assert.Equal(t, 4.0, NewRatingSummary(2, 8).Average())
+	assert.Equal(t, 4.3, NewRatingSummary(3, 13).Average())
+	assert.Equal(t, 3.7, NewRatingSummary(3, 11).Average())
+}
+
+func TestNewReview(t *testing.T) {
+	userId, _ := NewUserId(uuid.NewString())
+	itemId, _ := NewItemId(uuid.NewString())
+	rating, _ := NewRating(4)
+
+	t.Run("Trims Title And Body", func(t *testing.T) {
+		review, err := NewReview(*userId, *itemId, ReviewContent{Rating: *rating, Title: "  編みやすい ", Body: " 発色がきれいです\n"})
+		assert.NoError(t, err)
+		assert.Equal(t, 4, review.Rating())
+		assert.Equal(t, "編みやすい", review.Title())
+		assert.Equal(t, "発色がきれいです", review.Body())
+		assert.True(t, review.IsWrittenBy(userId.Value()))
+	})
+
+	tests := []struct {
+		name    string
+		content ReviewContent
+	}{
+		{"Missing Rating", ReviewContent{Title: "編みやすい", Body: "発色がきれいです"}},
+		{"Empty Title", ReviewContent{Rating: *rating, Title: " ", Body: "発色がきれいです"}},
+		{"Empty Body", ReviewContent{Rating: *rating, Title: "編みやすい", Body: ""}},
+		{"Title Too Long", ReviewContent{Rating: *rating, Title: strings.Repeat("毛", 101), Body: "発色がきれいです"}},
+		{"Body Too Long", ReviewContent{Rating: *rating, Title: "編みやすい", Body: strings.Repeat("毛", 2001)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewReview(*userId, *itemId, tt.content)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestReview_Edit(t *testing.T) {
+	userId, _ := NewUserId(uuid.NewString())
+	itemId, _ := NewItemId(uuid.NewString())
+	four, _ := NewRating(4)
+	two, _ := NewRating(2)
+	review, _ := NewReview(*userId, *itemId, ReviewContent{Rating: *four, Title: "編みやすい", Body: "発色がきれいです"})
+
+	edited, err := review.Edit(ReviewContent{Rating: *two, Title: "毛玉ができやすい", Body: "洗濯すると毛玉が目立ちます"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, review.ReviewId(), edited.ReviewId())
+	assert.Equal(t, 2, edited.Rating())
+	assert.Equal(t, 4, review.Rating())
+	assert.Equal(t, review.CreatedAt(), edited.CreatedAt())
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"html"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	highlightOpenTag  = "<mark>"
+	highlightCloseTag = "</mark>"
+	snippetContext    = 30
+)
+
+// Highlight は text 中の検索語を <mark> で囲んだ抜粋を返す
+// 検索語以外の部分は HTML エスケープするため、そのまま埋め込んで表示できる
+// 最初に一致した位置の前後 snippetContext 文字を抜粋し、省略した側には "…" を付ける
+// 一致しない場合は空文字を返す
+func Highlight(text string, terms []string) string {
+	runes := []rune(text)
+	lower := []rune(strings.ToLower(text))
+	if len(lower) != len(runes) {
+		// 大文字小文字変換で文字数が変わる場合は元の文字列で照合する
+		lower = runes
+	}
+
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		needle := []rune(strings.ToLower(term))
+		if len(needle) == 0 || utf8.RuneCountInString(term) != len(needle) {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(lower); i++ {
+			if !slices.Equal(lower[i:i+len(needle)], needle) {
+				continue
+			}
+			for j := i; j < i+len(needle); j++ {
+				marked[j] = true
+			}
+			if first == -1 || i < first {
+				first = i
+			}
+		}
+	}
+	if first == -1 {
+		return ""
+	}
+
+	start := max(first-snippetContext, 0)
+	end := min(first+snippetContext*2, len(runes))
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	inMark := false
+	for i := start; i < end; i++ {
+		if marked[i] && !inMark {
+			b.WriteString(highlightOpenTag)
+			inMark = true
+		}
+		if !marked[i] && inMark {
+			b.WriteString(highlightCloseTag)
+			inMark = false
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+	}
+	if inMark {
+		b.WriteString(highlightCloseTag)
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		terms    []string
+		expected string
+	}{
+		{"single term", "メリノウールの毛糸", []string{"毛糸"}, "メリノウールの<mark>毛糸</mark>"},
+		{"multiple terms", "メリノウールの毛糸", []string{"ウール", "毛糸"}, "メリノ<mark>ウール</mark>の<mark>毛糸</mark>"},
+		{"adjacent matches are merged", "ウール毛糸", []string{"ウール", "毛糸"}, "<mark>ウール毛糸</mark>"},
+		{"case insensitive", "Soft Merino", []string{"merino"}, "Soft <mark>Merino</mark>"},
+		{"escapes html", "<b>wool</b>", []string{"wool"}, "&lt;b&gt;<mark>wool</mark>&lt;/b&gt;"},
+		{"no match", "コットン", []string{"毛糸"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Highlight(tt.text, tt.terms))
+		})
+	}
+}
+
+func TestHighlightTruncatesAroundFirstMatch(t *testing.T) {
+	text := strings.Repeat("あ", 50) + "毛糸" + strings.Repeat("い", 100)
+
+	result := Highlight(text, []string{"毛糸"})
+
+	assert.True(t, strings.HasPrefix(result, "…"+strings.Repeat("あ", snippetContext)+"<mark>毛糸</mark>"))
+	assert.True(t, strings.HasSuffix(result, "い…"))
+}
+
+func TestNewItemSearchHit(t *testing.T) {
+	item, _, _ := createTestItem()
+	query, _ := NewSearchQuery("test")
+
+	hit := NewItemSearchHit(*item, 1.5, *query)
+
+	assert.Equal(t, item.ItemId(), hit.Item().ItemId())
+	assert.Equal(t, 1.5, hit.Score())
+	assert.Equal(t, "<mark>Test</mark> Item", hit.ItemNameHighlight())
+	assert.Equal(t, "This is a <mark>test</mark> item.", hit.DescriptionHighlight())
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxSearchQueryLength = 100
+
+type SearchQuery struct {
+	value string
+	terms []string
+}
+
+// NewSearchQuery は全角スペースを含む空白で検索語を分割する
+func NewSearchQuery(value string) (*SearchQuery, error) {
+	terms := strings.Fields(value)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("search query cannot be empty")
+	}
+
+	normalized := strings.Join(terms, " ")
+	if utf8.RuneCountInString(normalized) > maxSearchQueryLength {
+		return nil, fmt.Errorf("search query must be less than or equal to %d characters", maxSearchQueryLength)
+	}
+
+	return &SearchQuery{value: normalized, terms: terms}, nil
+}
+
+func (q *SearchQuery) Value() string {
+	return q.value
+}
+
+func (q *SearchQuery) Terms() []string {
+	return q.terms
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSearchQuery(t *testing.T) {
+	query, err := NewSearchQuery("  毛糸　ウール  soft ")
+	assert.NoError(t, err)
+	assert.Equal(t, "毛糸 ウール soft", query.Value())
+	assert.Equal(t, []string{"毛糸", "ウール", "soft"}, query.Terms())
+}
+
+func TestNewSearchQueryEmptyError(t *testing.T) {
+	_, err := NewSearchQuery(" 　 ")
+	assert.Error(t, err)
+}
+
+func TestNewSearchQueryOverLengthError(t *testing.T) {
+	_, err := NewSearchQuery(strings.Repeat("毛", maxSearchQueryLength+1))
+	assert.Error(t, err)
+}
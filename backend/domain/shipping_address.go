@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var phoneNumberPattern = regexp.MustCompile(`^0[0-9]{9,10}$`)
+
+// phoneNumberReplacer は全角の数字を半角に揃え、ハイフンと空白を取り除く
+var phoneNumberReplacer = strings.NewReplacer(
+	"０", "0", "１", "1", "２", "2", "３", "3", "４", "4",
+	"５", "5", "６", "6", "７", "7", "８", "8", "９", "9",
+	"-", "", "－", "", "ー", "", "‐", "", "−", "", " ", "", "　", "",
+)
+
+// ShippingAddressLines は配送先の宛名と住所
+// AddressLine1 は町名・番地、AddressLine2 は建物名・部屋番号で、AddressLine2 だけ省略できる
+type ShippingAddressLines struct {
+	RecipientName string
+	PostalCode    PostalCode
+	Prefecture    Prefecture
+	City          string
+	AddressLine1  string
+	AddressLine2  string
+	PhoneNumber   string
+}
+
+// ShippingAddress はユーザーが保存した配送先
+type ShippingAddress struct {
+	addressId string
+	userId    UserId
+	lines     ShippingAddressLines
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewShippingAddress(userId UserId, lines ShippingAddressLines) (*ShippingAddress, error) {
+	normalized, err := normalizeShippingAddressLines(lines)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return RestoreShippingAddress(uuid.NewString(), userId, *normalized, now, now), nil
+}
+
+// RestoreShippingAddress は永続化済みの配送先を復元する
+func RestoreShippingAddress(addressId string, userId UserId, lines ShippingAddressLines, createdAt time.Time, updatedAt time.Time) *ShippingAddress {
+	return &ShippingAddress{
+		addressId: addressId,
+		userId:    userId,
+		lines:     lines,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// Edit は宛名と住所を置き換えた配送先を返す
+func (a *ShippingAddress) Edit(lines ShippingAddressLines) (*ShippingAddress, error) {
+	normalized, err := normalizeShippingAddressLines(lines)
+	if err != nil {
+		return nil, err
+	}
+	return RestoreShippingAddress(a.addressId, a.userId, *normalized, a.createdAt, time.Now()), nil
+}
+
+func (a *ShippingAddress) AddressId() string {
+	return a.addressId
+}
+
+func (a *ShippingAddress) UserId() string {
+	return a.userId.Value()
+}
+
+func (a *ShippingAddress) RecipientName() string {
+	return a.lines.RecipientName
+}
+
+func (a *ShippingAddress) PostalCode() *PostalCode {
+	postalCode := a.lines.PostalCode
+	return &postalCode
+}
+
+func (a *ShippingAddress) Prefecture() *Prefecture {
+	prefecture := a.lines.Prefecture
+	return &prefecture
+}
+
+func (a *ShippingAddress) City() string {
+	return a.lines.City
+}
+
+func (a *ShippingAddress) AddressLine1() string {
+	return a.lines.AddressLine1
+}
+
+func (a *ShippingAddress) AddressLine2() string {
+	return a.lines.AddressLine2
+}
+
+// PhoneNumber はハイフンを除いた電話番号を返す
+func (a *ShippingAddress) PhoneNumber() string {
+	return a.lines.PhoneNumber
+}
+
+func (a *ShippingAddress) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+func (a *ShippingAddress) UpdatedAt() time.Time {
+	return a.updatedAt
+}
+
+func normalizeShippingAddressLines(lines ShippingAddressLines) (*ShippingAddressLines, error) {
+	if lines.PostalCode.value == "" {
+		return nil, fmt.Errorf("postal code must not be empty")
+	}
+	if lines.Prefecture.code == 0 {
+		return nil, fmt.Errorf("prefecture must not be empty")
+	}
+	normalized := lines
+	fields := []struct {
+		name     string
+		value    *string
+		required bool
+		max      int
+	}{
+		{name: "recipient name", value: &normalized.RecipientName, required: true, max: 50},
+		{name: "city", value: &normalized.City, required: true, max: 50},
+		{name: "address line 1", value: &normalized.AddressLine1, required: true, max: 100},
+		{name: "address line 2", value: &normalized.AddressLine2, max: 100},
+	}
+	for _, field := range fields {
+		*field.value = strings.TrimSpace(*field.value)
+		if field.required && *field.value == "" {
+			return nil, fmt.Errorf("%s must not be empty", field.name)
+		}
+		if utf8.RuneCountInString(*field.value) > field.max {
+			return nil, fmt.Errorf("%s must be %d characters or less", field.name, field.max)
+		}
+	}
+	normalized.PhoneNumber = phoneNumberReplacer.Replace(strings.TrimSpace(lines.PhoneNumber))
+	if !phoneNumberPattern.MatchString(normalized.PhoneNumber) {
+		return nil, fmt.Errorf("phone number must be 10 or 11 digits starting with 0: %q", lines.PhoneNumber)
+	}
+	return &normalized, nil
+}
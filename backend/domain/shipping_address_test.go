@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestShippingAddressLines(t *testing.T) ShippingAddressLines {
+	t.Helper()
+	postalCode, err := NewPostalCode("100-0001")
+	if err != nil {
+		t.Fatalf("Failed to create postal code: %v", err)
+	}
+	prefecture, err := NewPrefecture("東京都")
+	if err != nil {
+		t.Fatalf("Failed to create prefecture: %v", err)
+	}
+	return ShippingAddressLines{
+		RecipientName: " 山田 花子 ",
+		PostalCode:    *postalCode,
+		Prefecture:    *prefecture,
+		City:          "千代田区",
+		AddressLine1:  "千代田1-1",
+		PhoneNumber:   "03-1234-5678",
+	}
+}
+
+func TestNewShippingAddress(t *testing.T) {
+	userId, _ := NewUserId(uuid.NewString())
+	address, err := NewShippingAddress(*userId, newTestShippingAddressLines(t))
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, address.AddressId())
+	assert.Equal(t, userId.Value(), address.UserId())
+	assert.Equal(t, "山田 花子", address.RecipientName())
+	assert.Equal(t, "100-0001", address.PostalCode().Value())
+	assert.Equal(t, "東京都", address.Prefecture().Name())
+	assert.Equal(t, "", address.AddressLine2())
+	assert.Equal(t, "0312345678", address.PhoneNumber())
+}
+
+func TestNewShippingAddressInvalidLines(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(lines *ShippingAddressLines)
+	}{
+		{name: "empty recipient", modify: func(lines *ShippingAddressLines) { lines.RecipientName = "  " }},
+		{name: "long recipient", modify: func(lines *ShippingAddressLines) { lines.RecipientName = strings.Repeat("山", 51) }},
+		{name: "empty city", modify: func(lines *ShippingAddressLines) { lines.City = "" }},
+		{name: "empty address line 1", modify: func(lines *ShippingAddressLines) { lines.AddressLine1 = "" }},
+		{name: "long address line 2", modify: func(lines *ShippingAddressLines) { lines.AddressLine2 = strings.Repeat("a", 101) }},
+		{name: "missing postal code", modify: func(lines *ShippingAddressLines) { lines.PostalCode = PostalCode{} }},
+		{name: "missing prefecture", modify: func(lines *ShippingAddressLines) { lines.Prefecture = Prefecture{} }},
+		{name: "short phone number", modify: func(lines *ShippingAddressLines) { lines.PhoneNumber = "03-1234-567" }},
+		{name: "phone number without leading zero", modify: func(lines *ShippingAddressLines) { lines.PhoneNumber = "3-1234-56789" }},
+	}
+	userId, _ := NewUserId(uuid.NewString())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := newTestShippingAddressLines(t)
+			tt.modify(&lines)
+			_, err := NewShippingAddress(*userId, lines)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestShippingAddressEdit(t *testing.T) {
+	userId, _ := NewUserId(uuid.NewString())
+	address, _ := NewShippingAddress(*userId, newTestShippingAddressLines(t))
+
+	lines := newTestShippingAddressLines(t)
+	lines.AddressLine2 = "ニットビル 301"
+	lines.PhoneNumber = "０９０１２３４５６７８"
+	edited, err := address.Edit(lines)
+
+	assert.NoError(t, err)
+	assert.Equal(t, address.AddressId(), edited.AddressId())
+	assert.Equal(t, address.CreatedAt(), edited.CreatedAt())
+	assert.Equal(t, "ニットビル 301", edited.AddressLine2())
+	assert.Equal(t, "09012345678", edited.PhoneNumber())
+	assert.Equal(t, "", address.AddressLine2())
+}
@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrShippingUnavailable は配送料が設定されていない都道府県へ配送しようとした場合に返す
+var ErrShippingUnavailable = errors.New("shipping unavailable")
+
+// ShippingRate は都道府県ごとの配送料（税抜）
+type ShippingRate struct {
+	prefecture Prefecture
+	fee        Money
+}
+
+func NewShippingRate(prefecture Prefecture, fee Money) *ShippingRate {
+	return &ShippingRate{prefecture: prefecture, fee: fee}
+}
+
+func (r *ShippingRate) Prefecture() *Prefecture {
+	prefecture := r.prefecture
+	return &prefecture
+}
+
+func (r *ShippingRate) Fee() *Money {
+	fee := r.fee
+	return &fee
+}
+
+// ShippingRateTable は都道府県ごとの配送料と送料無料になる購入金額の表
+// 配送料を設定していない都道府県には配送しない
+type ShippingRateTable struct {
+	rates                 []ShippingRate
+	freeShippingThreshold *Money
+	updatedAt             time.Time
+}
+
+// NewShippingRateTable は配送料の表を作る。freeShippingThreshold が nil の場合は送料無料にしない
+// 同じ都道府県を複数回指定した場合や、通貨が揃っていない場合はエラーを返す
+func NewShippingRateTable(rates []ShippingRate, freeShippingThreshold *Money) (*ShippingRateTable, error) {
+	seen := make(map[int]bool, len(rates))
+	for _, rate := range rates {
+		if seen[rate.prefecture.code] {
+			return nil, fmt.Errorf("duplicate shipping rate for %s", rate.prefecture.Name())
+		}
+		seen[rate.prefecture.code] = true
+		if rate.fee.currency != rates[0].fee.currency {
+			return nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, rates[0].fee.currency, rate.fee.currency)
+		}
+		if freeShippingThreshold != nil && rate.fee.currency != freeShippingThreshold.currency {
+			return nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, rate.fee.currency, freeShippingThreshold.currency)
+		}
+	}
+	return RestoreShippingRateTable(rates, freeShippingThreshold, time.Now()), nil
+}
+
+// RestoreShippingRateTable は永続化済みの配送料の表を復元する。配送料は都道府県コード順に並べる
+func RestoreShippingRateTable(rates []ShippingRate, freeShippingThreshold *Money, updatedAt time.Time) *ShippingRateTable {
+	sorted := make([]ShippingRate, 0, len(rates))
+	for _, prefecture := range Prefectures() {
+		for _, rate := range rates {
+			if rate.prefecture.code == prefecture.code {
+				sorted = append(sorted, rate)
+			}
+		}
+	}
+	return &ShippingRateTable{rates: sorted, freeShippingThreshold: freeShippingThreshold, updatedAt: updatedAt}
+}
+
+// Rates は配送料を都道府県コード順に返す
+func (t *ShippingRateTable) Rates() []ShippingRate {
+	rates := make([]ShippingRate, len(t.rates))
+	copy(rates, t.rates)
+	return rates
+}
+
+// FreeShippingThreshold は送料無料になる購入金額（税抜）を返す。設けていない場合は nil
+func (t *ShippingRateTable) FreeShippingThreshold() *Money {
+	if t.freeShippingThreshold == nil {
+		return nil
+	}
+	threshold := *t.freeShippingThreshold
+	return &threshold
+}
+
+func (t *ShippingRateTable) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// Quote は税抜の小計 subtotal の商品を都道府県へ配送する場合の配送料を返す
+// 小計が送料無料の基準額以上であれば配送料は0円になる
+func (t *ShippingRateTable) Quote(prefecture Prefecture, subtotal Money) (*ShippingQuote, error) {
+	var rate *ShippingRate
+	for i := range t.rates {
+		if t.rates[i].prefecture.code == prefecture.code {
+			rate = &t.rates[i]
+			break
+		}
+	}
+	if rate == nil {
+		return nil, fmt.Errorf("%w: no shipping rate for %s", ErrShippingUnavailable, prefecture.Name())
+	}
+	if rate.fee.currency != subtotal.currency {
+		return nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, rate.fee.currency, subtotal.currency)
+	}
+
+	fee := rate.fee
+	if t.freeShippingThreshold != nil && !subtotal.amount.LessThan(t.freeShippingThreshold.amount) {
+		fee = Money{amount: decimal.Zero, currency: fee.currency}
+	}
+	return &ShippingQuote{
+		prefecture:            prefecture,
+		subtotal:              subtotal,
+		fee:                   fee,
+		freeShippingThreshold: t.FreeShippingThreshold(),
+	}, nil
+}
+
+// ShippingQuote は配送料の見積もり。注文時の配送料はその時点の表で計算し直す
+type ShippingQuote struct {
+	prefecture            Prefecture
+	subtotal              Money
+	fee                   Money
+	freeShippingThreshold *Money
+}
+
+func (q *ShippingQuote) Prefecture() *Prefecture {
+	prefecture := q.prefecture
+	return &prefecture
+}
+
+// Subtotal は商品の税抜の合計を返す
+func (q *ShippingQuote) Subtotal() *Money {
+	subtotal := q.subtotal
+	return &subtotal
+}
+
+// Fee は税抜の配送料を返す
+func (q *ShippingQuote) Fee() *Money {
+	fee := q.fee
+	return &fee
+}
+
+// IsFree は送料無料になったかを返す
+func (q *ShippingQuote) IsFree() bool {
+	return q.fee.amount.IsZero()
+}
+
+// FreeShippingThreshold は送料無料になる購入金額（税抜）を返す。設けていない場合は nil
+func (q *ShippingQuote) FreeShippingThreshold() *Money {
+	if q.freeShippingThreshold == nil {
+		return nil
+	}
+	threshold := *q.freeShippingThreshold
+	return &threshold
+}
+
+// RemainingForFreeShipping は送料無料まであといくら購入すればよいかを返す。送料無料にならない表や、すでに送料無料の場合は nil
+func (q *ShippingQuote) RemainingForFreeShipping() *Money {
+	if q.freeShippingThreshold == nil || !q.subtotal.amount.LessThan(q.freeShippingThreshold.amount) {
+		return nil
+	}
+	remaining, err := q.freeShippingThreshold.Subtract(q.subtotal)
+	if err != nil {
+		return nil
+	}
+	return remaining
+}
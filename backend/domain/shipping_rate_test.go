@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestShippingRate(t *testing.T, prefectureName string, fee string) ShippingRate {
+	t.Helper()
+	prefecture, err := NewPrefecture(prefectureName)
+	if err != nil {
+		t.Fatalf("Failed to create prefecture: %v", err)
+	}
+	money, err := NewMoneyFromString(fee, CurrencyJPY)
+	if err != nil {
+		t.Fatalf("Failed to create fee: %v", err)
+	}
+	return *NewShippingRate(*prefecture, *money)
+}
+
+func newTestShippingRateTable(t *testing.T) *ShippingRateTable {
+	t.Helper()
+	threshold, _ := NewMoneyFromString("10000", CurrencyJPY)
+	table, err := NewShippingRateTable([]ShippingRate{
+		newTestShippingRate(t, "沖縄県", "1500"),
+		newTestShippingRate(t, "東京都", "800"),
+		newTestShippingRate(t, "北海道", "1200"),
+	}, threshold)
+	if err != nil {
+		t.Fatalf("Failed to create shipping rate table: %v", err)
+	}
+	return table
+}
+
+func TestNewShippingRateTable(t *testing.T) {
+	table := newTestShippingRateTable(t)
+
+	rates := table.Rates()
+	assert.Len(t, rates, 3)
+	assert.Equal(t, "北海道", rates[0].Prefecture().Name())
+	assert.Equal(t, "東京都", rates[1].Prefecture().Name())
+	assert.Equal(t, "沖縄県", rates[2].Prefecture().Name())
+	assert.Equal(t, "10000", table.FreeShippingThreshold().String())
+}
+
+func TestNewShippingRateTableErrors(t *testing.T) {
+	_, err := NewShippingRateTable([]ShippingRate{
+		newTestShippingRate(t, "東京都", "800"),
+		newTestShippingRate(t, "東京都", "900"),
+	}, nil)
+	assert.Error(t, err)
+
+	usd, _ := NewMoneyFromString("10.00", "USD")
+	_, err = NewShippingRateTable([]ShippingRate{newTestShippingRate(t, "東京都", "800")}, usd)
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+}
+
+func TestShippingRateTableQuote(t *testing.T) {
+	table := newTestShippingRateTable(t)
+	tokyo, _ := NewPrefecture("東京都")
+	tests := []struct {
+		name      string
+		subtotal  string
+		fee       string
+		isFree    bool
+		remaining string
+	}{
+		{name: "below threshold", subtotal: "9999", fee: "800", isFree: false, remaining: "1"},
+		{name: "exactly threshold", subtotal: "10000", fee: "0", isFree: true},
+		{name: "above threshold", subtotal: "12000", fee: "0", isFree: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subtotal, _ := NewMoneyFromString(tt.subtotal, CurrencyJPY)
+			quote, err := table.Quote(*tokyo, *subtotal)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.fee, quote.Fee().String())
+			assert.Equal(t, tt.isFree, quote.IsFree())
+			if tt.remaining == "" {
+				assert.Nil(t, quote.RemainingForFreeShipping())
+			} else {
+				assert.Equal(t, tt.remaining, quote.RemainingForFreeShipping().String())
+			}
+		})
+	}
+}
+
+func TestShippingRateTableQuoteWithoutThreshold(t *testing.T) {
+	table, _ := NewShippingRateTable([]ShippingRate{newTestShippingRate(t, "北海道", "1200")}, nil)
+	hokkaido, _ := NewPrefecture("北海道")
+	subtotal, _ := NewMoneyFromString("100000", CurrencyJPY)
+
+	quote, err := table.Quote(*hokkaido, *subtotal)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1200", quote.Fee().String())
+	assert.Nil(t, quote.FreeShippingThreshold())
+	assert.Nil(t, quote.RemainingForFreeShipping())
+}
+
+func TestShippingRateTableQuoteUnavailable(t *testing.T) {
+	table := newTestShippingRateTable(t)
+	osaka, _ := NewPrefecture("大阪府")
+	subtotal, _ := NewMoneyFromString("1000", CurrencyJPY)
+
+	_, err := table.Quote(*osaka, *subtotal)
+
+	assert.True(t, errors.Is(err, ErrShippingUnavailable))
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var skuCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]*$`)
+
+// SkuCode は在庫管理単位（SKU）を識別するコード
+// 英数字・ハイフン・アンダースコアのみを許可し、大文字に揃えて保持する
+type SkuCode struct {
+	value string
+}
+
+func NewSkuCode(value string) (*SkuCode, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if len(value) == 0 {
+		return nil, fmt.Errorf("sku code must not be empty")
+	}
+	if len(value) > 64 {
+		return nil, fmt.Errorf("sku code must be 64 characters or less")
+	}
+	if !skuCodePattern.MatchString(value) {
+		return nil, fmt.Errorf("sku code must consist of letters, digits, hyphens and underscores: %q", value)
+	}
+
+	skuCode := new(SkuCode)
+	skuCode.value = value
+	return skuCode, nil
+}
+
+func (skuCode *SkuCode) Value() string {
+	return skuCode.value
+}
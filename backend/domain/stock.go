@@ -1,15 +1,106 @@
 package domain
 
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInsufficientStock は在庫移動の結果、実在庫が不足する場合に返す
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+// Stock は商品の在庫数量を表す
+// 引当済み数量は注文などで確保された分で、販売可能数は実在庫から引当済みを差し引いた数になる
 type Stock struct {
-	value bool
+	onHand            int
+	reserved          int
+	lowStockThreshold int
 }
 
-func NewStock(value bool) (*Stock, error) {
+func NewStock(onHand int, reserved int, lowStockThreshold int) (*Stock, error) {
+	if onHand < 0 {
+		return nil, fmt.Errorf("on hand quantity must not be negative")
+	}
+	if reserved < 0 {
+		return nil, fmt.Errorf("reserved quantity must not be negative")
+	}
+	if reserved > onHand {
+		return nil, fmt.Errorf("reserved quantity must not exceed on hand quantity")
+	}
+	if lowStockThreshold < 0 {
+		return nil, fmt.Errorf("low stock threshold must not be negative")
+	}
+
 	stock := new(Stock)
-	stock.value = value
+	stock.onHand = onHand
+	stock.reserved = reserved
+	stock.lowStockThreshold = lowStockThreshold
 	return stock, nil
 }
 
-func (stock *Stock) Value() bool {
-	return stock.value
+func (stock *Stock) OnHand() int {
+	return stock.onHand
+}
+
+func (stock *Stock) Reserved() int {
+	return stock.reserved
+}
+
+func (stock *Stock) LowStockThreshold() int {
+	return stock.lowStockThreshold
+}
+
+// Available は販売可能な数量を返す
+func (stock *Stock) Available() int {
+	return stock.onHand - stock.reserved
+}
+
+// InStock は販売可能な在庫があるかを返す。旧来の在庫有無フラグに相当する
+func (stock *Stock) InStock() bool {
+	return stock.Available() > 0
+}
+
+// IsLowStock は販売可能数が閾値以下まで減っているかを返す。在庫切れは含めない
+func (stock *Stock) IsLowStock() bool {
+	return stock.InStock() && stock.Available() <= stock.lowStockThreshold
+}
+
+// Apply は在庫移動を反映した新しい在庫を返す
+// 実在庫が負になる、または引当済み数量を下回る移動は受け付けない
+func (stock *Stock) Apply(movement *StockMovement) (*Stock, error) {
+	onHand := stock.onHand + movement.QuantityDelta()
+	if onHand < stock.reserved {
+		return nil, fmt.Errorf("%w: on hand %d, reserved %d, delta %d", ErrInsufficientStock, stock.onHand, stock.reserved, movement.QuantityDelta())
+	}
+	return NewStock(onHand, stock.reserved, stock.lowStockThreshold)
+}
+
+// Reserve は quantity だけ引き当てた新しい在庫を返す。販売可能数を超える引当は受け付けない
+func (stock *Stock) Reserve(quantity int) (*Stock, error) {
+	if quantity <= 0 {
+		return nil, fmt.Errorf("reserve quantity must be positive: %d", quantity)
+	}
+	if quantity > stock.Available() {
+		return nil, fmt.Errorf("%w: requested %d, available %d", ErrInsufficientStock, quantity, stock.Available())
+	}
+	return NewStock(stock.onHand, stock.reserved+quantity, stock.lowStockThreshold)
+}
+
+// Release は quantity だけ引当を解除した新しい在庫を返す。実在庫は変わらない
+func (stock *Stock) Release(quantity int) (*Stock, error) {
+	if quantity <= 0 {
+		return nil, fmt.Errorf("release quantity must be positive: %d", quantity)
+	}
+	if quantity > stock.reserved {
+		return nil, fmt.Errorf("release quantity %d exceeds reserved %d", quantity, stock.reserved)
+	}
+	return NewStock(stock.onHand, stock.reserved-quantity, stock.lowStockThreshold)
+}
+
+// Fulfill は引き当てた quantity を出庫した新しい在庫を返す。実在庫と引当済み数量を同じだけ減らすため販売可能数は変わらない
+func (stock *Stock) Fulfill(quantity int) (*Stock, error) {
+	released, err := stock.Release(quantity)
+	if err != nil {
+		return nil, err
+	}
+	return NewStock(released.onHand-quantity, released.reserved, released.lowStockThreshold)
 }
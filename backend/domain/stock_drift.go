@@ -0,0 +1,35 @@
+package domain
+
+// StockDrift は商品またはバリエーションの実在庫数と在庫移動台帳の合計が一致しない状態を表す
+// variantId は商品の在庫のずれでは空文字列
+type StockDrift struct {
+	itemId      string
+	variantId   string
+	onHand      int
+	ledgerTotal int
+}
+
+func NewStockDrift(itemId string, variantId string, onHand int, ledgerTotal int) *StockDrift {
+	return &StockDrift{itemId: itemId, variantId: variantId, onHand: onHand, ledgerTotal: ledgerTotal}
+}
+
+func (d *StockDrift) ItemId() string {
+	return d.itemId
+}
+
+func (d *StockDrift) VariantId() string {
+	return d.variantId
+}
+
+func (d *StockDrift) OnHand() int {
+	return d.onHand
+}
+
+func (d *StockDrift) LedgerTotal() int {
+	return d.ledgerTotal
+}
+
+// Difference は実在庫数から台帳の合計を引いた差分を返す
+func (d *StockDrift) Difference() int {
+	return d.onHand - d.ledgerTotal
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StockMovement は在庫数量の増減を1件ずつ記録する台帳の行
+// 記録後に書き換えることはなく、訂正は逆向きの移動を追加して行う
+// variantId はバリエーションの在庫の移動で、商品の在庫の移動では空文字列
+type StockMovement struct {
+	movementId    string
+	itemId        ItemId
+	variantId     string
+	movementType  StockMovementType
+	quantityDelta int
+	reason        string
+	userId        UserId
+	createdAt     time.Time
+}
+
+func NewStockMovement(itemId ItemId, movementType StockMovementType, quantityDelta int, reason string, userId UserId) (*StockMovement, error) {
+	if err := movementType.validateDelta(quantityDelta); err != nil {
+		return nil, err
+	}
+	if len(reason) > 191 {
+		return nil, fmt.Errorf("reason must be less than 191")
+	}
+	return &StockMovement{
+		movementId:    uuid.NewString(),
+		itemId:        itemId,
+		movementType:  movementType,
+		quantityDelta: quantityDelta,
+		reason:        reason,
+		userId:        userId,
+		createdAt:     time.Now(),
+	}, nil
+}
+
+// RestoreStockMovement は永続化済みの在庫移動を復元する
+func RestoreStockMovement(movementId string, itemId ItemId, variantId string, movementType StockMovementType, quantityDelta int, reason string, userId UserId, createdAt time.Time) *StockMovement {
+	return &StockMovement{
+		movementId:    movementId,
+		itemId:        itemId,
+		variantId:     variantId,
+		movementType:  movementType,
+		quantityDelta: quantityDelta,
+		reason:        reason,
+		userId:        userId,
+		createdAt:     createdAt,
+	}
+}
+
+func (m *StockMovement) MovementId() string {
+	return m.movementId
+}
+
+func (m *StockMovement) ItemId() string {
+	return m.itemId.Value()
+}
+
+func (m *StockMovement) VariantId() string {
+	return m.variantId
+}
+
+// WithVariantId はバリエーションの在庫の移動として記録するコピーを返す
+func (m *StockMovement) WithVariantId(variantId string) *StockMovement {
+	movement := *m
+	movement.variantId = variantId
+	return &movement
+}
+
+func (m *StockMovement) MovementType() string {
+	return m.movementType.Value()
+}
+
+func (m *StockMovement) QuantityDelta() int {
+	return m.quantityDelta
+}
+
+func (m *StockMovement) Reason() string {
+	return m.reason
+}
+
+func (m *StockMovement) UserId() string {
+	return m.userId.Value()
+}
+
+func (m *StockMovement) CreatedAt() time.Time {
+	return m.createdAt
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestStockMovement(t *testing.T, movementType string, delta int) (*StockMovement, error) {
+	t.Helper()
+	itemId, _ := NewItemId(uuid.NewString())
+	userId, _ := NewUserId(uuid.NewString())
+	mt, err := NewStockMovementType(movementType)
+	if err != nil {
+		t.Fatalf("Failed to create movement type: %v", err)
+	}
+	return NewStockMovement(*itemId, *mt, delta, "test", *userId)
+}
+
+func TestNewStockMovementType_Invalid(t *testing.T) {
+	mt, err := NewStockMovementType("transfer")
+	assert.Error(t, err)
+	assert.Nil(t, mt)
+}
+
+func TestNewStockMovement_DeltaDirection(t *testing.T) {
+	tests := []struct {
+		movementType string
+		delta        int
+		wantErr      bool
+	}{
+		{StockMovementReceive, 3, false},
+		{StockMovementReceive, -3, true},
+		{StockMovementReturn, 1, false},
+		{StockMovementReturn, -1, true},
+		{StockMovementSale, -2, false},
+		{StockMovementSale, 2, true},
+		{StockMovementDamage, -1, false},
+		{StockMovementDamage, 1, true},
+		{StockMovementAdjustment, 4, false},
+		{StockMovementAdjustment, -4, false},
+		{StockMovementAdjustment, 0, true},
+	}
+	for _, tt := range tests {
+		movement, err := newTestStockMovement(t, tt.movementType, tt.delta)
+		if tt.wantErr {
+			assert.Error(t, err, "%s %d", tt.movementType, tt.delta)
+			assert.Nil(t, movement)
+			continue
+		}
+		assert.NoError(t, err, "%s %d", tt.movementType, tt.delta)
+		assert.Equal(t, tt.movementType, movement.MovementType())
+		assert.Equal(t, tt.delta, movement.QuantityDelta())
+		assert.NotEmpty(t, movement.MovementId())
+	}
+}
+
+func TestStockMovementWithVariantId(t *testing.T) {
+	movement, err := newTestStockMovement(t, StockMovementReceive, 3)
+	assert.NoError(t, err)
+	assert.Empty(t, movement.VariantId())
+
+	variantId := uuid.NewString()
+	variantMovement := movement.WithVariantId(variantId)
+	assert.Equal(t, variantId, variantMovement.VariantId())
+	assert.Equal(t, movement.MovementId(), variantMovement.MovementId())
+	assert.Empty(t, movement.VariantId())
+}
+
+func TestStockApply(t *testing.T) {
+	stock, _ := NewStock(5, 2, 1)
+
+	received, _ := newTestStockMovement(t, StockMovementReceive, 3)
+	next, err := stock.Apply(received)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, next.OnHand())
+	assert.Equal(t, 2, next.Reserved())
+	assert.Equal(t, 1, next.LowStockThreshold())
+	// 元の在庫は変更しない
+	assert.Equal(t, 5, stock.OnHand())
+
+	sold, _ := newTestStockMovement(t, StockMovementSale, -3)
+	next, err = stock.Apply(sold)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, next.OnHand())
+
+	// 引当済み数量を下回る減少は在庫不足
+	damaged, _ := newTestStockMovement(t, StockMovementDamage, -4)
+	next, err = stock.Apply(damaged)
+	assert.ErrorIs(t, err, ErrInsufficientStock)
+	assert.Nil(t, next)
+}
+
+func TestStockDriftDifference(t *testing.T) {
+	drift := NewStockDrift(uuid.NewString(), "", 5, 3)
+	assert.Equal(t, 2, drift.Difference())
+}
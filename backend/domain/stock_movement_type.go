@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"fmt"
+)
+
+const (
+	StockMovementReceive    = "receive"
+	StockMovementSale       = "sale"
+	StockMovementAdjustment = "adjustment"
+	StockMovementReturn     = "return"
+	StockMovementDamage     = "damage"
+)
+
+// StockMovementType は在庫移動の種別を表す
+type StockMovementType struct {
+	value string
+}
+
+func NewStockMovementType(value string) (*StockMovementType, error) {
+	switch value {
+	case StockMovementReceive, StockMovementSale, StockMovementAdjustment, StockMovementReturn, StockMovementDamage:
+		return &StockMovementType{value: value}, nil
+	}
+	return nil, fmt.Errorf("invalid stock movement type: %s", value)
+}
+
+func (t *StockMovementType) Value() string {
+	return t.value
+}
+
+// validateDelta は種別ごとに増減の向きを検証する
+// 入荷・返品は増加、販売・破損は減少のみ許可し、調整はどちらの向きも許可する
+func (t *StockMovementType) validateDelta(delta int) error {
+	if delta == 0 {
+		return fmt.Errorf("quantity delta must not be zero")
+	}
+	switch t.value {
+	case StockMovementReceive, StockMovementReturn:
+		if delta < 0 {
+			return fmt.Errorf("quantity delta must be positive for %s", t.value)
+		}
+	case StockMovementSale, StockMovementDamage:
+		if delta > 0 {
+			return fmt.Errorf("quantity delta must be negative for %s", t.value)
+		}
+	}
+	return nil
+}
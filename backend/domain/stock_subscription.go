@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrItemInStock は販売可能な在庫がある商品の再入荷通知を申し込んだ場合に返す
+var ErrItemInStock = errors.New("item in stock")
+
+// MaxStockNotificationAttempts は再入荷通知を送り直す回数の上限。超えたら送信を諦める
+const MaxStockNotificationAttempts = 5
+
+// StockSubscription は在庫切れの商品の再入荷通知の申し込み
+// 商品が再入荷したら通知を1件積んで申し込みを消すため、通知は1回だけ届く
+type StockSubscription struct {
+	userId    UserId
+	itemId    ItemId
+	itemName  string
+	createdAt time.Time
+}
+
+// NewStockSubscription は在庫切れの商品への申し込みを作る。販売可能な在庫があれば ErrItemInStock を返す
+func NewStockSubscription(userId UserId, item *Item) (*StockSubscription, error) {
+	if item.InStock() {
+		return nil, fmt.Errorf("%w: %d available", ErrItemInStock, item.SellableStock().Available())
+	}
+	return RestoreStockSubscription(userId, item.itemId, item.ItemName(), time.Now()), nil
+}
+
+// RestoreStockSubscription は永続化済みの申し込みを復元する。itemName は現在の商品名
+func RestoreStockSubscription(userId UserId, itemId ItemId, itemName string, createdAt time.Time) *StockSubscription {
+	return &StockSubscription{userId: userId, itemId: itemId, itemName: itemName, createdAt: createdAt}
+}
+
+func (s *StockSubscription) UserId() string {
+	return s.userId.Value()
+}
+
+func (s *StockSubscription) ItemId() string {
+	return s.itemId.Value()
+}
+
+func (s *StockSubscription) ItemName() string {
+	return s.itemName
+}
+
+// CreatedAt は申し込んだ日時を返す
+func (s *StockSubscription) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// NewStockNotificationId は再入荷時に積む通知の ID を作る
+func NewStockNotificationId() string {
+	return uuid.NewString()
+}
+
+// StockNotification は再入荷時に積んだ、まだ送っていない通知
+// 宛先と商品名は送信時点のユーザー・商品から読み込む
+type StockNotification struct {
+	notificationId string
+	userId         UserId
+	email          string
+	userName       string
+	itemId         ItemId
+	itemName       string
+	attempts       int
+	createdAt      time.Time
+}
+
+// RestoreStockNotification は永続化済みの通知を、宛先のユーザーと商品の情報ごと復元する
+func RestoreStockNotification(notificationId string, userId UserId, email string, userName string, itemId ItemId, itemName string, attempts int, createdAt time.Time) *StockNotification {
+	return &StockNotification{
+		notificationId: notificationId,
+		userId:         userId,
+		email:          email,
+		userName:       userName,
+		itemId:         itemId,
+		itemName:       itemName,
+		attempts:       attempts,
+		createdAt:      createdAt,
+	}
+}
+
+func (n *StockNotification) NotificationId() string {
+	return n.notificationId
+}
+
+func (n *StockNotification) UserId() string {
+	return n.userId.Value()
+}
+
+// Email は宛先のメールアドレスを返す
+func (n *StockNotification) Email() string {
+	return n.email
+}
+
+func (n *StockNotification) UserName() string {
+	return n.userName
+}
+
+func (n *StockNotification) ItemId() string {
+	return n.itemId.Value()
+}
+
+func (n *StockNotification) ItemName() string {
+	return n.itemName
+}
+
+// Attempts はこれまでに送信に失敗した回数を返す
+func (n *StockNotification) Attempts() int {
+	return n.attempts
+}
+
+// CreatedAt は再入荷して通知を積んだ日時を返す
+func (n *StockNotification) CreatedAt() time.Time {
+	return n.createdAt
+}
+
+// ItemDemand は在庫切れの商品ごとの再入荷通知の申し込み状況。管理画面で仕入れの優先度を決めるために使う
+type ItemDemand struct {
+	itemId           ItemId
+	itemName         string
+	subscriberCount  int
+	firstSubscribed  time.Time
+	latestSubscribed time.Time
+}
+
+func RestoreItemDemand(itemId ItemId, itemName string, subscriberCount int, firstSubscribed time.Time, latestSubscribed time.Time) *ItemDemand {
+	return &ItemDemand{
+		itemId:           itemId,
+		itemName:         itemName,
+		subscriberCount:  subscriberCount,
+		firstSubscribed:  firstSubscribed,
+		latestSubscribed: latestSubscribed,
+	}
+}
+
+func (d *ItemDemand) ItemId() string {
+	return d.itemId.Value()
+}
+
+func (d *ItemDemand) ItemName() string {
+	return d.itemName
+}
+
+// SubscriberCount は再入荷通知を待っているユーザーの数を返す
+func (d *ItemDemand) SubscriberCount() int {
+	return d.subscriberCount
+}
+
+// FirstSubscribedAt は最も古い申し込みの日時を返す
+func (d *ItemDemand) FirstSubscribedAt() time.Time {
+	return d.firstSubscribed
+}
+
+// LatestSubscribedAt は最も新しい申し込みの日時を返す
+func (d *ItemDemand) LatestSubscribedAt() time.Time {
+	return d.latestSubscribed
+}
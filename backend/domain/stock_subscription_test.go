@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newStockSubscriptionTestItem(t *testing.T, onHand int, reserved int) *Item {
+	t.Helper()
+	userId, _ := NewUserId(uuid.NewString())
+	itemName, _ := NewItemName("手編みのセーター")
+	stock, err := NewStock(onHand, reserved, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	description, _ := NewDescription("一点ものです")
+	item, err := NewItem(nil, *userId, *itemName, *stock, *description, ZeroYen())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return item
+}
+
+func TestNewStockSubscription(t *testing.T) {
+	userId, _ := NewUserId(uuid.NewString())
+
+	t.Run("Sold Out", func(t *testing.T) {
+		item := newStockSubscriptionTestItem(t, 0, 0)
+		subscription, err := NewStockSubscription(*userId, item)
+		assert.NoError(t, err)
+		assert.Equal(t, userId.Value(), subscription.UserId())
+		assert.Equal(t, item.ItemId(), subscription.ItemId())
+		assert.Equal(t, "手編みのセーター", subscription.ItemName())
+	})
+
+	t.Run("All Reserved", func(t *testing.T) {
+		_, err := NewStockSubscription(*userId, newStockSubscriptionTestItem(t, 2, 2))
+		assert.NoError(t, err)
+	})
+
+	t.Run("In Stock", func(t *testing.T) {
+		_, err := NewStockSubscription(*userId, newStockSubscriptionTestItem(t, 2, 1))
+		assert.True(t, errors.Is(err, ErrItemInStock))
+	})
+}
@@ -1,17 +1,99 @@
 package domain
 
 import (
+	"errors"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
-func TestStockValue(t *testing.T) {
-	stock, _ := NewStock(true)
-	if stock.Value() != true {
-		t.Errorf("Value() returned %v, expected %v", stock.Value(), true)
-	}
+func TestNewStock(t *testing.T) {
+	stock, err := NewStock(5, 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, stock.OnHand())
+	assert.Equal(t, 2, stock.Reserved())
+	assert.Equal(t, 3, stock.LowStockThreshold())
+	assert.Equal(t, 3, stock.Available())
+}
 
-	stock, _ = NewStock(false)
-	if stock.Value() != false {
-		t.Errorf("Value() returned %v, expected %v", stock.Value(), false)
+func TestNewStock_Invalid(t *testing.T) {
+	tests := []struct {
+		name              string
+		onHand            int
+		reserved          int
+		lowStockThreshold int
+	}{
+		{"negative on hand", -1, 0, 0},
+		{"negative reserved", 1, -1, 0},
+		{"reserved exceeds on hand", 1, 2, 0},
+		{"negative threshold", 1, 0, -1},
 	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stock, err := NewStock(tt.onHand, tt.reserved, tt.lowStockThreshold)
+			assert.Error(t, err)
+			assert.Nil(t, stock)
+		})
+	}
+}
+
+func TestStockInStock(t *testing.T) {
+	stock, _ := NewStock(3, 0, 0)
+	assert.True(t, stock.InStock())
+
+	stock, _ = NewStock(0, 0, 0)
+	assert.False(t, stock.InStock())
+
+	// 全数が引き当て済みなら在庫なし扱い
+	stock, _ = NewStock(2, 2, 0)
+	assert.False(t, stock.InStock())
+}
+
+func TestStockIsLowStock(t *testing.T) {
+	stock, _ := NewStock(5, 2, 3)
+	assert.True(t, stock.IsLowStock())
+
+	stock, _ = NewStock(5, 0, 3)
+	assert.False(t, stock.IsLowStock())
+
+	stock, _ = NewStock(0, 0, 3)
+	assert.False(t, stock.IsLowStock())
+}
+
+func TestStockReserve(t *testing.T) {
+	stock, _ := NewStock(5, 2, 0)
+
+	reserved, err := stock.Reserve(3)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, reserved.OnHand())
+	assert.Equal(t, 5, reserved.Reserved())
+	assert.Equal(t, 0, reserved.Available())
+	// 元の在庫は変更しない
+	assert.Equal(t, 2, stock.Reserved())
+
+	_, err = stock.Reserve(4)
+	assert.True(t, errors.Is(err, ErrInsufficientStock))
+
+	_, err = stock.Reserve(0)
+	assert.Error(t, err)
+}
+
+func TestStockReleaseAndFulfill(t *testing.T) {
+	stock, _ := NewStock(5, 3, 0)
+
+	released, err := stock.Release(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, released.OnHand())
+	assert.Equal(t, 1, released.Reserved())
+
+	fulfilled, err := stock.Fulfill(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, fulfilled.OnHand())
+	assert.Equal(t, 1, fulfilled.Reserved())
+	assert.Equal(t, stock.Available(), fulfilled.Available())
+
+	_, err = stock.Release(4)
+	assert.Error(t, err)
+	_, err = stock.Fulfill(0)
+	assert.Error(t, err)
 }
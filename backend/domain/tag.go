@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const maxTagsPerItem = 20
+
+// TagName は商品に自由に付けるタグの名前
+// 前後の空白を除き、連続する空白を1つにまとめて英字を小文字に揃える
+type TagName struct {
+	value string
+}
+
+func NewTagName(value string) (*TagName, error) {
+	value = strings.ToLower(strings.Join(strings.Fields(value), " "))
+	if len(value) == 0 {
+		return nil, fmt.Errorf("tag name must not be empty")
+	}
+	if utf8.RuneCountInString(value) > 32 {
+		return nil, fmt.Errorf("tag name must be 32 characters or less")
+	}
+	if strings.Contains(value, ",") {
+		return nil, fmt.Errorf("tag name must not contain commas: %q", value)
+	}
+	tagName := new(TagName)
+	tagName.value = value
+	return tagName, nil
+}
+
+func (tagName *TagName) Value() string {
+	return tagName.value
+}
+
+// NewTagNames は商品に付けるタグ名の一覧を正規化する。正規化後に重複する名前は1つにまとめる
+func NewTagNames(values []string) ([]TagName, error) {
+	names := make([]TagName, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		name, err := NewTagName(value)
+		if err != nil {
+			return nil, err
+		}
+		if seen[name.Value()] {
+			continue
+		}
+		seen[name.Value()] = true
+		names = append(names, *name)
+	}
+	if len(names) > maxTagsPerItem {
+		return nil, fmt.Errorf("an item can have at most %d tags", maxTagsPerItem)
+	}
+	return names, nil
+}
+
+type Tag struct {
+	tagId string
+	name  TagName
+}
+
+func NewTag(name TagName) *Tag {
+	return RestoreTag(uuid.NewString(), name)
+}
+
+// RestoreTag は永続化済みのタグを復元する
+func RestoreTag(tagId string, name TagName) *Tag {
+	return &Tag{tagId: tagId, name: name}
+}
+
+func (t *Tag) TagId() string {
+	return t.tagId
+}
+
+func (t *Tag) Name() string {
+	return t.name.Value()
+}
@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ReducedTaxRate は消費税の軽減税率 8%
+var ReducedTaxRate = decimal.RequireFromString("0.08")
+
+// TaxRate は商品に適用する消費税率の区分
+type TaxRate string
+
+const (
+	// TaxRateStandard は標準税率 10%
+	TaxRateStandard TaxRate = "standard"
+	// TaxRateReduced は軽減税率 8%。飲食料品などに適用する
+	TaxRateReduced TaxRate = "reduced"
+)
+
+// taxRates は税率の区分を請求書に記載する順に並べたもの
+var taxRates = []TaxRate{TaxRateStandard, TaxRateReduced}
+
+// NewTaxRate は税率の区分を作る。空の場合は標準税率として扱う
+func NewTaxRate(value string) (TaxRate, error) {
+	if value == "" {
+		return TaxRateStandard, nil
+	}
+	for _, rate := range taxRates {
+		if string(rate) == value {
+			return rate, nil
+		}
+	}
+	return "", fmt.Errorf("invalid tax rate: %s", value)
+}
+
+// Rate は税率を返す（例: 0.10）
+func (r TaxRate) Rate() decimal.Decimal {
+	if r == TaxRateReduced {
+		return ReducedTaxRate
+	}
+	return StandardTaxRate
+}
+
+// Percent は百分率の税率を返す（例: 10）
+func (r TaxRate) Percent() int {
+	return int(r.Rate().Shift(2).IntPart())
+}
+
+func (r TaxRate) IsReduced() bool {
+	return r == TaxRateReduced
+}
+
+// TaxAmountAt は税抜価格に対する rate の消費税額を返す。1円未満の端数は切り捨てる
+func (m *Money) TaxAmountAt(rate TaxRate) *Money {
+	return m.roundDown(m.amount.Mul(rate.Rate()))
+}
+
+// TaxIncludedAt は税抜価格から rate の税込価格を返す
+func (m *Money) TaxIncludedAt(rate TaxRate) *Money {
+	return &Money{amount: m.amount.Add(m.TaxAmountAt(rate).amount), currency: m.currency}
+}
+
+// TaxRateTotal は税率ごとに区分した合計。割引は税率ごとに按分して差し引く
+type TaxRateTotal struct {
+	rate     TaxRate
+	subtotal Money
+	discount Money
+	tax      Money
+}
+
+func (t *TaxRateTotal) Rate() TaxRate {
+	return t.rate
+}
+
+// Subtotal は割引前の税抜の合計を返す
+func (t *TaxRateTotal) Subtotal() *Money {
+	subtotal := t.subtotal
+	return &subtotal
+}
+
+// Discount はこの税率に按分した税抜の割引額を返す
+func (t *TaxRateTotal) Discount() *Money {
+	discount := t.discount
+	return &discount
+}
+
+// Taxable は割引後の税抜の合計を返す
+func (t *TaxRateTotal) Taxable() *Money {
+	return &Money{amount: t.subtotal.amount.Sub(t.discount.amount), currency: t.subtotal.currency}
+}
+
+// Tax は割引後の合計に対する消費税額を返す
+func (t *TaxRateTotal) Tax() *Money {
+	tax := t.tax
+	return &tax
+}
+
+// Total は割引後の税込の合計を返す
+func (t *TaxRateTotal) Total() *Money {
+	return &Money{amount: t.Taxable().amount.Add(t.tax.amount), currency: t.subtotal.currency}
+}
+
+// TaxBreakdown は税率ごとの合計と消費税額。消費税は税率ごとに1回だけ計算し、1円未満を切り捨てる
+type TaxBreakdown struct {
+	currency string
+	totals   []TaxRateTotal
+}
+
+// taxableAmount は税率の区分と税抜の金額の組
+type taxableAmount struct {
+	rate   TaxRate
+	amount Money
+}
+
+// newTaxBreakdown は税抜の金額を税率ごとに合計し、割引を按分して消費税額を計算する
+// 割引は税率ごとの合計の比で按分して端数を切り捨て、残りを最後の税率に寄せる
+func newTaxBreakdown(amounts []taxableAmount, discount Money) (*TaxBreakdown, error) {
+	subtotals := make(map[TaxRate]*Money, len(taxRates))
+	subtotal := Money{currency: discount.currency}
+	for _, taxable := range amounts {
+		if taxable.amount.currency != discount.currency {
+			return nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, discount.currency, taxable.amount.currency)
+		}
+		sum := Money{currency: discount.currency}
+		if subtotals[taxable.rate] != nil {
+			sum = *subtotals[taxable.rate]
+		}
+		sum.amount = sum.amount.Add(taxable.amount.amount)
+		subtotals[taxable.rate] = &sum
+		subtotal.amount = subtotal.amount.Add(taxable.amount.amount)
+	}
+	if discount.amount.GreaterThan(subtotal.amount) {
+		return nil, fmt.Errorf("cannot subtract %s from %s", discount.String(), subtotal.String())
+	}
+
+	breakdown := &TaxBreakdown{currency: discount.currency}
+	remaining := discount.amount
+	for _, rate := range taxRates {
+		if subtotals[rate] == nil {
+			continue
+		}
+		breakdown.totals = append(breakdown.totals, TaxRateTotal{rate: rate, subtotal: *subtotals[rate]})
+	}
+	for i := range breakdown.totals {
+		total := &breakdown.totals[i]
+		share := remaining
+		if i < len(breakdown.totals)-1 && !subtotal.amount.IsZero() {
+			share = discount.amount.Mul(total.subtotal.amount).Div(subtotal.amount).Truncate(currencyScales[discount.currency])
+		}
+		remaining = remaining.Sub(share)
+		total.discount = Money{amount: share, currency: discount.currency}
+		total.tax = *total.Taxable().TaxAmountAt(total.rate)
+	}
+	return breakdown, nil
+}
+
+// Totals は税率ごとの合計を標準税率、軽減税率の順に返す。明細のない税率は含めない
+func (b *TaxBreakdown) Totals() []TaxRateTotal {
+	totals := make([]TaxRateTotal, len(b.totals))
+	copy(totals, b.totals)
+	return totals
+}
+
+// Tax は税率ごとの消費税額の合計を返す
+func (b *TaxBreakdown) Tax() *Money {
+	tax := Money{currency: b.currency}
+	for _, total := range b.totals {
+		tax.amount = tax.amount.Add(total.tax.amount)
+	}
+	return &tax
+}
+
+// Total は割引後の税込の合計を返す
+func (b *TaxBreakdown) Total() *Money {
+	sum := Money{currency: b.currency}
+	for _, total := range b.totals {
+		sum.amount = sum.amount.Add(total.Total().amount)
+	}
+	return &sum
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTaxableAmount(t *testing.T, rate TaxRate, amount string) taxableAmount {
+	t.Helper()
+	money, err := NewMoneyFromString(amount, CurrencyJPY)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return taxableAmount{rate: rate, amount: *money}
+}
+
+func TestNewTaxRate(t *testing.T) {
+	for value, want := range map[string]TaxRate{"": TaxRateStandard, "standard": TaxRateStandard, "reduced": TaxRateReduced} {
+		rate, err := NewTaxRate(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, want, rate, value)
+	}
+	_, err := NewTaxRate("zero")
+	assert.Error(t, err)
+
+	assert.Equal(t, 10, TaxRateStandard.Percent())
+	assert.Equal(t, 8, TaxRateReduced.Percent())
+	assert.True(t, TaxRateReduced.IsReduced())
+	assert.False(t, TaxRateStandard.IsReduced())
+}
+
+func TestMoneyTaxAmountAt(t *testing.T) {
+	tests := []struct {
+		price       string
+		rate        TaxRate
+		wantTax     string
+		wantWithTax string
+	}{
+		{"1000", TaxRateStandard, "100", "1100"},
+		{"1000", TaxRateReduced, "80", "1080"},
+		// 1円未満は切り捨て
+		{"1234", TaxRateReduced, "98", "1332"},
+		{"12", TaxRateReduced, "0", "12"},
+	}
+	for _, tt := range tests {
+		price, _ := NewMoneyFromString(tt.price, CurrencyJPY)
+		assert.Equal(t, tt.wantTax, price.TaxAmountAt(tt.rate).String(), tt.price)
+		assert.Equal(t, tt.wantWithTax, price.TaxIncludedAt(tt.rate).String(), tt.price)
+	}
+}
+
+func TestNewTaxBreakdown(t *testing.T) {
+	t.Run("Rounds Once Per Rate", func(t *testing.T) {
+		// 明細ごとに切り捨てると 8 + 9 = 17 円だが、税率ごとに合計してから切り捨てるので 18 円になる
+		breakdown, err := newTaxBreakdown([]taxableAmount{
+			newTestTaxableAmount(t, TaxRateReduced, "110"),
+			newTestTaxableAmount(t, TaxRateStandard, "1000"),
+			newTestTaxableAmount(t, TaxRateReduced, "120"),
+		}, ZeroYen())
+
+		assert.NoError(t, err)
+		totals := breakdown.Totals()
+		assert.Len(t, totals, 2)
+		assert.Equal(t, TaxRateStandard, totals[0].Rate())
+		assert.Equal(t, "1000", totals[0].Taxable().String())
+		assert.Equal(t, "100", totals[0].Tax().String())
+		assert.Equal(t, TaxRateReduced, totals[1].Rate())
+		assert.Equal(t, "230", totals[1].Taxable().String())
+		assert.Equal(t, "18", totals[1].Tax().String())
+		assert.Equal(t, "118", breakdown.Tax().String())
+		assert.Equal(t, "1348", breakdown.Total().String())
+	})
+
+	t.Run("Allocates Discount By Rate", func(t *testing.T) {
+		discount, _ := NewMoneyFromString("100", CurrencyJPY)
+		breakdown, err := newTaxBreakdown([]taxableAmount{
+			newTestTaxableAmount(t, TaxRateStandard, "1000"),
+			newTestTaxableAmount(t, TaxRateReduced, "2000"),
+		}, *discount)
+
+		assert.NoError(t, err)
+		totals := breakdown.Totals()
+		// 100 × 1000 / 3000 = 33.3 を切り捨て、残りの 67 円を軽減税率に寄せる
+		assert.Equal(t, "33", totals[0].Discount().String())
+		assert.Equal(t, "967", totals[0].Taxable().String())
+		assert.Equal(t, "96", totals[0].Tax().String())
+		assert.Equal(t, "67", totals[1].Discount().String())
+		assert.Equal(t, "1933", totals[1].Taxable().String())
+		assert.Equal(t, "154", totals[1].Tax().String())
+		assert.Equal(t, "250", breakdown.Tax().String())
+		assert.Equal(t, "3150", breakdown.Total().String())
+	})
+
+	t.Run("Single Rate Matches Tax On Subtotal", func(t *testing.T) {
+		discount, _ := NewMoneyFromString("150", CurrencyJPY)
+		breakdown, err := newTaxBreakdown([]taxableAmount{
+			newTestTaxableAmount(t, TaxRateStandard, "1500"),
+			newTestTaxableAmount(t, TaxRateStandard, "333"),
+		}, *discount)
+
+		assert.NoError(t, err)
+		assert.Len(t, breakdown.Totals(), 1)
+		assert.Equal(t, "168", breakdown.Tax().String())
+		assert.Equal(t, "1851", breakdown.Total().String())
+	})
+
+	t.Run("Discount Exceeds Subtotal", func(t *testing.T) {
+		discount, _ := NewMoneyFromString("1001", CurrencyJPY)
+		_, err := newTaxBreakdown([]taxableAmount{newTestTaxableAmount(t, TaxRateStandard, "1000")}, *discount)
+		assert.Error(t, err)
+	})
+}
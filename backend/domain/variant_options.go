@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxVariantOptions        = 3
+	maxVariantOptionNameLen  = 20
+	maxVariantOptionValueLen = 50
+	variantOptionPairSep     = ";"
+	variantOptionKeyValueSep = "="
+)
+
+// VariantOptions はバリエーションを区別するオプション（color=生成り、size=M など）の組み合わせ
+// オプション名は小文字に揃え、値は入力どおりに保持する
+type VariantOptions struct {
+	values map[string]string
+}
+
+func NewVariantOptions(values map[string]string) (*VariantOptions, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("variant must have at least one option")
+	}
+	if len(values) > maxVariantOptions {
+		return nil, fmt.Errorf("variant must have at most %d options", maxVariantOptions)
+	}
+
+	normalized := make(map[string]string, len(values))
+	for name, value := range values {
+		name = strings.ToLower(strings.TrimSpace(name))
+		value = strings.TrimSpace(value)
+		if err := validateVariantOption(name, value); err != nil {
+			return nil, err
+		}
+		if _, ok := normalized[name]; ok {
+			return nil, fmt.Errorf("option name %q is duplicated", name)
+		}
+		normalized[name] = value
+	}
+	return &VariantOptions{values: normalized}, nil
+}
+
+func validateVariantOption(name string, value string) error {
+	if name == "" {
+		return fmt.Errorf("option name must not be empty")
+	}
+	if value == "" {
+		return fmt.Errorf("option %q must have a value", name)
+	}
+	if utf8.RuneCountInString(name) > maxVariantOptionNameLen {
+		return fmt.Errorf("option name must be %d characters or less", maxVariantOptionNameLen)
+	}
+	if utf8.RuneCountInString(value) > maxVariantOptionValueLen {
+		return fmt.Errorf("option value must be %d characters or less", maxVariantOptionValueLen)
+	}
+	// Key() の区切り文字と衝突しないよう、区切り文字を含む名前・値は受け付けない
+	if strings.ContainsAny(name+value, variantOptionPairSep+variantOptionKeyValueSep) {
+		return fmt.Errorf("option %q must not contain %q or %q", name, variantOptionPairSep, variantOptionKeyValueSep)
+	}
+	return nil
+}
+
+// Values はオプション名と値の組み合わせのコピーを返す
+func (o *VariantOptions) Values() map[string]string {
+	values := make(map[string]string, len(o.values))
+	for name, value := range o.values {
+		values[name] = value
+	}
+	return values
+}
+
+// Key は組み合わせの重複判定に使う正規化済みの文字列を返す
+// オプション名の順に並べ、値の大文字・小文字は区別しない（例: color=生成り;size=m）
+func (o *VariantOptions) Key() string {
+	names := make([]string, 0, len(o.values))
+	for name := range o.values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + variantOptionKeyValueSep + strings.ToLower(o.values[name])
+	}
+	return strings.Join(pairs, variantOptionPairSep)
+}
+
+func (o *VariantOptions) Equals(other VariantOptions) bool {
+	return o.Key() == other.Key()
+}
@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// YarnWeight は毛糸の太さの区分。Craft Yarn Council の 0（lace）〜6（super bulky）に合わせる
+type YarnWeight string
+
+const (
+	YarnWeightLace       YarnWeight = "lace"
+	YarnWeightSuperFine  YarnWeight = "super_fine"
+	YarnWeightFine       YarnWeight = "fine"
+	YarnWeightLight      YarnWeight = "light"
+	YarnWeightMedium     YarnWeight = "medium"
+	YarnWeightBulky      YarnWeight = "bulky"
+	YarnWeightSuperBulky YarnWeight = "super_bulky"
+)
+
+func NewYarnWeight(value string) (YarnWeight, error) {
+	switch weight := YarnWeight(value); weight {
+	case YarnWeightLace, YarnWeightSuperFine, YarnWeightFine, YarnWeightLight, YarnWeightMedium, YarnWeightBulky, YarnWeightSuperBulky:
+		return weight, nil
+	}
+	return "", fmt.Errorf("unknown yarn weight: %q", value)
+}
+
+const maxFibers = 5
+
+// Fiber は毛糸の素材
+type Fiber string
+
+const (
+	FiberWool      Fiber = "wool"
+	FiberMerino    Fiber = "merino"
+	FiberAlpaca    Fiber = "alpaca"
+	FiberMohair    Fiber = "mohair"
+	FiberCashmere  Fiber = "cashmere"
+	FiberSilk      Fiber = "silk"
+	FiberCotton    Fiber = "cotton"
+	FiberLinen     Fiber = "linen"
+	FiberBamboo    Fiber = "bamboo"
+	FiberAcrylic   Fiber = "acrylic"
+	FiberNylon     Fiber = "nylon"
+	FiberPolyester Fiber = "polyester"
+	FiberOther     Fiber = "other"
+)
+
+func NewFiber(value string) (Fiber, error) {
+	switch fiber := Fiber(value); fiber {
+	case FiberWool, FiberMerino, FiberAlpaca, FiberMohair, FiberCashmere, FiberSilk, FiberCotton,
+		FiberLinen, FiberBamboo, FiberAcrylic, FiberNylon, FiberPolyester, FiberOther:
+		return fiber, nil
+	}
+	return "", fmt.Errorf("unknown fiber: %q", value)
+}
+
+// FiberContent は素材とその混率（%）
+type FiberContent struct {
+	Fiber      Fiber
+	Percentage int
+}
+
+// FiberComposition は毛糸の素材の混率。合計は100%で、混率の高い順に並べる
+type FiberComposition []FiberContent
+
+func NewFiberComposition(contents []FiberContent) (FiberComposition, error) {
+	if len(contents) == 0 {
+		return nil, fmt.Errorf("fiber composition must have at least one fiber")
+	}
+	if len(contents) > maxFibers {
+		return nil, fmt.Errorf("fiber composition must have at most %d fibers", maxFibers)
+	}
+	seen := make(map[Fiber]bool, len(contents))
+	total := 0
+	for _, content := range contents {
+		if content.Percentage <= 0 || content.Percentage > 100 {
+			return nil, fmt.Errorf("percentage of %s must be between 1 and 100: %d", content.Fiber, content.Percentage)
+		}
+		if seen[content.Fiber] {
+			return nil, fmt.Errorf("fiber %s is duplicated", content.Fiber)
+		}
+		seen[content.Fiber] = true
+		total += content.Percentage
+	}
+	if total != 100 {
+		return nil, fmt.Errorf("fiber percentages must total 100: %d", total)
+	}
+
+	composition := append(FiberComposition{}, contents...)
+	sort.SliceStable(composition, func(i, j int) bool {
+		if composition[i].Percentage != composition[j].Percentage {
+			return composition[i].Percentage > composition[j].Percentage
+		}
+		return composition[i].Fiber < composition[j].Fiber
+	})
+	return composition, nil
+}
+
+// NeedleRange は毛糸に合う針の太さの範囲
+type NeedleRange struct {
+	min NeedleSize
+	max NeedleSize
+}
+
+func NewNeedleRange(minSize NeedleSize, maxSize NeedleSize) (*NeedleRange, error) {
+	if minSize.millimeters > maxSize.millimeters {
+		return nil, fmt.Errorf("needle range minimum must not exceed maximum: %v > %v", minSize.millimeters, maxSize.millimeters)
+	}
+	return &NeedleRange{min: minSize, max: maxSize}, nil
+}
+
+func (r NeedleRange) Min() NeedleSize {
+	return r.min
+}
+
+func (r NeedleRange) Max() NeedleSize {
+	return r.max
+}
+
+// Contains は針の太さが範囲に含まれるかを返す
+func (r NeedleRange) Contains(size NeedleSize) bool {
+	return r.min.millimeters <= size.millimeters && size.millimeters <= r.max.millimeters
+}
+
+// YarnDetails は毛糸の商品の属性。YardagePerSkein は1玉の長さ（m）、GramsPerSkein は1玉の重さ（g）
+// GramsPerSkein の 0、Fibers・NeedleRange・Gauge の nil は未登録を表す
+type YarnDetails struct {
+	Weight          YarnWeight
+	YardagePerSkein int
+	GramsPerSkein   int
+	Fibers          FiberComposition
+	NeedleRange     *NeedleRange
+	Gauge           *Gauge
+}
+
+// YarnAttributes は毛糸の商品の属性。毛糸でない商品は持たない
+type YarnAttributes struct {
+	details YarnDetails
+}
+
+func NewYarnAttributes(details YarnDetails) (*YarnAttributes, error) {
+	if details.YardagePerSkein <= 0 {
+		return nil, fmt.Errorf("yardage per skein must be positive: %d", details.YardagePerSkein)
+	}
+	if details.GramsPerSkein < 0 {
+		return nil, fmt.Errorf("grams per skein must not be negative: %d", details.GramsPerSkein)
+	}
+	return &YarnAttributes{details: details}, nil
+}
+
+func (y YarnAttributes) Weight() YarnWeight {
+	return y.details.Weight
+}
+
+// YardagePerSkein は1玉の長さ（m）を返す
+func (y YarnAttributes) YardagePerSkein() int {
+	return y.details.YardagePerSkein
+}
+
+// GramsPerSkein は1玉の重さ（g）を返す。未登録なら 0
+func (y YarnAttributes) GramsPerSkein() int {
+	return y.details.GramsPerSkein
+}
+
+// Fibers は素材の混率を返す。未登録なら空
+func (y YarnAttributes) Fibers() FiberComposition {
+	return y.details.Fibers
+}
+
+// NeedleRange は合う針の太さの範囲を返す。未登録なら nil
+func (y YarnAttributes) NeedleRange() *NeedleRange {
+	return y.details.NeedleRange
+}
+
+// Gauge は推奨ゲージを返す。未登録なら nil
+func (y YarnAttributes) Gauge() *Gauge {
+	return y.details.Gauge
+}
+
+// SkeinsFor は yardage（m）を編むのに必要な玉数を返す。端数の玉は1玉として数える
+func (y YarnAttributes) SkeinsFor(yardage int) int {
+	return (yardage + y.details.YardagePerSkein - 1) / y.details.YardagePerSkein
+}
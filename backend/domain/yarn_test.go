@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewYarnWeight(t *testing.T) {
+	weight, err := NewYarnWeight("medium")
+	assert.NoError(t, err)
+	assert.Equal(t, YarnWeightMedium, weight)
+
+	for _, value := range []string{"", "worsted", "MEDIUM"} {
+		_, err := NewYarnWeight(value)
+		assert.Error(t, err)
+	}
+}
+
+func TestNewFiber(t *testing.T) {
+	fiber, err := NewFiber("merino")
+	assert.NoError(t, err)
+	assert.Equal(t, FiberMerino, fiber)
+
+	for _, value := range []string{"", "Wool", "yak"} {
+		_, err := NewFiber(value)
+		assert.Error(t, err)
+	}
+}
+
+func TestYarnAttributes_SkeinsFor(t *testing.T) {
+	yarn, err := NewYarnAttributes(YarnDetails{Weight: YarnWeightMedium, YardagePerSkein: 100})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		yardage int
+		skeins  int
+	}{
+		{yardage: 1, skeins: 1},
+		{yardage: 100, skeins: 1},
+		{yardage: 101, skeins: 2},
+		{yardage: 1250, skeins: 13},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.skeins, yarn.SkeinsFor(tt.yardage))
+	}
+
+	_, err = NewYarnAttributes(YarnDetails{Weight: YarnWeightMedium, YardagePerSkein: 0})
+	assert.Error(t, err)
+	_, err = NewYarnAttributes(YarnDetails{Weight: YarnWeightMedium, YardagePerSkein: 100, GramsPerSkein: -1})
+	assert.Error(t, err)
+}
+
+func TestNewFiberComposition(t *testing.T) {
+	composition, err := NewFiberComposition([]FiberContent{
+		{Fiber: FiberNylon, Percentage: 25},
+		{Fiber: FiberWool, Percentage: 75},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, FiberComposition{{Fiber: FiberWool, Percentage: 75}, {Fiber: FiberNylon, Percentage: 25}}, composition)
+
+	tests := []struct {
+		name     string
+		contents []FiberContent
+	}{
+		{"Empty", nil},
+		{"Total Below 100", []FiberContent{{Fiber: FiberWool, Percentage: 70}, {Fiber: FiberNylon, Percentage: 20}}},
+		{"Total Above 100", []FiberContent{{Fiber: FiberWool, Percentage: 80}, {Fiber: FiberNylon, Percentage: 30}}},
+		{"Zero Percentage", []FiberContent{{Fiber: FiberWool, Percentage: 100}, {Fiber: FiberNylon, Percentage: 0}}},
+		{"Duplicate Fiber", []FiberContent{{Fiber: FiberWool, Percentage: 50}, {Fiber: FiberWool, Percentage: 50}}},
+		{"Too Many Fibers", []FiberContent{
+			{Fiber: FiberWool, Percentage: 20}, {Fiber: FiberSilk, Percentage: 20}, {Fiber: FiberNylon, Percentage: 20},
+			{Fiber: FiberAlpaca, Percentage: 20}, {Fiber: FiberMohair, Percentage: 10}, {Fiber: FiberLinen, Percentage: 10},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFiberComposition(tt.contents)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewNeedleRange(t *testing.T) {
+	small, _ := NewNeedleSize(3.5)
+	large, _ := NewNeedleSize(4.5)
+	middle, _ := NewNeedleSize(4)
+
+	needles, err := NewNeedleRange(*small, *large)
+	assert.NoError(t, err)
+	assert.True(t, needles.Contains(*small))
+	assert.True(t, needles.Contains(*middle))
+	assert.False(t, needles.Contains(NeedleSize{millimeters: 5}))
+
+	_, err = NewNeedleRange(*large, *small)
+	assert.Error(t, err)
+}
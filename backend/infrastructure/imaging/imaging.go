@@ -0,0 +1,135 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+// MaxPixels はデコードを許可する画像の画素数の上限。巨大な画像でメモリを使い切らないようにする
+const MaxPixels = 40_000_000
+
+// Processor はこのパッケージの関数で画像を処理する。usecase.ImageProcessor として注入する
+type Processor struct{}
+
+func NewProcessor() *Processor {
+	return &Processor{}
+}
+
+func (Processor) DetectContentType(data []byte) string {
+	return DetectContentType(data)
+}
+
+func (Processor) Decode(data []byte) (image.Image, error) {
+	return Decode(data)
+}
+
+// Thumbnail は Resize で縮小してから Encode でエンコードする
+func (Processor) Thumbnail(img image.Image, maxSize int, contentType string) ([]byte, error) {
+	return Encode(Resize(img, maxSize), contentType)
+}
+
+// DetectContentType はファイルの先頭バイトから MIME タイプを判定する。クライアントが申告した Content-Type は使わない
+func DetectContentType(data []byte) string {
+	return http.DetectContentType(data)
+}
+
+// Decode は JPEG・PNG・GIF の画像をデコードする。画素数が MaxPixels を超える画像はデコードせずにエラーを返す
+func Decode(data []byte) (image.Image, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image header: %w", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, fmt.Errorf("image has no pixels")
+	}
+	if config.Width*config.Height > MaxPixels {
+		return nil, fmt.Errorf("image is too large: %dx%d", config.Width, config.Height)
+	}
+
+	var img image.Image
+	switch format {
+	case "jpeg":
+		img, err = jpeg.Decode(bytes.NewReader(data))
+	case "png":
+		img, err = png.Decode(bytes.NewReader(data))
+	case "gif":
+		img, err = gif.Decode(bytes.NewReader(data))
+	default:
+		return nil, fmt.Errorf("unsupported image format: %s", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s image: %w", format, err)
+	}
+	return img, nil
+}
+
+// Resize は縦横比を保ったまま、長辺が maxSize 以下になるよう縮小する
+// 縮小元の画素の平均を取るため、大きく縮めても細部がちらつかない。maxSize 以下の画像は拡大しない
+func Resize(src image.Image, maxSize int) image.Image {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	dstW, dstH := srcW, srcH
+	if srcW > maxSize || srcH > maxSize {
+		if srcW >= srcH {
+			dstW, dstH = maxSize, max(1, srcH*maxSize/srcW)
+		} else {
+			dstW, dstH = max(1, srcW*maxSize/srcH), maxSize
+		}
+	}
+
+	rgba := image.NewRGBA(image.Rect(0, 0, srcW, srcH))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	if dstW == srcW && dstH == srcH {
+		return rgba
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0, y1 := y*srcH/dstH, max((y+1)*srcH/dstH, y*srcH/dstH+1)
+		for x := 0; x < dstW; x++ {
+			x0, x1 := x*srcW/dstW, max((x+1)*srcW/dstW, x*srcW/dstW+1)
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				offset := rgba.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += int(rgba.Pix[offset])
+					g += int(rgba.Pix[offset+1])
+					b += int(rgba.Pix[offset+2])
+					a += int(rgba.Pix[offset+3])
+					offset += 4
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// Encode は画像を JPEG または PNG でエンコードする
+func Encode(img image.Image, contentType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch contentType {
+	case "image/jpeg":
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85})
+	case "image/png":
+		err = png.Encode(&buf, img)
+	default:
+		return nil, fmt.Errorf("unsupported image format: %s", contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,106 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodeTestImage(t *testing.T, format string, width int, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), 128, 255})
+		}
+	}
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case "jpeg":
+		err = jpeg.Encode(&buf, img, nil)
+	case "png":
+		err = png.Encode(&buf, img)
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDetectContentType(t *testing.T) {
+	assert.Equal(t, "image/jpeg", DetectContentType(encodeTestImage(t, "jpeg", 4, 4)))
+	assert.Equal(t, "image/png", DetectContentType(encodeTestImage(t, "png", 4, 4)))
+	assert.Equal(t, "image/gif", DetectContentType(encodeTestImage(t, "gif", 4, 4)))
+	assert.Equal(t, "text/plain; charset=utf-8", DetectContentType([]byte("not an image")))
+}
+
+func TestDecode(t *testing.T) {
+	img, err := Decode(encodeTestImage(t, "png", 30, 20))
+	assert.NoError(t, err)
+	assert.Equal(t, 30, img.Bounds().Dx())
+
+	_, err = Decode([]byte("not an image"))
+	assert.Error(t, err)
+
+	// ヘッダーだけが正しい壊れた画像
+	broken := encodeTestImage(t, "png", 30, 20)
+	_, err = Decode(broken[:len(broken)/2])
+	assert.Error(t, err)
+}
+
+func TestResize(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		maxSize       int
+		wantW, wantH  int
+	}{
+		{"landscape", 400, 200, 100, 100, 50},
+		{"portrait", 200, 400, 100, 50, 100},
+		{"square", 300, 300, 100, 100, 100},
+		{"very thin", 1000, 2, 100, 100, 1},
+		{"smaller than max is not enlarged", 40, 20, 100, 40, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := image.NewRGBA(image.Rect(0, 0, tt.width, tt.height))
+			resized := Resize(src, tt.maxSize)
+			assert.Equal(t, tt.wantW, resized.Bounds().Dx())
+			assert.Equal(t, tt.wantH, resized.Bounds().Dy())
+		})
+	}
+}
+
+func TestResize_AveragesPixels(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	src.Set(0, 0, color.RGBA{0, 0, 0, 255})
+	src.Set(1, 0, color.RGBA{200, 100, 50, 255})
+
+	resized := Resize(src, 1)
+
+	assert.Equal(t, color.RGBA{100, 50, 25, 255}, resized.At(0, 0))
+}
+
+func TestEncode(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+
+	data, err := Encode(img, "image/png")
+	assert.NoError(t, err)
+	assert.Equal(t, "image/png", DetectContentType(data))
+
+	data, err = Encode(img, "image/jpeg")
+	assert.NoError(t, err)
+	assert.Equal(t, "image/jpeg", DetectContentType(data))
+
+	_, err = Encode(img, "image/gif")
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+package notification
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// LogNotifier は通知を送らずに1行1件の JSON として書き出す。ローカルでの動作確認用
+type LogNotifier struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func NewLogNotifier(out io.Writer) *LogNotifier {
+	return &LogNotifier{out: out}
+}
+
+type loggedMessage struct {
+	SentAt  time.Time `json:"sent_at"`
+	To      string    `json:"to"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body"`
+}
+
+func (n *LogNotifier) Notify(to string, subject string, body string) error {
+	line, err := json.Marshal(loggedMessage{SentAt: time.Now(), To: to, Subject: subject, Body: body})
+	if err != nil {
+		return err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	_, err = n.out.Write(append(line, '\n'))
+	return err
+}
+
+// FileNotifier は通知をファイルに追記する。通知ごとにファイルを開くため、ログローテーションで消されても作り直す
+type FileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+func (n *FileNotifier) Notify(to string, subject string, body string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	file, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := NewLogNotifier(file).Notify(to, subject, body); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"fmt"
+	"os"
+)
+
+// Notifier はユーザーへの通知の送り方を抽象化する。to は宛先のメールアドレス
+type Notifier interface {
+	Notify(to string, subject string, body string) error
+}
+
+// NewNotifierFromEnv は NOTIFIER_DRIVER に応じた通知の送り先を返す。未設定の場合は標準出力のログに書く
+// file の場合は NOTIFIER_FILE_PATH（既定は notifications.log）に追記する
+func NewNotifierFromEnv() (Notifier, error) {
+	switch driver := os.Getenv("NOTIFIER_DRIVER"); driver {
+	case "", "log":
+		return NewLogNotifier(os.Stdout), nil
+	case "file":
+		path := os.Getenv("NOTIFIER_FILE_PATH")
+		if path == "" {
+			path = "notifications.log"
+		}
+		return NewFileNotifier(path), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier driver: %s", driver)
+	}
+}
@@ -0,0 +1,58 @@
+package notification
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogNotifier(t *testing.T) {
+	var out bytes.Buffer
+	n := NewLogNotifier(&out)
+
+	assert.NoError(t, n.Notify("hanako@example.com", "再入荷のお知らせ", "1行目\n2行目"))
+	assert.NoError(t, n.Notify("taro@example.com", "再入荷のお知らせ", "本文"))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 2)
+	var logged loggedMessage
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &logged))
+	assert.Equal(t, "hanako@example.com", logged.To)
+	assert.Equal(t, "1行目\n2行目", logged.Body)
+	assert.False(t, logged.SentAt.IsZero())
+}
+
+func TestFileNotifier(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.log")
+	n := NewFileNotifier(path)
+
+	assert.NoError(t, n.Notify("hanako@example.com", "再入荷のお知らせ", "本文"))
+	assert.NoError(t, n.Notify("taro@example.com", "再入荷のお知らせ", "本文"))
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(data), "\n"))
+	assert.Contains(t, string(data), `"to":"taro@example.com"`)
+}
+
+func TestNewNotifierFromEnv(t *testing.T) {
+	t.Setenv("NOTIFIER_DRIVER", "")
+	n, err := NewNotifierFromEnv()
+	assert.NoError(t, err)
+	assert.IsType(t, &LogNotifier{}, n)
+
+	t.Setenv("NOTIFIER_DRIVER", "file")
+	t.Setenv("NOTIFIER_FILE_PATH", filepath.Join(t.TempDir(), "out.log"))
+	n, err = NewNotifierFromEnv()
+	assert.NoError(t, err)
+	assert.IsType(t, &FileNotifier{}, n)
+
+	t.Setenv("NOTIFIER_DRIVER", "smtp")
+	_, err = NewNotifierFromEnv()
+	assert.Error(t, err)
+}
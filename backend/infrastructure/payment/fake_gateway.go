@@ -0,0 +1,141 @@
+package payment
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// FakeGateway に渡すトークンで決済の結果を指定する
+const (
+	FakeTokenSucceed        = "tok_succeed"
+	FakeTokenDecline        = "tok_decline"
+	FakeTokenRequiresAction = "tok_requires_action"
+)
+
+type fakePayment struct {
+	status AuthorizationStatus
+	// captured と refunded はオーソリ後の状態
+	captured bool
+	refunded bool
+}
+
+// FakeGateway はプロセス内で完結する決済代行サービスのフェイク。外部と通信せずにチェックアウトを試せる
+// 結果はトークンで決まり、本人確認が必要な決済は CompleteAction で確認を終えるまでオーソリされない
+type FakeGateway struct {
+	mu          sync.Mutex
+	secret      string
+	payments    map[string]*fakePayment
+	idempotency map[string]*AuthorizeResult
+}
+
+func NewFakeGateway(secret string) *FakeGateway {
+	return &FakeGateway{
+		secret:      secret,
+		payments:    map[string]*fakePayment{},
+		idempotency: map[string]*AuthorizeResult{},
+	}
+}
+
+func (g *FakeGateway) Authorize(req AuthorizeRequest) (*AuthorizeResult, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if result, ok := g.idempotency[req.IdempotencyKey]; ok {
+		copied := *result
+		return &copied, nil
+	}
+	if !req.Amount.IsPositive() {
+		return nil, fmt.Errorf("amount must be positive: %s", req.Amount)
+	}
+
+	result := &AuthorizeResult{ProviderPaymentId: "fakepay_" + uuid.NewString()}
+	switch req.Token {
+	case FakeTokenSucceed:
+		result.Status = AuthorizationStatusAuthorized
+	case FakeTokenRequiresAction:
+		result.Status = AuthorizationStatusRequiresAction
+	case FakeTokenDecline:
+		result.Status = AuthorizationStatusDeclined
+		result.DeclineCode = "card_declined"
+	default:
+		result.Status = AuthorizationStatusDeclined
+		result.DeclineCode = "invalid_token"
+	}
+	g.payments[result.ProviderPaymentId] = &fakePayment{status: result.Status}
+	g.idempotency[req.IdempotencyKey] = result
+	copied := *result
+	return &copied, nil
+}
+
+func (g *FakeGateway) Capture(providerPaymentId string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	p, ok := g.payments[providerPaymentId]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrPaymentNotFound, providerPaymentId)
+	}
+	if p.status != AuthorizationStatusAuthorized || p.refunded {
+		return fmt.Errorf("%w: %s is not authorized", ErrInvalidPaymentState, providerPaymentId)
+	}
+	p.captured = true
+	return nil
+}
+
+func (g *FakeGateway) Refund(providerPaymentId string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	p, ok := g.payments[providerPaymentId]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrPaymentNotFound, providerPaymentId)
+	}
+	if !p.captured {
+		return fmt.Errorf("%w: %s is not captured", ErrInvalidPaymentState, providerPaymentId)
+	}
+	p.refunded = true
+	return nil
+}
+
+func (g *FakeGateway) VerifyWebhook(payload []byte, signature string) (*WebhookEvent, error) {
+	if err := verifySignature(g.secret, payload, signature); err != nil {
+		return nil, err
+	}
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+	if event.EventId == "" || event.Type == "" || event.ProviderPaymentId == "" {
+		return nil, fmt.Errorf("invalid webhook payload: id, type and payment_id are required")
+	}
+	return &event, nil
+}
+
+// CompleteAction は本人確認を待っている決済の確認を終え、結果を通知する Webhook の本文と署名を返す
+// approve が false の場合は拒否される
+func (g *FakeGateway) CompleteAction(providerPaymentId string, approve bool) ([]byte, string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	p, ok := g.payments[providerPaymentId]
+	if !ok {
+		return nil, "", fmt.Errorf("%w: %s", ErrPaymentNotFound, providerPaymentId)
+	}
+	if p.status != AuthorizationStatusRequiresAction {
+		return nil, "", fmt.Errorf("%w: %s does not require action", ErrInvalidPaymentState, providerPaymentId)
+	}
+	eventType := EventPaymentAuthorized
+	p.status = AuthorizationStatusAuthorized
+	if !approve {
+		eventType = EventPaymentDeclined
+		p.status = AuthorizationStatusDeclined
+	}
+	return g.webhook(eventType, providerPaymentId)
+}
+
+func (g *FakeGateway) webhook(eventType EventType, providerPaymentId string) ([]byte, string, error) {
+	payload, err := json.Marshal(WebhookEvent{EventId: "evt_" + uuid.NewString(), Type: eventType, ProviderPaymentId: providerPaymentId})
+	if err != nil {
+		return nil, "", err
+	}
+	return payload, Sign(g.secret, payload), nil
+}
@@ -0,0 +1,108 @@
+package payment
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrPaymentNotFound は決済代行サービスに決済が存在しない場合に返す
+	ErrPaymentNotFound = errors.New("payment not found at provider")
+	// ErrInvalidPaymentState は売上確定・返金できない状態の決済を操作した場合に返す
+	ErrInvalidPaymentState = errors.New("invalid payment state at provider")
+	// ErrInvalidSignature は Webhook の署名が一致しない場合に返す
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+)
+
+// AuthorizationStatus はオーソリ（与信枠の確保）の結果
+type AuthorizationStatus string
+
+const (
+	AuthorizationStatusAuthorized AuthorizationStatus = "authorized"
+	AuthorizationStatusDeclined   AuthorizationStatus = "declined"
+	// AuthorizationStatusRequiresAction は 3D セキュアなどの本人確認が必要な状態。結果は Webhook で通知される
+	AuthorizationStatusRequiresAction AuthorizationStatus = "requires_action"
+)
+
+// EventType は Webhook で通知されるイベントの種類
+type EventType string
+
+const (
+	EventPaymentAuthorized EventType = "payment.authorized"
+	EventPaymentDeclined   EventType = "payment.declined"
+	EventPaymentCaptured   EventType = "payment.captured"
+	EventPaymentRefunded   EventType = "payment.refunded"
+)
+
+// AuthorizeRequest の IdempotencyKey が同じリクエストは、何度送っても同じ決済として扱われる
+// Token はフロントエンドが決済代行サービスから受け取った支払い方法のトークン
+type AuthorizeRequest struct {
+	IdempotencyKey string
+	Amount         decimal.Decimal
+	Currency       string
+	Token          string
+}
+
+type AuthorizeResult struct {
+	ProviderPaymentId string
+	Status            AuthorizationStatus
+	// DeclineCode は拒否された理由。拒否された場合だけ設定される
+	DeclineCode string
+}
+
+// WebhookEvent は署名を検証した Webhook の内容。EventId は再送されても変わらない
+type WebhookEvent struct {
+	EventId           string    `json:"id"`
+	Type              EventType `json:"type"`
+	ProviderPaymentId string    `json:"payment_id"`
+}
+
+// PaymentGateway は決済代行サービスとのやり取りを抽象化する
+// Capture と Refund は既に売上確定・返金済みの決済に対してはエラーにしない
+type PaymentGateway interface {
+	Authorize(req AuthorizeRequest) (*AuthorizeResult, error)
+	Capture(providerPaymentId string) error
+	Refund(providerPaymentId string) error
+	VerifyWebhook(payload []byte, signature string) (*WebhookEvent, error)
+}
+
+// NewGatewayFromEnv は PAYMENT_PROVIDER に応じた決済代行サービスを返す。未設定の場合はプロセス内のフェイクを使う
+// Webhook の署名鍵は PAYMENT_WEBHOOK_SECRET から読み込む
+func NewGatewayFromEnv() (PaymentGateway, error) {
+	secret := os.Getenv("PAYMENT_WEBHOOK_SECRET")
+	if secret == "" {
+		return nil, errors.New("PAYMENT_WEBHOOK_SECRET is not set")
+	}
+	switch provider := os.Getenv("PAYMENT_PROVIDER"); provider {
+	case "", "fake":
+		return NewFakeGateway(secret), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider: %s", provider)
+	}
+}
+
+// Sign は Webhook の本文に対する HMAC-SHA256 の署名を16進数で返す
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verifySignature は署名を定数時間で比較する
+func verifySignature(secret string, payload []byte, signature string) error {
+	expected, err := hex.DecodeString(Sign(secret, payload))
+	if err != nil {
+		return err
+	}
+	actual, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, actual) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
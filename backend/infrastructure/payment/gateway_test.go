@@ -0,0 +1,130 @@
+package payment
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func authorizeRequest(key string, token string) AuthorizeRequest {
+	return AuthorizeRequest{IdempotencyKey: key, Amount: decimal.NewFromInt(2200), Currency: "JPY", Token: token}
+}
+
+func TestFakeGateway_Authorize(t *testing.T) {
+	tests := []struct {
+		token       string
+		status      AuthorizationStatus
+		declineCode string
+	}{
+		{token: FakeTokenSucceed, status: AuthorizationStatusAuthorized},
+		{token: FakeTokenRequiresAction, status: AuthorizationStatusRequiresAction},
+		{token: FakeTokenDecline, status: AuthorizationStatusDeclined, declineCode: "card_declined"},
+		{token: "tok_unknown", status: AuthorizationStatusDeclined, declineCode: "invalid_token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			g := NewFakeGateway("secret")
+			result, err := g.Authorize(authorizeRequest("key-1", tt.token))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, result.Status)
+			assert.Equal(t, tt.declineCode, result.DeclineCode)
+			assert.NotEmpty(t, result.ProviderPaymentId)
+		})
+	}
+}
+
+func TestFakeGateway_AuthorizeIsIdempotent(t *testing.T) {
+	g := NewFakeGateway("secret")
+	first, _ := g.Authorize(authorizeRequest("key-1", FakeTokenSucceed))
+	retried, _ := g.Authorize(authorizeRequest("key-1", FakeTokenDecline))
+	other, _ := g.Authorize(authorizeRequest("key-2", FakeTokenSucceed))
+
+	assert.Equal(t, first, retried)
+	assert.NotEqual(t, first.ProviderPaymentId, other.ProviderPaymentId)
+
+	_, err := g.Authorize(AuthorizeRequest{IdempotencyKey: "key-3", Amount: decimal.Zero, Currency: "JPY", Token: FakeTokenSucceed})
+	assert.Error(t, err)
+}
+
+func TestFakeGateway_CaptureAndRefund(t *testing.T) {
+	g := NewFakeGateway("secret")
+	authorized, _ := g.Authorize(authorizeRequest("key-1", FakeTokenSucceed))
+	pending, _ := g.Authorize(authorizeRequest("key-2", FakeTokenRequiresAction))
+
+	assert.True(t, errors.Is(g.Refund(authorized.ProviderPaymentId), ErrInvalidPaymentState))
+	assert.NoError(t, g.Capture(authorized.ProviderPaymentId))
+	assert.NoError(t, g.Capture(authorized.ProviderPaymentId))
+	assert.NoError(t, g.Refund(authorized.ProviderPaymentId))
+	assert.NoError(t, g.Refund(authorized.ProviderPaymentId))
+	assert.True(t, errors.Is(g.Capture(authorized.ProviderPaymentId), ErrInvalidPaymentState))
+
+	assert.True(t, errors.Is(g.Capture(pending.ProviderPaymentId), ErrInvalidPaymentState))
+	assert.True(t, errors.Is(g.Capture("fakepay_missing"), ErrPaymentNotFound))
+}
+
+func TestFakeGateway_CompleteAction(t *testing.T) {
+	g := NewFakeGateway("secret")
+	approved, _ := g.Authorize(authorizeRequest("key-1", FakeTokenRequiresAction))
+	rejected, _ := g.Authorize(authorizeRequest("key-2", FakeTokenRequiresAction))
+
+	payload, signature, err := g.CompleteAction(approved.ProviderPaymentId, true)
+	assert.NoError(t, err)
+	event, err := g.VerifyWebhook(payload, signature)
+	assert.NoError(t, err)
+	assert.Equal(t, EventPaymentAuthorized, event.Type)
+	assert.Equal(t, approved.ProviderPaymentId, event.ProviderPaymentId)
+	assert.NotEmpty(t, event.EventId)
+	assert.NoError(t, g.Capture(approved.ProviderPaymentId))
+
+	payload, signature, err = g.CompleteAction(rejected.ProviderPaymentId, false)
+	assert.NoError(t, err)
+	event, _ = g.VerifyWebhook(payload, signature)
+	assert.Equal(t, EventPaymentDeclined, event.Type)
+	assert.True(t, errors.Is(g.Capture(rejected.ProviderPaymentId), ErrInvalidPaymentState))
+
+	_, _, err = g.CompleteAction(approved.ProviderPaymentId, true)
+	assert.True(t, errors.Is(err, ErrInvalidPaymentState))
+}
+
+func TestFakeGateway_VerifyWebhook(t *testing.T) {
+	g := NewFakeGateway("secret")
+	payload := []byte(`{"id":"evt_1","type":"payment.captured","payment_id":"fakepay_1"}`)
+
+	event, err := g.VerifyWebhook(payload, Sign("secret", payload))
+	assert.NoError(t, err)
+	assert.Equal(t, &WebhookEvent{EventId: "evt_1", Type: EventPaymentCaptured, ProviderPaymentId: "fakepay_1"}, event)
+
+	for name, signature := range map[string]string{
+		"wrong secret": Sign("other", payload),
+		"not hex":      "zz",
+		"empty":        "",
+	} {
+		_, err := g.VerifyWebhook(payload, signature)
+		assert.True(t, errors.Is(err, ErrInvalidSignature), name)
+	}
+
+	tampered := []byte(`{"id":"evt_1","type":"payment.refunded","payment_id":"fakepay_1"}`)
+	_, err = g.VerifyWebhook(tampered, Sign("secret", payload))
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+
+	incomplete := []byte(`{"id":"evt_1"}`)
+	_, err = g.VerifyWebhook(incomplete, Sign("secret", incomplete))
+	assert.Error(t, err)
+}
+
+func TestNewGatewayFromEnv(t *testing.T) {
+	t.Setenv("PAYMENT_WEBHOOK_SECRET", "")
+	_, err := NewGatewayFromEnv()
+	assert.Error(t, err)
+
+	t.Setenv("PAYMENT_WEBHOOK_SECRET", "secret")
+	g, err := NewGatewayFromEnv()
+	assert.NoError(t, err)
+	assert.IsType(t, &FakeGateway{}, g)
+
+	t.Setenv("PAYMENT_PROVIDER", "unknown")
+	_, err = NewGatewayFromEnv()
+	assert.Error(t, err)
+}
@@ -0,0 +1,3 @@
+-- CreateIndex
+-- 日本語は単語の区切りがないため ngram パーサーで分かち書きする
+CREATE FULLTEXT INDEX `items_item_name_description_idx` ON `items`(`item_name`, `description`) WITH PARSER ngram;
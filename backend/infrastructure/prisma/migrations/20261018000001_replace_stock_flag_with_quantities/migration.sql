@@ -0,0 +1,10 @@
+-- AlterTable
+ALTER TABLE `items` ADD COLUMN `on_hand_quantity` INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN `reserved_quantity` INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN `low_stock_threshold` INTEGER NOT NULL DEFAULT 0;
+
+-- 既存の在庫有無フラグは、在庫ありを1点、在庫なしを0点として移行する
+UPDATE `items` SET `on_hand_quantity` = 1 WHERE `stock` = true;
+
+-- AlterTable
+ALTER TABLE `items` DROP COLUMN `stock`;
@@ -0,0 +1,25 @@
+-- CreateTable
+CREATE TABLE `stock_movements` (
+    `movement_id` VARCHAR(36) NOT NULL,
+    `item_id` VARCHAR(36) NOT NULL,
+    `movement_type` VARCHAR(20) NOT NULL,
+    `quantity_delta` INTEGER NOT NULL,
+    `reason` VARCHAR(191) NOT NULL DEFAULT '',
+    `user_id` VARCHAR(36) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+
+    INDEX `stock_movements_item_id_created_at_idx`(`item_id`, `created_at`),
+    PRIMARY KEY (`movement_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `stock_movements` ADD CONSTRAINT `stock_movements_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `stock_movements` ADD CONSTRAINT `stock_movements_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users`(`user_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- 既存の在庫数を期首残高の調整として台帳に記録し、実在庫数と台帳の合計を一致させる
+INSERT INTO `stock_movements` (`movement_id`, `item_id`, `movement_type`, `quantity_delta`, `reason`, `user_id`, `created_at`)
+SELECT UUID(), `item_id`, 'adjustment', `on_hand_quantity`, 'opening balance', `user_id`, CURRENT_TIMESTAMP(3)
+FROM `items`
+WHERE `on_hand_quantity` > 0;
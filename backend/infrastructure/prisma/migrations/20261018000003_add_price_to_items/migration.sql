@@ -0,0 +1,4 @@
+-- AlterTable
+-- 価格は税抜で保持する。小数点以下2桁までの通貨に対応するため DECIMAL(12, 2) とする
+ALTER TABLE `items` ADD COLUMN `price` DECIMAL(12, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN `price_currency` CHAR(3) NOT NULL DEFAULT 'JPY';
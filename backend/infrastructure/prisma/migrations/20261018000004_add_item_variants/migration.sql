@@ -0,0 +1,22 @@
+-- CreateTable
+CREATE TABLE `item_variants` (
+    `variant_id` VARCHAR(36) NOT NULL,
+    `item_id` VARCHAR(36) NOT NULL,
+    `sku` VARCHAR(64) NOT NULL,
+    `options` JSON NOT NULL,
+    `option_key` VARCHAR(255) NOT NULL,
+    `on_hand_quantity` INTEGER NOT NULL DEFAULT 0,
+    `reserved_quantity` INTEGER NOT NULL DEFAULT 0,
+    `low_stock_threshold` INTEGER NOT NULL DEFAULT 0,
+    `price_override` DECIMAL(12, 2) NULL,
+    `price_override_currency` CHAR(3) NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updated_at` DATETIME(3) NULL,
+
+    UNIQUE INDEX `item_variants_sku_key`(`sku`),
+    UNIQUE INDEX `item_variants_item_id_option_key_key`(`item_id`, `option_key`),
+    PRIMARY KEY (`variant_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `item_variants` ADD CONSTRAINT `item_variants_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
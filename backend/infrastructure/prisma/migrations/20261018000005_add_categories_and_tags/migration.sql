@@ -0,0 +1,49 @@
+-- CreateTable
+CREATE TABLE `categories` (
+    `category_id` VARCHAR(36) NOT NULL,
+    `parent_id` VARCHAR(36) NULL,
+    `name` VARCHAR(64) NOT NULL,
+    `slug` VARCHAR(64) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updated_at` DATETIME(3) NULL,
+
+    UNIQUE INDEX `categories_slug_key`(`slug`),
+    INDEX `categories_parent_id_idx`(`parent_id`),
+    PRIMARY KEY (`category_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+CREATE TABLE `tags` (
+    `tag_id` VARCHAR(36) NOT NULL,
+    `name` VARCHAR(32) NOT NULL,
+
+    UNIQUE INDEX `tags_name_key`(`name`),
+    PRIMARY KEY (`tag_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+CREATE TABLE `item_tags` (
+    `item_id` VARCHAR(36) NOT NULL,
+    `tag_id` VARCHAR(36) NOT NULL,
+
+    INDEX `item_tags_tag_id_idx`(`tag_id`),
+    PRIMARY KEY (`item_id`, `tag_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AlterTable
+ALTER TABLE `items` ADD COLUMN `category_id` VARCHAR(36) NULL;
+
+-- CreateIndex
+CREATE INDEX `items_category_id_idx` ON `items`(`category_id`);
+
+-- AddForeignKey
+ALTER TABLE `categories` ADD CONSTRAINT `categories_parent_id_fkey` FOREIGN KEY (`parent_id`) REFERENCES `categories`(`category_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `items` ADD CONSTRAINT `items_category_id_fkey` FOREIGN KEY (`category_id`) REFERENCES `categories`(`category_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `item_tags` ADD CONSTRAINT `item_tags_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `item_tags` ADD CONSTRAINT `item_tags_tag_id_fkey` FOREIGN KEY (`tag_id`) REFERENCES `tags`(`tag_id`) ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,20 @@
+-- CreateTable
+CREATE TABLE `item_images` (
+    `image_id` VARCHAR(36) NOT NULL,
+    `item_id` VARCHAR(36) NOT NULL,
+    `position` INTEGER NOT NULL,
+    `content_type` VARCHAR(32) NOT NULL,
+    `width` INTEGER NOT NULL,
+    `height` INTEGER NOT NULL,
+    `storage_key` VARCHAR(255) NOT NULL,
+    `url` VARCHAR(1024) NOT NULL,
+    `thumbnail_key` VARCHAR(255) NOT NULL,
+    `thumbnail_url` VARCHAR(1024) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+
+    INDEX `item_images_item_id_position_idx`(`item_id`, `position`),
+    PRIMARY KEY (`image_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `item_images` ADD CONSTRAINT `item_images_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,5 @@
+-- AlterTable
+ALTER TABLE `carts` MODIFY `user_id` VARCHAR(36) NULL;
+
+-- CreateIndex
+CREATE INDEX `carts_updated_at_idx` ON `carts`(`updated_at`);
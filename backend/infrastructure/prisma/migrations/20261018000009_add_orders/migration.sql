@@ -0,0 +1,43 @@
+-- CreateTable
+CREATE TABLE `orders` (
+    `order_id` VARCHAR(36) NOT NULL,
+    `user_id` VARCHAR(36) NOT NULL,
+    `status` VARCHAR(20) NOT NULL,
+    `subtotal` DECIMAL(12, 2) NOT NULL,
+    `tax` DECIMAL(12, 2) NOT NULL,
+    `total` DECIMAL(12, 2) NOT NULL,
+    `currency` CHAR(3) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updated_at` DATETIME(3) NULL,
+
+    INDEX `orders_user_id_created_at_idx`(`user_id`, `created_at`),
+    PRIMARY KEY (`order_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+-- 商品名・SKU・オプション・単価は注文時点の値を複製し、後から商品を変更しても注文履歴を書き換えない
+CREATE TABLE `order_lines` (
+    `order_line_id` VARCHAR(36) NOT NULL,
+    `order_id` VARCHAR(36) NOT NULL,
+    `item_id` VARCHAR(36) NOT NULL,
+    `variant_id` VARCHAR(36) NOT NULL DEFAULT '',
+    `item_name` VARCHAR(191) NOT NULL,
+    `sku` VARCHAR(64) NOT NULL DEFAULT '',
+    `options` JSON NOT NULL,
+    `unit_price` DECIMAL(12, 2) NOT NULL,
+    `quantity` INTEGER NOT NULL,
+    `position` INTEGER NOT NULL,
+
+    INDEX `order_lines_order_id_position_idx`(`order_id`, `position`),
+    INDEX `order_lines_item_id_idx`(`item_id`),
+    PRIMARY KEY (`order_line_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `orders` ADD CONSTRAINT `orders_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users`(`user_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `order_lines` ADD CONSTRAINT `order_lines_order_id_fkey` FOREIGN KEY (`order_id`) REFERENCES `orders`(`order_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `order_lines` ADD CONSTRAINT `order_lines_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
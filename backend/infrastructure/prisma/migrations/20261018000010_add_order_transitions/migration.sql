@@ -0,0 +1,26 @@
+-- CreateTable
+-- 注文の状態遷移の記録。追記のみで、注文の作成は from_status が NULL の行として記録する
+CREATE TABLE `order_transitions` (
+    `transition_id` VARCHAR(36) NOT NULL,
+    `order_id` VARCHAR(36) NOT NULL,
+    `from_status` VARCHAR(20) NULL,
+    `to_status` VARCHAR(20) NOT NULL,
+    `user_id` VARCHAR(36) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+
+    INDEX `order_transitions_order_id_created_at_idx`(`order_id`, `created_at`),
+    PRIMARY KEY (`transition_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `order_transitions` ADD CONSTRAINT `order_transitions_order_id_fkey` FOREIGN KEY (`order_id`) REFERENCES `orders`(`order_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `order_transitions` ADD CONSTRAINT `order_transitions_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users`(`user_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- 支払い待ちの状態を追加したため、既存の注文は支払い待ちとし、作成時の遷移を記録する
+UPDATE `orders` SET `status` = 'pending_payment' WHERE `status` = 'pending';
+
+INSERT INTO `order_transitions` (`transition_id`, `order_id`, `from_status`, `to_status`, `user_id`, `created_at`)
+SELECT UUID(), `order_id`, NULL, 'pending_payment', `user_id`, `created_at`
+FROM `orders`;
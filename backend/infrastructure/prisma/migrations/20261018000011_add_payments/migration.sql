@@ -0,0 +1,34 @@
+-- CreateTable
+-- 注文に対する決済。拒否された場合は同じ注文に新しい行を作る
+CREATE TABLE `payments` (
+    `payment_id` VARCHAR(36) NOT NULL,
+    `order_id` VARCHAR(36) NOT NULL,
+    `provider_payment_id` VARCHAR(191) NULL,
+    `status` VARCHAR(20) NOT NULL,
+    `amount` DECIMAL(12, 2) NOT NULL,
+    `currency` CHAR(3) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updated_at` DATETIME(3) NULL,
+
+    UNIQUE INDEX `payments_provider_payment_id_key`(`provider_payment_id`),
+    INDEX `payments_order_id_created_at_idx`(`order_id`, `created_at`),
+    PRIMARY KEY (`payment_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+-- 処理済みの Webhook イベント。同じイベントが再送されても1回だけ反映する
+CREATE TABLE `payment_events` (
+    `event_id` VARCHAR(191) NOT NULL,
+    `payment_id` VARCHAR(36) NOT NULL,
+    `type` VARCHAR(64) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+
+    INDEX `payment_events_payment_id_idx`(`payment_id`),
+    PRIMARY KEY (`event_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `payments` ADD CONSTRAINT `payments_order_id_fkey` FOREIGN KEY (`order_id`) REFERENCES `orders`(`order_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `payment_events` ADD CONSTRAINT `payment_events_payment_id_fkey` FOREIGN KEY (`payment_id`) REFERENCES `payments`(`payment_id`) ON DELETE CASCADE ON UPDATE CASCADE;
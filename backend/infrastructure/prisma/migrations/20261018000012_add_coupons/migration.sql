@@ -0,0 +1,65 @@
+-- AlterTable
+-- クーポンを使った注文の割引額（税抜）とコード。消費税は割引後の小計に対して計算する
+ALTER TABLE `orders` ADD COLUMN `discount` DECIMAL(12, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN `coupon_code` VARCHAR(32) NULL;
+
+-- CreateTable
+-- 割引クーポン。上限の 0 は無制限を表し、used_count は行ロックを取ってから増やす
+CREATE TABLE `coupons` (
+    `coupon_id` VARCHAR(36) NOT NULL,
+    `code` VARCHAR(32) NOT NULL,
+    `discount_type` VARCHAR(10) NOT NULL,
+    `percent_off` INTEGER NULL,
+    `amount_off` DECIMAL(12, 2) NULL,
+    `minimum_spend` DECIMAL(12, 2) NULL,
+    `currency` CHAR(3) NOT NULL DEFAULT 'JPY',
+    `starts_at` DATETIME(3) NULL,
+    `ends_at` DATETIME(3) NULL,
+    `usage_limit` INTEGER NOT NULL DEFAULT 0,
+    `per_user_limit` INTEGER NOT NULL DEFAULT 0,
+    `used_count` INTEGER NOT NULL DEFAULT 0,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updated_at` DATETIME(3) NULL,
+
+    UNIQUE INDEX `coupons_code_key`(`code`),
+    PRIMARY KEY (`coupon_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+-- クーポンの対象商品。行がなければすべての商品が対象
+CREATE TABLE `coupon_items` (
+    `coupon_id` VARCHAR(36) NOT NULL,
+    `item_id` VARCHAR(36) NOT NULL,
+
+    INDEX `coupon_items_item_id_idx`(`item_id`),
+    PRIMARY KEY (`coupon_id`, `item_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+-- クーポンの利用履歴。1注文で使えるクーポンは1枚
+CREATE TABLE `coupon_redemptions` (
+    `redemption_id` VARCHAR(36) NOT NULL,
+    `coupon_id` VARCHAR(36) NOT NULL,
+    `user_id` VARCHAR(36) NOT NULL,
+    `order_id` VARCHAR(36) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+
+    UNIQUE INDEX `coupon_redemptions_order_id_key`(`order_id`),
+    INDEX `coupon_redemptions_coupon_id_user_id_idx`(`coupon_id`, `user_id`),
+    PRIMARY KEY (`redemption_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `coupon_items` ADD CONSTRAINT `coupon_items_coupon_id_fkey` FOREIGN KEY (`coupon_id`) REFERENCES `coupons`(`coupon_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `coupon_items` ADD CONSTRAINT `coupon_items_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `coupon_redemptions` ADD CONSTRAINT `coupon_redemptions_coupon_id_fkey` FOREIGN KEY (`coupon_id`) REFERENCES `coupons`(`coupon_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `coupon_redemptions` ADD CONSTRAINT `coupon_redemptions_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users`(`user_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `coupon_redemptions` ADD CONSTRAINT `coupon_redemptions_order_id_fkey` FOREIGN KEY (`order_id`) REFERENCES `orders`(`order_id`) ON DELETE CASCADE ON UPDATE CASCADE;
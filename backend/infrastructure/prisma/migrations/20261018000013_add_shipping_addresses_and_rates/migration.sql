@@ -0,0 +1,46 @@
+-- CreateTable
+-- ユーザーが保存した配送先。都道府県は JIS X 0401 のコード、電話番号はハイフンを除いて保存する
+CREATE TABLE `shipping_addresses` (
+    `address_id` VARCHAR(36) NOT NULL,
+    `user_id` VARCHAR(36) NOT NULL,
+    `recipient_name` VARCHAR(50) NOT NULL,
+    `postal_code` CHAR(8) NOT NULL,
+    `prefecture_code` INTEGER NOT NULL,
+    `city` VARCHAR(50) NOT NULL,
+    `address_line1` VARCHAR(100) NOT NULL,
+    `address_line2` VARCHAR(100) NOT NULL DEFAULT '',
+    `phone_number` VARCHAR(11) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updated_at` DATETIME(3) NULL,
+
+    INDEX `shipping_addresses_user_id_created_at_idx`(`user_id`, `created_at`),
+    PRIMARY KEY (`address_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+-- 都道府県ごとの配送料（税抜）。行のない都道府県には配送しない
+CREATE TABLE `shipping_rates` (
+    `prefecture_code` INTEGER NOT NULL,
+    `fee` DECIMAL(12, 2) NOT NULL,
+    `currency` CHAR(3) NOT NULL DEFAULT 'JPY',
+    `updated_at` DATETIME(3) NULL,
+
+    PRIMARY KEY (`prefecture_code`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+-- 配送料の表の設定。1行だけを持ち、配送料の表を置き換えるときは行ロックを取る
+CREATE TABLE `shipping_settings` (
+    `setting_id` INTEGER NOT NULL,
+    `free_shipping_threshold` DECIMAL(12, 2) NULL,
+    `currency` CHAR(3) NOT NULL DEFAULT 'JPY',
+    `updated_at` DATETIME(3) NULL,
+
+    PRIMARY KEY (`setting_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- InsertData
+INSERT INTO `shipping_settings` (`setting_id`, `free_shipping_threshold`, `currency`, `updated_at`) VALUES (1, NULL, 'JPY', CURRENT_TIMESTAMP(3));
+
+-- AddForeignKey
+ALTER TABLE `shipping_addresses` ADD CONSTRAINT `shipping_addresses_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users`(`user_id`) ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,37 @@
+-- AlterTable
+-- 商品に適用する消費税率の区分。standard は標準税率 10%、reduced は軽減税率 8%
+ALTER TABLE `items` ADD COLUMN `tax_rate` VARCHAR(20) NOT NULL DEFAULT 'standard';
+
+-- AlterTable
+-- 注文時点の消費税率の区分。既存の明細はすべて標準税率で注文したもの
+ALTER TABLE `order_lines` ADD COLUMN `tax_rate` VARCHAR(20) NOT NULL DEFAULT 'standard';
+
+-- CreateTable
+-- 発行済みの領収書（適格請求書）。1つの注文に1枚だけ発行し、発行時に作った HTML とテキストをそのまま保存する
+CREATE TABLE `receipts` (
+    `receipt_id` VARCHAR(36) NOT NULL,
+    `receipt_number` VARCHAR(32) NOT NULL,
+    `order_id` VARCHAR(36) NOT NULL,
+    `user_id` VARCHAR(36) NOT NULL,
+    `issuer_name` VARCHAR(100) NOT NULL,
+    `registration_number` CHAR(14) NOT NULL,
+    `total` DECIMAL(12, 2) NOT NULL,
+    `currency` CHAR(3) NOT NULL,
+    `html` MEDIUMTEXT NOT NULL,
+    `text` MEDIUMTEXT NOT NULL,
+    `issued_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+
+    UNIQUE INDEX `receipts_receipt_number_key`(`receipt_number`),
+    UNIQUE INDEX `receipts_order_id_key`(`order_id`),
+    INDEX `receipts_user_id_idx`(`user_id`),
+    PRIMARY KEY (`receipt_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `receipts` ADD CONSTRAINT `receipts_order_id_fkey` FOREIGN KEY (`order_id`) REFERENCES `orders`(`order_id`) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- CreateTrigger
+-- 発行済みの領収書は書き換え・削除させない
+CREATE TRIGGER `receipts_prevent_update` BEFORE UPDATE ON `receipts` FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'receipts are immutable';
+
+CREATE TRIGGER `receipts_prevent_delete` BEFORE DELETE ON `receipts` FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'receipts are immutable';
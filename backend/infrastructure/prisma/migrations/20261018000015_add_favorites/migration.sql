@@ -0,0 +1,21 @@
+-- AlterTable
+-- お気に入りに登録したユーザーの数。favorites の行の追加・削除と同じトランザクションで増減する
+ALTER TABLE `items` ADD COLUMN `favorite_count` INTEGER NOT NULL DEFAULT 0;
+
+-- CreateTable
+-- ユーザーがお気に入りに登録した商品。商品を論理削除しても行は残し、一覧では表示しない
+CREATE TABLE `favorites` (
+    `user_id` VARCHAR(36) NOT NULL,
+    `item_id` VARCHAR(36) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+
+    INDEX `favorites_user_id_created_at_idx`(`user_id`, `created_at`),
+    INDEX `favorites_item_id_idx`(`item_id`),
+    PRIMARY KEY (`user_id`, `item_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `favorites` ADD CONSTRAINT `favorites_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users`(`user_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `favorites` ADD CONSTRAINT `favorites_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,41 @@
+-- CreateTable
+-- 在庫切れの商品の再入荷通知の申し込み。再入荷したら通知を積んで行を削除する
+CREATE TABLE `stock_subscriptions` (
+    `user_id` VARCHAR(36) NOT NULL,
+    `item_id` VARCHAR(36) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+
+    INDEX `stock_subscriptions_user_id_created_at_idx`(`user_id`, `created_at`),
+    INDEX `stock_subscriptions_item_id_idx`(`item_id`),
+    PRIMARY KEY (`user_id`, `item_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+-- 再入荷時に積んだ通知。在庫の変更と同じトランザクションで作り、送信は別のジョブで行う
+CREATE TABLE `stock_notifications` (
+    `notification_id` VARCHAR(36) NOT NULL,
+    `user_id` VARCHAR(36) NOT NULL,
+    `item_id` VARCHAR(36) NOT NULL,
+    `status` VARCHAR(20) NOT NULL,
+    `attempts` INTEGER NOT NULL DEFAULT 0,
+    `last_error` VARCHAR(500) NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `sent_at` DATETIME(3) NULL,
+
+    INDEX `stock_notifications_status_created_at_idx`(`status`, `created_at`),
+    INDEX `stock_notifications_user_id_idx`(`user_id`),
+    INDEX `stock_notifications_item_id_idx`(`item_id`),
+    PRIMARY KEY (`notification_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `stock_subscriptions` ADD CONSTRAINT `stock_subscriptions_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users`(`user_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `stock_subscriptions` ADD CONSTRAINT `stock_subscriptions_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `stock_notifications` ADD CONSTRAINT `stock_notifications_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users`(`user_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `stock_notifications` ADD CONSTRAINT `stock_notifications_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,27 @@
+-- AlterTable
+-- レビューの件数と評価の合計。一覧のたびに reviews を集計しないよう、レビューの追加・編集と同じトランザクションで更新する
+ALTER TABLE `items` ADD COLUMN `rating_count` INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN `rating_total` INTEGER NOT NULL DEFAULT 0;
+
+-- CreateTable
+-- 商品のレビュー。1人のユーザーが書けるのは1商品につき1件
+CREATE TABLE `reviews` (
+    `review_id` VARCHAR(36) NOT NULL,
+    `user_id` VARCHAR(36) NOT NULL,
+    `item_id` VARCHAR(36) NOT NULL,
+    `rating` INTEGER NOT NULL,
+    `title` VARCHAR(100) NOT NULL,
+    `body` TEXT NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updated_at` DATETIME(3) NULL,
+
+    UNIQUE INDEX `reviews_user_id_item_id_key`(`user_id`, `item_id`),
+    INDEX `reviews_item_id_created_at_idx`(`item_id`, `created_at`),
+    PRIMARY KEY (`review_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `reviews` ADD CONSTRAINT `reviews_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users`(`user_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `reviews` ADD CONSTRAINT `reviews_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE CASCADE ON UPDATE CASCADE;
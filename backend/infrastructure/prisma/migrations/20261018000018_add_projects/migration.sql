@@ -0,0 +1,39 @@
+-- CreateTable
+-- ユーザーが編んでいる作品。item_id は使っている商品で、商品と結び付けない作品では NULL
+CREATE TABLE `projects` (
+    `project_id` VARCHAR(36) NOT NULL,
+    `user_id` VARCHAR(36) NOT NULL,
+    `item_id` VARCHAR(36) NULL,
+    `name` VARCHAR(100) NOT NULL,
+    `progress_percentage` INTEGER NOT NULL DEFAULT 0,
+    `row_count` INTEGER NOT NULL DEFAULT 0,
+    `notes` TEXT NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updated_at` DATETIME(3) NULL,
+
+    INDEX `projects_user_id_updated_at_idx`(`user_id`, `updated_at`),
+    INDEX `projects_item_id_idx`(`item_id`),
+    PRIMARY KEY (`project_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+-- 作品の進捗率と段数の履歴。作成時と、進捗率か段数が変わるたびに追加する
+CREATE TABLE `project_snapshots` (
+    `snapshot_id` VARCHAR(36) NOT NULL,
+    `project_id` VARCHAR(36) NOT NULL,
+    `progress_percentage` INTEGER NOT NULL,
+    `row_count` INTEGER NOT NULL,
+    `recorded_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+
+    INDEX `project_snapshots_project_id_recorded_at_idx`(`project_id`, `recorded_at`),
+    PRIMARY KEY (`snapshot_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `projects` ADD CONSTRAINT `projects_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users`(`user_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `projects` ADD CONSTRAINT `projects_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `project_snapshots` ADD CONSTRAINT `project_snapshots_project_id_fkey` FOREIGN KEY (`project_id`) REFERENCES `projects`(`project_id`) ON DELETE CASCADE ON UPDATE CASCADE;
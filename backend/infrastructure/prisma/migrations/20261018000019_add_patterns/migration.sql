@@ -0,0 +1,74 @@
+-- CreateTable
+-- 毛糸の商品の太さと1玉の長さ（m）。毛糸でない商品には行を作らない
+CREATE TABLE `item_yarns` (
+    `item_id` VARCHAR(36) NOT NULL,
+    `weight` VARCHAR(20) NOT NULL,
+    `yardage_per_skein` INTEGER NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updated_at` DATETIME(3) NULL,
+
+    PRIMARY KEY (`item_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+-- 販売している編み図。ゲージは10cm四方の目数と段数、needle_sizes は針の太さ（mm）を ";" で区切って細い順に並べたもの
+CREATE TABLE `patterns` (
+    `pattern_id` VARCHAR(36) NOT NULL,
+    `name` VARCHAR(100) NOT NULL,
+    `description` TEXT NOT NULL,
+    `difficulty` VARCHAR(20) NOT NULL,
+    `gauge_stitches` DECIMAL(3, 1) NOT NULL,
+    `gauge_rows` DECIMAL(3, 1) NOT NULL,
+    `needle_sizes` VARCHAR(100) NOT NULL,
+    `yarn_weight` VARCHAR(20) NOT NULL,
+    `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    `updated_at` DATETIME(3) NULL,
+
+    INDEX `patterns_name_idx`(`name`),
+    PRIMARY KEY (`pattern_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+-- 編み図の仕上がりサイズ。yardage はそのサイズを編むのに必要な糸の長さ（m）で、position は登録順
+CREATE TABLE `pattern_sizes` (
+    `pattern_id` VARCHAR(36) NOT NULL,
+    `position` INTEGER NOT NULL,
+    `label` VARCHAR(20) NOT NULL,
+    `finished_measurements` VARCHAR(100) NOT NULL DEFAULT '',
+    `yardage` INTEGER NOT NULL,
+
+    UNIQUE INDEX `pattern_sizes_pattern_id_label_key`(`pattern_id`, `label`),
+    PRIMARY KEY (`pattern_id`, `position`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- CreateTable
+-- 編み図を編むのに使える商品
+CREATE TABLE `pattern_items` (
+    `pattern_id` VARCHAR(36) NOT NULL,
+    `item_id` VARCHAR(36) NOT NULL,
+
+    INDEX `pattern_items_item_id_idx`(`item_id`),
+    PRIMARY KEY (`pattern_id`, `item_id`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AlterTable
+-- 作品で使っている編み図。編み図を削除すると NULL になる
+ALTER TABLE `projects` ADD COLUMN `pattern_id` VARCHAR(36) NULL;
+
+-- CreateIndex
+CREATE INDEX `projects_pattern_id_idx` ON `projects`(`pattern_id`);
+
+-- AddForeignKey
+ALTER TABLE `item_yarns` ADD CONSTRAINT `item_yarns_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `pattern_sizes` ADD CONSTRAINT `pattern_sizes_pattern_id_fkey` FOREIGN KEY (`pattern_id`) REFERENCES `patterns`(`pattern_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `pattern_items` ADD CONSTRAINT `pattern_items_pattern_id_fkey` FOREIGN KEY (`pattern_id`) REFERENCES `patterns`(`pattern_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `pattern_items` ADD CONSTRAINT `pattern_items_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `items`(`item_id`) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE `projects` ADD CONSTRAINT `projects_pattern_id_fkey` FOREIGN KEY (`pattern_id`) REFERENCES `patterns`(`pattern_id`) ON DELETE SET NULL ON UPDATE CASCADE;
@@ -0,0 +1,24 @@
+-- AlterTable
+-- 1玉の重さ（g）、合う針の太さの範囲（mm）、推奨ゲージ（10cm四方の目数と段数）。いずれも未登録なら NULL
+ALTER TABLE `item_yarns` ADD COLUMN `grams_per_skein` INTEGER NULL,
+    ADD COLUMN `needle_min_mm` DECIMAL(4, 2) NULL,
+    ADD COLUMN `needle_max_mm` DECIMAL(4, 2) NULL,
+    ADD COLUMN `gauge_stitches` DECIMAL(3, 1) NULL,
+    ADD COLUMN `gauge_rows` DECIMAL(3, 1) NULL;
+
+-- CreateIndex
+CREATE INDEX `item_yarns_weight_idx` ON `item_yarns`(`weight`);
+
+-- CreateTable
+-- 毛糸の素材の混率（%）。商品ごとの合計は100になる
+CREATE TABLE `item_yarn_fibers` (
+    `item_id` VARCHAR(36) NOT NULL,
+    `fiber` VARCHAR(20) NOT NULL,
+    `percentage` INTEGER NOT NULL,
+
+    INDEX `item_yarn_fibers_fiber_idx`(`fiber`),
+    PRIMARY KEY (`item_id`, `fiber`)
+) DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+-- AddForeignKey
+ALTER TABLE `item_yarn_fibers` ADD CONSTRAINT `item_yarn_fibers_item_id_fkey` FOREIGN KEY (`item_id`) REFERENCES `item_yarns`(`item_id`) ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,13 @@
+-- AlterTable
+-- バリエーションの在庫移動も台帳に記録する。商品の在庫の移動では空文字列
+ALTER TABLE `stock_movements` ADD COLUMN `variant_id` VARCHAR(36) NOT NULL DEFAULT '';
+
+-- CreateIndex
+CREATE INDEX `stock_movements_variant_id_created_at_idx` ON `stock_movements`(`variant_id`, `created_at`);
+
+-- 既存のバリエーションの在庫数を期首残高の調整として台帳に記録し、実在庫数と台帳の合計を一致させる
+INSERT INTO `stock_movements` (`movement_id`, `item_id`, `variant_id`, `movement_type`, `quantity_delta`, `reason`, `user_id`, `created_at`)
+SELECT UUID(), `item_variants`.`item_id`, `item_variants`.`variant_id`, 'adjustment', `item_variants`.`on_hand_quantity`, 'opening balance', `items`.`user_id`, CURRENT_TIMESTAMP(3)
+FROM `item_variants`
+JOIN `items` ON `items`.`item_id` = `item_variants`.`item_id`
+WHERE `item_variants`.`on_hand_quantity` > 0;
@@ -0,0 +1,166 @@
+package receipt
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+//go:embed templates
+var templates embed.FS
+
+var (
+	htmlTemplate = htmltemplate.Must(htmltemplate.ParseFS(templates, "templates/receipt.html.tmpl"))
+	textTemplate = texttemplate.Must(texttemplate.ParseFS(templates, "templates/receipt.txt.tmpl"))
+)
+
+// jst は領収書に記載する日付のタイムゾーン。tzdata のないコンテナでも動くよう固定のオフセットで持つ
+var jst = time.FixedZone("JST", 9*60*60)
+
+// Renderer はテンプレートで領収書を描画する。usecase.ReceiptRenderer として注入する
+type Renderer struct{}
+
+func NewRenderer() *Renderer {
+	return &Renderer{}
+}
+
+func (Renderer) RenderHTML(content *domain.ReceiptContent) (string, error) {
+	return RenderHTML(content)
+}
+
+func (Renderer) RenderText(content *domain.ReceiptContent) (string, error) {
+	return RenderText(content)
+}
+
+// RenderHTML は領収書の記載事項を HTML にする
+func RenderHTML(content *domain.ReceiptContent) (string, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, newReceiptView(content)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// RenderText は領収書の記載事項をプレーンテキストにする
+func RenderText(content *domain.ReceiptContent) (string, error) {
+	var buf bytes.Buffer
+	if err := textTemplate.Execute(&buf, newReceiptView(content)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// receiptView はテンプレートに渡す表示用の値。金額は通貨記号と3桁区切りを付けた文字列にしておく
+type receiptView struct {
+	ReceiptNumber      string
+	IssuedOn           string
+	RecipientName      string
+	IssuerName         string
+	RegistrationNumber string
+	OrderId            string
+	PurchasedOn        string
+	Lines              []receiptLineView
+	Subtotal           string
+	Discount           string
+	CouponCode         string
+	Rates              []receiptRateView
+	Tax                string
+	Total              string
+	HasReduced         bool
+}
+
+// receiptLineView の Reduced は軽減税率の対象の明細で、品名に「※」を付けて示す
+type receiptLineView struct {
+	ItemName  string
+	Detail    string
+	UnitPrice string
+	Quantity  int
+	Amount    string
+	TaxRate   string
+	Reduced   bool
+}
+
+// receiptRateView は税率ごとの合計。Taxable は割引後の税抜の合計
+type receiptRateView struct {
+	TaxRate string
+	Taxable string
+	Tax     string
+}
+
+func newReceiptView(content *domain.ReceiptContent) receiptView {
+	order := content.Order()
+	issuer := content.Issuer()
+	view := receiptView{
+		ReceiptNumber:      content.ReceiptNumber(),
+		IssuedOn:           formatDate(content.IssuedAt()),
+		RecipientName:      content.RecipientName(),
+		IssuerName:         issuer.Name(),
+		RegistrationNumber: issuer.RegistrationNumber(),
+		OrderId:            order.OrderId(),
+		PurchasedOn:        formatDate(order.CreatedAt()),
+		Subtotal:           order.Subtotal().Display(),
+		Tax:                order.Tax().Display(),
+		Total:              order.Total().Display(),
+	}
+	if !order.Discount().Amount().IsZero() {
+		view.Discount = order.Discount().Display()
+		view.CouponCode = order.CouponCode()
+	}
+	for _, line := range order.Lines() {
+		view.Lines = append(view.Lines, receiptLineView{
+			ItemName:  line.ItemName(),
+			Detail:    lineDetail(line),
+			UnitPrice: line.UnitPrice().Display(),
+			Quantity:  line.Quantity(),
+			Amount:    line.LineTotal().Display(),
+			TaxRate:   taxRateLabel(line.TaxRate()),
+			Reduced:   line.TaxRate().IsReduced(),
+		})
+		view.HasReduced = view.HasReduced || line.TaxRate().IsReduced()
+	}
+	for _, total := range content.TaxBreakdown().Totals() {
+		view.Rates = append(view.Rates, receiptRateView{
+			TaxRate: taxRateLabel(total.Rate()),
+			Taxable: total.Taxable().Display(),
+			Tax:     total.Tax().Display(),
+		})
+	}
+	return view
+}
+
+// lineDetail は SKU とバリエーションのオプションを "SOCK-L / size: L" のようにまとめる
+func lineDetail(line domain.OrderLine) string {
+	var parts []string
+	if line.Sku() != "" {
+		parts = append(parts, line.Sku())
+	}
+	options := line.Options()
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		parts = append(parts, name+": "+options[name])
+	}
+	return strings.Join(parts, " / ")
+}
+
+func taxRateLabel(rate domain.TaxRate) string {
+	label := fmt.Sprintf("%d%%", rate.Percent())
+	if rate.IsReduced() {
+		return "軽減 " + label
+	}
+	return label
+}
+
+func formatDate(t time.Time) string {
+	return t.In(jst).Format("2006年1月2日")
+}
@@ -0,0 +1,73 @@
+package receipt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestReceiptContent は標準税率と軽減税率の明細を含む支払い済みの注文の記載事項を作る
+// 割引後の消費税額と合計は NewReceiptContent が税率ごとの内訳と照合するので、呼び出し側で計算して渡す
+func newTestReceiptContent(t *testing.T, discount string, couponCode string, tax string, total string) *domain.ReceiptContent {
+	t.Helper()
+	yarnPrice, _ := domain.NewMoneyFromString("1000", domain.CurrencyJPY)
+	teaPrice, _ := domain.NewMoneyFromString("540", domain.CurrencyJPY)
+	lines := []domain.OrderLine{
+		*domain.RestoreOrderLine(uuid.NewString(), uuid.NewString(), "variant-1", "Merino <Wool>", "WOOL-RED", map[string]string{"weight": "fingering", "color": "red"}, *yarnPrice, domain.TaxRateStandard, 2),
+		*domain.RestoreOrderLine(uuid.NewString(), uuid.NewString(), "", "Herbal tea", "", nil, *teaPrice, domain.TaxRateReduced, 1),
+	}
+	userId, _ := domain.NewUserId(uuid.NewString())
+	order, err := domain.NewOrder(*userId, lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	discounted, _ := domain.NewMoneyFromString(discount, domain.CurrencyJPY)
+	taxMoney, _ := domain.NewMoneyFromString(tax, domain.CurrencyJPY)
+	totalMoney, _ := domain.NewMoneyFromString(total, domain.CurrencyJPY)
+	purchasedAt := time.Date(2026, 10, 17, 16, 30, 0, 0, time.UTC)
+	paid := domain.RestoreOrder(order.OrderId(), *userId, domain.OrderStatusPaid, order.Lines(), *order.Subtotal(), *discounted, *taxMoney, *totalMoney, couponCode, order.Transitions(), purchasedAt, purchasedAt)
+
+	number, _ := domain.NewInvoiceRegistrationNumber("T7000012050002")
+	issuer, _ := domain.NewReceiptIssuer("Posiposi Yarn", *number)
+	content, err := domain.NewReceiptContent(paid, *issuer, "Hanako", time.Date(2026, 10, 18, 1, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+func TestRenderHTML(t *testing.T) {
+	html, err := RenderHTML(newTestReceiptContent(t, "0", "", "243", "2783"))
+
+	assert.NoError(t, err)
+	assert.Contains(t, html, "Hanako 様")
+	assert.Contains(t, html, "登録番号: T7000012050002")
+	// 日付は日本時間で記載する
+	assert.Contains(t, html, "取引日: 2026年10月18日")
+	assert.Contains(t, html, "Merino &lt;Wool&gt;")
+	assert.Contains(t, html, "WOOL-RED / color: red / weight: fingering")
+	assert.Contains(t, html, "Herbal tea ※")
+	assert.Contains(t, html, "10%対象 ¥2,000（税抜）</th><td class=\"amount\">消費税 ¥200")
+	assert.Contains(t, html, "軽減 8%対象 ¥540（税抜）</th><td class=\"amount\">消費税 ¥43")
+	assert.Contains(t, html, "¥2,783")
+	assert.Contains(t, html, "※は軽減税率（8%）対象商品です。")
+	assert.NotContains(t, html, "割引")
+}
+
+func TestRenderText(t *testing.T) {
+	text, err := RenderText(newTestReceiptContent(t, "254", "WELCOME", "218", "2504"))
+
+	assert.NoError(t, err)
+	assert.Contains(t, text, "登録番号: T7000012050002")
+	assert.Contains(t, text, "Merino <Wool> (WOOL-RED / color: red / weight: fingering)\n  ¥1,000 x 2 = ¥2,000 [10%]")
+	assert.Contains(t, text, "Herbal tea ※\n  ¥540 x 1 = ¥540 [軽減 8%]")
+	assert.Contains(t, text, "割引（税抜）: -¥254 クーポン WELCOME")
+	// 254 × 2000 / 2540 = 200 円を標準税率に、残りの 54 円を軽減税率に按分する
+	assert.Contains(t, text, "10%対象: ¥1,800（税抜） 消費税: ¥180")
+	assert.Contains(t, text, "軽減 8%対象: ¥486（税抜） 消費税: ¥38")
+	assert.Contains(t, text, "消費税合計: ¥218")
+	assert.Contains(t, text, "合計（税込）: ¥2,504")
+}
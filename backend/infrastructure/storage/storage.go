@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+)
+
+// Storage は商品画像などのファイルを保存し、公開 URL を返す
+// キーは "items/<商品ID>/<ファイル名>" のようなスラッシュ区切りの相対パス
+type Storage interface {
+	Put(key string, body []byte, contentType string) (string, error)
+	Delete(key string) error
+}
+
+// NewStorageFromEnv は STORAGE_DRIVER に応じたストレージを返す。未設定の場合はローカルファイルシステムを使う
+func NewStorageFromEnv() (Storage, error) {
+	switch driver := os.Getenv("STORAGE_DRIVER"); driver {
+	case "", "local":
+		return NewLocalStorage(
+			envOrDefault("STORAGE_LOCAL_DIR", "uploads"),
+			envOrDefault("STORAGE_PUBLIC_URL", "https://localhost:8080/uploads"),
+		), nil
+	case "s3":
+		return NewS3Storage(S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          envOrDefault("S3_REGION", "us-east-1"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyId:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			PublicURL:       os.Getenv("STORAGE_PUBLIC_URL"),
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", driver)
+	}
+}
+
+func envOrDefault(name string, defaultValue string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// validateKey は保存先の外に出るキーや絶対パスを拒否する
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") || path.Clean(key) != key || strings.HasPrefix(key, "../") || key == ".." {
+		return fmt.Errorf("invalid storage key: %q", key)
+	}
+	return nil
+}
+
+func joinURL(baseURL string, key string) string {
+	return strings.TrimRight(baseURL, "/") + "/" + key
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// Cart の UserId はゲストのカートでは NULL になる
+type Cart struct {
+	CartId    string     `json:"cartId" gorm:"primaryKey"`
+	UserId    *string    `json:"userId" gorm:"size:36;uniqueIndex:carts_user_id_key"`
+	CreatedAt time.Time  `json:"createdAt" gorm:"not null"`
+	UpdatedAt time.Time  `json:"updatedAt" gorm:"index:carts_updated_at_idx"`
+	Items     []CartItem `gorm:"foreignKey:CartId;references:CartId"`
+}
+
+// CartItem の VariantId はバリエーションのない商品では空文字列。NULL にしないことで一意制約を効かせている
+type CartItem struct {
+	CartItemId string    `json:"cartItemId" gorm:"primaryKey"`
+	CartId     string    `json:"cartId" gorm:"size:36;not null;uniqueIndex:cart_items_cart_id_item_id_variant_id_key,priority:1"`
+	ItemId     string    `json:"itemId" gorm:"size:36;not null;uniqueIndex:cart_items_cart_id_item_id_variant_id_key,priority:2"`
+	VariantId  string    `json:"variantId" gorm:"size:36;not null;default:'';uniqueIndex:cart_items_cart_id_item_id_variant_id_key,priority:3"`
+	Quantity   int       `json:"quantity" gorm:"not null"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"not null"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
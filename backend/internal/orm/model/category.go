@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+)
+
+type Category struct {
+	CategoryId string    `json:"categoryId" gorm:"primaryKey"`
+	ParentId   *string   `json:"parentId" gorm:"size:36;index"`
+	Name       string    `json:"name" gorm:"size:64;not null"`
+	Slug       string    `json:"slug" gorm:"size:64;not null;uniqueIndex:categories_slug_key"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"not null"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Coupon の PercentOff は割合の割引、AmountOff は定額の割引でだけ値を持つ。上限の 0 は無制限を表す
+type Coupon struct {
+	CouponId     string           `json:"couponId" gorm:"primaryKey"`
+	Code         string           `json:"code" gorm:"size:32;not null;uniqueIndex:coupons_code_key"`
+	DiscountType string           `json:"discountType" gorm:"size:10;not null"`
+	PercentOff   *int             `json:"percentOff"`
+	AmountOff    *decimal.Decimal `json:"amountOff" gorm:"type:decimal(12,2)"`
+	MinimumSpend *decimal.Decimal `json:"minimumSpend" gorm:"type:decimal(12,2)"`
+	Currency     string           `json:"currency" gorm:"size:3;not null;default:JPY"`
+	StartsAt     *time.Time       `json:"startsAt"`
+	EndsAt       *time.Time       `json:"endsAt"`
+	UsageLimit   int              `json:"usageLimit" gorm:"not null;default:0"`
+	PerUserLimit int              `json:"perUserLimit" gorm:"not null;default:0"`
+	UsedCount    int              `json:"usedCount" gorm:"not null;default:0"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	UpdatedAt    time.Time        `json:"updatedAt"`
+	Items        []CouponItem     `gorm:"foreignKey:CouponId;references:CouponId"`
+}
+
+type CouponItem struct {
+	CouponId string `json:"couponId" gorm:"primaryKey;size:36"`
+	ItemId   string `json:"itemId" gorm:"primaryKey;size:36;index"`
+}
+
+type CouponRedemption struct {
+	RedemptionId string    `json:"redemptionId" gorm:"primaryKey"`
+	CouponId     string    `json:"couponId" gorm:"size:36;not null;index:coupon_redemptions_coupon_id_user_id_idx,priority:1"`
+	UserId       string    `json:"userId" gorm:"size:36;not null;index:coupon_redemptions_coupon_id_user_id_idx,priority:2"`
+	OrderId      string    `json:"orderId" gorm:"size:36;not null;uniqueIndex"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"not null"`
+}
@@ -0,0 +1,9 @@
+package model
+
+import "time"
+
+type Favorite struct {
+	UserId    string    `json:"userId" gorm:"primaryKey;size:36;index:favorites_user_id_created_at_idx,priority:1"`
+	ItemId    string    `json:"itemId" gorm:"primaryKey;size:36;index"`
+	CreatedAt time.Time `json:"createdAt" gorm:"index:favorites_user_id_created_at_idx,priority:2"`
+}
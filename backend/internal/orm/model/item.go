@@ -3,17 +3,32 @@ package model
 import (
 	"time"
 
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
 type Item struct {
-	ItemId      string         `json:"itemId" gorm:"primaryKey"`
-	UserId      string         `json:"userId" gorm:"size:36;not null"`
-	ItemName    string         `json:"itemName" gorm:"not null"`
-	Stock       bool           `json:"stock" gorm:"not null;default:true"`
-	Description string         `json:"description"`
-	CreatedAt   time.Time      `json:"createdAt" gorm:"not null"`
-	UpdatedAt   time.Time      `json:"updatedAt"`
-	DeletedAt   gorm.DeletedAt `json:"deletedAt" gorm:"index"`
-	User        User
+	ItemId            string          `json:"itemId" gorm:"primaryKey"`
+	UserId            string          `json:"userId" gorm:"size:36;not null"`
+	ItemName          string          `json:"itemName" gorm:"not null"`
+	OnHandQuantity    int             `json:"onHandQuantity" gorm:"not null;default:0"`
+	ReservedQuantity  int             `json:"reservedQuantity" gorm:"not null;default:0"`
+	LowStockThreshold int             `json:"lowStockThreshold" gorm:"not null;default:0"`
+	Description       string          `json:"description"`
+	Price             decimal.Decimal `json:"price" gorm:"type:decimal(12,2);not null;default:0"`
+	PriceCurrency     string          `json:"priceCurrency" gorm:"size:3;not null;default:JPY"`
+	TaxRate           string          `json:"taxRate" gorm:"size:20;not null;default:standard"`
+	FavoriteCount     int             `json:"favoriteCount" gorm:"not null;default:0"`
+	RatingCount       int             `json:"ratingCount" gorm:"not null;default:0"`
+	RatingTotal       int             `json:"ratingTotal" gorm:"not null;default:0"`
+	CategoryId        *string         `json:"categoryId" gorm:"size:36;index"`
+	CreatedAt         time.Time       `json:"createdAt" gorm:"not null"`
+	UpdatedAt         time.Time       `json:"updatedAt"`
+	DeletedAt         gorm.DeletedAt  `json:"deletedAt" gorm:"index"`
+	User              User
+	Variants          []ItemVariant `gorm:"foreignKey:ItemId;references:ItemId"`
+	Category          *Category     `gorm:"foreignKey:CategoryId;references:CategoryId"`
+	Tags              []Tag         `gorm:"many2many:item_tags;foreignKey:ItemId;joinForeignKey:ItemId;references:TagId;joinReferences:TagId"`
+	Images            []ItemImage   `gorm:"foreignKey:ItemId;references:ItemId"`
+	Yarn              *ItemYarn     `gorm:"foreignKey:ItemId;references:ItemId"`
 }
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+type ItemImage struct {
+	ImageId      string    `json:"imageId" gorm:"primaryKey"`
+	ItemId       string    `json:"itemId" gorm:"size:36;not null;index:item_images_item_id_position_idx,priority:1"`
+	Position     int       `json:"position" gorm:"not null;index:item_images_item_id_position_idx,priority:2"`
+	ContentType  string    `json:"contentType" gorm:"size:32;not null"`
+	Width        int       `json:"width" gorm:"not null"`
+	Height       int       `json:"height" gorm:"not null"`
+	StorageKey   string    `json:"storageKey" gorm:"size:255;not null"`
+	Url          string    `json:"url" gorm:"size:1024;not null"`
+	ThumbnailKey string    `json:"thumbnailKey" gorm:"size:255;not null"`
+	ThumbnailUrl string    `json:"thumbnailUrl" gorm:"size:1024;not null"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"not null"`
+}
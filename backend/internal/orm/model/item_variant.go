@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type ItemVariant struct {
+	VariantId             string              `json:"variantId" gorm:"primaryKey"`
+	ItemId                string              `json:"itemId" gorm:"size:36;not null;uniqueIndex:item_variants_item_id_option_key_key,priority:1"`
+	Sku                   string              `json:"sku" gorm:"size:64;not null;uniqueIndex:item_variants_sku_key"`
+	Options               string              `json:"options" gorm:"type:json;not null"`
+	OptionKey             string              `json:"optionKey" gorm:"size:255;not null;uniqueIndex:item_variants_item_id_option_key_key,priority:2"`
+	OnHandQuantity        int                 `json:"onHandQuantity" gorm:"not null;default:0"`
+	ReservedQuantity      int                 `json:"reservedQuantity" gorm:"not null;default:0"`
+	LowStockThreshold     int                 `json:"lowStockThreshold" gorm:"not null;default:0"`
+	PriceOverride         decimal.NullDecimal `json:"priceOverride" gorm:"type:decimal(12,2)"`
+	PriceOverrideCurrency *string             `json:"priceOverrideCurrency" gorm:"size:3"`
+	CreatedAt             time.Time           `json:"createdAt" gorm:"not null"`
+	UpdatedAt             time.Time           `json:"updatedAt"`
+}
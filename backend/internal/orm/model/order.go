@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Order の CouponCode はクーポンを使っていない注文では NULL
+type Order struct {
+	OrderId     string            `json:"orderId" gorm:"primaryKey"`
+	UserId      string            `json:"userId" gorm:"size:36;not null;index:orders_user_id_created_at_idx,priority:1"`
+	Status      string            `json:"status" gorm:"size:20;not null"`
+	Subtotal    decimal.Decimal   `json:"subtotal" gorm:"type:decimal(12,2);not null"`
+	Discount    decimal.Decimal   `json:"discount" gorm:"type:decimal(12,2);not null;default:0"`
+	Tax         decimal.Decimal   `json:"tax" gorm:"type:decimal(12,2);not null"`
+	Total       decimal.Decimal   `json:"total" gorm:"type:decimal(12,2);not null"`
+	Currency    string            `json:"currency" gorm:"size:3;not null"`
+	CouponCode  *string           `json:"couponCode" gorm:"size:32"`
+	CreatedAt   time.Time         `json:"createdAt" gorm:"not null;index:orders_user_id_created_at_idx,priority:2"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+	Lines       []OrderLine       `gorm:"foreignKey:OrderId;references:OrderId"`
+	Transitions []OrderTransition `gorm:"foreignKey:OrderId;references:OrderId"`
+}
+
+// OrderLine の商品名・SKU・オプション・単価・税率は注文時点の値。VariantId はバリエーションのない商品では空文字列
+type OrderLine struct {
+	OrderLineId string          `json:"orderLineId" gorm:"primaryKey"`
+	OrderId     string          `json:"orderId" gorm:"size:36;not null;index:order_lines_order_id_position_idx,priority:1"`
+	ItemId      string          `json:"itemId" gorm:"size:36;not null;index"`
+	VariantId   string          `json:"variantId" gorm:"size:36;not null;default:''"`
+	ItemName    string          `json:"itemName" gorm:"not null"`
+	Sku         string          `json:"sku" gorm:"size:64;not null;default:''"`
+	Options     string          `json:"options" gorm:"type:json;not null"`
+	UnitPrice   decimal.Decimal `json:"unitPrice" gorm:"type:decimal(12,2);not null"`
+	TaxRate     string          `json:"taxRate" gorm:"size:20;not null;default:standard"`
+	Quantity    int             `json:"quantity" gorm:"not null"`
+	Position    int             `json:"position" gorm:"not null;index:order_lines_order_id_position_idx,priority:2"`
+}
+
+// OrderTransition の FromStatus は注文の作成時の行では NULL になる
+type OrderTransition struct {
+	TransitionId string    `json:"transitionId" gorm:"primaryKey"`
+	OrderId      string    `json:"orderId" gorm:"size:36;not null;index:order_transitions_order_id_created_at_idx,priority:1"`
+	FromStatus   *string   `json:"fromStatus" gorm:"size:20"`
+	ToStatus     string    `json:"toStatus" gorm:"size:20;not null"`
+	UserId       string    `json:"userId" gorm:"size:36;not null"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"not null;index:order_transitions_order_id_created_at_idx,priority:2"`
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// Pattern の NeedleSizes は針の太さ（mm）を ";" で区切って細い順に並べたもの
+type Pattern struct {
+	PatternId     string        `json:"patternId" gorm:"primaryKey"`
+	Name          string        `json:"name" gorm:"size:100;not null;index"`
+	Description   string        `json:"description" gorm:"type:text;not null"`
+	Difficulty    string        `json:"difficulty" gorm:"size:20;not null"`
+	GaugeStitches float64       `json:"gaugeStitches" gorm:"type:decimal(3,1);not null"`
+	GaugeRows     float64       `json:"gaugeRows" gorm:"type:decimal(3,1);not null"`
+	NeedleSizes   string        `json:"needleSizes" gorm:"size:100;not null"`
+	YarnWeight    string        `json:"yarnWeight" gorm:"size:20;not null"`
+	CreatedAt     time.Time     `json:"createdAt" gorm:"not null"`
+	UpdatedAt     time.Time     `json:"updatedAt"`
+	Sizes         []PatternSize `gorm:"foreignKey:PatternId;references:PatternId"`
+	Items         []PatternItem `gorm:"foreignKey:PatternId;references:PatternId"`
+}
+
+// PatternSize の Yardage はそのサイズを編むのに必要な糸の長さ（m）
+type PatternSize struct {
+	PatternId            string `json:"patternId" gorm:"primaryKey;size:36;uniqueIndex:pattern_sizes_pattern_id_label_key,priority:1"`
+	Position             int    `json:"position" gorm:"primaryKey"`
+	Label                string `json:"label" gorm:"size:20;not null;uniqueIndex:pattern_sizes_pattern_id_label_key,priority:2"`
+	FinishedMeasurements string `json:"finishedMeasurements" gorm:"size:100;not null;default:''"`
+	Yardage              int    `json:"yardage" gorm:"not null"`
+}
+
+type PatternItem struct {
+	PatternId string `json:"patternId" gorm:"primaryKey;size:36"`
+	ItemId    string `json:"itemId" gorm:"primaryKey;size:36;index"`
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Payment の ProviderPaymentId はオーソリを依頼する前は NULL
+type Payment struct {
+	PaymentId         string          `json:"paymentId" gorm:"primaryKey"`
+	OrderId           string          `json:"orderId" gorm:"size:36;not null;index:payments_order_id_created_at_idx,priority:1"`
+	ProviderPaymentId *string         `json:"providerPaymentId" gorm:"size:191;uniqueIndex"`
+	Status            string          `json:"status" gorm:"size:20;not null"`
+	Amount            decimal.Decimal `json:"amount" gorm:"type:decimal(12,2);not null"`
+	Currency          string          `json:"currency" gorm:"size:3;not null"`
+	CreatedAt         time.Time       `json:"createdAt" gorm:"not null;index:payments_order_id_created_at_idx,priority:2"`
+	UpdatedAt         time.Time       `json:"updatedAt"`
+}
+
+// PaymentEvent は処理済みの Webhook イベント。EventId の一意制約で二重の反映を防ぐ
+type PaymentEvent struct {
+	EventId   string    `json:"eventId" gorm:"primaryKey;size:191"`
+	PaymentId string    `json:"paymentId" gorm:"size:36;not null;index"`
+	Type      string    `json:"type" gorm:"size:64;not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"not null"`
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// Project の ItemId・PatternId は商品・編み図と結び付けない作品では NULL
+type Project struct {
+	ProjectId          string    `json:"projectId" gorm:"primaryKey"`
+	UserId             string    `json:"userId" gorm:"size:36;not null;index:projects_user_id_updated_at_idx,priority:1"`
+	ItemId             *string   `json:"itemId" gorm:"size:36;index"`
+	PatternId          *string   `json:"patternId" gorm:"size:36;index"`
+	Name               string    `json:"name" gorm:"size:100;not null"`
+	ProgressPercentage int       `json:"progressPercentage" gorm:"not null;default:0"`
+	RowCount           int       `json:"rowCount" gorm:"not null;default:0"`
+	Notes              string    `json:"notes" gorm:"type:text;not null"`
+	CreatedAt          time.Time `json:"createdAt" gorm:"not null"`
+	UpdatedAt          time.Time `json:"updatedAt" gorm:"index:projects_user_id_updated_at_idx,priority:2"`
+}
+
+type ProjectSnapshot struct {
+	SnapshotId         string    `json:"snapshotId" gorm:"primaryKey"`
+	ProjectId          string    `json:"projectId" gorm:"size:36;not null;index:project_snapshots_project_id_recorded_at_idx,priority:1"`
+	ProgressPercentage int       `json:"progressPercentage" gorm:"not null"`
+	RowCount           int       `json:"rowCount" gorm:"not null"`
+	RecordedAt         time.Time `json:"recordedAt" gorm:"not null;index:project_snapshots_project_id_recorded_at_idx,priority:2"`
+}
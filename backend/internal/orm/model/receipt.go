@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Receipt は発行済みの領収書。Html と Text は発行時に作った内容で、テーブルのトリガーが更新・削除を拒否する
+type Receipt struct {
+	ReceiptId          string          `json:"receiptId" gorm:"primaryKey"`
+	ReceiptNumber      string          `json:"receiptNumber" gorm:"size:32;not null;uniqueIndex"`
+	OrderId            string          `json:"orderId" gorm:"size:36;not null;uniqueIndex"`
+	UserId             string          `json:"userId" gorm:"size:36;not null;index"`
+	IssuerName         string          `json:"issuerName" gorm:"size:100;not null"`
+	RegistrationNumber string          `json:"registrationNumber" gorm:"size:14;not null"`
+	Total              decimal.Decimal `json:"total" gorm:"type:decimal(12,2);not null"`
+	Currency           string          `json:"currency" gorm:"size:3;not null"`
+	Html               string          `json:"html" gorm:"type:mediumtext;not null"`
+	Text               string          `json:"text" gorm:"type:mediumtext;not null"`
+	IssuedAt           time.Time       `json:"issuedAt" gorm:"not null"`
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// Review の User は一覧に書いたユーザーの名前を表示するために読み込む
+type Review struct {
+	ReviewId  string    `json:"reviewId" gorm:"primaryKey"`
+	UserId    string    `json:"userId" gorm:"size:36;not null;uniqueIndex:reviews_user_id_item_id_key,priority:1"`
+	ItemId    string    `json:"itemId" gorm:"size:36;not null;uniqueIndex:reviews_user_id_item_id_key,priority:2;index:reviews_item_id_created_at_idx,priority:1"`
+	Rating    int       `json:"rating" gorm:"not null"`
+	Title     string    `json:"title" gorm:"size:100;not null"`
+	Body      string    `json:"body" gorm:"type:text;not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"not null;index:reviews_item_id_created_at_idx,priority:2"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	User      User      `gorm:"foreignKey:UserId;references:Id"`
+}
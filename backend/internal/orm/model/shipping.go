@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ShippingAddress の PrefectureCode は JIS X 0401 の都道府県コード
+type ShippingAddress struct {
+	AddressId      string    `json:"addressId" gorm:"primaryKey"`
+	UserId         string    `json:"userId" gorm:"size:36;not null;index:shipping_addresses_user_id_created_at_idx,priority:1"`
+	RecipientName  string    `json:"recipientName" gorm:"size:50;not null"`
+	PostalCode     string    `json:"postalCode" gorm:"size:8;not null"`
+	PrefectureCode int       `json:"prefectureCode" gorm:"not null"`
+	City           string    `json:"city" gorm:"size:50;not null"`
+	AddressLine1   string    `json:"addressLine1" gorm:"column:address_line1;size:100;not null"`
+	AddressLine2   string    `json:"addressLine2" gorm:"column:address_line2;size:100;not null;default:''"`
+	PhoneNumber    string    `json:"phoneNumber" gorm:"size:11;not null"`
+	CreatedAt      time.Time `json:"createdAt" gorm:"index:shipping_addresses_user_id_created_at_idx,priority:2"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+type ShippingRate struct {
+	PrefectureCode int             `json:"prefectureCode" gorm:"primaryKey;autoIncrement:false"`
+	Fee            decimal.Decimal `json:"fee" gorm:"type:decimal(12,2);not null"`
+	Currency       string          `json:"currency" gorm:"size:3;not null;default:JPY"`
+	UpdatedAt      time.Time       `json:"updatedAt"`
+}
+
+// ShippingSetting は setting_id が 1 の行だけを持つ
+type ShippingSetting struct {
+	SettingId             int              `json:"settingId" gorm:"primaryKey;autoIncrement:false"`
+	FreeShippingThreshold *decimal.Decimal `json:"freeShippingThreshold" gorm:"type:decimal(12,2)"`
+	Currency              string           `json:"currency" gorm:"size:3;not null;default:JPY"`
+	UpdatedAt             time.Time        `json:"updatedAt"`
+}
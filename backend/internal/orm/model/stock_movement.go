@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+)
+
+// StockMovement の VariantId は商品の在庫の移動では空文字列
+type StockMovement struct {
+	MovementId    string    `json:"movementId" gorm:"primaryKey"`
+	ItemId        string    `json:"itemId" gorm:"size:36;not null"`
+	VariantId     string    `json:"variantId" gorm:"size:36;not null;default:''"`
+	MovementType  string    `json:"movementType" gorm:"size:20;not null"`
+	QuantityDelta int       `json:"quantityDelta" gorm:"not null"`
+	Reason        string    `json:"reason" gorm:"not null;default:''"`
+	UserId        string    `json:"userId" gorm:"size:36;not null"`
+	CreatedAt     time.Time `json:"createdAt" gorm:"not null"`
+}
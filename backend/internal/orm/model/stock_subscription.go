@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+type StockSubscription struct {
+	UserId    string    `json:"userId" gorm:"primaryKey;size:36;index:stock_subscriptions_user_id_created_at_idx,priority:1"`
+	ItemId    string    `json:"itemId" gorm:"primaryKey;size:36;index"`
+	CreatedAt time.Time `json:"createdAt" gorm:"index:stock_subscriptions_user_id_created_at_idx,priority:2"`
+}
+
+// StockNotification の SentAt は送信するまで NULL
+type StockNotification struct {
+	NotificationId string     `json:"notificationId" gorm:"primaryKey;size:36"`
+	UserId         string     `json:"userId" gorm:"size:36;not null;index"`
+	ItemId         string     `json:"itemId" gorm:"size:36;not null;index"`
+	Status         string     `json:"status" gorm:"size:20;not null;index:stock_notifications_status_created_at_idx,priority:1"`
+	Attempts       int        `json:"attempts" gorm:"not null;default:0"`
+	LastError      *string    `json:"lastError" gorm:"size:500"`
+	CreatedAt      time.Time  `json:"createdAt" gorm:"not null;index:stock_notifications_status_created_at_idx,priority:2"`
+	SentAt         *time.Time `json:"sentAt"`
+}
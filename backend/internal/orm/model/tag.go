@@ -0,0 +1,11 @@
+package model
+
+type Tag struct {
+	TagId string `json:"tagId" gorm:"primaryKey"`
+	Name  string `json:"name" gorm:"size:32;not null;uniqueIndex:tags_name_key"`
+}
+
+type ItemTag struct {
+	ItemId string `json:"itemId" gorm:"primaryKey;size:36"`
+	TagId  string `json:"tagId" gorm:"primaryKey;size:36"`
+}
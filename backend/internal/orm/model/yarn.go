@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// ItemYarn の YardagePerSkein は1玉の長さ（m）、GramsPerSkein は1玉の重さ（g）
+// NeedleMinMm・NeedleMaxMm は合う針の太さの範囲（mm）、GaugeStitches・GaugeRows は推奨ゲージ。未登録の項目は nil
+type ItemYarn struct {
+	ItemId          string          `json:"itemId" gorm:"primaryKey;size:36"`
+	Weight          string          `json:"weight" gorm:"size:20;not null;index"`
+	YardagePerSkein int             `json:"yardagePerSkein" gorm:"not null"`
+	GramsPerSkein   *int            `json:"gramsPerSkein"`
+	NeedleMinMm     *float64        `json:"needleMinMm" gorm:"type:decimal(4,2)"`
+	NeedleMaxMm     *float64        `json:"needleMaxMm" gorm:"type:decimal(4,2)"`
+	GaugeStitches   *float64        `json:"gaugeStitches" gorm:"type:decimal(3,1)"`
+	GaugeRows       *float64        `json:"gaugeRows" gorm:"type:decimal(3,1)"`
+	CreatedAt       time.Time       `json:"createdAt" gorm:"not null"`
+	UpdatedAt       time.Time       `json:"updatedAt"`
+	Fibers          []ItemYarnFiber `gorm:"foreignKey:ItemId;references:ItemId"`
+}
+
+// ItemYarnFiber の Percentage は素材の混率（%）
+type ItemYarnFiber struct {
+	ItemId     string `json:"itemId" gorm:"primaryKey;size:36"`
+	Fiber      string `json:"fiber" gorm:"primaryKey;size:20;index"`
+	Percentage int    `json:"percentage" gorm:"not null"`
+}
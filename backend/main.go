@@ -2,10 +2,16 @@ package main
 
 import (
 	"log"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/posiposi/project/backend/controller"
 	"github.com/posiposi/project/backend/db"
+	"github.com/posiposi/project/backend/infrastructure/imaging"
+	"github.com/posiposi/project/backend/infrastructure/notification"
+	"github.com/posiposi/project/backend/infrastructure/payment"
+	"github.com/posiposi/project/backend/infrastructure/receipt"
+	"github.com/posiposi/project/backend/infrastructure/storage"
 	"github.com/posiposi/project/backend/repository"
 	"github.com/posiposi/project/backend/router"
 	"github.com/posiposi/project/backend/usecase"
@@ -18,14 +24,220 @@ func main() {
 		log.Fatalln("Error loading .env file")
 	}
 	log.Println("Successfully connected to database")
+	imageStorage, err := storage.NewStorageFromEnv()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	// 決済代行サービスを設定できない場合は決済の API だけを無効にして起動する
+	paymentGateway, err := payment.NewGatewayFromEnv()
+	if err != nil {
+		log.Printf("payment routes are disabled: %v", err)
+	}
+	guestCartTTL, err := usecase.GuestCartTTLFromEnv()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	// 領収書の発行者を設定できない場合は領収書の API だけを無効にして起動する
+	receiptIssuer, err := usecase.ReceiptIssuerFromEnv()
+	if err != nil {
+		log.Printf("receipt routes are disabled: %v", err)
+	}
+	notifier, err := notification.NewNotifierFromEnv()
+	if err != nil {
+		log.Fatalln(err)
+	}
 	userRepository := repository.NewUserRepository(db)
 	itemRepository := repository.NewItemRepository(db)
+	itemSearcher := repository.NewMySQLItemSearcher(db)
+	stockMovementRepository := repository.NewStockMovementRepository(db)
+	itemVariantRepository := repository.NewItemVariantRepository(db)
+	categoryRepository := repository.NewCategoryRepository(db)
+	tagRepository := repository.NewTagRepository(db)
+	itemImageRepository := repository.NewItemImageRepository(db)
+	cartRepository := repository.NewCartRepository(db)
+	orderRepository := repository.NewOrderRepository(db)
+	paymentRepository := repository.NewPaymentRepository(db)
+	couponRepository := repository.NewCouponRepository(db)
+	shippingAddressRepository := repository.NewShippingAddressRepository(db)
+	shippingRateRepository := repository.NewShippingRateRepository(db)
+	receiptRepository := repository.NewReceiptRepository(db)
+	favoriteRepository := repository.NewFavoriteRepository(db)
+	stockSubscriptionRepository := repository.NewStockSubscriptionRepository(db)
+	reviewRepository := repository.NewReviewRepository(db)
+	projectRepository := repository.NewProjectRepository(db)
+	patternRepository := repository.NewPatternRepository(db)
 	userUsecase := usecase.NewUserUsecase(userRepository)
-	itemUsecase := usecase.NewItemUsecase(itemRepository)
-	userController := controller.NewUserController(userUsecase)
-	itemController := controller.NewItemController(itemUsecase)
+	itemUsecase := usecase.NewItemUsecase(itemRepository, userRepository)
+	itemSearchUsecase := usecase.NewItemSearchUsecase(itemSearcher)
+	stockMovementUsecase := usecase.NewStockMovementUsecase(itemRepository, stockMovementRepository)
+	itemVariantUsecase := usecase.NewItemVariantUsecase(itemRepository, itemVariantRepository)
+	itemImageUsecase := usecase.NewItemImageUsecase(itemRepository, itemImageRepository, imageStorage, imaging.NewProcessor())
+	categoryUsecase := usecase.NewCategoryUsecase(categoryRepository, itemRepository)
+	tagUsecase := usecase.NewTagUsecase(tagRepository, itemRepository)
+	cartUsecase := usecase.NewCartUsecase(cartRepository, itemRepository, guestCartTTL)
+	orderUsecase := usecase.NewOrderUsecase(orderRepository)
+	couponUsecase := usecase.NewCouponUsecase(couponRepository, itemRepository)
+	shippingAddressUsecase := usecase.NewShippingAddressUsecase(shippingAddressRepository)
+	shippingUsecase := usecase.NewShippingUsecase(shippingRateRepository, shippingAddressRepository, itemRepository)
+	favoriteUsecase := usecase.NewFavoriteUsecase(favoriteRepository)
+	stockSubscriptionUsecase := usecase.NewStockSubscriptionUsecase(stockSubscriptionRepository, notifier)
+	reviewUsecase := usecase.NewReviewUsecase(reviewRepository)
+	projectUsecase := usecase.NewProjectUsecase(projectRepository, itemRepository, patternRepository)
+	patternUsecase := usecase.NewPatternUsecase(patternRepository, itemRepository)
+	gaugeUsecase := usecase.NewGaugeUsecase()
+	userController := controller.NewUserController(userUsecase, cartUsecase)
+	itemController := controller.NewItemController(itemUsecase, favoriteUsecase)
+	itemSearchController := controller.NewItemSearchController(itemSearchUsecase, favoriteUsecase)
 	adminItemController := controller.NewAdminItemController(itemUsecase)
+	adminItemVariantController := controller.NewAdminItemVariantController(itemVariantUsecase)
+	adminItemImageController := controller.NewAdminItemImageController(itemImageUsecase)
+	adminCategoryController := controller.NewAdminCategoryController(categoryUsecase)
+	adminTagController := controller.NewAdminTagController(tagUsecase)
+	adminStockMovementController := controller.NewAdminStockMovementController(stockMovementUsecase)
 	adminAuthController := controller.NewAdminAuthController()
-	e := router.NewRouter(userController, itemController, adminItemController, adminAuthController, userRepository)
+	cartController := controller.NewCartController(cartUsecase)
+	orderController := controller.NewOrderController(orderUsecase)
+	adminOrderController := controller.NewAdminOrderController(orderUsecase)
+	var paymentController controller.IPaymentController
+	var adminPaymentController controller.IAdminPaymentController
+	if paymentGateway != nil {
+		paymentUsecase := usecase.NewPaymentUsecase(paymentRepository, orderRepository, paymentGatewayAdapter{paymentGateway})
+		paymentController = controller.NewPaymentController(paymentUsecase)
+		adminPaymentController = controller.NewAdminPaymentController(paymentUsecase)
+	}
+	couponController := controller.NewCouponController(couponUsecase)
+	adminCouponController := controller.NewAdminCouponController(couponUsecase)
+	shippingAddressController := controller.NewShippingAddressController(shippingAddressUsecase)
+	shippingController := controller.NewShippingController(shippingUsecase)
+	adminShippingRateController := controller.NewAdminShippingRateController(shippingUsecase)
+	var receiptController controller.IReceiptController
+	var adminReceiptController controller.IAdminReceiptController
+	if receiptIssuer != nil {
+		receiptUsecase := usecase.NewReceiptUsecase(receiptRepository, orderRepository, userRepository, *receiptIssuer, receipt.NewRenderer())
+		receiptController = controller.NewReceiptController(receiptUsecase)
+		adminReceiptController = controller.NewAdminReceiptController(receiptUsecase)
+	}
+	favoriteController := controller.NewFavoriteController(favoriteUsecase)
+	stockSubscriptionController := controller.NewStockSubscriptionController(stockSubscriptionUsecase)
+	adminStockSubscriptionController := controller.NewAdminStockSubscriptionController(stockSubscriptionUsecase)
+	reviewController := controller.NewReviewController(reviewUsecase)
+	projectController := controller.NewProjectController(projectUsecase)
+	patternController := controller.NewPatternController(patternUsecase)
+	adminPatternController := controller.NewAdminPatternController(patternUsecase)
+	gaugeController := controller.NewGaugeController(gaugeUsecase)
+	e := router.NewRouter(router.Controllers{
+		User:                   userController,
+		Item:                   itemController,
+		ItemSearch:             itemSearchController,
+		AdminItem:              adminItemController,
+		AdminItemVariant:       adminItemVariantController,
+		AdminItemImage:         adminItemImageController,
+		AdminCategory:          adminCategoryController,
+		AdminTag:               adminTagController,
+		AdminStockMovement:     adminStockMovementController,
+		AdminAuth:              adminAuthController,
+		Cart:                   cartController,
+		Order:                  orderController,
+		AdminOrder:             adminOrderController,
+		Payment:                paymentController,
+		AdminPayment:           adminPaymentController,
+		Coupon:                 couponController,
+		AdminCoupon:            adminCouponController,
+		ShippingAddress:        shippingAddressController,
+		Shipping:               shippingController,
+		AdminShippingRate:      adminShippingRateController,
+		Receipt:                receiptController,
+		AdminReceipt:           adminReceiptController,
+		Favorite:               favoriteController,
+		StockSubscription:      stockSubscriptionController,
+		AdminStockSubscription: adminStockSubscriptionController,
+		Review:                 reviewController,
+		Project:                projectController,
+		Pattern:                patternController,
+		AdminPattern:           adminPatternController,
+		Gauge:                  gaugeController,
+	}, userRepository)
+	// ローカルストレージに保存した画像は API サーバーから配信する。STORAGE_PUBLIC_URL はこのパスを指すようにする
+	if localStorage, ok := imageStorage.(*storage.LocalStorage); ok {
+		e.Static("/uploads", localStorage.Dir())
+	}
+	go purgeExpiredGuestCarts(cartUsecase)
+	go dispatchStockNotifications(stockSubscriptionUsecase)
 	e.Logger.Fatal(e.StartTLS(":8080", "/go/src/localhost+2.pem", "/go/src/localhost+2-key.pem"))
 }
+
+// purgeExpiredGuestCarts は期限切れのゲストのカートを1時間ごとに削除する
+// 期限切れのカートは取得時にも破棄するため、ここでは放置されたカートの掃除だけを行う
+func purgeExpiredGuestCarts(cu usecase.ICartUsecase) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for range ticker.C {
+		purged, err := cu.PurgeExpiredGuestCarts()
+		if err != nil {
+			log.Printf("failed to purge expired guest carts: %v", err)
+			continue
+		}
+		if purged > 0 {
+			log.Printf("purged %d expired guest carts", purged)
+		}
+	}
+}
+
+// dispatchStockNotifications は再入荷時に積まれた通知を1分ごとに送る
+func dispatchStockNotifications(su usecase.IStockSubscriptionUsecase) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for range ticker.C {
+		sent, err := su.DispatchNotifications()
+		if err != nil {
+			log.Printf("failed to dispatch stock notifications: %v", err)
+			continue
+		}
+		if sent > 0 {
+			log.Printf("sent %d stock notifications", sent)
+		}
+	}
+}
+
+// paymentGatewayAdapter は決済代行サービスのクライアントを usecase.PaymentGateway として使えるようにする
+// オーソリの結果とイベントの種類は同じ文字列の値で受け渡す
+type paymentGatewayAdapter struct {
+	gw payment.PaymentGateway
+}
+
+func (a paymentGatewayAdapter) Authorize(req usecase.PaymentAuthorizeRequest) (*usecase.PaymentAuthorizeResult, error) {
+	result, err := a.gw.Authorize(payment.AuthorizeRequest{
+		IdempotencyKey: req.IdempotencyKey,
+		Amount:         req.Amount,
+		Currency:       req.Currency,
+		Token:          req.Token,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &usecase.PaymentAuthorizeResult{
+		ProviderPaymentId: result.ProviderPaymentId,
+		Status:            usecase.PaymentAuthorizationStatus(result.Status),
+		DeclineCode:       result.DeclineCode,
+	}, nil
+}
+
+func (a paymentGatewayAdapter) Capture(providerPaymentId string) error {
+	return a.gw.Capture(providerPaymentId)
+}
+
+func (a paymentGatewayAdapter) Refund(providerPaymentId string) error {
+	return a.gw.Refund(providerPaymentId)
+}
+
+func (a paymentGatewayAdapter) VerifyWebhook(payload []byte, signature string) (*usecase.PaymentWebhookEvent, error) {
+	event, err := a.gw.VerifyWebhook(payload, signature)
+	if err != nil {
+		return nil, err
+	}
+	return &usecase.PaymentWebhookEvent{
+		EventId:           event.EventId,
+		Type:              usecase.PaymentEventType(event.Type),
+		ProviderPaymentId: event.ProviderPaymentId,
+	}, nil
+}
@@ -2,6 +2,7 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"os"
 	"strings"
@@ -13,43 +14,65 @@ import (
 func AuthMiddleware() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			cookie, err := c.Cookie("token")
-			var tokenString string
-
-			if err == nil && cookie != nil {
-				tokenString = cookie.Value
-			} else {
-				auth := c.Request().Header.Get("Authorization")
-				if auth == "" {
-					return c.JSON(http.StatusUnauthorized, "missing authentication token")
-				}
-
-				parts := strings.Split(auth, " ")
-				if len(parts) != 2 || parts[0] != "Bearer" {
-					return c.JSON(http.StatusUnauthorized, "invalid authorization header format")
-				}
-				tokenString = parts[1]
+			userId, err := authenticatedUserId(c)
+			if err != nil {
+				return c.JSON(http.StatusUnauthorized, err.Error())
 			}
+			c.Set("user_id", userId)
+			return next(c)
+		}
+	}
+}
 
-			token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
-				if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-					return nil, jwt.ErrSignatureInvalid
-				}
-				return []byte(os.Getenv("SECRET")), nil
-			})
-
-			if err != nil || !token.Valid {
-				return c.JSON(http.StatusUnauthorized, "invalid or expired token")
+// OptionalAuthMiddleware はログインしていればコンテキストに user_id を設定し、していなければゲストとしてそのまま通す
+// トークンが無効・期限切れの場合もゲストとして扱う
+func OptionalAuthMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if userId, err := authenticatedUserId(c); err == nil {
+				c.Set("user_id", userId)
 			}
+			return next(c)
+		}
+	}
+}
 
-			if claims, ok := token.Claims.(jwt.MapClaims); ok {
-				if userId, exists := claims["user_id"].(string); exists {
-					c.Set("user_id", userId)
-					return next(c)
-				}
-			}
+// authenticatedUserId はクッキーまたは Authorization ヘッダーの JWT を検証し、ユーザー ID を返す
+func authenticatedUserId(c echo.Context) (string, error) {
+	cookie, err := c.Cookie("token")
+	var tokenString string
 
-			return c.JSON(http.StatusUnauthorized, "invalid token claims")
+	if err == nil && cookie != nil {
+		tokenString = cookie.Value
+	} else {
+		auth := c.Request().Header.Get("Authorization")
+		if auth == "" {
+			return "", errors.New("missing authentication token")
 		}
+
+		parts := strings.Split(auth, " ")
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			return "", errors.New("invalid authorization header format")
+		}
+		tokenString = parts[1]
+	}
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(os.Getenv("SECRET")), nil
+	})
+
+	if err != nil || !token.Valid {
+		return "", errors.New("invalid or expired token")
 	}
+
+	if claims, ok := token.Claims.(jwt.MapClaims); ok {
+		if userId, exists := claims["user_id"].(string); exists {
+			return userId, nil
+		}
+	}
+
+	return "", errors.New("invalid token claims")
 }
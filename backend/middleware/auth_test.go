@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func signTestToken(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test-secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func runOptionalAuth(t *testing.T, authorization string) (any, bool) {
+	t.Helper()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/cart/items", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	called := false
+	err := OptionalAuthMiddleware()(func(c echo.Context) error {
+		called = true
+		return c.NoContent(http.StatusOK)
+	})(c)
+	assert.NoError(t, err)
+	return c.Get("user_id"), called
+}
+
+func TestOptionalAuthMiddleware(t *testing.T) {
+	t.Setenv("SECRET", "test-secret")
+	userID := "f47ac10b-58cc-4372-a567-0e02b2c3d500"
+
+	t.Run("ログイン中は user_id を設定する", func(t *testing.T) {
+		token := signTestToken(t, jwt.MapClaims{"user_id": userID, "exp": time.Now().Add(time.Hour).Unix()})
+		userId, called := runOptionalAuth(t, "Bearer "+token)
+		assert.True(t, called)
+		assert.Equal(t, userID, userId)
+	})
+
+	t.Run("トークンがなければゲストとして通す", func(t *testing.T) {
+		userId, called := runOptionalAuth(t, "")
+		assert.True(t, called)
+		assert.Nil(t, userId)
+	})
+
+	t.Run("期限切れのトークンはゲストとして通す", func(t *testing.T) {
+		token := signTestToken(t, jwt.MapClaims{"user_id": userID, "exp": time.Now().Add(-time.Hour).Unix()})
+		userId, called := runOptionalAuth(t, "Bearer "+token)
+		assert.True(t, called)
+		assert.Nil(t, userId)
+	})
+}
+
+func TestAuthMiddleware_RejectsMissingToken(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/auth/check", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	called := false
+	err := AuthMiddleware()(func(c echo.Context) error {
+		called = true
+		return nil
+	})(c)
+
+	assert.NoError(t, err)
+	assert.False(t, called)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), "missing authentication token")
+}
@@ -0,0 +1,97 @@
+package presenter
+
+import (
+	"github.com/posiposi/project/backend/domain"
+)
+
+// CartLineJSON の VariantId はバリエーションのない商品では null になる
+// 商品が削除された行では ItemName と価格はすべて null になる
+// Status は available / insufficient_stock / out_of_stock / unavailable のいずれか
+type CartLineJSON struct {
+	LineId               string  `json:"line_id"`
+	ItemId               string  `json:"item_id"`
+	VariantId            *string `json:"variant_id"`
+	ItemName             *string `json:"item_name"`
+	Sku                  *string `json:"sku"`
+	Quantity             int     `json:"quantity"`
+	Status               string  `json:"status"`
+	AvailableQuantity    int     `json:"available_quantity"`
+	UnitPrice            *string `json:"unit_price"`
+	LineTotal            *string `json:"line_total"`
+	LineTotalTaxIncluded *string `json:"line_total_tax_included"`
+}
+
+// CartResponseJSON の合計は購入できる行だけから計算し、消費税は小計に対して税率ごとに1回だけ計算する
+type CartResponseJSON struct {
+	Items         []CartLineJSON `json:"items"`
+	ItemCount     int            `json:"item_count"`
+	Subtotal      string         `json:"subtotal"`
+	Tax           string         `json:"tax"`
+	Total         string         `json:"total"`
+	TotalDisplay  string         `json:"total_display"`
+	Currency      string         `json:"currency"`
+	CheckoutReady bool           `json:"checkout_ready"`
+}
+
+type ICartPresenter interface {
+	ToJSON(summary *domain.CartSummary) CartResponseJSON
+}
+
+type cartPresenter struct{}
+
+func NewCartPresenter() ICartPresenter {
+	return &cartPresenter{}
+}
+
+func (p *cartPresenter) ToJSON(summary *domain.CartSummary) CartResponseJSON {
+	lines := summary.Lines()
+	items := make([]CartLineJSON, len(lines))
+	for i := range lines {
+		items[i] = toCartLineJSON(&lines[i])
+	}
+
+	subtotal := summary.Subtotal()
+	total := summary.Total()
+	return CartResponseJSON{
+		Items:         items,
+		ItemCount:     summary.ItemCount(),
+		Subtotal:      subtotal.String(),
+		Tax:           summary.Tax().String(),
+		Total:         total.String(),
+		TotalDisplay:  total.TaxIncludedAmountDisplay(),
+		Currency:      subtotal.Currency(),
+		CheckoutReady: summary.CheckoutReady(),
+	}
+}
+
+func toCartLineJSON(priced *domain.PricedCartLine) CartLineJSON {
+	line := priced.Line()
+	result := CartLineJSON{
+		LineId:            line.LineId(),
+		ItemId:            line.ItemId(),
+		Quantity:          line.Quantity(),
+		Status:            string(priced.Status()),
+		AvailableQuantity: priced.Available(),
+	}
+	if variantId := line.VariantId(); variantId != "" {
+		result.VariantId = &variantId
+	}
+	if item := priced.Item(); item != nil {
+		name := item.ItemName()
+		result.ItemName = &name
+	}
+	if variant := priced.Variant(); variant != nil {
+		sku := variant.Sku()
+		result.Sku = &sku
+	}
+	if unitPrice := priced.UnitPrice(); unitPrice != nil {
+		lineTotal := priced.LineTotal()
+		unit := unitPrice.String()
+		total := lineTotal.String()
+		taxIncluded := lineTotal.TaxIncludedAt(priced.Item().TaxRate()).String()
+		result.UnitPrice = &unit
+		result.LineTotal = &total
+		result.LineTotalTaxIncluded = &taxIncluded
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package presenter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCartPresenter_ToJSON(t *testing.T) {
+	presenter := NewCartPresenter()
+	userId, _ := domain.NewUserId(uuid.NewString())
+
+	newItem := func(name string, price string, available int) *domain.Item {
+		itemId, _ := domain.NewItemId(uuid.NewString())
+		itemName, _ := domain.NewItemName(name)
+		stock, _ := domain.NewStock(available, 0, 0)
+		description, _ := domain.NewDescription("Test Description")
+		money, _ := domain.NewMoneyFromString(price, domain.CurrencyJPY)
+		item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, *money)
+		return item
+	}
+	newLine := func(itemId string, quantity int) domain.CartLine {
+		id, _ := domain.NewItemId(itemId)
+		q, _ := domain.NewCartQuantity(quantity)
+		return *domain.RestoreCartLine(uuid.NewString(), *id, "", *q, time.Now(), time.Now())
+	}
+
+	yarn := newItem("Merino Wool", "1000", 5)
+	needles := newItem("Bamboo Needles", "333", 0)
+	deletedId := uuid.NewString()
+	cart := domain.RestoreCart(uuid.NewString(), domain.UserCartOwner(*userId), []domain.CartLine{
+		newLine(yarn.ItemId(), 2),
+		newLine(needles.ItemId(), 1),
+		newLine(deletedId, 1),
+	}, time.Now(), time.Now())
+	summary, err := domain.PriceCart(cart, domain.Items{*yarn, *needles})
+	assert.NoError(t, err)
+
+	result := presenter.ToJSON(summary)
+
+	assert.Len(t, result.Items, 3)
+	assert.Equal(t, "available", result.Items[0].Status)
+	assert.Equal(t, "Merino Wool", *result.Items[0].ItemName)
+	assert.Nil(t, result.Items[0].VariantId)
+	assert.Equal(t, "1000", *result.Items[0].UnitPrice)
+	assert.Equal(t, "2000", *result.Items[0].LineTotal)
+	assert.Equal(t, "2200", *result.Items[0].LineTotalTaxIncluded)
+
+	assert.Equal(t, "out_of_stock", result.Items[1].Status)
+	assert.Equal(t, "333", *result.Items[1].LineTotal)
+
+	assert.Equal(t, "unavailable", result.Items[2].Status)
+	assert.Equal(t, deletedId, result.Items[2].ItemId)
+	assert.Nil(t, result.Items[2].ItemName)
+	assert.Nil(t, result.Items[2].LineTotal)
+
+	assert.Equal(t, 2, result.ItemCount)
+	assert.Equal(t, "2000", result.Subtotal)
+	assert.Equal(t, "200", result.Tax)
+	assert.Equal(t, "2200", result.Total)
+	assert.Equal(t, "JPY", result.Currency)
+	assert.False(t, result.CheckoutReady)
+}
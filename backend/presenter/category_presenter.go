@@ -0,0 +1,72 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// CategoryJSON の ParentId は根のカテゴリでは null になる
+type CategoryJSON struct {
+	CategoryId string    `json:"category_id"`
+	ParentId   *string   `json:"parent_id"`
+	Name       string    `json:"name"`
+	Slug       string    `json:"slug"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type CategoryNodeJSON struct {
+	CategoryJSON
+	Children []CategoryNodeJSON `json:"children"`
+}
+
+type CategoryTreeResponseJSON struct {
+	Items []CategoryNodeJSON `json:"items"`
+}
+
+type ICategoryPresenter interface {
+	ToJSON(category *domain.Category) CategoryJSON
+	ToTreeJSON(roots []*domain.CategoryNode) CategoryTreeResponseJSON
+}
+
+type categoryPresenter struct{}
+
+func NewCategoryPresenter() ICategoryPresenter {
+	return &categoryPresenter{}
+}
+
+func (p *categoryPresenter) ToJSON(category *domain.Category) CategoryJSON {
+	return toCategoryJSON(category)
+}
+
+func (p *categoryPresenter) ToTreeJSON(roots []*domain.CategoryNode) CategoryTreeResponseJSON {
+	return CategoryTreeResponseJSON{Items: toCategoryNodeJSONList(roots)}
+}
+
+func toCategoryJSON(category *domain.Category) CategoryJSON {
+	var parentId *string
+	if !category.IsRoot() {
+		id := category.ParentId()
+		parentId = &id
+	}
+	return CategoryJSON{
+		CategoryId: category.CategoryId(),
+		ParentId:   parentId,
+		Name:       category.Name(),
+		Slug:       category.Slug(),
+		CreatedAt:  category.CreatedAt(),
+		UpdatedAt:  category.UpdatedAt(),
+	}
+}
+
+func toCategoryNodeJSONList(nodes []*domain.CategoryNode) []CategoryNodeJSON {
+	result := make([]CategoryNodeJSON, len(nodes))
+	for i, node := range nodes {
+		result[i] = CategoryNodeJSON{
+			CategoryJSON: toCategoryJSON(&node.Category),
+			Children:     toCategoryNodeJSONList(node.Children),
+		}
+	}
+	return result
+}
@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryPresenter_ToTreeJSON(t *testing.T) {
+	presenter := NewCategoryPresenter()
+	yarnSlug, _ := domain.NewCategorySlug("yarn")
+	yarn, _ := domain.NewCategory(nil, "毛糸", *yarnSlug)
+	yarnId, _ := domain.NewCategoryId(yarn.CategoryId())
+	woolSlug, _ := domain.NewCategorySlug("wool")
+	wool, _ := domain.NewCategory(yarnId, "ウール", *woolSlug)
+
+	result := presenter.ToTreeJSON(domain.Categories{*yarn, *wool}.Tree())
+
+	assert.Len(t, result.Items, 1)
+	assert.Equal(t, "yarn", result.Items[0].Slug)
+	assert.Nil(t, result.Items[0].ParentId)
+	assert.Len(t, result.Items[0].Children, 1)
+	assert.Equal(t, "ウール", result.Items[0].Children[0].Name)
+	assert.Equal(t, yarn.CategoryId(), *result.Items[0].Children[0].ParentId)
+	assert.NotNil(t, result.Items[0].Children[0].Children)
+}
+
+func TestItemPresenter_CategoryAndTags(t *testing.T) {
+	itemId, _ := domain.NewItemId(uuid.NewString())
+	userId, _ := domain.NewUserId(uuid.NewString())
+	itemName, _ := domain.NewItemName("Merino Wool")
+	stock, _ := domain.NewStock(1, 0, 0)
+	description, _ := domain.NewDescription("Fingering weight")
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
+
+	result := NewItemPresenter().ToJSON(item)
+	assert.Nil(t, result.Category)
+	assert.NotNil(t, result.Tags)
+	assert.Empty(t, result.Tags)
+
+	slug, _ := domain.NewCategorySlug("fingering")
+	category, _ := domain.NewCategory(nil, "合細", *slug)
+	handmade, _ := domain.NewTagName("handmade")
+	merino, _ := domain.NewTagName("merino")
+	item = item.WithCategory(category).WithTags([]domain.Tag{*domain.NewTag(*handmade), *domain.NewTag(*merino)})
+
+	result = NewItemPresenter().ToJSON(item)
+	assert.Equal(t, "fingering", result.Category.Slug)
+	assert.Equal(t, []string{"handmade", "merino"}, result.Tags)
+}
@@ -0,0 +1,106 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// CouponResponseJSON の PercentOff は割合の割引、AmountOff は定額の割引でだけ値を持つ
+// MinimumSpend・StartsAt・EndsAt は設けていない場合は null、上限の 0 は無制限を表す。ItemIds が空の場合はすべての商品が対象
+type CouponResponseJSON struct {
+	CouponId     string     `json:"coupon_id"`
+	Code         string     `json:"code"`
+	DiscountType string     `json:"discount_type"`
+	PercentOff   *int       `json:"percent_off"`
+	AmountOff    *string    `json:"amount_off"`
+	MinimumSpend *string    `json:"minimum_spend"`
+	Currency     string     `json:"currency"`
+	StartsAt     *time.Time `json:"starts_at"`
+	EndsAt       *time.Time `json:"ends_at"`
+	UsageLimit   int        `json:"usage_limit"`
+	PerUserLimit int        `json:"per_user_limit"`
+	UsedCount    int        `json:"used_count"`
+	ItemIds      []string   `json:"item_ids"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+type CouponListResponseJSON struct {
+	Items []CouponResponseJSON `json:"items"`
+}
+
+// CouponPreviewResponseJSON は注文前にクーポンを使った場合の金額。Discount は税抜の割引額
+type CouponPreviewResponseJSON struct {
+	Code         string `json:"code"`
+	Subtotal     string `json:"subtotal"`
+	Discount     string `json:"discount"`
+	Tax          string `json:"tax"`
+	Total        string `json:"total"`
+	TotalDisplay string `json:"total_display"`
+	Currency     string `json:"currency"`
+}
+
+type ICouponPresenter interface {
+	ToJSON(coupon *domain.Coupon) CouponResponseJSON
+	ToListJSON(coupons []*domain.Coupon) CouponListResponseJSON
+	ToPreviewJSON(preview *domain.CouponPreview) CouponPreviewResponseJSON
+}
+
+type couponPresenter struct{}
+
+func NewCouponPresenter() ICouponPresenter {
+	return &couponPresenter{}
+}
+
+func (p *couponPresenter) ToJSON(coupon *domain.Coupon) CouponResponseJSON {
+	result := CouponResponseJSON{
+		CouponId:     coupon.CouponId(),
+		Code:         coupon.Code(),
+		DiscountType: string(coupon.Discount().Type()),
+		Currency:     domain.CurrencyJPY,
+		StartsAt:     coupon.Validity().StartsAt(),
+		EndsAt:       coupon.Validity().EndsAt(),
+		UsageLimit:   coupon.UsageLimit().Total(),
+		PerUserLimit: coupon.UsageLimit().PerUser(),
+		UsedCount:    coupon.UsedCount(),
+		ItemIds:      coupon.ItemIds(),
+		CreatedAt:    coupon.CreatedAt(),
+		UpdatedAt:    coupon.UpdatedAt(),
+	}
+	if amountOff := coupon.Discount().AmountOff(); amountOff != nil {
+		value := amountOff.String()
+		result.AmountOff = &value
+		result.Currency = amountOff.Currency()
+	} else {
+		percentOff := coupon.Discount().PercentOff()
+		result.PercentOff = &percentOff
+	}
+	if minimumSpend := coupon.MinimumSpend(); minimumSpend != nil {
+		value := minimumSpend.String()
+		result.MinimumSpend = &value
+		result.Currency = minimumSpend.Currency()
+	}
+	return result
+}
+
+func (p *couponPresenter) ToListJSON(coupons []*domain.Coupon) CouponListResponseJSON {
+	items := make([]CouponResponseJSON, len(coupons))
+	for i, coupon := range coupons {
+		items[i] = p.ToJSON(coupon)
+	}
+	return CouponListResponseJSON{Items: items}
+}
+
+func (p *couponPresenter) ToPreviewJSON(preview *domain.CouponPreview) CouponPreviewResponseJSON {
+	total := preview.Total()
+	return CouponPreviewResponseJSON{
+		Code:         preview.Code(),
+		Subtotal:     preview.Subtotal().String(),
+		Discount:     preview.Discount().String(),
+		Tax:          preview.Tax().String(),
+		Total:        total.String(),
+		TotalDisplay: total.TaxIncludedAmountDisplay(),
+		Currency:     total.Currency(),
+	}
+}
@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCouponPresenter_ToJSON(t *testing.T) {
+	presenter := NewCouponPresenter()
+	code, _ := domain.NewCouponCode("SPRING500")
+	amountOff, _ := domain.NewMoneyFromString("500", domain.CurrencyJPY)
+	discount, _ := domain.NewFixedDiscount(*amountOff)
+	endsAt := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	validity, _ := domain.NewCouponValidity(nil, &endsAt)
+	limit, _ := domain.NewCouponUsageLimit(100, 1)
+	minimumSpend, _ := domain.NewMoneyFromString("3000", domain.CurrencyJPY)
+	coupon := domain.RestoreCoupon(uuid.NewString(), *code, *discount, *validity, *limit, minimumSpend, nil, 7, time.Now(), time.Now())
+
+	result := presenter.ToJSON(coupon)
+
+	assert.Equal(t, "SPRING500", result.Code)
+	assert.Equal(t, "fixed", result.DiscountType)
+	assert.Nil(t, result.PercentOff)
+	assert.Equal(t, "500", *result.AmountOff)
+	assert.Equal(t, "3000", *result.MinimumSpend)
+	assert.Nil(t, result.StartsAt)
+	assert.Equal(t, endsAt, *result.EndsAt)
+	assert.Equal(t, 100, result.UsageLimit)
+	assert.Equal(t, 1, result.PerUserLimit)
+	assert.Equal(t, 7, result.UsedCount)
+
+	body, err := json.Marshal(result)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"item_ids":[]`)
+}
+
+func TestCouponPresenter_ToPreviewJSON(t *testing.T) {
+	code, _ := domain.NewCouponCode("WELCOME10")
+	discount, _ := domain.NewPercentDiscount(10)
+	validity, _ := domain.NewCouponValidity(nil, nil)
+	limit, _ := domain.NewCouponUsageLimit(0, 0)
+	coupon := domain.NewCoupon(*code, *discount, *validity, *limit, nil, nil)
+	price, _ := domain.NewMoneyFromString("1000", domain.CurrencyJPY)
+	line := domain.RestoreOrderLine(uuid.NewString(), uuid.NewString(), "", "Merino Wool", "", nil, *price, domain.TaxRateStandard, 3)
+	preview, _ := coupon.Preview([]domain.OrderLine{*line}, 0, time.Now())
+
+	result := NewCouponPresenter().ToPreviewJSON(preview)
+
+	assert.Equal(t, "WELCOME10", result.Code)
+	assert.Equal(t, "3000", result.Subtotal)
+	assert.Equal(t, "300", result.Discount)
+	assert.Equal(t, "270", result.Tax)
+	assert.Equal(t, "2970", result.Total)
+	assert.Equal(t, "¥2,970（税込）", result.TotalDisplay)
+}
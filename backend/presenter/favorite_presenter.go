@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// FavoriteJSON の FavoritedAt はお気に入りに登録した日時
+type FavoriteJSON struct {
+	ItemResponseJSON
+	FavoritedAt time.Time `json:"favorited_at"`
+}
+
+type FavoriteListResponseJSON struct {
+	Items []FavoriteJSON `json:"items"`
+}
+
+type IFavoritePresenter interface {
+	ToListJSON(favorites []*domain.Favorite) FavoriteListResponseJSON
+}
+
+type favoritePresenter struct{}
+
+func NewFavoritePresenter() IFavoritePresenter {
+	return &favoritePresenter{}
+}
+
+func (p *favoritePresenter) ToListJSON(favorites []*domain.Favorite) FavoriteListResponseJSON {
+	favorited := make(map[string]bool, len(favorites))
+	for _, favorite := range favorites {
+		favorited[favorite.Item().ItemId()] = true
+	}
+	ip := NewItemPresenter().WithFavorited(favorited)
+
+	items := make([]FavoriteJSON, len(favorites))
+	for i, favorite := range favorites {
+		items[i] = FavoriteJSON{
+			ItemResponseJSON: ip.ToJSON(favorite.Item()),
+			FavoritedAt:      favorite.CreatedAt(),
+		}
+	}
+	return FavoriteListResponseJSON{Items: items}
+}
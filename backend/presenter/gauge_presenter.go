@@ -0,0 +1,54 @@
+package presenter
+
+import "github.com/posiposi/project/backend/domain"
+
+// GaugeCalculationJSON の寸法は Unit の単位で、ゲージは GaugeSpan（10cm または4インチ）あたりの目数と段数
+// Pattern* と *DifferencePercentage は編み図のゲージを指定しなければ null になる
+// 差は試し編みのゲージから見た割合で、正の値は編み図より目が詰まっていることを表す
+type GaugeCalculationJSON struct {
+	Unit                       string     `json:"unit"`
+	GaugeSpan                  float64    `json:"gauge_span"`
+	SwatchGauge                GaugeJSON  `json:"swatch_gauge"`
+	TargetWidth                float64    `json:"target_width"`
+	TargetHeight               float64    `json:"target_height"`
+	CastOnStitches             int        `json:"cast_on_stitches"`
+	Rows                       int        `json:"rows"`
+	PatternGauge               *GaugeJSON `json:"pattern_gauge"`
+	PatternCastOnStitches      *int       `json:"pattern_cast_on_stitches"`
+	PatternRows                *int       `json:"pattern_rows"`
+	StitchDifferencePercentage *float64   `json:"stitch_difference_percentage"`
+	RowDifferencePercentage    *float64   `json:"row_difference_percentage"`
+}
+
+type IGaugePresenter interface {
+	ToJSON(calculation *domain.GaugeCalculation) GaugeCalculationJSON
+}
+
+type gaugePresenter struct{}
+
+func NewGaugePresenter() IGaugePresenter {
+	return &gaugePresenter{}
+}
+
+func (p *gaugePresenter) ToJSON(calculation *domain.GaugeCalculation) GaugeCalculationJSON {
+	swatch := calculation.Swatch()
+	result := GaugeCalculationJSON{
+		Unit:           string(swatch.Unit()),
+		GaugeSpan:      swatch.Unit().GaugeSpan(),
+		SwatchGauge:    GaugeJSON{Stitches: swatch.SpanStitches(), Rows: swatch.SpanRows()},
+		TargetWidth:    calculation.Width(),
+		TargetHeight:   calculation.Height(),
+		CastOnStitches: calculation.CastOnStitches(),
+		Rows:           calculation.Rows(),
+	}
+	if pattern := calculation.Pattern(); pattern != nil {
+		result.PatternGauge = &GaugeJSON{Stitches: pattern.SpanStitches(), Rows: pattern.SpanRows()}
+	}
+	if stitches, rows, ok := calculation.PatternCounts(); ok {
+		result.PatternCastOnStitches, result.PatternRows = &stitches, &rows
+	}
+	if stitches, rows, ok := calculation.Difference(); ok {
+		result.StitchDifferencePercentage, result.RowDifferencePercentage = &stitches, &rows
+	}
+	return result
+}
@@ -0,0 +1,55 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+type ItemImageJSON struct {
+	ImageId      string    `json:"image_id"`
+	Position     int       `json:"position"`
+	Url          string    `json:"url"`
+	ThumbnailUrl string    `json:"thumbnail_url"`
+	ContentType  string    `json:"content_type"`
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type IItemImagePresenter interface {
+	ToJSON(image *domain.ItemImage) ItemImageJSON
+}
+
+type itemImagePresenter struct{}
+
+func NewItemImagePresenter() IItemImagePresenter {
+	return &itemImagePresenter{}
+}
+
+func (p *itemImagePresenter) ToJSON(image *domain.ItemImage) ItemImageJSON {
+	return toItemImageJSON(image)
+}
+
+func toItemImageJSON(image *domain.ItemImage) ItemImageJSON {
+	return ItemImageJSON{
+		ImageId:      image.ImageId(),
+		Position:     image.Position(),
+		Url:          image.Original().URL,
+		ThumbnailUrl: image.Thumbnail().URL,
+		ContentType:  image.ContentType(),
+		Width:        image.Width(),
+		Height:       image.Height(),
+		CreatedAt:    image.CreatedAt(),
+	}
+}
+
+// toItemImageJSONList は商品画像を表示順のまま変換する。画像のない商品では空配列を返す
+func toItemImageJSONList(item *domain.Item) []ItemImageJSON {
+	images := item.Images()
+	list := make([]ItemImageJSON, 0, len(images))
+	for i := range images {
+		list = append(list, toItemImageJSON(&images[i]))
+	}
+	return list
+}
@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemPresenter_Images(t *testing.T) {
+	itemId, _ := domain.NewItemId(uuid.NewString())
+	userId, _ := domain.NewUserId(uuid.NewString())
+	itemName, _ := domain.NewItemName("Hand-knit socks")
+	stock, _ := domain.NewStock(0, 0, 0)
+	description, _ := domain.NewDescription("Wool socks")
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
+
+	body, _ := json.Marshal(NewItemPresenter().ToJSON(item))
+	assert.Contains(t, string(body), `"images":[]`)
+
+	jpeg, _ := domain.NewImageContentType("image/jpeg")
+	gif, _ := domain.NewImageContentType("image/gif")
+	front := domain.RestoreItemImage("front", *itemId, 1, *jpeg, 1200, 800,
+		domain.StoredImage{Key: "items/x/front.jpg", URL: "https://cdn.example.com/items/x/front.jpg"},
+		domain.StoredImage{Key: "items/x/front_thumb.jpg", URL: "https://cdn.example.com/items/x/front_thumb.jpg"},
+		time.Now())
+	back := domain.RestoreItemImage("back", *itemId, 2, *gif, 400, 400,
+		domain.StoredImage{Key: "items/x/back.gif", URL: "https://cdn.example.com/items/x/back.gif"},
+		domain.StoredImage{Key: "items/x/back_thumb.png", URL: "https://cdn.example.com/items/x/back_thumb.png"},
+		time.Now())
+	item = item.WithImages(domain.ItemImages{*front, *back})
+
+	result := NewItemPresenter().ToJSON(item)
+
+	assert.Len(t, result.Images, 2)
+	assert.Equal(t, "front", result.Images[0].ImageId)
+	assert.Equal(t, "https://cdn.example.com/items/x/front.jpg", result.Images[0].Url)
+	assert.Equal(t, "https://cdn.example.com/items/x/front_thumb.jpg", result.Images[0].ThumbnailUrl)
+	assert.Equal(t, 2, result.Images[1].Position)
+	assert.Equal(t, "image/gif", result.Images[1].ContentType)
+}
@@ -6,37 +6,189 @@ import (
 	"github.com/posiposi/project/backend/domain"
 )
 
+// ItemResponseJSON の Stock は販売可能数から導出した在庫有無で、数量導入前のクライアント向けに残している
+// Stock・AvailableQuantity・LowStock はバリエーションのある商品ではバリエーションの在庫の合計から求める
+// OnHandQuantity・ReservedQuantity は商品自体の在庫数量
+// Price は税抜価格、PriceDisplay は商品の税率で計算した税込の総額表示用の文字列
+// TaxRate は "standard"（10%）または "reduced"（8%）
+// Variants はバリエーションのない商品では空配列になる
+// Category はカテゴリ未設定の場合は null、Tags はタグ名を名前順に並べたもの
+// Images は表示順に並べた画像で、画像のない商品では空配列になる
+// IsFavorited は認証済みのリクエストでだけ返し、閲覧しているユーザーがお気に入りに登録しているかを表す
+// Yarn は毛糸の属性で、毛糸でない商品では null になる
 type ItemResponseJSON struct {
-	ItemId      string    `json:"item_id"`
-	UserId      string    `json:"user_id"`
-	ItemName    string    `json:"item_name"`
-	Stock       bool      `json:"stock"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ItemId            string                    `json:"item_id"`
+	UserId            string                    `json:"user_id"`
+	ItemName          string                    `json:"item_name"`
+	Stock             bool                      `json:"stock"`
+	OnHandQuantity    int                       `json:"on_hand_quantity"`
+	ReservedQuantity  int                       `json:"reserved_quantity"`
+	AvailableQuantity int                       `json:"available_quantity"`
+	LowStockThreshold int                       `json:"low_stock_threshold"`
+	LowStock          bool                      `json:"low_stock"`
+	Description       string                    `json:"description"`
+	Price             string                    `json:"price"`
+	PriceTaxIncluded  string                    `json:"price_tax_included"`
+	PriceDisplay      string                    `json:"price_display"`
+	Currency          string                    `json:"currency"`
+	TaxRate           string                    `json:"tax_rate"`
+	Variants          []ItemVariantResponseJSON `json:"variants"`
+	Category          *CategoryJSON             `json:"category"`
+	Tags              []string                  `json:"tags"`
+	Images            []ItemImageJSON           `json:"images"`
+	FavoriteCount     int                       `json:"favorite_count"`
+	IsFavorited       *bool                     `json:"is_favorited,omitempty"`
+	RatingAverage     float64                   `json:"rating_average"`
+	RatingCount       int                       `json:"rating_count"`
+	Yarn              *ItemYarnJSON             `json:"yarn"`
+	CreatedAt         time.Time                 `json:"created_at"`
+	UpdatedAt         time.Time                 `json:"updated_at"`
+}
+
+// ItemYarnJSON の YardagePerSkein は1玉の長さ（m）、GramsPerSkein は1玉の重さ（g）
+// Fibers は混率の高い順に並べ、GramsPerSkein・NeedleRange・Gauge は未登録なら null になる
+type ItemYarnJSON struct {
+	Weight          string             `json:"weight"`
+	YardagePerSkein int                `json:"yardage_per_skein"`
+	GramsPerSkein   *int               `json:"grams_per_skein"`
+	Fibers          []FiberContentJSON `json:"fibers"`
+	NeedleRange     *NeedleRangeJSON   `json:"needle_range"`
+	Gauge           *GaugeJSON         `json:"gauge"`
+}
+
+// FiberContentJSON の Percentage は素材の混率（%）
+type FiberContentJSON struct {
+	Fiber      string `json:"fiber"`
+	Percentage int    `json:"percentage"`
+}
+
+// NeedleRangeJSON は合う針の太さの範囲（mm）
+type NeedleRangeJSON struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+type ItemListResponseJSON struct {
+	Items      []ItemResponseJSON `json:"items"`
+	NextCursor *string            `json:"next_cursor"`
+	Total      *int64             `json:"total,omitempty"`
+}
+
+type ItemHighlightJSON struct {
+	ItemName    string `json:"item_name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type ItemSearchHitJSON struct {
+	ItemResponseJSON
+	Score     float64           `json:"score"`
+	Highlight ItemHighlightJSON `json:"highlight"`
+}
+
+type ItemSearchResponseJSON struct {
+	Items []ItemSearchHitJSON `json:"items"`
 }
 
 type IItemPresenter interface {
 	ToJSON(item *domain.Item) ItemResponseJSON
 	ToJSONList(items []*domain.Item) []ItemResponseJSON
+	ToPageJSON(page *domain.ItemPage) ItemListResponseJSON
+	ToSearchJSON(hits []*domain.ItemSearchHit) ItemSearchResponseJSON
+	WithFavorited(favorited map[string]bool) IItemPresenter
 }
 
-type itemPresenter struct{}
+// itemPresenter の favorited は閲覧しているユーザーがお気に入りに登録している商品の ID で、未認証のリクエストでは nil
+type itemPresenter struct {
+	favorited map[string]bool
+}
 
 func NewItemPresenter() IItemPresenter {
 	return &itemPresenter{}
 }
 
+// WithFavorited は is_favorited を返すプレゼンターを返す。favorited にない商品は登録していないものとして扱う
+func (p *itemPresenter) WithFavorited(favorited map[string]bool) IItemPresenter {
+	if favorited == nil {
+		favorited = map[string]bool{}
+	}
+	return &itemPresenter{favorited: favorited}
+}
+
 func (p *itemPresenter) ToJSON(item *domain.Item) ItemResponseJSON {
+	stock := item.Stock()
+	sellable := item.SellableStock()
+	price := item.Price()
+	priceTaxIncluded := item.PriceTaxIncluded()
+
+	var category *CategoryJSON
+	if c := item.Category(); c != nil {
+		categoryJSON := toCategoryJSON(c)
+		category = &categoryJSON
+	}
+	tags := make([]string, 0, len(item.Tags()))
+	for _, tag := range item.Tags() {
+		tags = append(tags, tag.Name())
+	}
+	var yarn *ItemYarnJSON
+	if y := item.Yarn(); y != nil {
+		yarnJSON := toItemYarnJSON(y)
+		yarn = &yarnJSON
+	}
+	var isFavorited *bool
+	if p.favorited != nil {
+		favorited := p.favorited[item.ItemId()]
+		isFavorited = &favorited
+	}
+
 	return ItemResponseJSON{
-		ItemId:      item.ItemId(),
-		UserId:      item.UserId(),
-		ItemName:    item.ItemName(),
-		Stock:       item.Stock(),
-		Description: item.Description(),
-		CreatedAt:   item.CreatedAt(),
-		UpdatedAt:   item.UpdatedAt(),
+		ItemId:            item.ItemId(),
+		UserId:            item.UserId(),
+		ItemName:          item.ItemName(),
+		Stock:             sellable.InStock(),
+		OnHandQuantity:    stock.OnHand(),
+		ReservedQuantity:  stock.Reserved(),
+		AvailableQuantity: sellable.Available(),
+		LowStockThreshold: stock.LowStockThreshold(),
+		LowStock:          sellable.IsLowStock(),
+		Description:       item.Description(),
+		Price:             price.String(),
+		PriceTaxIncluded:  priceTaxIncluded.String(),
+		PriceDisplay:      priceTaxIncluded.TaxIncludedAmountDisplay(),
+		Currency:          price.Currency(),
+		TaxRate:           string(item.TaxRate()),
+		Variants:          toItemVariantJSONList(item),
+		Category:          category,
+		Tags:              tags,
+		Images:            toItemImageJSONList(item),
+		FavoriteCount:     item.FavoriteCount(),
+		IsFavorited:       isFavorited,
+		RatingAverage:     item.Rating().Average(),
+		RatingCount:       item.Rating().Count(),
+		Yarn:              yarn,
+		CreatedAt:         item.CreatedAt(),
+		UpdatedAt:         item.UpdatedAt(),
+	}
+}
+
+func toItemYarnJSON(yarn *domain.YarnAttributes) ItemYarnJSON {
+	yarnJSON := ItemYarnJSON{
+		Weight:          string(yarn.Weight()),
+		YardagePerSkein: yarn.YardagePerSkein(),
+		Fibers:          make([]FiberContentJSON, 0, len(yarn.Fibers())),
+	}
+	if grams := yarn.GramsPerSkein(); grams > 0 {
+		yarnJSON.GramsPerSkein = &grams
+	}
+	for _, content := range yarn.Fibers() {
+		yarnJSON.Fibers = append(yarnJSON.Fibers, FiberContentJSON{Fiber: string(content.Fiber), Percentage: content.Percentage})
+	}
+	if needles := yarn.NeedleRange(); needles != nil {
+		yarnJSON.NeedleRange = &NeedleRangeJSON{Min: needles.Min().Millimeters(), Max: needles.Max().Millimeters()}
+	}
+	if gauge := yarn.Gauge(); gauge != nil {
+		yarnJSON.Gauge = &GaugeJSON{Stitches: gauge.Stitches(), Rows: gauge.Rows()}
 	}
+	return yarnJSON
 }
 
 func (p *itemPresenter) ToJSONList(items []*domain.Item) []ItemResponseJSON {
@@ -46,3 +198,37 @@ func (p *itemPresenter) ToJSONList(items []*domain.Item) []ItemResponseJSON {
 	}
 	return result
 }
+
+func (p *itemPresenter) ToPageJSON(page *domain.ItemPage) ItemListResponseJSON {
+	items := make([]*domain.Item, len(page.Items()))
+	for i := range page.Items() {
+		items[i] = &page.Items()[i]
+	}
+
+	var nextCursor *string
+	if page.HasNext() {
+		encoded := page.NextCursor().Encode()
+		nextCursor = &encoded
+	}
+
+	return ItemListResponseJSON{
+		Items:      p.ToJSONList(items),
+		NextCursor: nextCursor,
+		Total:      page.Total(),
+	}
+}
+
+func (p *itemPresenter) ToSearchJSON(hits []*domain.ItemSearchHit) ItemSearchResponseJSON {
+	items := make([]ItemSearchHitJSON, len(hits))
+	for i, hit := range hits {
+		items[i] = ItemSearchHitJSON{
+			ItemResponseJSON: p.ToJSON(hit.Item()),
+			Score:            hit.Score(),
+			Highlight: ItemHighlightJSON{
+				ItemName:    hit.ItemNameHighlight(),
+				Description: hit.DescriptionHighlight(),
+			},
+		}
+	}
+	return ItemSearchResponseJSON{Items: items}
+}
@@ -12,10 +12,10 @@ import (
 func createTestDomainItem() *domain.Item {
 	userId, _ := domain.NewUserId(uuid.NewString())
 	itemName, _ := domain.NewItemName("Test Item")
-	stock, _ := domain.NewStock(true)
+	stock, _ := domain.NewStock(1, 0, 0)
 	description, _ := domain.NewDescription("Test Description")
 
-	item, _ := domain.NewItem(nil, *userId, *itemName, *stock, *description)
+	item, _ := domain.NewItem(nil, *userId, *itemName, *stock, *description, domain.ZeroYen())
 	return item
 }
 
@@ -28,12 +28,141 @@ func TestItemPresenter_ToJSON(t *testing.T) {
 	assert.Equal(t, domainItem.ItemId(), result.ItemId)
 	assert.Equal(t, domainItem.UserId(), result.UserId)
 	assert.Equal(t, domainItem.ItemName(), result.ItemName)
-	assert.Equal(t, domainItem.Stock(), result.Stock)
+	assert.Equal(t, domainItem.InStock(), result.Stock)
 	assert.Equal(t, domainItem.Description(), result.Description)
 	assert.IsType(t, time.Time{}, result.CreatedAt)
 	assert.IsType(t, time.Time{}, result.UpdatedAt)
 }
 
+func TestItemPresenter_ToJSON_Favorites(t *testing.T) {
+	favorited := createTestDomainItem().WithFavoriteCount(4)
+	other := createTestDomainItem()
+
+	anonymous := NewItemPresenter().ToJSON(favorited)
+	assert.Equal(t, 4, anonymous.FavoriteCount)
+	assert.Nil(t, anonymous.IsFavorited)
+
+	presenter := NewItemPresenter().WithFavorited(map[string]bool{favorited.ItemId(): true})
+	assert.True(t, *presenter.ToJSON(favorited).IsFavorited)
+	assert.False(t, *presenter.ToJSON(other).IsFavorited)
+	assert.Equal(t, 0, presenter.ToJSON(other).FavoriteCount)
+}
+
+func TestItemPresenter_ToJSON_Rating(t *testing.T) {
+	presenter := NewItemPresenter()
+
+	unrated := presenter.ToJSON(createTestDomainItem())
+	assert.Equal(t, 0.0, unrated.RatingAverage)
+	assert.Equal(t, 0, unrated.RatingCount)
+
+	rated := presenter.ToJSON(createTestDomainItem().WithRating(domain.NewRatingSummary(3, 13)))
+	assert.Equal(t, 4.3, rated.RatingAverage)
+	assert.Equal(t, 3, rated.RatingCount)
+}
+
+func TestItemPresenter_ToJSON_Yarn(t *testing.T) {
+	presenter := NewItemPresenter()
+	fibers, _ := domain.NewFiberComposition([]domain.FiberContent{{Fiber: domain.FiberMerino, Percentage: 70}, {Fiber: domain.FiberSilk, Percentage: 30}})
+	thin, _ := domain.NewNeedleSize(3.5)
+	thick, _ := domain.NewNeedleSize(4.5)
+	needles, _ := domain.NewNeedleRange(*thin, *thick)
+	gauge, _ := domain.NewGauge(22, 30)
+	yarn, _ := domain.NewYarnAttributes(domain.YarnDetails{
+		Weight:          domain.YarnWeightLight,
+		YardagePerSkein: 200,
+		GramsPerSkein:   50,
+		Fibers:          fibers,
+		NeedleRange:     needles,
+		Gauge:           gauge,
+	})
+
+	result := presenter.ToJSON(createTestDomainItem().WithYarn(yarn))
+
+	assert.Equal(t, "light", result.Yarn.Weight)
+	assert.Equal(t, 50, *result.Yarn.GramsPerSkein)
+	assert.Equal(t, []FiberContentJSON{{Fiber: "merino", Percentage: 70}, {Fiber: "silk", Percentage: 30}}, result.Yarn.Fibers)
+	assert.Equal(t, &NeedleRangeJSON{Min: 3.5, Max: 4.5}, result.Yarn.NeedleRange)
+	assert.Equal(t, &GaugeJSON{Stitches: 22, Rows: 30}, result.Yarn.Gauge)
+
+	// 重さ・針・ゲージが未登録なら null、素材が未登録なら空配列
+	minimal, _ := domain.NewYarnAttributes(domain.YarnDetails{Weight: domain.YarnWeightLight, YardagePerSkein: 200})
+	result = presenter.ToJSON(createTestDomainItem().WithYarn(minimal))
+
+	assert.Nil(t, result.Yarn.GramsPerSkein)
+	assert.Empty(t, result.Yarn.Fibers)
+	assert.NotNil(t, result.Yarn.Fibers)
+	assert.Nil(t, result.Yarn.NeedleRange)
+	assert.Nil(t, result.Yarn.Gauge)
+}
+
+func TestItemPresenter_ToJSON_StockQuantities(t *testing.T) {
+	presenter := NewItemPresenter()
+	userId, _ := domain.NewUserId(uuid.NewString())
+	itemName, _ := domain.NewItemName("Merino Wool")
+	description, _ := domain.NewDescription("Test Description")
+
+	stock, _ := domain.NewStock(5, 3, 2)
+	item, _ := domain.NewItem(nil, *userId, *itemName, *stock, *description, domain.ZeroYen())
+	result := presenter.ToJSON(item)
+	assert.True(t, result.Stock)
+	assert.Equal(t, 5, result.OnHandQuantity)
+	assert.Equal(t, 3, result.ReservedQuantity)
+	assert.Equal(t, 2, result.AvailableQuantity)
+	assert.Equal(t, 2, result.LowStockThreshold)
+	assert.True(t, result.LowStock)
+
+	// 全数が引き当て済みなら stock は false になる
+	stock, _ = domain.NewStock(3, 3, 2)
+	item, _ = domain.NewItem(nil, *userId, *itemName, *stock, *description, domain.ZeroYen())
+	result = presenter.ToJSON(item)
+	assert.False(t, result.Stock)
+	assert.False(t, result.LowStock)
+}
+
+func TestItemPresenter_ToJSON_VariantStock(t *testing.T) {
+	presenter := NewItemPresenter()
+	itemId, _ := domain.NewItemId(uuid.NewString())
+	userId, _ := domain.NewUserId(uuid.NewString())
+	itemName, _ := domain.NewItemName("Hand-knit socks")
+	description, _ := domain.NewDescription("Test Description")
+	stock, _ := domain.NewStock(0, 0, 1)
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
+
+	variants := domain.ItemVariants{}
+	for _, size := range []string{"M", "L"} {
+		sku, _ := domain.NewSkuCode("SOCK-" + size)
+		options, _ := domain.NewVariantOptions(map[string]string{"size": size})
+		variantStock, _ := domain.NewStock(3, 1, 0)
+		variants = append(variants, *domain.NewItemVariant(*itemId, *sku, *options, *variantStock, nil))
+	}
+	item, err := item.WithVariants(variants)
+	assert.NoError(t, err)
+
+	// 商品自体に在庫がなくても、バリエーションの在庫があれば販売可能として返す
+	result := presenter.ToJSON(item)
+	assert.True(t, result.Stock)
+	assert.Equal(t, 4, result.AvailableQuantity)
+	assert.Equal(t, 0, result.OnHandQuantity)
+	assert.False(t, result.LowStock)
+}
+
+func TestItemPresenter_ToJSON_Price(t *testing.T) {
+	presenter := NewItemPresenter()
+	userId, _ := domain.NewUserId(uuid.NewString())
+	itemName, _ := domain.NewItemName("Merino Wool")
+	stock, _ := domain.NewStock(1, 0, 0)
+	description, _ := domain.NewDescription("Test Description")
+	price, _ := domain.NewMoneyFromString("1980", domain.CurrencyJPY)
+	item, _ := domain.NewItem(nil, *userId, *itemName, *stock, *description, *price)
+
+	result := presenter.ToJSON(item)
+
+	assert.Equal(t, "1980", result.Price)
+	assert.Equal(t, "2178", result.PriceTaxIncluded)
+	assert.Equal(t, "¥2,178（税込）", result.PriceDisplay)
+	assert.Equal(t, "JPY", result.Currency)
+}
+
 func TestItemPresenter_ToJSONList(t *testing.T) {
 	presenter := NewItemPresenter()
 	domainItems := []*domain.Item{
@@ -49,7 +178,7 @@ func TestItemPresenter_ToJSONList(t *testing.T) {
 		assert.Equal(t, domainItems[i].ItemId(), jsonItem.ItemId)
 		assert.Equal(t, domainItems[i].UserId(), jsonItem.UserId)
 		assert.Equal(t, domainItems[i].ItemName(), jsonItem.ItemName)
-		assert.Equal(t, domainItems[i].Stock(), jsonItem.Stock)
+		assert.Equal(t, domainItems[i].InStock(), jsonItem.Stock)
 		assert.Equal(t, domainItems[i].Description(), jsonItem.Description)
 	}
 }
@@ -63,3 +192,49 @@ func TestItemPresenter_ToJSONList_EmptySlice(t *testing.T) {
 	assert.Empty(t, result)
 	assert.NotNil(t, result)
 }
+
+func TestItemPresenter_ToPageJSON(t *testing.T) {
+	presenter := NewItemPresenter()
+	first := createTestDomainItem()
+	second := createTestDomainItem()
+	total := int64(10)
+	sort, _ := domain.NewItemSort(nil)
+	cursor := domain.NewItemCursor(second, *sort)
+	page := domain.NewItemPage(domain.Items{*first, *second}, cursor, &total)
+
+	result := presenter.ToPageJSON(page)
+
+	assert.Len(t, result.Items, 2)
+	assert.Equal(t, first.ItemId(), result.Items[0].ItemId)
+	assert.Equal(t, second.ItemId(), result.Items[1].ItemId)
+	assert.NotNil(t, result.NextCursor)
+	assert.Equal(t, cursor.Encode(), *result.NextCursor)
+	assert.Equal(t, &total, result.Total)
+}
+
+func TestItemPresenter_ToPageJSON_LastPage(t *testing.T) {
+	presenter := NewItemPresenter()
+	page := domain.NewItemPage(domain.Items{}, nil, nil)
+
+	result := presenter.ToPageJSON(page)
+
+	assert.Empty(t, result.Items)
+	assert.NotNil(t, result.Items)
+	assert.Nil(t, result.NextCursor)
+	assert.Nil(t, result.Total)
+}
+
+func TestItemPresenter_ToSearchJSON(t *testing.T) {
+	presenter := NewItemPresenter()
+	domainItem := createTestDomainItem()
+	query, _ := domain.NewSearchQuery("item")
+	hits := []*domain.ItemSearchHit{domain.NewItemSearchHit(*domainItem, 2, *query)}
+
+	result := presenter.ToSearchJSON(hits)
+
+	assert.Len(t, result.Items, 1)
+	assert.Equal(t, domainItem.ItemId(), result.Items[0].ItemId)
+	assert.Equal(t, float64(2), result.Items[0].Score)
+	assert.Equal(t, "Test <mark>Item</mark>", result.Items[0].Highlight.ItemName)
+	assert.Equal(t, "", result.Items[0].Highlight.Description)
+}
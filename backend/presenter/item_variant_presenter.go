@@ -0,0 +1,93 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// ItemVariantResponseJSON の Price は上書き価格を反映した税抜価格
+// PriceOverride は管理画面向けに上書きの有無が分かるよう、上書きしていない場合は null にする
+type ItemVariantResponseJSON struct {
+	VariantId         string            `json:"variant_id"`
+	ItemId            string            `json:"item_id"`
+	Sku               string            `json:"sku"`
+	Options           map[string]string `json:"options"`
+	Stock             bool              `json:"stock"`
+	OnHandQuantity    int               `json:"on_hand_quantity"`
+	ReservedQuantity  int               `json:"reserved_quantity"`
+	AvailableQuantity int               `json:"available_quantity"`
+	LowStockThreshold int               `json:"low_stock_threshold"`
+	LowStock          bool              `json:"low_stock"`
+	Price             string            `json:"price"`
+	PriceTaxIncluded  string            `json:"price_tax_included"`
+	PriceDisplay      string            `json:"price_display"`
+	PriceOverride     *string           `json:"price_override"`
+	Currency          string            `json:"currency"`
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+}
+
+type ItemVariantListResponseJSON struct {
+	Items []ItemVariantResponseJSON `json:"items"`
+}
+
+type IItemVariantPresenter interface {
+	ToJSON(variant *domain.ItemVariant, item *domain.Item) ItemVariantResponseJSON
+	ToListJSON(item *domain.Item) ItemVariantListResponseJSON
+}
+
+type itemVariantPresenter struct{}
+
+func NewItemVariantPresenter() IItemVariantPresenter {
+	return &itemVariantPresenter{}
+}
+
+func (p *itemVariantPresenter) ToJSON(variant *domain.ItemVariant, item *domain.Item) ItemVariantResponseJSON {
+	return toItemVariantJSON(variant, item)
+}
+
+func (p *itemVariantPresenter) ToListJSON(item *domain.Item) ItemVariantListResponseJSON {
+	return ItemVariantListResponseJSON{Items: toItemVariantJSONList(item)}
+}
+
+func toItemVariantJSON(variant *domain.ItemVariant, item *domain.Item) ItemVariantResponseJSON {
+	stock := variant.Stock()
+	price := variant.EffectivePrice(*item.Price())
+	priceTaxIncluded := price.TaxIncludedAt(item.TaxRate())
+
+	var priceOverride *string
+	if override := variant.PriceOverride(); override != nil {
+		value := override.String()
+		priceOverride = &value
+	}
+
+	return ItemVariantResponseJSON{
+		VariantId:         variant.VariantId(),
+		ItemId:            variant.ItemId(),
+		Sku:               variant.Sku(),
+		Options:           variant.Options().Values(),
+		Stock:             stock.InStock(),
+		OnHandQuantity:    stock.OnHand(),
+		ReservedQuantity:  stock.Reserved(),
+		AvailableQuantity: stock.Available(),
+		LowStockThreshold: stock.LowStockThreshold(),
+		LowStock:          stock.IsLowStock(),
+		Price:             price.String(),
+		PriceTaxIncluded:  priceTaxIncluded.String(),
+		PriceDisplay:      priceTaxIncluded.TaxIncludedAmountDisplay(),
+		PriceOverride:     priceOverride,
+		Currency:          price.Currency(),
+		CreatedAt:         variant.CreatedAt(),
+		UpdatedAt:         variant.UpdatedAt(),
+	}
+}
+
+func toItemVariantJSONList(item *domain.Item) []ItemVariantResponseJSON {
+	variants := item.Variants()
+	result := make([]ItemVariantResponseJSON, len(variants))
+	for i := range variants {
+		result[i] = toItemVariantJSON(&variants[i], item)
+	}
+	return result
+}
@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemVariantPresenter_ToListJSON(t *testing.T) {
+	presenter := NewItemVariantPresenter()
+	itemId, _ := domain.NewItemId(uuid.NewString())
+	userId, _ := domain.NewUserId(uuid.NewString())
+	itemName, _ := domain.NewItemName("Hand-knit socks")
+	stock, _ := domain.NewStock(0, 0, 0)
+	description, _ := domain.NewDescription("Wool socks")
+	price, _ := domain.NewMoneyFromString("1000", domain.CurrencyJPY)
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, *price)
+
+	mediumSku, _ := domain.NewSkuCode("SOCK-M")
+	mediumOptions, _ := domain.NewVariantOptions(map[string]string{"size": "M", "color": "生成り"})
+	mediumStock, _ := domain.NewStock(3, 1, 2)
+	medium := domain.NewItemVariant(*itemId, *mediumSku, *mediumOptions, *mediumStock, nil)
+
+	largeSku, _ := domain.NewSkuCode("SOCK-L")
+	largeOptions, _ := domain.NewVariantOptions(map[string]string{"size": "L", "color": "生成り"})
+	largeStock, _ := domain.NewStock(0, 0, 0)
+	override, _ := domain.NewMoneyFromString("1200", domain.CurrencyJPY)
+	large := domain.NewItemVariant(*itemId, *largeSku, *largeOptions, *largeStock, override)
+
+	item, _ = item.WithVariants(domain.ItemVariants{*medium, *large})
+
+	result := presenter.ToListJSON(item)
+
+	assert.Len(t, result.Items, 2)
+	assert.Equal(t, "SOCK-M", result.Items[0].Sku)
+	assert.Equal(t, map[string]string{"size": "M", "color": "生成り"}, result.Items[0].Options)
+	assert.Equal(t, 2, result.Items[0].AvailableQuantity)
+	assert.True(t, result.Items[0].LowStock)
+	assert.Equal(t, "1000", result.Items[0].Price)
+	assert.Nil(t, result.Items[0].PriceOverride)
+
+	assert.False(t, result.Items[1].Stock)
+	assert.Equal(t, "1200", result.Items[1].Price)
+	assert.Equal(t, "1320", result.Items[1].PriceTaxIncluded)
+	assert.Equal(t, "¥1,320（税込）", result.Items[1].PriceDisplay)
+	assert.Equal(t, "1200", *result.Items[1].PriceOverride)
+
+	itemJSON := NewItemPresenter().ToJSON(item)
+	assert.Equal(t, result.Items, itemJSON.Variants)
+}
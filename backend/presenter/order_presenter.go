@@ -0,0 +1,136 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// OrderLineJSON の商品名・SKU・オプション・単価・税率は注文時点の値
+// VariantId・Sku・Options はバリエーションのない商品では null になる
+type OrderLineJSON struct {
+	LineId    string            `json:"line_id"`
+	ItemId    string            `json:"item_id"`
+	VariantId *string           `json:"variant_id"`
+	ItemName  string            `json:"item_name"`
+	Sku       *string           `json:"sku"`
+	Options   map[string]string `json:"options"`
+	UnitPrice string            `json:"unit_price"`
+	TaxRate   string            `json:"tax_rate"`
+	Quantity  int               `json:"quantity"`
+	LineTotal string            `json:"line_total"`
+}
+
+// OrderTransitionJSON の FromStatus は注文の作成では null になる
+type OrderTransitionJSON struct {
+	FromStatus *string   `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	UserId     string    `json:"user_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// OrderResponseJSON の Discount はクーポンによる税抜の割引額。CouponCode はクーポンを使っていない注文では null になる
+type OrderResponseJSON struct {
+	OrderId      string                `json:"order_id"`
+	Status       string                `json:"status"`
+	Items        []OrderLineJSON       `json:"items"`
+	Subtotal     string                `json:"subtotal"`
+	Discount     string                `json:"discount"`
+	Tax          string                `json:"tax"`
+	Total        string                `json:"total"`
+	TotalDisplay string                `json:"total_display"`
+	Currency     string                `json:"currency"`
+	CouponCode   *string               `json:"coupon_code"`
+	Transitions  []OrderTransitionJSON `json:"transitions"`
+	CreatedAt    time.Time             `json:"created_at"`
+}
+
+type OrderListResponseJSON struct {
+	Items []OrderResponseJSON `json:"items"`
+}
+
+type IOrderPresenter interface {
+	ToJSON(order *domain.Order) OrderResponseJSON
+	ToListJSON(orders []*domain.Order) OrderListResponseJSON
+}
+
+type orderPresenter struct{}
+
+func NewOrderPresenter() IOrderPresenter {
+	return &orderPresenter{}
+}
+
+func (p *orderPresenter) ToJSON(order *domain.Order) OrderResponseJSON {
+	lines := order.Lines()
+	items := make([]OrderLineJSON, len(lines))
+	for i := range lines {
+		items[i] = toOrderLineJSON(&lines[i])
+	}
+
+	transitions := order.Transitions()
+	history := make([]OrderTransitionJSON, len(transitions))
+	for i := range transitions {
+		history[i] = toOrderTransitionJSON(&transitions[i])
+	}
+
+	var couponCode *string
+	if order.CouponCode() != "" {
+		value := order.CouponCode()
+		couponCode = &value
+	}
+	total := order.Total()
+	return OrderResponseJSON{
+		OrderId:      order.OrderId(),
+		Status:       string(order.Status()),
+		Items:        items,
+		Subtotal:     order.Subtotal().String(),
+		Discount:     order.Discount().String(),
+		Tax:          order.Tax().String(),
+		Total:        total.String(),
+		TotalDisplay: total.TaxIncludedAmountDisplay(),
+		Currency:     total.Currency(),
+		CouponCode:   couponCode,
+		Transitions:  history,
+		CreatedAt:    order.CreatedAt(),
+	}
+}
+
+func (p *orderPresenter) ToListJSON(orders []*domain.Order) OrderListResponseJSON {
+	items := make([]OrderResponseJSON, len(orders))
+	for i, order := range orders {
+		items[i] = p.ToJSON(order)
+	}
+	return OrderListResponseJSON{Items: items}
+}
+
+func toOrderLineJSON(line *domain.OrderLine) OrderLineJSON {
+	result := OrderLineJSON{
+		LineId:    line.LineId(),
+		ItemId:    line.ItemId(),
+		ItemName:  line.ItemName(),
+		UnitPrice: line.UnitPrice().String(),
+		TaxRate:   string(line.TaxRate()),
+		Quantity:  line.Quantity(),
+		LineTotal: line.LineTotal().String(),
+	}
+	if variantId := line.VariantId(); variantId != "" {
+		sku := line.Sku()
+		result.VariantId = &variantId
+		result.Sku = &sku
+		result.Options = line.Options()
+	}
+	return result
+}
+
+func toOrderTransitionJSON(transition *domain.OrderTransition) OrderTransitionJSON {
+	result := OrderTransitionJSON{
+		ToStatus:  string(transition.To()),
+		UserId:    transition.ActorId(),
+		CreatedAt: transition.CreatedAt(),
+	}
+	if from := transition.From(); from != "" {
+		value := string(from)
+		result.FromStatus = &value
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+package presenter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderPresenter_ToJSON(t *testing.T) {
+	presenter := NewOrderPresenter()
+	userId, _ := domain.NewUserId(uuid.NewString())
+	yarnPrice, _ := domain.NewMoneyFromString("1000", domain.CurrencyJPY)
+	sockPrice, _ := domain.NewMoneyFromString("2500", domain.CurrencyJPY)
+	yarn := domain.RestoreOrderLine(uuid.NewString(), uuid.NewString(), "", "Merino Wool", "", nil, *yarnPrice, domain.TaxRateStandard, 2)
+	socks := domain.RestoreOrderLine(uuid.NewString(), uuid.NewString(), "variant-1", "Hand-knit socks", "SOCK-L", map[string]string{"size": "L"}, *sockPrice, domain.TaxRateStandard, 1)
+	subtotal, _ := domain.NewMoneyFromString("4500", domain.CurrencyJPY)
+	adminId, _ := domain.NewUserId(uuid.NewString())
+	transitions := []domain.OrderTransition{
+		*domain.RestoreOrderTransition(uuid.NewString(), "", domain.OrderStatusPendingPayment, *userId, time.Now()),
+		*domain.RestoreOrderTransition(uuid.NewString(), domain.OrderStatusPendingPayment, domain.OrderStatusPaid, *adminId, time.Now()),
+	}
+	order := domain.RestoreOrder(uuid.NewString(), *userId, domain.OrderStatusPaid, []domain.OrderLine{*yarn, *socks}, *subtotal, domain.ZeroYen(), *subtotal.TaxAmount(), *subtotal.TaxIncluded(), "", transitions, time.Now(), time.Now())
+
+	result := presenter.ToJSON(order)
+
+	assert.Equal(t, order.OrderId(), result.OrderId)
+	assert.Equal(t, "paid", result.Status)
+	assert.Equal(t, "4500", result.Subtotal)
+	assert.Equal(t, "450", result.Tax)
+	assert.Equal(t, "4950", result.Total)
+	assert.Equal(t, "¥4,950（税込）", result.TotalDisplay)
+	assert.Equal(t, "JPY", result.Currency)
+	assert.Equal(t, "0", result.Discount)
+	assert.Nil(t, result.CouponCode)
+	assert.Len(t, result.Items, 2)
+	assert.Equal(t, "2000", result.Items[0].LineTotal)
+	assert.Equal(t, "standard", result.Items[0].TaxRate)
+	assert.Nil(t, result.Items[0].VariantId)
+	assert.Nil(t, result.Items[0].Sku)
+	assert.Equal(t, "SOCK-L", *result.Items[1].Sku)
+	assert.Equal(t, map[string]string{"size": "L"}, result.Items[1].Options)
+
+	assert.Len(t, result.Transitions, 2)
+	assert.Nil(t, result.Transitions[0].FromStatus)
+	assert.Equal(t, "pending_payment", *result.Transitions[1].FromStatus)
+	assert.Equal(t, adminId.Value(), result.Transitions[1].UserId)
+
+	body, err := json.Marshal(result.Items[0])
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"options":null`)
+}
+
+func TestOrderPresenter_ToJSON_WithCoupon(t *testing.T) {
+	userId, _ := domain.NewUserId(uuid.NewString())
+	price, _ := domain.NewMoneyFromString("1000", domain.CurrencyJPY)
+	line := domain.RestoreOrderLine(uuid.NewString(), uuid.NewString(), "", "Merino Wool", "", nil, *price, domain.TaxRateStandard, 3)
+	subtotal, _ := domain.NewMoneyFromString("3000", domain.CurrencyJPY)
+	discount, _ := domain.NewMoneyFromString("300", domain.CurrencyJPY)
+	tax, _ := domain.NewMoneyFromString("270", domain.CurrencyJPY)
+	total, _ := domain.NewMoneyFromString("2970", domain.CurrencyJPY)
+	order := domain.RestoreOrder(uuid.NewString(), *userId, domain.OrderStatusPendingPayment, []domain.OrderLine{*line}, *subtotal, *discount, *tax, *total, "WELCOME10", nil, time.Now(), time.Now())
+
+	result := NewOrderPresenter().ToJSON(order)
+
+	assert.Equal(t, "300", result.Discount)
+	assert.Equal(t, "WELCOME10", *result.CouponCode)
+	assert.Equal(t, "2970", result.Total)
+	assert.Equal(t, "¥2,970（税込）", result.TotalDisplay)
+}
+
+func TestOrderPresenter_ToListJSON_Empty(t *testing.T) {
+	result := NewOrderPresenter().ToListJSON(nil)
+
+	body, err := json.Marshal(result)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"items":[]}`, string(body))
+}
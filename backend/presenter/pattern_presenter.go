@@ -0,0 +1,127 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// GaugeJSON は10cm四方の目数と段数
+type GaugeJSON struct {
+	Stitches float64 `json:"stitches"`
+	Rows     float64 `json:"rows"`
+}
+
+// PatternSizeJSON の Yardage はそのサイズを編むのに必要な糸の長さ（m）
+type PatternSizeJSON struct {
+	Label                string `json:"label"`
+	FinishedMeasurements string `json:"finished_measurements"`
+	Yardage              int    `json:"yardage"`
+}
+
+// PatternJSON の NeedleSizes は針の太さ（mm）を細い順に並べたもの、ItemIds はパターンを編むのに使える商品
+type PatternJSON struct {
+	PatternId   string            `json:"pattern_id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Difficulty  string            `json:"difficulty"`
+	Gauge       GaugeJSON         `json:"gauge"`
+	NeedleSizes []float64         `json:"needle_sizes"`
+	YarnWeight  string            `json:"yarn_weight"`
+	Sizes       []PatternSizeJSON `json:"sizes"`
+	ItemIds     []string          `json:"item_ids"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+type PatternListResponseJSON struct {
+	Patterns []PatternJSON `json:"patterns"`
+}
+
+// PatternItemJSON の SkeinsNeeded は選んだサイズを編むのに必要な玉数で、サイズを選んでいないか毛糸の属性がない商品では null
+type PatternItemJSON struct {
+	ItemResponseJSON
+	SkeinsNeeded *int `json:"skeins_needed"`
+}
+
+// PatternDetailJSON の SelectedSize はサイズを選んでいなければ null、Items は削除されていない使える商品
+type PatternDetailJSON struct {
+	PatternJSON
+	SelectedSize *PatternSizeJSON  `json:"selected_size"`
+	Items        []PatternItemJSON `json:"items"`
+}
+
+type IPatternPresenter interface {
+	ToJSON(pattern *domain.Pattern) PatternJSON
+	ToListJSON(patterns []*domain.Pattern) PatternListResponseJSON
+	ToDetailJSON(guide *domain.PatternGuide) PatternDetailJSON
+}
+
+type patternPresenter struct {
+	ip IItemPresenter
+}
+
+func NewPatternPresenter() IPatternPresenter {
+	return &patternPresenter{ip: NewItemPresenter()}
+}
+
+func (p *patternPresenter) ToJSON(pattern *domain.Pattern) PatternJSON {
+	needleSizes := make([]float64, 0, len(pattern.NeedleSizes()))
+	for _, size := range pattern.NeedleSizes() {
+		needleSizes = append(needleSizes, size.Millimeters())
+	}
+	sizes := make([]PatternSizeJSON, 0, len(pattern.Sizes()))
+	for _, size := range pattern.Sizes() {
+		sizes = append(sizes, toPatternSizeJSON(size))
+	}
+	return PatternJSON{
+		PatternId:   pattern.PatternId(),
+		Name:        pattern.Name(),
+		Description: pattern.Description(),
+		Difficulty:  string(pattern.Difficulty()),
+		Gauge:       GaugeJSON{Stitches: pattern.Gauge().Stitches(), Rows: pattern.Gauge().Rows()},
+		NeedleSizes: needleSizes,
+		YarnWeight:  string(pattern.YarnWeight()),
+		Sizes:       sizes,
+		ItemIds:     pattern.ItemIds(),
+		CreatedAt:   pattern.CreatedAt(),
+		UpdatedAt:   pattern.UpdatedAt(),
+	}
+}
+
+func (p *patternPresenter) ToListJSON(patterns []*domain.Pattern) PatternListResponseJSON {
+	items := make([]PatternJSON, len(patterns))
+	for i, pattern := range patterns {
+		items[i] = p.ToJSON(pattern)
+	}
+	return PatternListResponseJSON{Patterns: items}
+}
+
+func (p *patternPresenter) ToDetailJSON(guide *domain.PatternGuide) PatternDetailJSON {
+	var selectedSize *PatternSizeJSON
+	if size := guide.Size(); size != nil {
+		sizeJSON := toPatternSizeJSON(*size)
+		selectedSize = &sizeJSON
+	}
+	items := make([]PatternItemJSON, 0, len(guide.Items()))
+	for _, item := range guide.Items() {
+		var skeinsNeeded *int
+		if skeins, ok := guide.Skeins(&item); ok {
+			skeinsNeeded = &skeins
+		}
+		items = append(items, PatternItemJSON{ItemResponseJSON: p.ip.ToJSON(&item), SkeinsNeeded: skeinsNeeded})
+	}
+	return PatternDetailJSON{
+		PatternJSON:  p.ToJSON(guide.Pattern()),
+		SelectedSize: selectedSize,
+		Items:        items,
+	}
+}
+
+func toPatternSizeJSON(size domain.PatternSize) PatternSizeJSON {
+	return PatternSizeJSON{
+		Label:                size.Label,
+		FinishedMeasurements: size.FinishedMeasurements,
+		Yardage:              size.Yardage,
+	}
+}
@@ -0,0 +1,47 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// PaymentResponseJSON の ProviderPaymentId は決済代行サービスでの決済 ID。オーソリを依頼する前は null になる
+type PaymentResponseJSON struct {
+	PaymentId         string    `json:"payment_id"`
+	OrderId           string    `json:"order_id"`
+	ProviderPaymentId *string   `json:"provider_payment_id"`
+	Status            string    `json:"status"`
+	Amount            string    `json:"amount"`
+	Currency          string    `json:"currency"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type IPaymentPresenter interface {
+	ToJSON(payment *domain.Payment) PaymentResponseJSON
+}
+
+type paymentPresenter struct{}
+
+func NewPaymentPresenter() IPaymentPresenter {
+	return &paymentPresenter{}
+}
+
+func (p *paymentPresenter) ToJSON(payment *domain.Payment) PaymentResponseJSON {
+	var providerPaymentId *string
+	if payment.ProviderPaymentId() != "" {
+		value := payment.ProviderPaymentId()
+		providerPaymentId = &value
+	}
+	return PaymentResponseJSON{
+		PaymentId:         payment.PaymentId(),
+		OrderId:           payment.OrderId(),
+		ProviderPaymentId: providerPaymentId,
+		Status:            string(payment.Status()),
+		Amount:            payment.Amount().String(),
+		Currency:          payment.Amount().Currency(),
+		CreatedAt:         payment.CreatedAt(),
+		UpdatedAt:         payment.UpdatedAt(),
+	}
+}
@@ -0,0 +1,27 @@
+package presenter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentPresenter_ToJSON(t *testing.T) {
+	presenter := NewPaymentPresenter()
+	amount, _ := domain.NewMoneyFromString("4950", domain.CurrencyJPY)
+	payment := domain.RestorePayment(uuid.NewString(), uuid.NewString(), "fakepay_1", domain.PaymentStatusCaptured, *amount, time.Now(), time.Now())
+	pending := domain.RestorePayment(uuid.NewString(), uuid.NewString(), "", domain.PaymentStatusPending, *amount, time.Now(), time.Now())
+
+	result := presenter.ToJSON(payment)
+
+	assert.Equal(t, payment.PaymentId(), result.PaymentId)
+	assert.Equal(t, payment.OrderId(), result.OrderId)
+	assert.Equal(t, "fakepay_1", *result.ProviderPaymentId)
+	assert.Equal(t, "captured", result.Status)
+	assert.Equal(t, "4950", result.Amount)
+	assert.Equal(t, domain.CurrencyJPY, result.Currency)
+	assert.Nil(t, presenter.ToJSON(pending).ProviderPaymentId)
+}
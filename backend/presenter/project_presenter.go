@@ -0,0 +1,90 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// ProjectJSON の ItemId・PatternId は商品・編み図と結び付けない作品では null
+type ProjectJSON struct {
+	ProjectId          string    `json:"project_id"`
+	Name               string    `json:"name"`
+	ItemId             *string   `json:"item_id"`
+	PatternId          *string   `json:"pattern_id"`
+	ProgressPercentage int       `json:"progress_percentage"`
+	RowCount           int       `json:"row_count"`
+	Notes              string    `json:"notes"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+type ProjectListResponseJSON struct {
+	Projects []ProjectJSON `json:"projects"`
+}
+
+type ProjectSnapshotJSON struct {
+	ProgressPercentage int       `json:"progress_percentage"`
+	RowCount           int       `json:"row_count"`
+	RecordedAt         time.Time `json:"recorded_at"`
+}
+
+// ProjectHistoryResponseJSON の Snapshots は記録の古い順
+type ProjectHistoryResponseJSON struct {
+	ProjectId string                `json:"project_id"`
+	Snapshots []ProjectSnapshotJSON `json:"snapshots"`
+}
+
+type IProjectPresenter interface {
+	ToJSON(project *domain.Project) ProjectJSON
+	ToListJSON(projects []*domain.Project) ProjectListResponseJSON
+	ToHistoryJSON(projectId string, snapshots []*domain.ProjectSnapshot) ProjectHistoryResponseJSON
+}
+
+type projectPresenter struct{}
+
+func NewProjectPresenter() IProjectPresenter {
+	return &projectPresenter{}
+}
+
+func (p *projectPresenter) ToJSON(project *domain.Project) ProjectJSON {
+	var itemId *string
+	if id := project.ItemId(); id != "" {
+		itemId = &id
+	}
+	var patternId *string
+	if id := project.PatternId(); id != "" {
+		patternId = &id
+	}
+	return ProjectJSON{
+		ProjectId:          project.ProjectId(),
+		Name:               project.Name(),
+		ItemId:             itemId,
+		PatternId:          patternId,
+		ProgressPercentage: project.Progress(),
+		RowCount:           project.RowCount(),
+		Notes:              project.Notes(),
+		CreatedAt:          project.CreatedAt(),
+		UpdatedAt:          project.UpdatedAt(),
+	}
+}
+
+func (p *projectPresenter) ToListJSON(projects []*domain.Project) ProjectListResponseJSON {
+	items := make([]ProjectJSON, len(projects))
+	for i, project := range projects {
+		items[i] = p.ToJSON(project)
+	}
+	return ProjectListResponseJSON{Projects: items}
+}
+
+func (p *projectPresenter) ToHistoryJSON(projectId string, snapshots []*domain.ProjectSnapshot) ProjectHistoryResponseJSON {
+	items := make([]ProjectSnapshotJSON, len(snapshots))
+	for i, snapshot := range snapshots {
+		items[i] = ProjectSnapshotJSON{
+			ProgressPercentage: snapshot.Progress(),
+			RowCount:           snapshot.RowCount(),
+			RecordedAt:         snapshot.RecordedAt(),
+		}
+	}
+	return ProjectHistoryResponseJSON{ProjectId: projectId, Snapshots: items}
+}
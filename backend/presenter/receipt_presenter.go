@@ -0,0 +1,43 @@
+package presenter
+
+import (
+	"github.com/posiposi/project/backend/domain"
+)
+
+const (
+	ReceiptFormatHTML = "html"
+	ReceiptFormatText = "text"
+)
+
+// ReceiptDocument はダウンロードさせる領収書のファイル。Body は発行時に保存した内容をそのまま使う
+type ReceiptDocument struct {
+	Filename    string
+	ContentType string
+	Body        []byte
+}
+
+type IReceiptPresenter interface {
+	ToDocument(receipt *domain.Receipt, format string) ReceiptDocument
+}
+
+type receiptPresenter struct{}
+
+func NewReceiptPresenter() IReceiptPresenter {
+	return &receiptPresenter{}
+}
+
+// ToDocument は format が "text" ならテキスト、それ以外なら HTML の領収書を返す
+func (p *receiptPresenter) ToDocument(receipt *domain.Receipt, format string) ReceiptDocument {
+	if format == ReceiptFormatText {
+		return ReceiptDocument{
+			Filename:    "receipt-" + receipt.ReceiptNumber() + ".txt",
+			ContentType: "text/plain; charset=UTF-8",
+			Body:        []byte(receipt.Text()),
+		}
+	}
+	return ReceiptDocument{
+		Filename:    "receipt-" + receipt.ReceiptNumber() + ".html",
+		ContentType: "text/html; charset=UTF-8",
+		Body:        []byte(receipt.HTML()),
+	}
+}
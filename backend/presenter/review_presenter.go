@@ -0,0 +1,57 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// ReviewJSON の AuthorName はレビューを書いたユーザーの現在の名前
+type ReviewJSON struct {
+	ReviewId   string    `json:"review_id"`
+	ItemId     string    `json:"item_id"`
+	UserId     string    `json:"user_id"`
+	AuthorName string    `json:"author_name"`
+	Rating     int       `json:"rating"`
+	Title      string    `json:"title"`
+	Body       string    `json:"body"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type ReviewListResponseJSON struct {
+	Reviews []ReviewJSON `json:"reviews"`
+}
+
+type IReviewPresenter interface {
+	ToJSON(review *domain.Review) ReviewJSON
+	ToListJSON(reviews []*domain.Review) ReviewListResponseJSON
+}
+
+type reviewPresenter struct{}
+
+func NewReviewPresenter() IReviewPresenter {
+	return &reviewPresenter{}
+}
+
+func (p *reviewPresenter) ToJSON(review *domain.Review) ReviewJSON {
+	return ReviewJSON{
+		ReviewId:   review.ReviewId(),
+		ItemId:     review.ItemId(),
+		UserId:     review.UserId(),
+		AuthorName: review.AuthorName(),
+		Rating:     review.Rating(),
+		Title:      review.Title(),
+		Body:       review.Body(),
+		CreatedAt:  review.CreatedAt(),
+		UpdatedAt:  review.UpdatedAt(),
+	}
+}
+
+func (p *reviewPresenter) ToListJSON(reviews []*domain.Review) ReviewListResponseJSON {
+	items := make([]ReviewJSON, len(reviews))
+	for i, review := range reviews {
+		items[i] = p.ToJSON(review)
+	}
+	return ReviewListResponseJSON{Reviews: items}
+}
@@ -0,0 +1,61 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// ShippingAddressResponseJSON の PrefectureCode は JIS X 0401 の都道府県コード、PhoneNumber はハイフンを除いた電話番号
+type ShippingAddressResponseJSON struct {
+	AddressId      string    `json:"address_id"`
+	RecipientName  string    `json:"recipient_name"`
+	PostalCode     string    `json:"postal_code"`
+	Prefecture     string    `json:"prefecture"`
+	PrefectureCode int       `json:"prefecture_code"`
+	City           string    `json:"city"`
+	AddressLine1   string    `json:"address_line1"`
+	AddressLine2   string    `json:"address_line2"`
+	PhoneNumber    string    `json:"phone_number"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type ShippingAddressListResponseJSON struct {
+	Items []ShippingAddressResponseJSON `json:"items"`
+}
+
+type IShippingAddressPresenter interface {
+	ToJSON(address *domain.ShippingAddress) ShippingAddressResponseJSON
+	ToListJSON(addresses []*domain.ShippingAddress) ShippingAddressListResponseJSON
+}
+
+type shippingAddressPresenter struct{}
+
+func NewShippingAddressPresenter() IShippingAddressPresenter {
+	return &shippingAddressPresenter{}
+}
+
+func (p *shippingAddressPresenter) ToJSON(address *domain.ShippingAddress) ShippingAddressResponseJSON {
+	return ShippingAddressResponseJSON{
+		AddressId:      address.AddressId(),
+		RecipientName:  address.RecipientName(),
+		PostalCode:     address.PostalCode().Value(),
+		Prefecture:     address.Prefecture().Name(),
+		PrefectureCode: address.Prefecture().Code(),
+		City:           address.City(),
+		AddressLine1:   address.AddressLine1(),
+		AddressLine2:   address.AddressLine2(),
+		PhoneNumber:    address.PhoneNumber(),
+		CreatedAt:      address.CreatedAt(),
+		UpdatedAt:      address.UpdatedAt(),
+	}
+}
+
+func (p *shippingAddressPresenter) ToListJSON(addresses []*domain.ShippingAddress) ShippingAddressListResponseJSON {
+	items := make([]ShippingAddressResponseJSON, len(addresses))
+	for i, address := range addresses {
+		items[i] = p.ToJSON(address)
+	}
+	return ShippingAddressListResponseJSON{Items: items}
+}
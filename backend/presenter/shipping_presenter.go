@@ -0,0 +1,94 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// ShippingRateResponseJSON の Fee は税抜、FeeDisplay は税込の配送料
+type ShippingRateResponseJSON struct {
+	Prefecture     string `json:"prefecture"`
+	PrefectureCode int    `json:"prefecture_code"`
+	Fee            string `json:"fee"`
+	FeeDisplay     string `json:"fee_display"`
+}
+
+// ShippingRateTableResponseJSON の FreeShippingThreshold は税抜の基準額で、送料無料にしない場合は null
+type ShippingRateTableResponseJSON struct {
+	Rates                 []ShippingRateResponseJSON `json:"rates"`
+	FreeShippingThreshold *string                    `json:"free_shipping_threshold"`
+	Currency              string                     `json:"currency"`
+	UpdatedAt             time.Time                  `json:"updated_at"`
+}
+
+// ShippingQuoteResponseJSON の Subtotal・Fee は税抜
+// RemainingForFreeShipping は送料無料まであといくら購入すればよいかで、送料無料にならない表やすでに送料無料の場合は null
+type ShippingQuoteResponseJSON struct {
+	Prefecture               string  `json:"prefecture"`
+	PrefectureCode           int     `json:"prefecture_code"`
+	Subtotal                 string  `json:"subtotal"`
+	Fee                      string  `json:"fee"`
+	FeeDisplay               string  `json:"fee_display"`
+	IsFreeShipping           bool    `json:"is_free_shipping"`
+	FreeShippingThreshold    *string `json:"free_shipping_threshold"`
+	RemainingForFreeShipping *string `json:"remaining_for_free_shipping"`
+	Currency                 string  `json:"currency"`
+}
+
+type IShippingPresenter interface {
+	ToRateTableJSON(table *domain.ShippingRateTable) ShippingRateTableResponseJSON
+	ToQuoteJSON(quote *domain.ShippingQuote) ShippingQuoteResponseJSON
+}
+
+type shippingPresenter struct{}
+
+func NewShippingPresenter() IShippingPresenter {
+	return &shippingPresenter{}
+}
+
+func (p *shippingPresenter) ToRateTableJSON(table *domain.ShippingRateTable) ShippingRateTableResponseJSON {
+	result := ShippingRateTableResponseJSON{
+		Rates:     make([]ShippingRateResponseJSON, 0, len(table.Rates())),
+		Currency:  domain.CurrencyJPY,
+		UpdatedAt: table.UpdatedAt(),
+	}
+	for _, rate := range table.Rates() {
+		fee := rate.Fee()
+		result.Rates = append(result.Rates, ShippingRateResponseJSON{
+			Prefecture:     rate.Prefecture().Name(),
+			PrefectureCode: rate.Prefecture().Code(),
+			Fee:            fee.String(),
+			FeeDisplay:     fee.TaxIncludedDisplay(),
+		})
+		result.Currency = fee.Currency()
+	}
+	if threshold := table.FreeShippingThreshold(); threshold != nil {
+		value := threshold.String()
+		result.FreeShippingThreshold = &value
+		result.Currency = threshold.Currency()
+	}
+	return result
+}
+
+func (p *shippingPresenter) ToQuoteJSON(quote *domain.ShippingQuote) ShippingQuoteResponseJSON {
+	fee := quote.Fee()
+	result := ShippingQuoteResponseJSON{
+		Prefecture:     quote.Prefecture().Name(),
+		PrefectureCode: quote.Prefecture().Code(),
+		Subtotal:       quote.Subtotal().String(),
+		Fee:            fee.String(),
+		FeeDisplay:     fee.TaxIncludedDisplay(),
+		IsFreeShipping: quote.IsFree(),
+		Currency:       fee.Currency(),
+	}
+	if threshold := quote.FreeShippingThreshold(); threshold != nil {
+		value := threshold.String()
+		result.FreeShippingThreshold = &value
+	}
+	if remaining := quote.RemainingForFreeShipping(); remaining != nil {
+		value := remaining.String()
+		result.RemainingForFreeShipping = &value
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package presenter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShippingAddressPresenter_ToJSON(t *testing.T) {
+	presenter := NewShippingAddressPresenter()
+	userId, _ := domain.NewUserId(uuid.NewString())
+	postalCode, _ := domain.NewPostalCode("9000001")
+	prefecture, _ := domain.NewPrefecture("沖縄県")
+	address := domain.RestoreShippingAddress(uuid.NewString(), *userId, domain.ShippingAddressLines{
+		RecipientName: "山田 花子",
+		PostalCode:    *postalCode,
+		Prefecture:    *prefecture,
+		City:          "那覇市",
+		AddressLine1:  "港町1-1",
+		PhoneNumber:   "0981234567",
+	}, time.Now(), time.Now())
+
+	result := presenter.ToJSON(address)
+
+	assert.Equal(t, "900-0001", result.PostalCode)
+	assert.Equal(t, "沖縄県", result.Prefecture)
+	assert.Equal(t, 47, result.PrefectureCode)
+	assert.Equal(t, "", result.AddressLine2)
+}
+
+func TestShippingPresenter_ToQuoteJSON(t *testing.T) {
+	presenter := NewShippingPresenter()
+	tokyo, _ := domain.NewPrefecture("東京都")
+	fee, _ := domain.NewMoneyFromString("800", domain.CurrencyJPY)
+	threshold, _ := domain.NewMoneyFromString("5000", domain.CurrencyJPY)
+	table, _ := domain.NewShippingRateTable([]domain.ShippingRate{*domain.NewShippingRate(*tokyo, *fee)}, threshold)
+	subtotal, _ := domain.NewMoneyFromString("3500", domain.CurrencyJPY)
+	quote, _ := table.Quote(*tokyo, *subtotal)
+
+	result := presenter.ToQuoteJSON(quote)
+
+	assert.Equal(t, "東京都", result.Prefecture)
+	assert.Equal(t, "3500", result.Subtotal)
+	assert.Equal(t, "800", result.Fee)
+	assert.Equal(t, "¥880（税込）", result.FeeDisplay)
+	assert.False(t, result.IsFreeShipping)
+	assert.Equal(t, "5000", *result.FreeShippingThreshold)
+	assert.Equal(t, "1500", *result.RemainingForFreeShipping)
+}
+
+func TestShippingPresenter_ToRateTableJSON(t *testing.T) {
+	presenter := NewShippingPresenter()
+	table := domain.RestoreShippingRateTable(nil, nil, time.Now())
+
+	result := presenter.ToRateTableJSON(table)
+
+	assert.Nil(t, result.FreeShippingThreshold)
+	body, err := json.Marshal(result)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"rates":[]`)
+	assert.Contains(t, string(body), `"free_shipping_threshold":null`)
+}
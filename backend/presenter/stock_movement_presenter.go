@@ -0,0 +1,103 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// StockMovementResponseJSON の VariantId は商品の在庫の移動では空文字列
+type StockMovementResponseJSON struct {
+	MovementId    string    `json:"movement_id"`
+	ItemId        string    `json:"item_id"`
+	VariantId     string    `json:"variant_id"`
+	MovementType  string    `json:"movement_type"`
+	QuantityDelta int       `json:"quantity_delta"`
+	Reason        string    `json:"reason"`
+	UserId        string    `json:"user_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type StockMovementListResponseJSON struct {
+	Items []StockMovementResponseJSON `json:"items"`
+}
+
+// RecordStockMovementResponseJSON は記録した移動と、反映後の商品をまとめて返す
+type RecordStockMovementResponseJSON struct {
+	Movement StockMovementResponseJSON `json:"movement"`
+	Item     ItemResponseJSON          `json:"item"`
+}
+
+// StockDriftJSON の VariantId は商品の在庫のずれでは空文字列
+type StockDriftJSON struct {
+	ItemId         string `json:"item_id"`
+	VariantId      string `json:"variant_id"`
+	OnHandQuantity int    `json:"on_hand_quantity"`
+	LedgerTotal    int    `json:"ledger_total"`
+	Difference     int    `json:"difference"`
+}
+
+type StockReconciliationResponseJSON struct {
+	Consistent bool             `json:"consistent"`
+	Drifts     []StockDriftJSON `json:"drifts"`
+}
+
+type IStockMovementPresenter interface {
+	ToJSON(movement *domain.StockMovement) StockMovementResponseJSON
+	ToListJSON(movements []*domain.StockMovement) StockMovementListResponseJSON
+	ToRecordJSON(movement *domain.StockMovement, item *domain.Item) RecordStockMovementResponseJSON
+	ToReconciliationJSON(drifts []*domain.StockDrift) StockReconciliationResponseJSON
+}
+
+type stockMovementPresenter struct {
+	ip IItemPresenter
+}
+
+func NewStockMovementPresenter() IStockMovementPresenter {
+	return &stockMovementPresenter{NewItemPresenter()}
+}
+
+func (p *stockMovementPresenter) ToJSON(movement *domain.StockMovement) StockMovementResponseJSON {
+	return StockMovementResponseJSON{
+		MovementId:    movement.MovementId(),
+		ItemId:        movement.ItemId(),
+		VariantId:     movement.VariantId(),
+		MovementType:  movement.MovementType(),
+		QuantityDelta: movement.QuantityDelta(),
+		Reason:        movement.Reason(),
+		UserId:        movement.UserId(),
+		CreatedAt:     movement.CreatedAt(),
+	}
+}
+
+func (p *stockMovementPresenter) ToListJSON(movements []*domain.StockMovement) StockMovementListResponseJSON {
+	items := make([]StockMovementResponseJSON, len(movements))
+	for i, movement := range movements {
+		items[i] = p.ToJSON(movement)
+	}
+	return StockMovementListResponseJSON{Items: items}
+}
+
+func (p *stockMovementPresenter) ToRecordJSON(movement *domain.StockMovement, item *domain.Item) RecordStockMovementResponseJSON {
+	return RecordStockMovementResponseJSON{
+		Movement: p.ToJSON(movement),
+		Item:     p.ip.ToJSON(item),
+	}
+}
+
+func (p *stockMovementPresenter) ToReconciliationJSON(drifts []*domain.StockDrift) StockReconciliationResponseJSON {
+	result := make([]StockDriftJSON, len(drifts))
+	for i, drift := range drifts {
+		result[i] = StockDriftJSON{
+			ItemId:         drift.ItemId(),
+			VariantId:      drift.VariantId(),
+			OnHandQuantity: drift.OnHand(),
+			LedgerTotal:    drift.LedgerTotal(),
+			Difference:     drift.Difference(),
+		}
+	}
+	return StockReconciliationResponseJSON{
+		Consistent: len(drifts) == 0,
+		Drifts:     result,
+	}
+}
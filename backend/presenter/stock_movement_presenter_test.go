@@ -0,0 +1,42 @@
+package presenter
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStockMovementPresenter_ToListJSON(t *testing.T) {
+	presenter := NewStockMovementPresenter()
+	itemId, _ := domain.NewItemId(uuid.NewString())
+	userId, _ := domain.NewUserId(uuid.NewString())
+	movementType, _ := domain.NewStockMovementType(domain.StockMovementSale)
+	movement, _ := domain.NewStockMovement(*itemId, *movementType, -2, "店頭販売", *userId)
+
+	result := presenter.ToListJSON([]*domain.StockMovement{movement})
+
+	assert.Len(t, result.Items, 1)
+	assert.Equal(t, movement.MovementId(), result.Items[0].MovementId)
+	assert.Equal(t, itemId.Value(), result.Items[0].ItemId)
+	assert.Equal(t, "sale", result.Items[0].MovementType)
+	assert.Equal(t, -2, result.Items[0].QuantityDelta)
+	assert.Equal(t, "店頭販売", result.Items[0].Reason)
+	assert.Equal(t, userId.Value(), result.Items[0].UserId)
+}
+
+func TestStockMovementPresenter_ToReconciliationJSON(t *testing.T) {
+	presenter := NewStockMovementPresenter()
+
+	result := presenter.ToReconciliationJSON(nil)
+	assert.True(t, result.Consistent)
+	assert.NotNil(t, result.Drifts)
+
+	itemId := uuid.NewString()
+	variantId := uuid.NewString()
+	result = presenter.ToReconciliationJSON([]*domain.StockDrift{domain.NewStockDrift(itemId, "", 5, 3), domain.NewStockDrift(itemId, variantId, 1, 2)})
+	assert.False(t, result.Consistent)
+	assert.Equal(t, StockDriftJSON{ItemId: itemId, OnHandQuantity: 5, LedgerTotal: 3, Difference: 2}, result.Drifts[0])
+	assert.Equal(t, StockDriftJSON{ItemId: itemId, VariantId: variantId, OnHandQuantity: 1, LedgerTotal: 2, Difference: -1}, result.Drifts[1])
+}
@@ -0,0 +1,67 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+)
+
+// StockSubscriptionJSON の SubscribedAt は再入荷通知を申し込んだ日時
+type StockSubscriptionJSON struct {
+	ItemId       string    `json:"item_id"`
+	ItemName     string    `json:"item_name"`
+	SubscribedAt time.Time `json:"subscribed_at"`
+}
+
+type StockSubscriptionListResponseJSON struct {
+	Subscriptions []StockSubscriptionJSON `json:"subscriptions"`
+}
+
+type ItemDemandJSON struct {
+	ItemId             string    `json:"item_id"`
+	ItemName           string    `json:"item_name"`
+	SubscriberCount    int       `json:"subscriber_count"`
+	FirstSubscribedAt  time.Time `json:"first_subscribed_at"`
+	LatestSubscribedAt time.Time `json:"latest_subscribed_at"`
+}
+
+type ItemDemandListResponseJSON struct {
+	Items []ItemDemandJSON `json:"items"`
+}
+
+type IStockSubscriptionPresenter interface {
+	ToListJSON(subscriptions []*domain.StockSubscription) StockSubscriptionListResponseJSON
+	ToDemandJSON(demand []*domain.ItemDemand) ItemDemandListResponseJSON
+}
+
+type stockSubscriptionPresenter struct{}
+
+func NewStockSubscriptionPresenter() IStockSubscriptionPresenter {
+	return &stockSubscriptionPresenter{}
+}
+
+func (p *stockSubscriptionPresenter) ToListJSON(subscriptions []*domain.StockSubscription) StockSubscriptionListResponseJSON {
+	items := make([]StockSubscriptionJSON, len(subscriptions))
+	for i, subscription := range subscriptions {
+		items[i] = StockSubscriptionJSON{
+			ItemId:       subscription.ItemId(),
+			ItemName:     subscription.ItemName(),
+			SubscribedAt: subscription.CreatedAt(),
+		}
+	}
+	return StockSubscriptionListResponseJSON{Subscriptions: items}
+}
+
+func (p *stockSubscriptionPresenter) ToDemandJSON(demand []*domain.ItemDemand) ItemDemandListResponseJSON {
+	items := make([]ItemDemandJSON, len(demand))
+	for i, d := range demand {
+		items[i] = ItemDemandJSON{
+			ItemId:             d.ItemId(),
+			ItemName:           d.ItemName(),
+			SubscriberCount:    d.SubscriberCount(),
+			FirstSubscribedAt:  d.FirstSubscribedAt(),
+			LatestSubscribedAt: d.LatestSubscribedAt(),
+		}
+	}
+	return ItemDemandListResponseJSON{Items: items}
+}
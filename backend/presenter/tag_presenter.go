@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"github.com/posiposi/project/backend/domain"
+)
+
+type TagJSON struct {
+	TagId string `json:"tag_id"`
+	Name  string `json:"name"`
+}
+
+type TagListResponseJSON struct {
+	Items []TagJSON `json:"items"`
+}
+
+type ITagPresenter interface {
+	ToListJSON(tags []domain.Tag) TagListResponseJSON
+}
+
+type tagPresenter struct{}
+
+func NewTagPresenter() ITagPresenter {
+	return &tagPresenter{}
+}
+
+func (p *tagPresenter) ToListJSON(tags []domain.Tag) TagListResponseJSON {
+	items := make([]TagJSON, len(tags))
+	for i := range tags {
+		items[i] = TagJSON{
+			TagId: tags[i].TagId(),
+			Name:  tags[i].Name(),
+		}
+	}
+	return TagListResponseJSON{Items: items}
+}
@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ICartRepository はユーザーとゲストのカートを扱う
+// 商品の在庫・価格・削除状態はカートには持たず、取得のたびに商品から評価する
+type ICartRepository interface {
+	GetCart(owner domain.CartOwner) (*domain.Cart, error)
+	SaveLine(cart *domain.Cart, line *domain.CartLine) error
+	DeleteLine(owner domain.CartOwner, lineId string) error
+	DeleteCart(cartId string) error
+	MergeGuestCart(guestCartId string, userId *domain.UserId) (int, error)
+	DeleteGuestCartsUpdatedBefore(before time.Time) (int64, error)
+}
+
+type cartRepository struct {
+	db *gorm.DB
+}
+
+func NewCartRepository(db *gorm.DB) ICartRepository {
+	return &cartRepository{db}
+}
+
+// GetCart は持ち主のカートを返す。まだカートがない場合は永続化していない空のカートを返す
+func (cr *cartRepository) GetCart(owner domain.CartOwner) (*domain.Cart, error) {
+	var ormCart model.Cart
+	err := whereCartOwner(cr.db, owner).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC").Order("cart_item_id ASC")
+		}).
+		Limit(1).
+		Find(&ormCart).Error
+	if err != nil {
+		return nil, err
+	}
+	if ormCart.CartId == "" {
+		return domain.NewCart(owner), nil
+	}
+	return toDomainCart(ormCart)
+}
+
+// SaveLine は行を追加または更新する。カートがまだなければこのとき作成する
+func (cr *cartRepository) SaveLine(cart *domain.Cart, line *domain.CartLine) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		ormCart, err := lockCart(tx, cart)
+		if err != nil {
+			return err
+		}
+		if err := saveCartLine(tx, ormCart.CartId, line); err != nil {
+			return err
+		}
+		return touchCart(tx, ormCart.CartId)
+	})
+}
+
+func (cr *cartRepository) DeleteLine(owner domain.CartOwner, lineId string) error {
+	result := cr.db.
+		Where("cart_item_id = ? AND cart_id IN (?)", lineId, whereCartOwner(cr.db.Model(&model.Cart{}), owner).Select("cart_id")).
+		Delete(&model.CartItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteCart はカートを行ごと削除する。カートがない場合も成功とする
+func (cr *cartRepository) DeleteCart(cartId string) error {
+	return cr.db.Where("cart_id = ?", cartId).Delete(&model.Cart{}).Error
+}
+
+// MergeGuestCart はゲストのカートの行をユーザーのカートに取り込み、ゲストのカートを削除する
+// 取り込めなかった行の数を返す。ゲストのカートがない場合は何もしない
+func (cr *cartRepository) MergeGuestCart(guestCartId string, userId *domain.UserId) (int, error) {
+	guestOwner, err := domain.GuestCartOwner(guestCartId)
+	if err != nil {
+		return 0, err
+	}
+	dropped := 0
+	err = cr.db.Transaction(func(tx *gorm.DB) error {
+		var guestOrm model.Cart
+		err := whereCartOwner(tx, guestOwner).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("Items", func(db *gorm.DB) *gorm.DB {
+				return db.Order("created_at ASC").Order("cart_item_id ASC")
+			}).
+			Limit(1).
+			Find(&guestOrm).Error
+		if err != nil {
+			return err
+		}
+		if guestOrm.CartId == "" {
+			return nil
+		}
+		guestCart, err := toDomainCart(guestOrm)
+		if err != nil {
+			return err
+		}
+
+		userOrm, err := lockCart(tx, domain.NewCart(domain.UserCartOwner(*userId)))
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("cart_id = ?", userOrm.CartId).Order("created_at ASC").Order("cart_item_id ASC").Find(&userOrm.Items).Error; err != nil {
+			return err
+		}
+		userCart, err := toDomainCart(*userOrm)
+		if err != nil {
+			return err
+		}
+
+		var lines []*domain.CartLine
+		lines, dropped = userCart.MergeLines(guestCart)
+		for _, line := range lines {
+			if err := saveCartLine(tx, userOrm.CartId, line); err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("cart_id = ?", guestOrm.CartId).Delete(&model.Cart{}).Error; err != nil {
+			return err
+		}
+		return touchCart(tx, userOrm.CartId)
+	})
+	return dropped, err
+}
+
+// DeleteGuestCartsUpdatedBefore は before より前から変更されていないゲストのカートを削除し、削除した数を返す
+func (cr *cartRepository) DeleteGuestCartsUpdatedBefore(before time.Time) (int64, error) {
+	result := cr.db.Where("user_id IS NULL AND updated_at < ?", before).Delete(&model.Cart{})
+	return result.RowsAffected, result.Error
+}
+
+// whereCartOwner は持ち主のカートに絞り込む。ゲストのカートはユーザーのカートと取り違えないよう user_id が NULL のものに限る
+func whereCartOwner(db *gorm.DB, owner domain.CartOwner) *gorm.DB {
+	if owner.IsGuest() {
+		return db.Where("cart_id = ? AND user_id IS NULL", owner.GuestCartId())
+	}
+	return db.Where("user_id = ?", owner.UserId())
+}
+
+// lockCart は持ち主のカートの行ロックを取って返す。カートがなければ作成する
+// 最初の追加が並行しても、カートが2つできないよう cart_id と user_id の一意制約で重複を無視している
+func lockCart(tx *gorm.DB, cart *domain.Cart) (*model.Cart, error) {
+	var userId *string
+	if !cart.Owner().IsGuest() {
+		value := cart.UserId()
+		userId = &value
+	}
+	newCart := model.Cart{
+		CartId:    cart.CartId(),
+		UserId:    userId,
+		CreatedAt: cart.CreatedAt(),
+		UpdatedAt: time.Now(),
+	}
+	if err := tx.Clauses(clause.Insert{Modifier: "IGNORE"}).Create(&newCart).Error; err != nil {
+		return nil, err
+	}
+	var ormCart model.Cart
+	if err := whereCartOwner(tx, cart.Owner()).Clauses(clause.Locking{Strength: "UPDATE"}).First(&ormCart).Error; err != nil {
+		return nil, err
+	}
+	return &ormCart, nil
+}
+
+// saveCartLine は行を更新し、まだない行であれば作成する
+func saveCartLine(tx *gorm.DB, cartId string, line *domain.CartLine) error {
+	ormLine := model.CartItem{
+		CartItemId: line.LineId(),
+		CartId:     cartId,
+		ItemId:     line.ItemId(),
+		VariantId:  line.VariantId(),
+		Quantity:   line.Quantity(),
+		CreatedAt:  line.CreatedAt(),
+		UpdatedAt:  line.UpdatedAt(),
+	}
+	result := tx.Model(&model.CartItem{}).
+		Where("cart_item_id = ? AND cart_id = ?", line.LineId(), cartId).
+		Select("quantity", "updated_at").
+		Updates(&ormLine)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return tx.Create(&ormLine).Error
+	}
+	return nil
+}
+
+// touchCart はカートの更新日時を進める。ゲストのカートの有効期限はこの日時から数える
+func touchCart(tx *gorm.DB, cartId string) error {
+	return tx.Model(&model.Cart{}).Where("cart_id = ?", cartId).Update("updated_at", time.Now()).Error
+}
+
+func toDomainCart(ormCart model.Cart) (*domain.Cart, error) {
+	var owner domain.CartOwner
+	if ormCart.UserId != nil {
+		userId, err := domain.NewUserId(*ormCart.UserId)
+		if err != nil {
+			return nil, err
+		}
+		owner = domain.UserCartOwner(*userId)
+	} else {
+		guestOwner, err := domain.GuestCartOwner(ormCart.CartId)
+		if err != nil {
+			return nil, err
+		}
+		owner = guestOwner
+	}
+	lines := make([]domain.CartLine, 0, len(ormCart.Items))
+	for _, ormLine := range ormCart.Items {
+		itemId, err := domain.NewItemId(ormLine.ItemId)
+		if err != nil {
+			return nil, err
+		}
+		quantity, err := domain.NewCartQuantity(ormLine.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, *domain.RestoreCartLine(ormLine.CartItemId, *itemId, ormLine.VariantId, *quantity, ormLine.CreatedAt, ormLine.UpdatedAt))
+	}
+	return domain.RestoreCart(ormCart.CartId, owner, lines, ormCart.CreatedAt, ormCart.UpdatedAt), nil
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// seedCartTestItem は商品を作成し、その商品と出品者のユーザー ID を返す。カートは出品者のものとして作る
+func seedCartTestItem(t *testing.T, tx *gorm.DB) (*domain.ItemId, domain.CartOwner) {
+	t.Helper()
+	itemIdValue := seedVariantTestItem(t, tx)
+	var item model.Item
+	if err := tx.Where("item_id = ?", itemIdValue).First(&item).Error; err != nil {
+		t.Fatal(err)
+	}
+	itemId, _ := domain.NewItemId(itemIdValue)
+	userId, _ := domain.NewUserId(item.UserId)
+	return itemId, domain.UserCartOwner(*userId)
+}
+
+func TestCartRepository(t *testing.T) {
+	t.Run("Get Cart - Empty Cart Is Not Persisted", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		_, owner := seedCartTestItem(t, tx)
+		cr := NewCartRepository(tx)
+
+		cart, err := cr.GetCart(owner)
+		assert.NoError(t, err)
+		assert.Empty(t, cart.Lines())
+
+		var count int64
+		tx.Model(&model.Cart{}).Where("user_id = ?", owner.UserId()).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("Save Line - Creates Cart And Updates Quantity", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, owner := seedCartTestItem(t, tx)
+		cr := NewCartRepository(tx)
+
+		cart, _ := cr.GetCart(owner)
+		line, err := cart.AddLine(*itemId, "", 2)
+		assert.NoError(t, err)
+		assert.NoError(t, cr.SaveLine(cart, line))
+
+		cart, err = cr.GetCart(owner)
+		assert.NoError(t, err)
+		assert.Len(t, cart.Lines(), 1)
+		assert.Equal(t, line.LineId(), cart.Lines()[0].LineId())
+
+		merged, err := cart.AddLine(*itemId, "", 3)
+		assert.NoError(t, err)
+		assert.NoError(t, cr.SaveLine(cart, merged))
+
+		cart, _ = cr.GetCart(owner)
+		assert.Len(t, cart.Lines(), 1)
+		assert.Equal(t, 5, cart.Lines()[0].Quantity())
+
+		var count int64
+		tx.Model(&model.Cart{}).Where("user_id = ?", owner.UserId()).Count(&count)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("Delete Line", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, owner := seedCartTestItem(t, tx)
+		cr := NewCartRepository(tx)
+
+		cart, _ := cr.GetCart(owner)
+		line, _ := cart.AddLine(*itemId, "", 1)
+		assert.NoError(t, cr.SaveLine(cart, line))
+
+		assert.NoError(t, cr.DeleteLine(owner, line.LineId()))
+		cart, _ = cr.GetCart(owner)
+		assert.Empty(t, cart.Lines())
+
+		err := cr.DeleteLine(owner, line.LineId())
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	})
+
+	t.Run("Soft Deleted Item - Line Remains But Item Is Excluded", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, owner := seedCartTestItem(t, tx)
+		cr := NewCartRepository(tx)
+		ir := NewItemRepository(tx)
+
+		cart, _ := cr.GetCart(owner)
+		line, _ := cart.AddLine(*itemId, "", 1)
+		assert.NoError(t, cr.SaveLine(cart, line))
+		assert.NoError(t, ir.DeleteItem(itemId))
+
+		cart, err := cr.GetCart(owner)
+		assert.NoError(t, err)
+		assert.Len(t, cart.Lines(), 1)
+
+		items, err := ir.GetItemsByIDs([]*domain.ItemId{itemId})
+		assert.NoError(t, err)
+		assert.Empty(t, items)
+
+		summary, err := domain.PriceCart(cart, items)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.CartLineUnavailable, summary.Lines()[0].Status())
+	})
+	t.Run("Guest Cart - Kept Apart From User Carts", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, userOwner := seedCartTestItem(t, tx)
+		cr := NewCartRepository(tx)
+		guestOwner, _ := domain.GuestCartOwner(uuid.NewString())
+
+		guestCart, _ := cr.GetCart(guestOwner)
+		line, _ := guestCart.AddLine(*itemId, "", 2)
+		assert.NoError(t, cr.SaveLine(guestCart, line))
+
+		guestCart, err := cr.GetCart(guestOwner)
+		assert.NoError(t, err)
+		assert.Equal(t, guestOwner.GuestCartId(), guestCart.CartId())
+		assert.Len(t, guestCart.Lines(), 1)
+
+		userCart, _ := cr.GetCart(userOwner)
+		assert.Empty(t, userCart.Lines())
+		// ユーザーの持ち主ではゲストのカートの行を削除できない
+		assert.True(t, errors.Is(cr.DeleteLine(userOwner, line.LineId()), gorm.ErrRecordNotFound))
+	})
+
+	t.Run("Merge Guest Cart - Sums Duplicates And Deletes Guest Cart", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, userOwner := seedCartTestItem(t, tx)
+		otherItemId, _ := seedCartTestItem(t, tx)
+		cr := NewCartRepository(tx)
+		userId, _ := domain.NewUserId(userOwner.UserId())
+		guestOwner, _ := domain.GuestCartOwner(uuid.NewString())
+
+		userCart, _ := cr.GetCart(userOwner)
+		userLine, _ := userCart.AddLine(*itemId, "", 95)
+		assert.NoError(t, cr.SaveLine(userCart, userLine))
+
+		guestCart, _ := cr.GetCart(guestOwner)
+		guestLine, _ := guestCart.AddLine(*itemId, "", 10)
+		assert.NoError(t, cr.SaveLine(guestCart, guestLine))
+		guestCart, _ = cr.GetCart(guestOwner)
+		otherLine, _ := guestCart.AddLine(*otherItemId, "", 1)
+		assert.NoError(t, cr.SaveLine(guestCart, otherLine))
+
+		dropped, err := cr.MergeGuestCart(guestOwner.GuestCartId(), userId)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, dropped)
+
+		userCart, _ = cr.GetCart(userOwner)
+		assert.Len(t, userCart.Lines(), 2)
+		assert.Equal(t, userLine.LineId(), userCart.Lines()[0].LineId())
+		assert.Equal(t, domain.MaxCartLineQuantity, userCart.Lines()[0].Quantity())
+		assert.Equal(t, otherItemId.Value(), userCart.Lines()[1].ItemId())
+
+		var count int64
+		tx.Model(&model.Cart{}).Where("cart_id = ?", guestOwner.GuestCartId()).Count(&count)
+		assert.Equal(t, int64(0), count)
+		tx.Model(&model.CartItem{}).Where("cart_id = ?", guestOwner.GuestCartId()).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("Merge Guest Cart - Missing Guest Cart Is A No-op", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		_, userOwner := seedCartTestItem(t, tx)
+		cr := NewCartRepository(tx)
+		userId, _ := domain.NewUserId(userOwner.UserId())
+
+		dropped, err := cr.MergeGuestCart(uuid.NewString(), userId)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, dropped)
+	})
+
+	t.Run("Delete Guest Carts Updated Before - Leaves User Carts", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, userOwner := seedCartTestItem(t, tx)
+		cr := NewCartRepository(tx)
+		guestOwner, _ := domain.GuestCartOwner(uuid.NewString())
+
+		for _, owner := range []domain.CartOwner{userOwner, guestOwner} {
+			cart, _ := cr.GetCart(owner)
+			line, _ := cart.AddLine(*itemId, "", 1)
+			assert.NoError(t, cr.SaveLine(cart, line))
+		}
+		stale := time.Now().Add(-48 * time.Hour)
+		tx.Model(&model.Cart{}).Where("1 = 1").Update("updated_at", stale)
+
+		purged, err := cr.DeleteGuestCartsUpdatedBefore(time.Now().Add(-24 * time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), purged)
+
+		guestCart, _ := cr.GetCart(guestOwner)
+		assert.Empty(t, guestCart.Lines())
+		userCart, _ := cr.GetCart(userOwner)
+		assert.Len(t, userCart.Lines(), 1)
+	})
+}
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ICategoryRepository はカテゴリツリーと商品のカテゴリ設定を扱う
+type ICategoryRepository interface {
+	GetAllCategories() (domain.Categories, error)
+	CreateCategory(category *domain.Category) (*domain.Category, error)
+	UpdateCategory(category *domain.Category) (*domain.Category, error)
+	DeleteCategory(categoryId *domain.CategoryId) error
+	SetItemCategory(itemId *domain.ItemId, categoryId *domain.CategoryId) error
+}
+
+type categoryRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) ICategoryRepository {
+	return &categoryRepository{db}
+}
+
+func (cr *categoryRepository) GetAllCategories() (domain.Categories, error) {
+	var ocs []model.Category
+	if err := cr.db.Order("name ASC").Order("category_id ASC").Find(&ocs).Error; err != nil {
+		return nil, err
+	}
+
+	categories := make(domain.Categories, 0, len(ocs))
+	for _, oc := range ocs {
+		category, err := toDomainCategory(oc)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, *category)
+	}
+	return categories, nil
+}
+
+func (cr *categoryRepository) CreateCategory(category *domain.Category) (*domain.Category, error) {
+	ormCategory := toCategoryModel(category)
+	err := cr.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkCategorySlug(tx, category); err != nil {
+			return err
+		}
+		return tx.Create(&ormCategory).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCategory(ormCategory)
+}
+
+func (cr *categoryRepository) UpdateCategory(category *domain.Category) (*domain.Category, error) {
+	ormCategory := toCategoryModel(category)
+	var updated model.Category
+	err := cr.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkCategorySlug(tx, category); err != nil {
+			return err
+		}
+		result := tx.Model(&model.Category{}).
+			Where("category_id = ?", category.CategoryId()).
+			Select("parent_id", "name", "slug", "updated_at").
+			Updates(&ormCategory)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Where("category_id = ?", category.CategoryId()).First(&updated).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCategory(updated)
+}
+
+// DeleteCategory は子カテゴリも商品も持たないカテゴリだけを削除する
+// 論理削除済みの商品はカテゴリの設定を外してから削除する
+// カテゴリが存在しなければ gorm.ErrRecordNotFound を返す
+func (cr *categoryRepository) DeleteCategory(categoryId *domain.CategoryId) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		// カテゴリの行ロックで、確認から削除までの間に子カテゴリや商品が結び付くのを防ぐ
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("category_id").Where("category_id = ?", categoryId.Value()).First(&model.Category{}).Error; err != nil {
+			return err
+		}
+
+		var children int64
+		if err := tx.Model(&model.Category{}).Where("parent_id = ?", categoryId.Value()).Count(&children).Error; err != nil {
+			return err
+		}
+		if children > 0 {
+			return fmt.Errorf("%w: %d child categories", domain.ErrCategoryHasChildren, children)
+		}
+
+		var items int64
+		if err := tx.Model(&model.Item{}).Where("category_id = ?", categoryId.Value()).Count(&items).Error; err != nil {
+			return err
+		}
+		if items > 0 {
+			return fmt.Errorf("%w: %d items", domain.ErrCategoryInUse, items)
+		}
+
+		if err := tx.Unscoped().Model(&model.Item{}).Where("category_id = ?", categoryId.Value()).Update("category_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Where("category_id = ?", categoryId.Value()).Delete(&model.Category{}).Error
+	})
+}
+
+// SetItemCategory は商品のカテゴリを設定する。categoryId が nil の場合は設定を外す
+func (cr *categoryRepository) SetItemCategory(itemId *domain.ItemId, categoryId *domain.CategoryId) error {
+	var value *string
+	if categoryId != nil {
+		id := categoryId.Value()
+		value = &id
+	}
+	result := cr.db.Model(&model.Item{}).Where("item_id = ?", itemId.Value()).Update("category_id", value)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func checkCategorySlug(tx *gorm.DB, category *domain.Category) error {
+	var count int64
+	err := tx.Model(&model.Category{}).
+		Where("slug = ? AND category_id <> ?", category.Slug(), category.CategoryId()).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %s", domain.ErrDuplicateCategorySlug, category.Slug())
+	}
+	return nil
+}
+
+func toCategoryModel(category *domain.Category) model.Category {
+	ormCategory := model.Category{
+		CategoryId: category.CategoryId(),
+		Name:       category.Name(),
+		Slug:       category.Slug(),
+		CreatedAt:  category.CreatedAt(),
+		UpdatedAt:  category.UpdatedAt(),
+	}
+	if !category.IsRoot() {
+		parentId := category.ParentId()
+		ormCategory.ParentId = &parentId
+	}
+	return ormCategory
+}
+
+func toDomainCategory(oc model.Category) (*domain.Category, error) {
+	categoryId, err := domain.NewCategoryId(oc.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	var parentId *domain.CategoryId
+	if oc.ParentId != nil {
+		parentId, err = domain.NewCategoryId(*oc.ParentId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	slug, err := domain.NewCategorySlug(oc.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return domain.RestoreCategory(*categoryId, parentId, oc.Name, *slug, oc.CreatedAt, oc.UpdatedAt)
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func createTestCategory(t *testing.T, cr ICategoryRepository, parent *domain.Category, slug string) *domain.Category {
+	t.Helper()
+	var parentId *domain.CategoryId
+	if parent != nil {
+		parentId, _ = domain.NewCategoryId(parent.CategoryId())
+	}
+	slugValue, err := domain.NewCategorySlug(slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	category, err := domain.NewCategory(parentId, slug, *slugValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := cr.CreateCategory(category)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return created
+}
+
+func TestCategoryRepository(t *testing.T) {
+	t.Run("Filter Items By Category Includes Descendants", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		cr := NewCategoryRepository(tx)
+		yarn := createTestCategory(t, cr, nil, "yarn")
+		wool := createTestCategory(t, cr, yarn, "wool")
+		needles := createTestCategory(t, cr, nil, "needles")
+
+		woolItemId := seedVariantTestItem(t, tx)
+		needleItemId := seedVariantTestItem(t, tx)
+		woolCategoryId, _ := domain.NewCategoryId(wool.CategoryId())
+		needlesCategoryId, _ := domain.NewCategoryId(needles.CategoryId())
+		woolItem, _ := domain.NewItemId(woolItemId)
+		needleItem, _ := domain.NewItemId(needleItemId)
+		assert.NoError(t, cr.SetItemCategory(woolItem, woolCategoryId))
+		assert.NoError(t, cr.SetItemCategory(needleItem, needlesCategoryId))
+
+		query := newItemListQuery(0, nil)
+		query.Filter.Category, _ = domain.NewCategorySlug("yarn")
+		page, err := NewItemRepository(tx).GetAllItems(query)
+		assert.NoError(t, err)
+		assert.Len(t, page.Items(), 1)
+		assert.Equal(t, woolItemId, page.Items()[0].ItemId())
+		assert.Equal(t, "wool", page.Items()[0].Category().Slug())
+	})
+
+	t.Run("Delete Category - Blocked While In Use", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		cr := NewCategoryRepository(tx)
+		yarn := createTestCategory(t, cr, nil, "yarn")
+		wool := createTestCategory(t, cr, yarn, "wool")
+		yarnId, _ := domain.NewCategoryId(yarn.CategoryId())
+		woolId, _ := domain.NewCategoryId(wool.CategoryId())
+
+		err := cr.DeleteCategory(yarnId)
+		assert.True(t, errors.Is(err, domain.ErrCategoryHasChildren))
+
+		itemId, _ := domain.NewItemId(seedVariantTestItem(t, tx))
+		assert.NoError(t, cr.SetItemCategory(itemId, woolId))
+		err = cr.DeleteCategory(woolId)
+		assert.True(t, errors.Is(err, domain.ErrCategoryInUse))
+
+		assert.NoError(t, cr.SetItemCategory(itemId, nil))
+		assert.NoError(t, cr.DeleteCategory(woolId))
+		assert.NoError(t, cr.DeleteCategory(yarnId))
+		assert.True(t, errors.Is(cr.DeleteCategory(yarnId), gorm.ErrRecordNotFound))
+	})
+
+	t.Run("Create Category - Duplicate Slug", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		cr := NewCategoryRepository(tx)
+		createTestCategory(t, cr, nil, "yarn")
+		slug, _ := domain.NewCategorySlug("yarn")
+		category, _ := domain.NewCategory(nil, "Yarn", *slug)
+		_, err := cr.CreateCategory(category)
+		assert.True(t, errors.Is(err, domain.ErrDuplicateCategorySlug))
+	})
+}
+
+func TestTagRepository(t *testing.T) {
+	t.Run("Replace Item Tags - Filter By Tag", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		tr := NewTagRepository(tx)
+		itemIdValue := seedVariantTestItem(t, tx)
+		seedVariantTestItem(t, tx)
+		itemId, _ := domain.NewItemId(itemIdValue)
+
+		names, _ := domain.NewTagNames([]string{"Bulky", "wool"})
+		assert.NoError(t, tr.ReplaceItemTags(itemId, names))
+		names, _ = domain.NewTagNames([]string{"bulky", "Merino"})
+		assert.NoError(t, tr.ReplaceItemTags(itemId, names))
+
+		item, err := NewItemRepository(tx).GetItemByID(itemId)
+		assert.NoError(t, err)
+		tagNames := []string{}
+		for _, tag := range item.Tags() {
+			tagNames = append(tagNames, tag.Name())
+		}
+		assert.Equal(t, []string{"bulky", "merino"}, tagNames)
+
+		query := newItemListQuery(0, nil)
+		query.Filter.Tag, _ = domain.NewTagName("BULKY")
+		page, err := NewItemRepository(tx).GetAllItems(query)
+		assert.NoError(t, err)
+		assert.Len(t, page.Items(), 1)
+		assert.Equal(t, itemIdValue, page.Items()[0].ItemId())
+	})
+}
@@ -0,0 +1,283 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ICouponRepository はクーポンと利用履歴を扱う。注文での利用は IOrderRepository.PlaceOrder が同じトランザクションで記録する
+type ICouponRepository interface {
+	GetAllCoupons() ([]*domain.Coupon, error)
+	GetCouponByID(couponId string) (*domain.Coupon, error)
+	GetCouponByCode(code *domain.CouponCode) (*domain.Coupon, error)
+	CountRedemptions(couponId string, userId *domain.UserId) (int, error)
+	CreateCoupon(coupon *domain.Coupon) (*domain.Coupon, error)
+	UpdateCoupon(coupon *domain.Coupon) (*domain.Coupon, error)
+	DeleteCoupon(couponId string) error
+}
+
+type couponRepository struct {
+	db *gorm.DB
+}
+
+func NewCouponRepository(db *gorm.DB) ICouponRepository {
+	return &couponRepository{db}
+}
+
+// GetAllCoupons はクーポンを新しい順に返す
+func (cr *couponRepository) GetAllCoupons() ([]*domain.Coupon, error) {
+	var ormCoupons []model.Coupon
+	if err := cr.db.Preload("Items").Order("created_at DESC").Order("coupon_id ASC").Find(&ormCoupons).Error; err != nil {
+		return nil, err
+	}
+
+	coupons := make([]*domain.Coupon, 0, len(ormCoupons))
+	for _, ormCoupon := range ormCoupons {
+		coupon, err := toDomainCoupon(ormCoupon)
+		if err != nil {
+			return nil, err
+		}
+		coupons = append(coupons, coupon)
+	}
+	return coupons, nil
+}
+
+func (cr *couponRepository) GetCouponByID(couponId string) (*domain.Coupon, error) {
+	var ormCoupon model.Coupon
+	if err := cr.db.Preload("Items").Where("coupon_id = ?", couponId).First(&ormCoupon).Error; err != nil {
+		return nil, err
+	}
+	return toDomainCoupon(ormCoupon)
+}
+
+func (cr *couponRepository) GetCouponByCode(code *domain.CouponCode) (*domain.Coupon, error) {
+	var ormCoupon model.Coupon
+	if err := cr.db.Preload("Items").Where("code = ?", code.Value()).First(&ormCoupon).Error; err != nil {
+		return nil, err
+	}
+	return toDomainCoupon(ormCoupon)
+}
+
+// CountRedemptions はユーザーがクーポンを利用した回数を返す
+func (cr *couponRepository) CountRedemptions(couponId string, userId *domain.UserId) (int, error) {
+	return countCouponRedemptions(cr.db, couponId, userId)
+}
+
+func (cr *couponRepository) CreateCoupon(coupon *domain.Coupon) (*domain.Coupon, error) {
+	ormCoupon := toCouponModel(coupon)
+	err := cr.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkCouponCode(tx, coupon); err != nil {
+			return err
+		}
+		return tx.Create(&ormCoupon).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCoupon(ormCoupon)
+}
+
+// UpdateCoupon はクーポンの内容と対象商品を置き換える
+// 利用回数は注文と並行して増えるため書き換えず、行ロックを取ってから更新後のクーポンを読み直す
+func (cr *couponRepository) UpdateCoupon(coupon *domain.Coupon) (*domain.Coupon, error) {
+	ormCoupon := toCouponModel(coupon)
+	var updated model.Coupon
+	err := cr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("coupon_id").Where("coupon_id = ?", coupon.CouponId()).First(&model.Coupon{}).Error; err != nil {
+			return err
+		}
+		if err := checkCouponCode(tx, coupon); err != nil {
+			return err
+		}
+		err := tx.Model(&model.Coupon{}).
+			Where("coupon_id = ?", coupon.CouponId()).
+			Select("code", "discount_type", "percent_off", "amount_off", "minimum_spend", "currency", "starts_at", "ends_at", "usage_limit", "per_user_limit", "updated_at").
+			Updates(&ormCoupon).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("coupon_id = ?", coupon.CouponId()).Delete(&model.CouponItem{}).Error; err != nil {
+			return err
+		}
+		if len(ormCoupon.Items) > 0 {
+			if err := tx.Create(&ormCoupon.Items).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Preload("Items").Where("coupon_id = ?", coupon.CouponId()).First(&updated).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCoupon(updated)
+}
+
+// DeleteCoupon は一度も利用されていないクーポンだけを削除する。利用されたクーポンは有効期間を終えて使えなくする
+func (cr *couponRepository) DeleteCoupon(couponId string) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		var ormCoupon model.Coupon
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("coupon_id = ?", couponId).First(&ormCoupon).Error; err != nil {
+			return err
+		}
+		var redemptions int64
+		if err := tx.Model(&model.CouponRedemption{}).Where("coupon_id = ?", couponId).Count(&redemptions).Error; err != nil {
+			return err
+		}
+		if redemptions > 0 {
+			return fmt.Errorf("%w: %d redemptions", domain.ErrCouponInUse, redemptions)
+		}
+		if err := tx.Where("coupon_id = ?", couponId).Delete(&model.CouponItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("coupon_id = ?", couponId).Delete(&model.Coupon{}).Error
+	})
+}
+
+// lockCouponByCode はクーポンの行ロックを取って返す。同じクーポンを使う注文はここで直列になる
+func lockCouponByCode(tx *gorm.DB, code string) (*domain.Coupon, error) {
+	var ormCoupon model.Coupon
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Items").Where("code = ?", code).First(&ormCoupon).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrCouponNotFound, code)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCoupon(ormCoupon)
+}
+
+func countCouponRedemptions(db *gorm.DB, couponId string, userId *domain.UserId) (int, error) {
+	var count int64
+	err := db.Model(&model.CouponRedemption{}).
+		Where("coupon_id = ? AND user_id = ?", couponId, userId.Value()).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// redeemCoupon は注文でのクーポンの利用を記録し、利用回数を増やす。クーポンの行ロックを取った tx で呼ぶ
+func redeemCoupon(tx *gorm.DB, coupon *domain.Coupon, order *domain.Order) error {
+	redeemed := coupon.Redeem()
+	redemption := model.CouponRedemption{
+		RedemptionId: uuid.NewString(),
+		CouponId:     coupon.CouponId(),
+		UserId:       order.UserId(),
+		OrderId:      order.OrderId(),
+		CreatedAt:    order.CreatedAt(),
+	}
+	if err := tx.Create(&redemption).Error; err != nil {
+		return err
+	}
+	return tx.Model(&model.Coupon{}).
+		Where("coupon_id = ?", coupon.CouponId()).
+		Updates(map[string]interface{}{"used_count": redeemed.UsedCount(), "updated_at": redeemed.UpdatedAt()}).Error
+}
+
+func checkCouponCode(tx *gorm.DB, coupon *domain.Coupon) error {
+	var count int64
+	err := tx.Model(&model.Coupon{}).
+		Where("code = ? AND coupon_id <> ?", coupon.Code(), coupon.CouponId()).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %s", domain.ErrDuplicateCouponCode, coupon.Code())
+	}
+	return nil
+}
+
+func toCouponModel(coupon *domain.Coupon) model.Coupon {
+	ormCoupon := model.Coupon{
+		CouponId:     coupon.CouponId(),
+		Code:         coupon.Code(),
+		DiscountType: string(coupon.Discount().Type()),
+		Currency:     domain.CurrencyJPY,
+		StartsAt:     coupon.Validity().StartsAt(),
+		EndsAt:       coupon.Validity().EndsAt(),
+		UsageLimit:   coupon.UsageLimit().Total(),
+		PerUserLimit: coupon.UsageLimit().PerUser(),
+		UsedCount:    coupon.UsedCount(),
+		CreatedAt:    coupon.CreatedAt(),
+		UpdatedAt:    coupon.UpdatedAt(),
+	}
+	if amountOff := coupon.Discount().AmountOff(); amountOff != nil {
+		amount := amountOff.Amount()
+		ormCoupon.AmountOff = &amount
+		ormCoupon.Currency = amountOff.Currency()
+	} else {
+		percentOff := coupon.Discount().PercentOff()
+		ormCoupon.PercentOff = &percentOff
+	}
+	if minimumSpend := coupon.MinimumSpend(); minimumSpend != nil {
+		amount := minimumSpend.Amount()
+		ormCoupon.MinimumSpend = &amount
+		ormCoupon.Currency = minimumSpend.Currency()
+	}
+	for _, itemId := range coupon.ItemIds() {
+		ormCoupon.Items = append(ormCoupon.Items, model.CouponItem{CouponId: coupon.CouponId(), ItemId: itemId})
+	}
+	return ormCoupon
+}
+
+func toDomainCoupon(ormCoupon model.Coupon) (*domain.Coupon, error) {
+	code, err := domain.NewCouponCode(ormCoupon.Code)
+	if err != nil {
+		return nil, err
+	}
+	var discount *domain.Discount
+	switch domain.DiscountType(ormCoupon.DiscountType) {
+	case domain.DiscountPercent:
+		if ormCoupon.PercentOff == nil {
+			return nil, fmt.Errorf("coupon %s has no percent off", ormCoupon.CouponId)
+		}
+		discount, err = domain.NewPercentDiscount(*ormCoupon.PercentOff)
+	case domain.DiscountFixed:
+		if ormCoupon.AmountOff == nil {
+			return nil, fmt.Errorf("coupon %s has no amount off", ormCoupon.CouponId)
+		}
+		var amountOff *domain.Money
+		amountOff, err = domain.NewMoney(*ormCoupon.AmountOff, ormCoupon.Currency)
+		if err != nil {
+			return nil, err
+		}
+		discount, err = domain.NewFixedDiscount(*amountOff)
+	default:
+		return nil, fmt.Errorf("coupon %s has unknown discount type: %s", ormCoupon.CouponId, ormCoupon.DiscountType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	validity, err := domain.NewCouponValidity(ormCoupon.StartsAt, ormCoupon.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := domain.NewCouponUsageLimit(ormCoupon.UsageLimit, ormCoupon.PerUserLimit)
+	if err != nil {
+		return nil, err
+	}
+	var minimumSpend *domain.Money
+	if ormCoupon.MinimumSpend != nil {
+		minimumSpend, err = domain.NewMoney(*ormCoupon.MinimumSpend, ormCoupon.Currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+	itemIds := make([]domain.ItemId, 0, len(ormCoupon.Items))
+	for _, ormItem := range ormCoupon.Items {
+		itemId, err := domain.NewItemId(ormItem.ItemId)
+		if err != nil {
+			return nil, err
+		}
+		itemIds = append(itemIds, *itemId)
+	}
+	return domain.RestoreCoupon(ormCoupon.CouponId, *code, *discount, *validity, *limit, minimumSpend, itemIds, ormCoupon.UsedCount, ormCoupon.CreatedAt, ormCoupon.UpdatedAt), nil
+}
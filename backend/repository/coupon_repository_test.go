@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// newTestCoupon は totalLimit 回まで使える percentOff% 引きのクーポンを作る。itemIds を渡すとその商品だけを対象にする
+func newTestCoupon(t *testing.T, percentOff int, totalLimit int, itemIds ...string) *domain.Coupon {
+	t.Helper()
+	code, err := domain.NewCouponCode("TEST-" + uuid.NewString()[:8])
+	if err != nil {
+		t.Fatal(err)
+	}
+	discount, _ := domain.NewPercentDiscount(percentOff)
+	validity, _ := domain.NewCouponValidity(nil, nil)
+	limit, _ := domain.NewCouponUsageLimit(totalLimit, 0)
+	ids := make([]domain.ItemId, 0, len(itemIds))
+	for _, itemId := range itemIds {
+		id, _ := domain.NewItemId(itemId)
+		ids = append(ids, *id)
+	}
+	return domain.NewCoupon(*code, *discount, *validity, *limit, nil, ids)
+}
+
+func TestCouponRepository(t *testing.T) {
+	t.Run("Create And Get Coupon With Items", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		seller := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, seller.Value(), 5, "1000")
+		cr := NewCouponRepository(tx)
+
+		created, err := cr.CreateCoupon(newTestCoupon(t, 10, 0, itemId))
+		assert.NoError(t, err)
+
+		code, _ := domain.NewCouponCode(created.Code())
+		found, err := cr.GetCouponByCode(code)
+		assert.NoError(t, err)
+		assert.Equal(t, created.CouponId(), found.CouponId())
+		assert.Equal(t, domain.DiscountPercent, found.Discount().Type())
+		assert.Equal(t, 10, found.Discount().PercentOff())
+		assert.Equal(t, []string{itemId}, found.ItemIds())
+	})
+
+	t.Run("Create Coupon - Duplicate Code", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		cr := NewCouponRepository(tx)
+		created, err := cr.CreateCoupon(newTestCoupon(t, 10, 0))
+		assert.NoError(t, err)
+
+		duplicate := newTestCoupon(t, 20, 0)
+		code, _ := domain.NewCouponCode(created.Code())
+		duplicate = domain.RestoreCoupon(duplicate.CouponId(), *code, *duplicate.Discount(), *duplicate.Validity(), *duplicate.UsageLimit(), nil, nil, 0, duplicate.CreatedAt(), duplicate.UpdatedAt())
+		_, err = cr.CreateCoupon(duplicate)
+		assert.True(t, errors.Is(err, domain.ErrDuplicateCouponCode))
+	})
+
+	t.Run("Update Coupon - Replaces Items And Keeps Used Count", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		seller := seedOrderTestUser(t, tx)
+		yarn := seedOrderTestItem(t, tx, seller.Value(), 5, "1000")
+		needles := seedOrderTestItem(t, tx, seller.Value(), 5, "1500")
+		cr := NewCouponRepository(tx)
+		created, _ := cr.CreateCoupon(newTestCoupon(t, 10, 0, yarn))
+		tx.Model(&model.Coupon{}).Where("coupon_id = ?", created.CouponId()).Update("used_count", 3)
+
+		needlesId, _ := domain.NewItemId(needles)
+		code, _ := domain.NewCouponCode(created.Code())
+		discount, _ := domain.NewPercentDiscount(25)
+		revised := domain.RestoreCoupon(created.CouponId(), *code, *discount, *created.Validity(), *created.UsageLimit(), nil, []domain.ItemId{*needlesId}, 0, created.CreatedAt(), created.UpdatedAt())
+
+		updated, err := cr.UpdateCoupon(revised)
+		assert.NoError(t, err)
+		assert.Equal(t, 25, updated.Discount().PercentOff())
+		assert.Equal(t, []string{needles}, updated.ItemIds())
+		assert.Equal(t, 3, updated.UsedCount())
+	})
+
+	t.Run("Update Coupon - Not Found", func(t *testing.T) {
+		_, err := NewCouponRepository(db).UpdateCoupon(newTestCoupon(t, 10, 0))
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	})
+
+	t.Run("Delete Coupon - Rejects Redeemed Coupon", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, buyer.Value(), 5, "1000")
+		cr := NewCouponRepository(tx)
+		coupon, _ := cr.CreateCoupon(newTestCoupon(t, 10, 0))
+		code, _ := domain.NewCouponCode(coupon.Code())
+		_, err := NewOrderRepository(tx).PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 1)).WithCouponCode(*code))
+		assert.NoError(t, err)
+
+		err = cr.DeleteCoupon(coupon.CouponId())
+		assert.True(t, errors.Is(err, domain.ErrCouponInUse))
+	})
+
+	t.Run("Delete Coupon", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		cr := NewCouponRepository(tx)
+		coupon, _ := cr.CreateCoupon(newTestCoupon(t, 10, 0))
+
+		assert.NoError(t, cr.DeleteCoupon(coupon.CouponId()))
+		_, err := cr.GetCouponByID(coupon.CouponId())
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	})
+}
+
+func TestOrderRepository_PlaceOrderWithCoupon(t *testing.T) {
+	t.Run("Applies Discount Before Tax And Records Redemption", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		yarn := seedOrderTestItem(t, tx, buyer.Value(), 5, "1000")
+		needles := seedOrderTestItem(t, tx, buyer.Value(), 5, "2000")
+		coupon, _ := NewCouponRepository(tx).CreateCoupon(newTestCoupon(t, 10, 0, needles))
+		code, _ := domain.NewCouponCode(coupon.Code())
+		or := NewOrderRepository(tx)
+
+		order, err := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, yarn, "", 1), newTestOrderLine(t, needles, "", 1)).WithCouponCode(*code))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "3000", order.Subtotal().String())
+		assert.Equal(t, "200", order.Discount().String())
+		assert.Equal(t, "280", order.Tax().String())
+		assert.Equal(t, "3080", order.Total().String())
+
+		found, err := or.GetOrderByID(order.OrderId())
+		assert.NoError(t, err)
+		assert.Equal(t, coupon.Code(), found.CouponCode())
+		assert.Equal(t, "200", found.Discount().String())
+		var ormCoupon model.Coupon
+		tx.Where("coupon_id = ?", coupon.CouponId()).First(&ormCoupon)
+		assert.Equal(t, 1, ormCoupon.UsedCount)
+	})
+
+	t.Run("Unknown Code", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, buyer.Value(), 5, "1000")
+		code, _ := domain.NewCouponCode("NO-SUCH-CODE")
+
+		_, err := NewOrderRepository(tx).PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 1)).WithCouponCode(*code))
+
+		assert.True(t, errors.Is(err, domain.ErrCouponNotFound))
+		var ormItem model.Item
+		tx.Where("item_id = ?", itemId).First(&ormItem)
+		assert.Equal(t, 0, ormItem.ReservedQuantity)
+	})
+}
+
+// 並行注文のテストはトランザクションをまたいだ行ロックを確かめるため、データをコミットして後で削除する
+func TestOrderRepository_ConcurrentCouponRedemptions(t *testing.T) {
+	t.Run("Single-Use Coupon Is Redeemed Only Once", func(t *testing.T) {
+		const buyersCount = 10
+		seller := seedOrderTestUser(t, db)
+		itemId := seedOrderTestItem(t, db, seller.Value(), buyersCount, "2000")
+		coupon, err := NewCouponRepository(db).CreateCoupon(newTestCoupon(t, 50, 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		code, _ := domain.NewCouponCode(coupon.Code())
+		userIds := []string{seller.Value()}
+		buyers := make([]*domain.UserId, buyersCount)
+		requests := make([]*domain.OrderRequest, buyersCount)
+		for i := range buyers {
+			buyers[i] = seedOrderTestUser(t, db)
+			userIds = append(userIds, buyers[i].Value())
+			requests[i] = newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 1)).WithCouponCode(*code)
+		}
+		defer func() {
+			cleanupOrderTestData(t, userIds, []string{itemId})
+			db.Where("coupon_id = ?", coupon.CouponId()).Delete(&model.Coupon{})
+		}()
+
+		errs := placeOrdersConcurrently(NewOrderRepository(db), buyers, requests)
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+				continue
+			}
+			assert.True(t, errors.Is(err, domain.ErrCouponNotApplicable), err)
+		}
+		assert.Equal(t, 1, succeeded)
+
+		var ormCoupon model.Coupon
+		db.Where("coupon_id = ?", coupon.CouponId()).First(&ormCoupon)
+		assert.Equal(t, 1, ormCoupon.UsedCount)
+		var redemptions int64
+		db.Model(&model.CouponRedemption{}).Where("coupon_id = ?", coupon.CouponId()).Count(&redemptions)
+		assert.Equal(t, int64(1), redemptions)
+		var ormItem model.Item
+		db.Where("item_id = ?", itemId).First(&ormItem)
+		assert.Equal(t, 1, ormItem.ReservedQuantity)
+	})
+}
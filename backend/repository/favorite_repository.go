@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IFavoriteRepository はユーザーのお気に入りを扱う
+// 登録・解除では商品の favorite_count を同じトランザクションで増減する
+type IFavoriteRepository interface {
+	GetFavorites(userId *domain.UserId) ([]*domain.Favorite, error)
+	GetFavoritedItemIDs(userId *domain.UserId, itemIds []string) (map[string]bool, error)
+	AddFavorite(userId *domain.UserId, itemId *domain.ItemId) error
+	RemoveFavorite(userId *domain.UserId, itemId *domain.ItemId) error
+}
+
+type favoriteRepository struct {
+	db *gorm.DB
+}
+
+func NewFavoriteRepository(db *gorm.DB) IFavoriteRepository {
+	return &favoriteRepository{db}
+}
+
+// GetFavorites はお気に入りを登録の新しい順に返す。論理削除した商品のお気に入りは含めない
+// お気に入りの一覧と商品をそれぞれ1回のクエリで取得し、商品ごとに問い合わせない
+func (fr *favoriteRepository) GetFavorites(userId *domain.UserId) ([]*domain.Favorite, error) {
+	var ormFavorites []model.Favorite
+	err := fr.db.
+		Joins("JOIN items ON items.item_id = favorites.item_id AND items.deleted_at IS NULL").
+		Where("favorites.user_id = ?", userId.Value()).
+		Order("favorites.created_at DESC").
+		Order("favorites.item_id ASC").
+		Find(&ormFavorites).Error
+	if err != nil {
+		return nil, err
+	}
+
+	favorites := make([]*domain.Favorite, 0, len(ormFavorites))
+	if len(ormFavorites) == 0 {
+		return favorites, nil
+	}
+	itemIds := make([]string, len(ormFavorites))
+	for i, ormFavorite := range ormFavorites {
+		itemIds[i] = ormFavorite.ItemId
+	}
+	var ormItems []model.Item
+	if err := fr.db.Scopes(preloadItemRelations).Where("item_id IN ?", itemIds).Find(&ormItems).Error; err != nil {
+		return nil, err
+	}
+	loaded := make(map[string]model.Item, len(ormItems))
+	for _, ormItem := range ormItems {
+		loaded[ormItem.ItemId] = ormItem
+	}
+
+	for _, ormFavorite := range ormFavorites {
+		// 一覧の取得後に論理削除された商品は飛ばす
+		ormItem, ok := loaded[ormFavorite.ItemId]
+		if !ok {
+			continue
+		}
+		item, err := toDomainItem(ormItem)
+		if err != nil {
+			return nil, err
+		}
+		favorites = append(favorites, domain.RestoreFavorite(*userId, *item, ormFavorite.CreatedAt))
+	}
+	return favorites, nil
+}
+
+// GetFavoritedItemIDs は itemIds のうちユーザーがお気に入りに登録している商品の ID を返す
+func (fr *favoriteRepository) GetFavoritedItemIDs(userId *domain.UserId, itemIds []string) (map[string]bool, error) {
+	favorited := make(map[string]bool)
+	if len(itemIds) == 0 {
+		return favorited, nil
+	}
+	var ids []string
+	err := fr.db.Model(&model.Favorite{}).
+		Where("user_id = ? AND item_id IN ?", userId.Value(), itemIds).
+		Pluck("item_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		favorited[id] = true
+	}
+	return favorited, nil
+}
+
+// AddFavorite は商品をお気に入りに登録する。登録済みの場合は何もしない
+// 論理削除した商品や存在しない商品では gorm.ErrRecordNotFound を返す
+func (fr *favoriteRepository) AddFavorite(userId *domain.UserId, itemId *domain.ItemId) error {
+	return fr.db.Transaction(func(tx *gorm.DB) error {
+		// 商品の行ロックで同じ商品への登録・解除を直列にし、登録数と行の数を一致させる
+		var ormItem model.Item
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("item_id").Where("item_id = ?", itemId.Value()).First(&ormItem).Error; err != nil {
+			return err
+		}
+
+		var existing model.Favorite
+		err := tx.Where("user_id = ? AND item_id = ?", userId.Value(), itemId.Value()).First(&existing).Error
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err := tx.Create(&model.Favorite{UserId: userId.Value(), ItemId: itemId.Value()}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.Item{}).Where("item_id = ?", itemId.Value()).
+			UpdateColumn("favorite_count", gorm.Expr("favorite_count + 1")).Error
+	})
+}
+
+// RemoveFavorite はお気に入りを解除する。登録していない場合は何もしない
+// 論理削除した商品のお気に入りも解除できる
+func (fr *favoriteRepository) RemoveFavorite(userId *domain.UserId, itemId *domain.ItemId) error {
+	return fr.db.Transaction(func(tx *gorm.DB) error {
+		var ormItem model.Item
+		err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Select("item_id").Where("item_id = ?", itemId.Value()).First(&ormItem).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		result := tx.Where("user_id = ? AND item_id = ?", userId.Value(), itemId.Value()).Delete(&model.Favorite{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return tx.Unscoped().Model(&model.Item{}).Where("item_id = ? AND favorite_count > 0", itemId.Value()).
+			UpdateColumn("favorite_count", gorm.Expr("favorite_count - 1")).Error
+	})
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func seedFavoriteTestItem(t *testing.T, tx *gorm.DB, owner *domain.UserId) *domain.ItemId {
+	t.Helper()
+	itemId, err := domain.NewItemId(seedOrderTestItem(t, tx, owner.Value(), 3, "1000"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return itemId
+}
+
+func TestFavoriteRepository_AddFavorite(t *testing.T) {
+	t.Run("Counts Each User Once", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		alice := seedOrderTestUser(t, tx)
+		bob := seedOrderTestUser(t, tx)
+		itemId := seedFavoriteTestItem(t, tx, alice)
+		fr := NewFavoriteRepository(tx)
+
+		assert.NoError(t, fr.AddFavorite(alice, itemId))
+		assert.NoError(t, fr.AddFavorite(alice, itemId))
+		assert.NoError(t, fr.AddFavorite(bob, itemId))
+
+		item, _ := NewItemRepository(tx).GetItemByID(itemId)
+		assert.Equal(t, 2, item.FavoriteCount())
+	})
+
+	t.Run("Deleted Item", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		itemId := seedFavoriteTestItem(t, tx, buyer)
+		NewItemRepository(tx).DeleteItem(itemId)
+
+		err := NewFavoriteRepository(tx).AddFavorite(buyer, itemId)
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	})
+}
+
+func TestFavoriteRepository_RemoveFavorite(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	buyer := seedOrderTestUser(t, tx)
+	itemId := seedFavoriteTestItem(t, tx, buyer)
+	fr := NewFavoriteRepository(tx)
+	fr.AddFavorite(buyer, itemId)
+
+	assert.NoError(t, fr.RemoveFavorite(buyer, itemId))
+	assert.NoError(t, fr.RemoveFavorite(buyer, itemId))
+
+	item, _ := NewItemRepository(tx).GetItemByID(itemId)
+	assert.Equal(t, 0, item.FavoriteCount())
+	favorited, err := fr.GetFavoritedItemIDs(buyer, []string{itemId.Value()})
+	assert.NoError(t, err)
+	assert.Empty(t, favorited)
+}
+
+func TestFavoriteRepository_GetFavorites(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	buyer := seedOrderTestUser(t, tx)
+	kept := seedFavoriteTestItem(t, tx, buyer)
+	deleted := seedFavoriteTestItem(t, tx, buyer)
+	fr := NewFavoriteRepository(tx)
+	fr.AddFavorite(buyer, kept)
+	fr.AddFavorite(buyer, deleted)
+	NewItemRepository(tx).DeleteItem(deleted)
+
+	favorites, err := fr.GetFavorites(buyer)
+	assert.NoError(t, err)
+	assert.Len(t, favorites, 1)
+	assert.Equal(t, kept.Value(), favorites[0].Item().ItemId())
+	assert.Equal(t, 1, favorites[0].Item().FavoriteCount())
+
+	favorited, err := fr.GetFavoritedItemIDs(buyer, []string{kept.Value(), deleted.Value()})
+	assert.NoError(t, err)
+	assert.True(t, favorited[kept.Value()])
+}
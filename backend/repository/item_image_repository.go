@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IItemImageRepository は商品画像のメタデータを扱う。ファイル本体はストレージに保存する
+// 画像の読み込みは商品と一緒に IItemRepository で行う
+type IItemImageRepository interface {
+	CreateImage(image *domain.ItemImage) (*domain.ItemImage, error)
+	DeleteImage(itemId *domain.ItemId, imageId string) error
+	ReorderImages(itemId *domain.ItemId, imageIds []string) error
+}
+
+type itemImageRepository struct {
+	db *gorm.DB
+}
+
+func NewItemImageRepository(db *gorm.DB) IItemImageRepository {
+	return &itemImageRepository{db}
+}
+
+// CreateImage は画像を商品の末尾に追加する。商品の行ロックを取ってから表示順を採番する
+func (iir *itemImageRepository) CreateImage(image *domain.ItemImage) (*domain.ItemImage, error) {
+	ormImage := toItemImageModel(image)
+	err := iir.db.Transaction(func(tx *gorm.DB) error {
+		images, err := lockItemImages(tx, image.ItemId())
+		if err != nil {
+			return err
+		}
+		if len(images) >= domain.MaxItemImages {
+			return fmt.Errorf("%w: up to %d images per item", domain.ErrTooManyItemImages, domain.MaxItemImages)
+		}
+		ormImage.Position = 1
+		if len(images) > 0 {
+			ormImage.Position = images[len(images)-1].Position + 1
+		}
+		return tx.Create(&ormImage).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainItemImage(ormImage)
+}
+
+func (iir *itemImageRepository) DeleteImage(itemId *domain.ItemId, imageId string) error {
+	result := iir.db.Where("image_id = ? AND item_id = ?", imageId, itemId.Value()).Delete(&model.ItemImage{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ReorderImages は imageIds の順に表示順を振り直す。imageIds には商品の画像をすべて含める必要がある
+func (iir *itemImageRepository) ReorderImages(itemId *domain.ItemId, imageIds []string) error {
+	return iir.db.Transaction(func(tx *gorm.DB) error {
+		oms, err := lockItemImages(tx, itemId.Value())
+		if err != nil {
+			return err
+		}
+		images, err := toDomainItemImages(oms)
+		if err != nil {
+			return err
+		}
+		if err := images.CheckOrder(imageIds); err != nil {
+			return err
+		}
+		for i, imageId := range imageIds {
+			if err := tx.Model(&model.ItemImage{}).Where("image_id = ?", imageId).Update("position", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// lockItemImages は商品の行ロックを取ったうえで、その商品の画像を表示順に返す
+// 同じ商品への追加や並び替えが並行しても表示順が重複しないようにしている
+func lockItemImages(tx *gorm.DB, itemId string) ([]model.ItemImage, error) {
+	var ormItem model.Item
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("item_id = ?", itemId).First(&ormItem).Error; err != nil {
+		return nil, err
+	}
+	var oms []model.ItemImage
+	if err := tx.Where("item_id = ?", itemId).Order("position ASC").Find(&oms).Error; err != nil {
+		return nil, err
+	}
+	return oms, nil
+}
+
+func toItemImageModel(image *domain.ItemImage) model.ItemImage {
+	return model.ItemImage{
+		ImageId:      image.ImageId(),
+		ItemId:       image.ItemId(),
+		Position:     image.Position(),
+		ContentType:  image.ContentType(),
+		Width:        image.Width(),
+		Height:       image.Height(),
+		StorageKey:   image.Original().Key,
+		Url:          image.Original().URL,
+		ThumbnailKey: image.Thumbnail().Key,
+		ThumbnailUrl: image.Thumbnail().URL,
+		CreatedAt:    image.CreatedAt(),
+	}
+}
+
+func toDomainItemImage(om model.ItemImage) (*domain.ItemImage, error) {
+	itemId, err := domain.NewItemId(om.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	contentType, err := domain.NewImageContentType(om.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	return domain.RestoreItemImage(
+		om.ImageId,
+		*itemId,
+		om.Position,
+		*contentType,
+		om.Width,
+		om.Height,
+		domain.StoredImage{Key: om.StorageKey, URL: om.Url},
+		domain.StoredImage{Key: om.ThumbnailKey, URL: om.ThumbnailUrl},
+		om.CreatedAt,
+	), nil
+}
+
+func toDomainItemImages(oms []model.ItemImage) (domain.ItemImages, error) {
+	images := make(domain.ItemImages, 0, len(oms))
+	for _, om := range oms {
+		image, err := toDomainItemImage(om)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, *image)
+	}
+	return images, nil
+}
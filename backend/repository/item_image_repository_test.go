@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestItemImage(t *testing.T, itemId string) *domain.ItemImage {
+	t.Helper()
+	itemIdValue, _ := domain.NewItemId(itemId)
+	contentType, _ := domain.NewImageContentType("image/jpeg")
+	image, err := domain.NewItemImage(*itemIdValue, *contentType, 800, 600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return image.WithStoredFiles(
+		domain.StoredImage{Key: image.OriginalKey(), URL: "https://cdn.example.com/" + image.OriginalKey()},
+		domain.StoredImage{Key: image.ThumbnailKey(), URL: "https://cdn.example.com/" + image.ThumbnailKey()},
+	)
+}
+
+func TestItemImageRepository(t *testing.T) {
+	t.Run("Create And Reorder Images", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId := seedVariantTestItem(t, tx)
+		iir := NewItemImageRepository(tx)
+
+		first, err := iir.CreateImage(newTestItemImage(t, itemId))
+		assert.NoError(t, err)
+		second, err := iir.CreateImage(newTestItemImage(t, itemId))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, first.Position())
+		assert.Equal(t, 2, second.Position())
+
+		itemIdValue, _ := domain.NewItemId(itemId)
+		assert.NoError(t, iir.ReorderImages(itemIdValue, []string{second.ImageId(), first.ImageId()}))
+		assert.Error(t, iir.ReorderImages(itemIdValue, []string{second.ImageId()}))
+
+		item, err := NewItemRepository(tx).GetItemByID(itemIdValue)
+		assert.NoError(t, err)
+		images := item.Images()
+		assert.Len(t, images, 2)
+		assert.Equal(t, second.ImageId(), images[0].ImageId())
+		assert.Equal(t, "https://cdn.example.com/"+first.ThumbnailKey(), images[1].Thumbnail().URL)
+	})
+
+	t.Run("Create Image - Limit Per Item", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId := seedVariantTestItem(t, tx)
+		iir := NewItemImageRepository(tx)
+		for i := 0; i < domain.MaxItemImages; i++ {
+			_, err := iir.CreateImage(newTestItemImage(t, itemId))
+			assert.NoError(t, err)
+		}
+
+		_, err := iir.CreateImage(newTestItemImage(t, itemId))
+		assert.True(t, errors.Is(err, domain.ErrTooManyItemImages))
+	})
+
+	t.Run("Delete Image - Other Item", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId := seedVariantTestItem(t, tx)
+		otherItemId, _ := domain.NewItemId(seedVariantTestItem(t, tx))
+		iir := NewItemImageRepository(tx)
+		image, err := iir.CreateImage(newTestItemImage(t, itemId))
+		assert.NoError(t, err)
+
+		assert.Error(t, iir.DeleteImage(otherItemId, image.ImageId()))
+		itemIdValue, _ := domain.NewItemId(itemId)
+		assert.NoError(t, iir.DeleteImage(itemIdValue, image.ImageId()))
+	})
+}
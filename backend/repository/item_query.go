@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"gorm.io/gorm"
+)
+
+// ItemFilter は商品一覧の絞り込み条件。ゼロ値の項目は条件に含めない
+// Stock は販売可能数（実在庫 - 引当済み）の有無で絞り込む。バリエーションのある商品はいずれかのバリエーションの販売可能数で判定する
+// Category は指定したカテゴリとその子孫のカテゴリに属する商品に絞り込む
+// YarnWeight・Fiber・NeedleSize は毛糸の属性で絞り込む。Fiber は混率に関わらずその素材を含む商品、NeedleSize は合う針の太さの範囲に含む商品を返す
+type ItemFilter struct {
+	Stock         *bool
+	UserId        *domain.UserId
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	NameContains  string
+	Category      *domain.CategorySlug
+	Tag           *domain.TagName
+	YarnWeight    domain.YarnWeight
+	Fiber         domain.Fiber
+	NeedleSize    *domain.NeedleSize
+}
+
+type ItemListQuery struct {
+	Limit  domain.PageLimit
+	Cursor *domain.ItemCursor
+	Sort   domain.ItemSort
+	Filter ItemFilter
+}
+
+// categorySubtreeQuery はスラッグで指定したカテゴリと、その子孫すべてのカテゴリ ID を返す
+const categorySubtreeQuery = `WITH RECURSIVE category_subtree AS (
+	SELECT category_id FROM categories WHERE slug = ?
+	UNION ALL
+	SELECT categories.category_id FROM categories JOIN category_subtree ON categories.parent_id = category_subtree.category_id
+) SELECT category_id FROM category_subtree`
+
+// sellableItemCondition は販売可能な商品の条件。バリエーションのある商品は商品自体の在庫ではなくバリエーションの在庫で判定する
+const sellableItemCondition = `(
+	EXISTS (SELECT 1 FROM item_variants WHERE item_variants.item_id = items.item_id AND item_variants.on_hand_quantity > item_variants.reserved_quantity)
+	OR (items.on_hand_quantity > items.reserved_quantity AND NOT EXISTS (SELECT 1 FROM item_variants WHERE item_variants.item_id = items.item_id))
+)`
+
+const taggedItemsQuery = "SELECT item_tags.item_id FROM item_tags JOIN tags ON tags.tag_id = item_tags.tag_id WHERE tags.name = ?"
+
+const (
+	yarnWeightItemsQuery = "SELECT item_id FROM item_yarns WHERE weight = ?"
+	fiberItemsQuery      = "SELECT item_id FROM item_yarn_fibers WHERE fiber = ?"
+	needleSizeItemsQuery = "SELECT item_id FROM item_yarns WHERE needle_min_mm <= ? AND needle_max_mm >= ?"
+)
+
+// itemSortColumns は並び替えキーと items テーブルのカラムの対応
+// ORDER BY に埋め込むため、ここに定義したカラム名以外は使わない
+var itemSortColumns = map[string]string{
+	domain.ItemSortCreatedAt: "created_at",
+	domain.ItemSortItemName:  "item_name",
+}
+
+func (f ItemFilter) scope() func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if f.Stock != nil {
+			if *f.Stock {
+				db = db.Where(sellableItemCondition)
+			} else {
+				db = db.Where("NOT " + sellableItemCondition)
+			}
+		}
+		if f.UserId != nil {
+			db = db.Where("user_id = ?", f.UserId.Value())
+		}
+		if f.CreatedAfter != nil {
+			db = db.Where("created_at > ?", *f.CreatedAfter)
+		}
+		if f.CreatedBefore != nil {
+			db = db.Where("created_at < ?", *f.CreatedBefore)
+		}
+		if f.NameContains != "" {
+			db = db.Where("item_name LIKE ?", "%"+escapeLike(f.NameContains)+"%")
+		}
+		if f.Category != nil {
+			db = db.Where("category_id IN ("+categorySubtreeQuery+")", f.Category.Value())
+		}
+		if f.Tag != nil {
+			db = db.Where("item_id IN ("+taggedItemsQuery+")", f.Tag.Value())
+		}
+		if f.YarnWeight != "" {
+			db = db.Where("item_id IN ("+yarnWeightItemsQuery+")", string(f.YarnWeight))
+		}
+		if f.Fiber != "" {
+			db = db.Where("item_id IN ("+fiberItemsQuery+")", string(f.Fiber))
+		}
+		if f.NeedleSize != nil {
+			db = db.Where("item_id IN ("+needleSizeItemsQuery+")", f.NeedleSize.Millimeters(), f.NeedleSize.Millimeters())
+		}
+		return db
+	}
+}
+
+func sortScope(sort domain.ItemSort) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, key := range sort.Keys() {
+			direction := " ASC"
+			if key.Descending() {
+				direction = " DESC"
+			}
+			db = db.Order(itemSortColumns[key.Field()] + direction)
+		}
+		return db.Order("item_id ASC")
+	}
+}
+
+// cursorScope はカーソル位置より後ろの行だけを対象にする（キーセットページング）
+// 並び替えキー k1..kn に対して
+// (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ... OR (k1 = v1 AND ... AND kn = vn AND item_id > id)
+// を組み立てる。降順のキーは不等号を反転させる
+func cursorScope(sort domain.ItemSort, cursor *domain.ItemCursor) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if cursor == nil {
+			return db
+		}
+
+		var clauses []string
+		var args []any
+		var prefix []string
+		var prefixArgs []any
+		for _, key := range sort.Keys() {
+			column := itemSortColumns[key.Field()]
+			operator := " > ?"
+			if key.Descending() {
+				operator = " < ?"
+			}
+			clauses = append(clauses, "("+strings.Join(append(append([]string{}, prefix...), column+operator), " AND ")+")")
+			args = append(append(args, prefixArgs...), cursor.Value(key.Field()))
+			prefix = append(prefix, column+" = ?")
+			prefixArgs = append(prefixArgs, cursor.Value(key.Field()))
+		}
+		clauses = append(clauses, "("+strings.Join(append(prefix, "item_id > ?"), " AND ")+")")
+		args = append(append(args, prefixArgs...), cursor.ItemId())
+
+		return db.Where("("+strings.Join(clauses, " OR ")+")", args...)
+	}
+}
+
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(value)
+}
@@ -8,8 +8,10 @@ import (
 )
 
 type IItemRepository interface {
-	GetAllItems() (domain.Items, error)
+	GetAllItems(query ItemListQuery) (*domain.ItemPage, error)
+	CountItems(filter ItemFilter) (int64, error)
 	GetItemByID(itemId *domain.ItemId) (*domain.Item, error)
+	GetItemsByIDs(itemIds []*domain.ItemId) (domain.Items, error)
 	CreateItem(item *domain.Item) (*domain.Item, error)
 	UpdateItem(item *domain.Item) (*domain.Item, error)
 	DeleteItem(itemId *domain.ItemId) error
@@ -23,42 +25,111 @@ func NewItemRepository(db *gorm.DB) IItemRepository {
 	return &itemRepository{db}
 }
 
-func (ir *itemRepository) GetAllItems() (domain.Items, error) {
-	// DBから全ての商品を取得（item_idで昇順ソート）
+func (ir *itemRepository) GetAllItems(query ItemListQuery) (*domain.ItemPage, error) {
+	limit := query.Limit.Value()
+
+	// 次ページの有無を判定するため1件多く取得する
 	var oi []model.Item
-	if err := ir.db.Order("item_id ASC").Find(&oi).Error; err != nil {
+	err := ir.db.
+		Scopes(query.Filter.scope(), cursorScope(query.Sort, query.Cursor), sortScope(query.Sort), preloadItemRelations).
+		Limit(limit + 1).
+		Find(&oi).Error
+	if err != nil {
 		return nil, err
 	}
+
+	hasNext := len(oi) > limit
+	if hasNext {
+		oi = oi[:limit]
+	}
+
 	// ループ処理で各商品をドメインモデルに変換してから、itemsに追加する
-	var items domain.Items
+	items := domain.Items{}
 	for _, v := range oi {
-		itemId, err := domain.NewItemId(v.ItemId)
-		if err != nil {
-			return nil, err
-		}
-		userId, err := domain.NewUserId(v.UserId)
+		item, err := toDomainItem(v)
 		if err != nil {
 			return nil, err
 		}
-		itemName, err := domain.NewItemName(v.ItemName)
-		if err != nil {
-			return nil, err
+		items = append(items, *item)
+	}
+
+	var nextCursor *domain.ItemCursor
+	if hasNext {
+		nextCursor = domain.NewItemCursor(&items[len(items)-1], query.Sort)
+	}
+	return domain.NewItemPage(items, nextCursor, nil), nil
+}
+
+func (ir *itemRepository) CountItems(filter ItemFilter) (int64, error) {
+	var count int64
+	if err := ir.db.Model(&model.Item{}).Scopes(filter.scope()).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (ir *itemRepository) CreateItem(item *domain.Item) (*domain.Item, error) {
+	ormItem := model.Item{
+		ItemId:            item.ItemId(),
+		UserId:            item.UserId(),
+		ItemName:          item.ItemName(),
+		OnHandQuantity:    item.Stock().OnHand(),
+		ReservedQuantity:  item.Stock().Reserved(),
+		LowStockThreshold: item.Stock().LowStockThreshold(),
+		Description:       item.Description(),
+		Price:             item.Price().Amount(),
+		PriceCurrency:     item.Price().Currency(),
+		TaxRate:           string(item.TaxRate()),
+	}
+
+	// 初期在庫は入荷として台帳に記録し、実在庫数と台帳の合計を一致させておく
+	err := ir.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&ormItem).Error; err != nil {
+			return err
 		}
-		stock, err := domain.NewStock(v.Stock)
-		if err != nil {
-			return nil, err
+		if item.Stock().OnHand() == 0 {
+			return nil
 		}
-		description, err := domain.NewDescription(v.Description)
+		movement, err := newInitialStockMovement(item)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		item, err := domain.NewItem(
-			itemId,
-			*userId,
-			*itemName,
-			*stock,
-			*description,
-		)
+		ormMovement := toStockMovementModel(movement)
+		return tx.Create(&ormMovement).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainItem(ormItem)
+}
+
+func (ir *itemRepository) GetItemByID(itemId *domain.ItemId) (*domain.Item, error) {
+	var ormItem model.Item
+	if err := ir.db.Scopes(preloadItemRelations).Where("item_id = ?", itemId.Value()).First(&ormItem).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomainItem(ormItem)
+}
+
+// GetItemsByIDs は ID が一致する商品を返す。削除済みや存在しない商品は結果に含めない
+func (ir *itemRepository) GetItemsByIDs(itemIds []*domain.ItemId) (domain.Items, error) {
+	items := domain.Items{}
+	if len(itemIds) == 0 {
+		return items, nil
+	}
+	values := make([]string, len(itemIds))
+	for i, itemId := range itemIds {
+		values[i] = itemId.Value()
+	}
+
+	var ormItems []model.Item
+	if err := ir.db.Scopes(preloadItemRelations).Where("item_id IN ?", values).Find(&ormItems).Error; err != nil {
+		return nil, err
+	}
+	for _, ormItem := range ormItems {
+		item, err := toDomainItem(ormItem)
 		if err != nil {
 			return nil, err
 		}
@@ -67,55 +138,85 @@ func (ir *itemRepository) GetAllItems() (domain.Items, error) {
 	return items, nil
 }
 
-func (ir *itemRepository) CreateItem(item *domain.Item) (*domain.Item, error) {
+func (ir *itemRepository) UpdateItem(item *domain.Item) (*domain.Item, error) {
 	ormItem := model.Item{
-		ItemId:      item.ItemId(),
-		UserId:      item.UserId(),
-		ItemName:    item.ItemName(),
-		Stock:       item.Stock(),
-		Description: item.Description(),
+		ItemId:            item.ItemId(),
+		UserId:            item.UserId(),
+		ItemName:          item.ItemName(),
+		OnHandQuantity:    item.Stock().OnHand(),
+		ReservedQuantity:  item.Stock().Reserved(),
+		LowStockThreshold: item.Stock().LowStockThreshold(),
+		Description:       item.Description(),
+		Price:             item.Price().Amount(),
+		PriceCurrency:     item.Price().Currency(),
+		TaxRate:           string(item.TaxRate()),
 	}
 
-	if err := ir.db.Create(&ormItem).Error; err != nil {
-		return nil, err
+	// 実在庫数は在庫移動、引当済み数量は注文処理でのみ更新するため、ここでは書き換えない
+	result := ir.db.Where("item_id = ?", item.ItemId()).Select("item_name", "low_stock_threshold", "description", "price", "price_currency", "tax_rate").Updates(&ormItem)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
 	}
 
-	itemId, err := domain.NewItemId(ormItem.ItemId)
-	if err != nil {
+	var updatedOrmItem model.Item
+	if err := ir.db.Scopes(preloadItemRelations).Where("item_id = ?", item.ItemId()).First(&updatedOrmItem).Error; err != nil {
 		return nil, err
 	}
-	userId, err := domain.NewUserId(ormItem.UserId)
-	if err != nil {
-		return nil, err
+
+	return toDomainItem(updatedOrmItem)
+}
+
+func (ir *itemRepository) DeleteItem(itemId *domain.ItemId) error {
+	result := ir.db.Where("item_id = ?", itemId.Value()).Delete(&model.Item{})
+	if result.Error != nil {
+		return result.Error
 	}
-	itemName, err := domain.NewItemName(ormItem.ItemName)
-	if err != nil {
-		return nil, err
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
 	}
-	stock, err := domain.NewStock(ormItem.Stock)
+	return nil
+}
+
+// preloadItemRelations は商品と一緒にバリエーション（作成順）・カテゴリ・タグ（名前順）・画像（表示順）・毛糸の属性と素材（混率の高い順）を読み込む
+func preloadItemRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Variants", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC").Order("variant_id ASC")
+		}).
+		Preload("Category").
+		Preload("Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("name ASC")
+		}).
+		Preload("Images", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Yarn").
+		Preload("Yarn.Fibers", func(db *gorm.DB) *gorm.DB {
+			return db.Order("percentage DESC").Order("fiber ASC")
+		})
+}
+
+func newInitialStockMovement(item *domain.Item) (*domain.StockMovement, error) {
+	itemId, err := domain.NewItemId(item.ItemId())
 	if err != nil {
 		return nil, err
 	}
-	description, err := domain.NewDescription(ormItem.Description)
+	userId, err := domain.NewUserId(item.UserId())
 	if err != nil {
 		return nil, err
 	}
-
-	createdItem, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description)
+	movementType, err := domain.NewStockMovementType(domain.StockMovementReceive)
 	if err != nil {
 		return nil, err
 	}
-
-	return createdItem, nil
+	return domain.NewStockMovement(*itemId, *movementType, item.Stock().OnHand(), "initial stock", *userId)
 }
 
-func (ir *itemRepository) GetItemByID(itemId *domain.ItemId) (*domain.Item, error) {
-	var ormItem model.Item
-	if err := ir.db.Where("item_id = ?", itemId.Value()).First(&ormItem).Error; err != nil {
-		return nil, err
-	}
-
-	itemIdValue, err := domain.NewItemId(ormItem.ItemId)
+func toDomainItem(ormItem model.Item) (*domain.Item, error) {
+	itemId, err := domain.NewItemId(ormItem.ItemId)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +228,7 @@ func (ir *itemRepository) GetItemByID(itemId *domain.ItemId) (*domain.Item, erro
 	if err != nil {
 		return nil, err
 	}
-	stock, err := domain.NewStock(ormItem.Stock)
+	stock, err := domain.NewStock(ormItem.OnHandQuantity, ormItem.ReservedQuantity, ormItem.LowStockThreshold)
 	if err != nil {
 		return nil, err
 	}
@@ -135,73 +236,57 @@ func (ir *itemRepository) GetItemByID(itemId *domain.ItemId) (*domain.Item, erro
 	if err != nil {
 		return nil, err
 	}
-
-	item, err := domain.NewItem(itemIdValue, *userId, *itemName, *stock, *description)
+	price, err := domain.NewMoney(ormItem.Price, ormItem.PriceCurrency)
 	if err != nil {
 		return nil, err
 	}
-
-	return item, nil
-}
-
-func (ir *itemRepository) UpdateItem(item *domain.Item) (*domain.Item, error) {
-	ormItem := model.Item{
-		ItemId:      item.ItemId(),
-		UserId:      item.UserId(),
-		ItemName:    item.ItemName(),
-		Stock:       item.Stock(),
-		Description: item.Description(),
-	}
-
-	result := ir.db.Where("item_id = ?", item.ItemId()).Select("item_name", "stock", "description").Updates(&ormItem)
-	if result.Error != nil {
-		return nil, result.Error
-	}
-	if result.RowsAffected == 0 {
-		return nil, gorm.ErrRecordNotFound
-	}
-
-	var updatedOrmItem model.Item
-	if err := ir.db.Where("item_id = ?", item.ItemId()).First(&updatedOrmItem).Error; err != nil {
+	taxRate, err := domain.NewTaxRate(ormItem.TaxRate)
+	if err != nil {
 		return nil, err
 	}
 
-	itemId, err := domain.NewItemId(updatedOrmItem.ItemId)
+	variants, err := toDomainItemVariants(ormItem.Variants)
 	if err != nil {
 		return nil, err
 	}
-	userId, err := domain.NewUserId(updatedOrmItem.UserId)
+
+	item, err := domain.NewItemWithTimestamps(
+		itemId,
+		*userId,
+		*itemName,
+		*stock,
+		*description,
+		*price,
+		ormItem.CreatedAt,
+		ormItem.UpdatedAt,
+	)
 	if err != nil {
 		return nil, err
 	}
-	itemName, err := domain.NewItemName(updatedOrmItem.ItemName)
+	item, err = item.WithTaxRate(taxRate).WithFavoriteCount(ormItem.FavoriteCount).WithRating(domain.NewRatingSummary(ormItem.RatingCount, ormItem.RatingTotal)).WithVariants(variants)
 	if err != nil {
 		return nil, err
 	}
-	stock, err := domain.NewStock(updatedOrmItem.Stock)
+
+	if ormItem.Category != nil {
+		category, err := toDomainCategory(*ormItem.Category)
+		if err != nil {
+			return nil, err
+		}
+		item = item.WithCategory(category)
+	}
+
+	tags, err := toDomainTags(ormItem.Tags)
 	if err != nil {
 		return nil, err
 	}
-	description, err := domain.NewDescription(updatedOrmItem.Description)
+	images, err := toDomainItemImages(ormItem.Images)
 	if err != nil {
 		return nil, err
 	}
-
-	updatedItem, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description)
+	yarn, err := toDomainYarnAttributes(ormItem.Yarn)
 	if err != nil {
 		return nil, err
 	}
-
-	return updatedItem, nil
-}
-
-func (ir *itemRepository) DeleteItem(itemId *domain.ItemId) error {
-	result := ir.db.Where("item_id = ?", itemId.Value()).Delete(&model.Item{})
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
-	}
-	return nil
+	return item.WithTags(tags).WithImages(images).WithYarn(yarn), nil
 }
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+)
+
+// IItemSearcher は商品名・商品説明に対する全文検索を提供する
+// 結果は関連度の高い順（同じ関連度では item_id の昇順）に並ぶ
+type IItemSearcher interface {
+	SearchItems(query domain.SearchQuery, limit domain.PageLimit) ([]*domain.ItemSearchHit, error)
+}
+
+type mysqlItemSearcher struct {
+	db *gorm.DB
+}
+
+// NewMySQLItemSearcher は items の FULLTEXT インデックス（ngram パーサー）を使う検索を返す
+func NewMySQLItemSearcher(db *gorm.DB) IItemSearcher {
+	return &mysqlItemSearcher{db}
+}
+
+type itemSearchRow struct {
+	model.Item
+	Score float64
+}
+
+const itemMatchAgainst = "MATCH(item_name, description) AGAINST (? IN NATURAL LANGUAGE MODE)"
+
+func (s *mysqlItemSearcher) SearchItems(query domain.SearchQuery, limit domain.PageLimit) ([]*domain.ItemSearchHit, error) {
+	var rows []itemSearchRow
+	err := s.db.Model(&model.Item{}).
+		Select("items.*, "+itemMatchAgainst+" AS score", query.Value()).
+		Where(itemMatchAgainst, query.Value()).
+		Order("score DESC").
+		Order("item_id ASC").
+		Limit(limit.Value()).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.loadRelations(rows); err != nil {
+		return nil, err
+	}
+
+	hits := make([]*domain.ItemSearchHit, 0, len(rows))
+	for _, row := range rows {
+		item, err := toDomainItem(row.Item)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, domain.NewItemSearchHit(*item, row.Score, query))
+	}
+	return hits, nil
+}
+
+// loadRelations は検索結果の商品を関連ごとまとめて読み込み直す
+// Scan では Preload が効かないため、ヒットした商品 ID で別途取得し、商品一覧と同じ関連を持たせる
+func (s *mysqlItemSearcher) loadRelations(rows []itemSearchRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	itemIds := make([]string, len(rows))
+	for i, row := range rows {
+		itemIds[i] = row.ItemId
+	}
+
+	var ormItems []model.Item
+	if err := s.db.Scopes(preloadItemRelations).Where("item_id IN ?", itemIds).Find(&ormItems).Error; err != nil {
+		return err
+	}
+
+	loaded := make(map[string]model.Item, len(ormItems))
+	for _, ormItem := range ormItems {
+		loaded[ormItem.ItemId] = ormItem
+	}
+	for i := range rows {
+		if ormItem, ok := loaded[rows[i].ItemId]; ok {
+			rows[i].Item = ormItem
+		}
+	}
+	return nil
+}
+
+type inMemoryItemSearcher struct {
+	items domain.Items
+}
+
+// NewInMemoryItemSearcher は DB を使わずに検索するテスト・ローカル用の実装を返す
+// 検索語ごとに商品名の一致を2点、商品説明の一致を1点として関連度を数える
+func NewInMemoryItemSearcher(items domain.Items) IItemSearcher {
+	return &inMemoryItemSearcher{items}
+}
+
+func (s *inMemoryItemSearcher) SearchItems(query domain.SearchQuery, limit domain.PageLimit) ([]*domain.ItemSearchHit, error) {
+	hits := []*domain.ItemSearchHit{}
+	for _, item := range s.items {
+		name := strings.ToLower(item.ItemName())
+		description := strings.ToLower(item.Description())
+		score := 0.0
+		for _, term := range query.Terms() {
+			term = strings.ToLower(term)
+			score += float64(strings.Count(name, term)) * 2
+			score += float64(strings.Count(description, term))
+		}
+		if score > 0 {
+			hits = append(hits, domain.NewItemSearchHit(item, score, query))
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score() != hits[j].Score() {
+			return hits[i].Score() > hits[j].Score()
+		}
+		return hits[i].Item().ItemId() < hits[j].Item().ItemId()
+	})
+
+	if len(hits) > limit.Value() {
+		hits = hits[:limit.Value()]
+	}
+	return hits, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// InnoDB の FULLTEXT インデックスはコミット時に更新されるため、
+// ロールバック前提のトランザクション内では MySQL 実装を検証できない。
+// ここではインターフェースの振る舞いをインメモリ実装で確認する。
+func TestInMemoryItemSearcher(t *testing.T) {
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d111")
+	var items domain.Items
+	for _, v := range []struct{ id, name, description string }{
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d203", "Wool Hat", "Hand knit"},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d202", "Cotton Yarn", "Not wool"},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d201", "Alpaca Yarn", "Not wool"},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d204", "Needles", "Bamboo"},
+	} {
+		itemId, _ := domain.NewItemId(v.id)
+		itemName, _ := domain.NewItemName(v.name)
+		stock, _ := domain.NewStock(1, 0, 0)
+		description, _ := domain.NewDescription(v.description)
+		item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
+		items = append(items, *item)
+	}
+	searcher := NewInMemoryItemSearcher(items)
+
+	t.Run("Search Items - Ranked by relevance then item_id", func(t *testing.T) {
+		query, _ := domain.NewSearchQuery("wool")
+		limit, _ := domain.NewPageLimit(0)
+		hits, err := searcher.SearchItems(*query, *limit)
+		assert.NoError(t, err)
+		assert.Len(t, hits, 3)
+		assert.Equal(t, "Wool Hat", hits[0].Item().ItemName())
+		assert.Equal(t, "Alpaca Yarn", hits[1].Item().ItemName())
+		assert.Equal(t, "Cotton Yarn", hits[2].Item().ItemName())
+	})
+
+	t.Run("Search Items - No match", func(t *testing.T) {
+		query, _ := domain.NewSearchQuery("mohair")
+		limit, _ := domain.NewPageLimit(0)
+		hits, err := searcher.SearchItems(*query, *limit)
+		assert.NoError(t, err)
+		assert.Empty(t, hits)
+	})
+}
+
+// MATCH を使わない関連の読み込みだけは、トランザクション内で MySQL 実装を検証できる
+func TestMySQLItemSearcher_LoadRelations(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	owner := seedOrderTestUser(t, tx)
+	itemId := seedFavoriteTestItem(t, tx, owner)
+	fibers, _ := domain.NewFiberComposition([]domain.FiberContent{{Fiber: domain.FiberMerino, Percentage: 70}, {Fiber: domain.FiberSilk, Percentage: 30}})
+	yarn, _ := domain.NewYarnAttributes(domain.YarnDetails{Weight: domain.YarnWeightLight, YardagePerSkein: 200, GramsPerSkein: 50, Fibers: fibers})
+	if err := NewPatternRepository(tx).SetItemYarn(itemId, yarn); err != nil {
+		t.Fatal(err)
+	}
+
+	var rows []itemSearchRow
+	if err := tx.Model(&model.Item{}).Where("item_id = ?", itemId.Value()).Scan(&rows).Error; err != nil {
+		t.Fatal(err)
+	}
+	searcher := &mysqlItemSearcher{tx}
+	assert.NoError(t, searcher.loadRelations(rows))
+
+	item, err := toDomainItem(rows[0].Item)
+	assert.NoError(t, err)
+	if assert.NotNil(t, item.Yarn()) {
+		assert.Equal(t, domain.YarnWeightLight, item.Yarn().Weight())
+		assert.Equal(t, 200, item.Yarn().YardagePerSkein())
+		assert.Equal(t, 50, item.Yarn().GramsPerSkein())
+		assert.Equal(t, fibers, item.Yarn().Fibers())
+	}
+}
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"encoding/json"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IItemVariantRepository は商品のバリエーションを扱う
+// バリエーションの読み込みは商品と一緒に IItemRepository で行う
+type IItemVariantRepository interface {
+	CreateVariant(variant *domain.ItemVariant) (*domain.ItemVariant, error)
+	UpdateVariant(variant *domain.ItemVariant) (*domain.ItemVariant, error)
+	DeleteVariant(itemId *domain.ItemId, variantId string) error
+}
+
+type itemVariantRepository struct {
+	db *gorm.DB
+}
+
+func NewItemVariantRepository(db *gorm.DB) IItemVariantRepository {
+	return &itemVariantRepository{db}
+}
+
+func (ivr *itemVariantRepository) CreateVariant(variant *domain.ItemVariant) (*domain.ItemVariant, error) {
+	ormVariant, err := toItemVariantModel(variant)
+	if err != nil {
+		return nil, err
+	}
+
+	// 初期在庫は入荷として台帳に記録し、実在庫数と台帳の合計を一致させておく
+	err = ivr.db.Transaction(func(tx *gorm.DB) error {
+		ormItem, err := checkVariantConflict(tx, variant)
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(&ormVariant).Error; err != nil {
+			return err
+		}
+		if variant.Stock().OnHand() == 0 {
+			return nil
+		}
+		movement, err := newInitialVariantStockMovement(ormItem, variant)
+		if err != nil {
+			return err
+		}
+		ormMovement := toStockMovementModel(movement)
+		return tx.Create(&ormMovement).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainItemVariant(ormVariant)
+}
+
+func (ivr *itemVariantRepository) UpdateVariant(variant *domain.ItemVariant) (*domain.ItemVariant, error) {
+	ormVariant, err := toItemVariantModel(variant)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated model.ItemVariant
+	err = ivr.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := checkVariantConflict(tx, variant); err != nil {
+			return err
+		}
+		// 実在庫数は在庫移動、引当済み数量は注文処理でのみ更新するため、ここでは書き換えない
+		result := tx.Model(&model.ItemVariant{}).
+			Where("variant_id = ? AND item_id = ?", variant.VariantId(), variant.ItemId()).
+			Select("sku", "options", "option_key", "low_stock_threshold", "price_override", "price_override_currency", "updated_at").
+			Updates(&ormVariant)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Where("variant_id = ?", variant.VariantId()).First(&updated).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainItemVariant(updated)
+}
+
+func (ivr *itemVariantRepository) DeleteVariant(itemId *domain.ItemId, variantId string) error {
+	result := ivr.db.Where("variant_id = ? AND item_id = ?", variantId, itemId.Value()).Delete(&model.ItemVariant{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// checkVariantConflict は商品の行ロックを取ったうえで、オプションの組み合わせと SKU の重複を確認し、ロックした商品を返す
+// 同じ商品へのバリエーション追加が並行しても、ユニーク制約違反ではなく重複エラーとして返せるようにしている
+func checkVariantConflict(tx *gorm.DB, variant *domain.ItemVariant) (*model.Item, error) {
+	var ormItem model.Item
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("item_id = ?", variant.ItemId()).First(&ormItem).Error; err != nil {
+		return nil, err
+	}
+
+	// SKU は商品をまたいで一意なので、他の商品のバリエーションも対象にする
+	var oms []model.ItemVariant
+	if err := tx.Where("item_id = ? OR sku = ?", variant.ItemId(), variant.Sku()).Find(&oms).Error; err != nil {
+		return nil, err
+	}
+	variants, err := toDomainItemVariants(oms)
+	if err != nil {
+		return nil, err
+	}
+	if err := variants.CheckConflict(variant); err != nil {
+		return nil, err
+	}
+	return &ormItem, nil
+}
+
+// newInitialVariantStockMovement はバリエーションの初期在庫を、商品の出品者による入荷として作る
+func newInitialVariantStockMovement(ormItem *model.Item, variant *domain.ItemVariant) (*domain.StockMovement, error) {
+	itemId, err := domain.NewItemId(variant.ItemId())
+	if err != nil {
+		return nil, err
+	}
+	userId, err := domain.NewUserId(ormItem.UserId)
+	if err != nil {
+		return nil, err
+	}
+	movementType, err := domain.NewStockMovementType(domain.StockMovementReceive)
+	if err != nil {
+		return nil, err
+	}
+	movement, err := domain.NewStockMovement(*itemId, *movementType, variant.Stock().OnHand(), "initial stock", *userId)
+	if err != nil {
+		return nil, err
+	}
+	return movement.WithVariantId(variant.VariantId()), nil
+}
+
+func toItemVariantModel(variant *domain.ItemVariant) (model.ItemVariant, error) {
+	options, err := json.Marshal(variant.Options().Values())
+	if err != nil {
+		return model.ItemVariant{}, err
+	}
+
+	ormVariant := model.ItemVariant{
+		VariantId:         variant.VariantId(),
+		ItemId:            variant.ItemId(),
+		Sku:               variant.Sku(),
+		Options:           string(options),
+		OptionKey:         variant.Options().Key(),
+		OnHandQuantity:    variant.Stock().OnHand(),
+		ReservedQuantity:  variant.Stock().Reserved(),
+		LowStockThreshold: variant.Stock().LowStockThreshold(),
+		CreatedAt:         variant.CreatedAt(),
+		UpdatedAt:         variant.UpdatedAt(),
+	}
+	if override := variant.PriceOverride(); override != nil {
+		currency := override.Currency()
+		ormVariant.PriceOverride = decimal.NewNullDecimal(override.Amount())
+		ormVariant.PriceOverrideCurrency = &currency
+	}
+	return ormVariant, nil
+}
+
+func toDomainItemVariant(om model.ItemVariant) (*domain.ItemVariant, error) {
+	itemId, err := domain.NewItemId(om.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	sku, err := domain.NewSkuCode(om.Sku)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]string
+	if err := json.Unmarshal([]byte(om.Options), &values); err != nil {
+		return nil, err
+	}
+	options, err := domain.NewVariantOptions(values)
+	if err != nil {
+		return nil, err
+	}
+	stock, err := domain.NewStock(om.OnHandQuantity, om.ReservedQuantity, om.LowStockThreshold)
+	if err != nil {
+		return nil, err
+	}
+	var priceOverride *domain.Money
+	if om.PriceOverride.Valid {
+		currency := ""
+		if om.PriceOverrideCurrency != nil {
+			currency = *om.PriceOverrideCurrency
+		}
+		priceOverride, err = domain.NewMoney(om.PriceOverride.Decimal, currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return domain.RestoreItemVariant(om.VariantId, *itemId, *sku, *options, *stock, priceOverride, om.CreatedAt, om.UpdatedAt), nil
+}
+
+func toDomainItemVariants(oms []model.ItemVariant) (domain.ItemVariants, error) {
+	variants := make(domain.ItemVariants, 0, len(oms))
+	for _, om := range oms {
+		variant, err := toDomainItemVariant(om)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, *variant)
+	}
+	return variants, nil
+}
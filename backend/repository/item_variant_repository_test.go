@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func seedVariantTestItem(t *testing.T, tx *gorm.DB) string {
+	t.Helper()
+	userId := uuid.NewString()
+	user := model.User{Id: userId, Name: "VariantUser", Email: userId + "@example.com", Password: "password", Role: "ADMINISTRATOR", IsAdmin: true}
+	if err := tx.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	itemId := uuid.NewString()
+	item := model.Item{ItemId: itemId, UserId: userId, ItemName: "Hand-knit socks", Description: "Desc"}
+	if err := tx.Create(&item).Error; err != nil {
+		t.Fatal(err)
+	}
+	return itemId
+}
+
+func newTestVariant(t *testing.T, itemId string, sku string, options map[string]string, priceOverride string) *domain.ItemVariant {
+	t.Helper()
+	itemIdValue, _ := domain.NewItemId(itemId)
+	skuCode, err := domain.NewSkuCode(sku)
+	if err != nil {
+		t.Fatal(err)
+	}
+	variantOptions, err := domain.NewVariantOptions(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stock, _ := domain.NewStock(3, 0, 1)
+	var price *domain.Money
+	if priceOverride != "" {
+		price, _ = domain.NewMoneyFromString(priceOverride, domain.CurrencyJPY)
+	}
+	return domain.NewItemVariant(*itemIdValue, *skuCode, *variantOptions, *stock, price)
+}
+
+func TestItemVariantRepository(t *testing.T) {
+	t.Run("Create Variant - Loaded With Item", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId := seedVariantTestItem(t, tx)
+		ivr := NewItemVariantRepository(tx)
+
+		created, err := ivr.CreateVariant(newTestVariant(t, itemId, "SOCK-M-RED", map[string]string{"color": "Red", "size": "M"}, "1200"))
+		assert.NoError(t, err)
+		assert.Equal(t, "1200", created.PriceOverride().String())
+
+		_, err = ivr.CreateVariant(newTestVariant(t, itemId, "SOCK-L-RED", map[string]string{"color": "Red", "size": "L"}, ""))
+		assert.NoError(t, err)
+
+		itemIdValue, _ := domain.NewItemId(itemId)
+		item, err := NewItemRepository(tx).GetItemByID(itemIdValue)
+		assert.NoError(t, err)
+		variants := item.Variants()
+		assert.Len(t, variants, 2)
+		assert.Equal(t, map[string]string{"color": "Red", "size": "M"}, variants.FindByID(created.VariantId()).Options().Values())
+		assert.Nil(t, variants[1].PriceOverride())
+	})
+
+	t.Run("Create Variant - Records Initial Stock Movement", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId := seedVariantTestItem(t, tx)
+		created, err := NewItemVariantRepository(tx).CreateVariant(newTestVariant(t, itemId, "SOCK-M", map[string]string{"size": "M"}, ""))
+		assert.NoError(t, err)
+
+		var movements []model.StockMovement
+		tx.Where("item_id = ?", itemId).Find(&movements)
+		if assert.Len(t, movements, 1) {
+			assert.Equal(t, created.VariantId(), movements[0].VariantId)
+			assert.Equal(t, domain.StockMovementReceive, movements[0].MovementType)
+			assert.Equal(t, 3, movements[0].QuantityDelta)
+		}
+	})
+
+	t.Run("Create Variant - Duplicate Options And Sku", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId := seedVariantTestItem(t, tx)
+		otherItemId := seedVariantTestItem(t, tx)
+		ivr := NewItemVariantRepository(tx)
+
+		_, err := ivr.CreateVariant(newTestVariant(t, itemId, "SOCK-M", map[string]string{"size": "M"}, ""))
+		assert.NoError(t, err)
+
+		_, err = ivr.CreateVariant(newTestVariant(t, itemId, "SOCK-M-2", map[string]string{"size": "m"}, ""))
+		assert.True(t, errors.Is(err, domain.ErrDuplicateVariantOptions))
+
+		// SKU は商品をまたいで一意
+		_, err = ivr.CreateVariant(newTestVariant(t, otherItemId, "SOCK-M", map[string]string{"size": "M"}, ""))
+		assert.True(t, errors.Is(err, domain.ErrDuplicateSkuCode))
+
+		// 同じ組み合わせでも別の商品なら登録できる
+		_, err = ivr.CreateVariant(newTestVariant(t, otherItemId, "MITTEN-M", map[string]string{"size": "M"}, ""))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Update And Delete Variant", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId := seedVariantTestItem(t, tx)
+		ivr := NewItemVariantRepository(tx)
+
+		created, err := ivr.CreateVariant(newTestVariant(t, itemId, "SOCK-M", map[string]string{"size": "M"}, "1200"))
+		assert.NoError(t, err)
+
+		itemIdValue, _ := domain.NewItemId(itemId)
+		sku, _ := domain.NewSkuCode("SOCK-M-NEW")
+		options, _ := domain.NewVariantOptions(map[string]string{"size": "M", "color": "Blue"})
+		stock, _ := domain.NewStock(7, 0, 2)
+		revised := domain.RestoreItemVariant(created.VariantId(), *itemIdValue, *sku, *options, *stock, nil, created.CreatedAt(), created.UpdatedAt())
+
+		updated, err := ivr.UpdateVariant(revised)
+		assert.NoError(t, err)
+		assert.Equal(t, "SOCK-M-NEW", updated.Sku())
+		// 実在庫数は在庫移動でのみ変わる
+		assert.Equal(t, 3, updated.Stock().OnHand())
+		assert.Equal(t, 2, updated.Stock().LowStockThreshold())
+		assert.Nil(t, updated.PriceOverride())
+
+		assert.NoError(t, ivr.DeleteVariant(itemIdValue, created.VariantId()))
+		assert.ErrorIs(t, ivr.DeleteVariant(itemIdValue, created.VariantId()), gorm.ErrRecordNotFound)
+	})
+}
@@ -0,0 +1,492 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IOrderRepository は注文を扱う
+// 在庫の引当と注文の作成は同じトランザクションで行う
+type IOrderRepository interface {
+	PlaceOrder(userId *domain.UserId, req *domain.OrderRequest) (*domain.Order, error)
+	GetOrderByID(orderId string) (*domain.Order, error)
+	GetOrdersByUserID(userId *domain.UserId) ([]*domain.Order, error)
+	TransitionOrder(orderId string, to domain.OrderStatus, actor *domain.UserId) (*domain.Order, error)
+}
+
+type orderRepository struct {
+	db *gorm.DB
+}
+
+func NewOrderRepository(db *gorm.DB) IOrderRepository {
+	return &orderRepository{db}
+}
+
+// PlaceOrder は明細の在庫を引き当てて注文を作成する
+// 商品とバリエーションの行ロックを取ってから販売可能数を確かめるため、最後の1点に注文が並行しても引き当てられるのは1件だけになる
+// クーポンを使う場合は、同じトランザクションでクーポンの行ロックを取ってから利用回数を確かめて増やす
+// 注文を取り消してもクーポンの利用回数は戻さない
+func (or *orderRepository) PlaceOrder(userId *domain.UserId, req *domain.OrderRequest) (*domain.Order, error) {
+	var order *domain.Order
+	err := or.db.Transaction(func(tx *gorm.DB) error {
+		// クーポンの対象商品の更新と同じく、クーポン、商品の順にロックしてデッドロックを避ける
+		var coupon *domain.Coupon
+		if req.CouponCode() != "" {
+			var err error
+			coupon, err = lockCouponByCode(tx, req.CouponCode())
+			if err != nil {
+				return err
+			}
+		}
+
+		items, err := lockItems(tx, req.ItemIds())
+		if err != nil {
+			return err
+		}
+
+		lines := make([]domain.OrderLine, 0, len(req.Lines()))
+		for _, reqLine := range req.Lines() {
+			item, ok := items[reqLine.ItemId()]
+			if !ok {
+				return fmt.Errorf("%w: item %s", domain.ErrOrderItemUnavailable, reqLine.ItemId())
+			}
+			line, stock, err := domain.ReserveOrderLine(item, reqLine)
+			if err != nil {
+				return err
+			}
+			if err := saveReservation(tx, line, stock); err != nil {
+				return err
+			}
+			lines = append(lines, *line)
+		}
+
+		order, err = domain.NewOrder(*userId, lines)
+		if err != nil {
+			return err
+		}
+		if coupon != nil {
+			redemptions, err := countCouponRedemptions(tx, coupon.CouponId(), userId)
+			if err != nil {
+				return err
+			}
+			order, err = order.ApplyCoupon(coupon, redemptions, time.Now())
+			if err != nil {
+				return err
+			}
+		}
+		ormOrder, err := toOrderModel(order)
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(&ormOrder).Error; err != nil {
+			return err
+		}
+		if coupon != nil {
+			return redeemCoupon(tx, coupon, order)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func (or *orderRepository) GetOrderByID(orderId string) (*domain.Order, error) {
+	var ormOrder model.Order
+	if err := or.db.Scopes(preloadOrderRelations).Where("order_id = ?", orderId).First(&ormOrder).Error; err != nil {
+		return nil, err
+	}
+	return toDomainOrder(ormOrder)
+}
+
+// GetOrdersByUserID はユーザーの注文を新しい順に返す
+func (or *orderRepository) GetOrdersByUserID(userId *domain.UserId) ([]*domain.Order, error) {
+	var ormOrders []model.Order
+	err := or.db.Scopes(preloadOrderRelations).
+		Where("user_id = ?", userId.Value()).
+		Order("created_at DESC").
+		Order("order_id DESC").
+		Find(&ormOrders).Error
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*domain.Order, 0, len(ormOrders))
+	for _, ormOrder := range ormOrders {
+		order, err := toDomainOrder(ormOrder)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+// TransitionOrder は注文を to に遷移させ、遷移を記録する
+// 発送では引き当てた在庫を出庫し、発送前の取り消し・返金では引当を解除する。在庫の更新も同じトランザクションで行う
+func (or *orderRepository) TransitionOrder(orderId string, to domain.OrderStatus, actor *domain.UserId) (*domain.Order, error) {
+	var next *domain.Order
+	err := or.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		next, err = transitionOrder(tx, orderId, to, actor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+// transitionOrder は tx の中で注文を遷移させる。決済の反映など、他の更新と同じトランザクションで遷移させる場合にも使う
+func transitionOrder(tx *gorm.DB, orderId string, to domain.OrderStatus, actor *domain.UserId) (*domain.Order, error) {
+	// 同じ注文への遷移が並行しても、どちらも同じ遷移元から遷移しないよう行ロックを取る
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("order_id").Where("order_id = ?", orderId).First(&model.Order{}).Error; err != nil {
+		return nil, err
+	}
+	var ormOrder model.Order
+	if err := tx.Scopes(preloadOrderRelations).Where("order_id = ?", orderId).First(&ormOrder).Error; err != nil {
+		return nil, err
+	}
+	order, err := toDomainOrder(ormOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	next, transition, err := order.TransitionTo(to, *actor)
+	if err != nil {
+		return nil, err
+	}
+	if transition.ReleasesReservation() || transition.FulfilsReservation() {
+		if err := applyOrderStock(tx, next, transition); err != nil {
+			return nil, err
+		}
+	}
+
+	result := tx.Model(&model.Order{}).
+		Where("order_id = ?", orderId).
+		Updates(map[string]interface{}{"status": string(next.Status()), "updated_at": next.UpdatedAt()})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	ormTransition := toOrderTransitionModel(orderId, transition)
+	if err := tx.Create(&ormTransition).Error; err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+// lockItems は商品とそのバリエーションの行ロックを取って返す。削除済みの商品は含めない
+// 複数の商品を含む注文や在庫移動が並行してもデッドロックしないよう、商品 ID 順、バリエーション ID 順にロックする
+func lockItems(tx *gorm.DB, itemIds []string) (map[string]*domain.Item, error) {
+	var ormItems []model.Item
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("item_id IN ?", itemIds).Order("item_id ASC").Find(&ormItems).Error; err != nil {
+		return nil, err
+	}
+	var ormVariants []model.ItemVariant
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("item_id IN ?", itemIds).Order("variant_id ASC").Find(&ormVariants).Error; err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]*domain.Item, len(ormItems))
+	for _, ormItem := range ormItems {
+		for _, ormVariant := range ormVariants {
+			if ormVariant.ItemId == ormItem.ItemId {
+				ormItem.Variants = append(ormItem.Variants, ormVariant)
+			}
+		}
+		item, err := toDomainItem(ormItem)
+		if err != nil {
+			return nil, err
+		}
+		items[ormItem.ItemId] = item
+	}
+	return items, nil
+}
+
+// saveReservation は引当後の引当済み数量を、バリエーションの明細ではバリエーションに、それ以外は商品に書き込む
+func saveReservation(tx *gorm.DB, line *domain.OrderLine, stock *domain.Stock) error {
+	if line.VariantId() != "" {
+		return tx.Model(&model.ItemVariant{}).Where("variant_id = ?", line.VariantId()).Update("reserved_quantity", stock.Reserved()).Error
+	}
+	return tx.Model(&model.Item{}).Where("item_id = ?", line.ItemId()).Update("reserved_quantity", stock.Reserved()).Error
+}
+
+// applyOrderStock は遷移に応じて明細の在庫の引当を解除、または出庫する
+// 出庫は商品・バリエーションとも在庫移動台帳に販売として記録する
+// 注文後に削除された商品の在庫も更新し、削除されたバリエーションの明細は在庫がないため何もしない
+func applyOrderStock(tx *gorm.DB, order *domain.Order, transition *domain.OrderTransition) error {
+	itemIds := make([]string, 0, len(order.Lines()))
+	for _, line := range order.Lines() {
+		itemIds = append(itemIds, line.ItemId())
+	}
+	// 注文の作成と同じく商品 ID 順、バリエーション ID 順にロックしてデッドロックを避ける
+	var ormItems []model.Item
+	if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Where("item_id IN ?", itemIds).Order("item_id ASC").Find(&ormItems).Error; err != nil {
+		return err
+	}
+	var ormVariants []model.ItemVariant
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("item_id IN ?", itemIds).Order("variant_id ASC").Find(&ormVariants).Error; err != nil {
+		return err
+	}
+	items := make(map[string]*model.Item, len(ormItems))
+	for i := range ormItems {
+		items[ormItems[i].ItemId] = &ormItems[i]
+	}
+	for _, ormVariant := range ormVariants {
+		items[ormVariant.ItemId].Variants = append(items[ormVariant.ItemId].Variants, ormVariant)
+	}
+
+	// 再入荷の判定のため、遷移前に販売可能だったかを商品ごとに覚えておく
+	wasInStock := make(map[string]bool, len(items))
+	for itemId, ormItem := range items {
+		item, err := toDomainItem(*ormItem)
+		if err != nil {
+			return err
+		}
+		wasInStock[itemId] = item.InStock()
+	}
+
+	for _, line := range order.Lines() {
+		ormItem, ok := items[line.ItemId()]
+		if !ok {
+			return fmt.Errorf("item %s of order %s not found", line.ItemId(), order.OrderId())
+		}
+		if err := applyOrderLine(tx, order, line, transition, ormItem); err != nil {
+			return err
+		}
+	}
+
+	// 引当の解除で在庫切れから販売可能になった商品は、再入荷として通知を積む
+	for _, ormItem := range ormItems {
+		item, err := toDomainItem(*items[ormItem.ItemId])
+		if err != nil {
+			return err
+		}
+		if !wasInStock[ormItem.ItemId] && item.InStock() {
+			if err := queueStockNotifications(tx, ormItem.ItemId); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyOrderLine は明細の在庫をバリエーションの明細ではバリエーションに、それ以外は商品に反映し、ormItem の数量も更新する
+func applyOrderLine(tx *gorm.DB, order *domain.Order, line domain.OrderLine, transition *domain.OrderTransition, ormItem *model.Item) error {
+	if line.VariantId() == "" {
+		stock, err := applyOrderLineStock(ormItem.OnHandQuantity, ormItem.ReservedQuantity, ormItem.LowStockThreshold, line, transition)
+		if err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&model.Item{}).Where("item_id = ?", line.ItemId()).
+			Updates(map[string]interface{}{"on_hand_quantity": stock.OnHand(), "reserved_quantity": stock.Reserved()}).Error; err != nil {
+			return err
+		}
+		ormItem.OnHandQuantity, ormItem.ReservedQuantity = stock.OnHand(), stock.Reserved()
+	} else {
+		ormVariant := findVariantModel(ormItem, line.VariantId())
+		if ormVariant == nil {
+			return nil
+		}
+		stock, err := applyOrderLineStock(ormVariant.OnHandQuantity, ormVariant.ReservedQuantity, ormVariant.LowStockThreshold, line, transition)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&model.ItemVariant{}).Where("variant_id = ?", line.VariantId()).
+			Updates(map[string]interface{}{"on_hand_quantity": stock.OnHand(), "reserved_quantity": stock.Reserved()}).Error; err != nil {
+			return err
+		}
+		ormVariant.OnHandQuantity, ormVariant.ReservedQuantity = stock.OnHand(), stock.Reserved()
+	}
+
+	if !transition.FulfilsReservation() {
+		return nil
+	}
+	movement, err := newOrderSaleMovement(order, line, transition)
+	if err != nil {
+		return err
+	}
+	ormMovement := toStockMovementModel(movement)
+	return tx.Create(&ormMovement).Error
+}
+
+// findVariantModel は ormItem に読み込んだバリエーションのうち ID が一致するものを返す。見つからない場合は nil
+func findVariantModel(ormItem *model.Item, variantId string) *model.ItemVariant {
+	for i := range ormItem.Variants {
+		if ormItem.Variants[i].VariantId == variantId {
+			return &ormItem.Variants[i]
+		}
+	}
+	return nil
+}
+
+func applyOrderLineStock(onHand int, reserved int, lowStockThreshold int, line domain.OrderLine, transition *domain.OrderTransition) (*domain.Stock, error) {
+	stock, err := domain.NewStock(onHand, reserved, lowStockThreshold)
+	if err != nil {
+		return nil, err
+	}
+	if transition.FulfilsReservation() {
+		return stock.Fulfill(line.Quantity())
+	}
+	return stock.Release(line.Quantity())
+}
+
+// newOrderSaleMovement は明細の出庫を、遷移させたユーザーによる販売の在庫移動として作る。バリエーションの明細ではバリエーションの移動になる
+func newOrderSaleMovement(order *domain.Order, line domain.OrderLine, transition *domain.OrderTransition) (*domain.StockMovement, error) {
+	itemId, err := domain.NewItemId(line.ItemId())
+	if err != nil {
+		return nil, err
+	}
+	actor, err := domain.NewUserId(transition.ActorId())
+	if err != nil {
+		return nil, err
+	}
+	movementType, err := domain.NewStockMovementType(domain.StockMovementSale)
+	if err != nil {
+		return nil, err
+	}
+	movement, err := domain.NewStockMovement(*itemId, *movementType, -line.Quantity(), "order "+order.OrderId(), *actor)
+	if err != nil {
+		return nil, err
+	}
+	return movement.WithVariantId(line.VariantId()), nil
+}
+
+// preloadOrderRelations は注文と一緒に明細（注文時の順）と状態遷移（古い順）を読み込む
+func preloadOrderRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Transitions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC").Order("transition_id ASC")
+		})
+}
+
+func toOrderModel(order *domain.Order) (model.Order, error) {
+	ormLines := make([]model.OrderLine, 0, len(order.Lines()))
+	for i, line := range order.Lines() {
+		options, err := json.Marshal(line.Options())
+		if err != nil {
+			return model.Order{}, err
+		}
+		ormLines = append(ormLines, model.OrderLine{
+			OrderLineId: line.LineId(),
+			OrderId:     order.OrderId(),
+			ItemId:      line.ItemId(),
+			VariantId:   line.VariantId(),
+			ItemName:    line.ItemName(),
+			Sku:         line.Sku(),
+			Options:     string(options),
+			UnitPrice:   line.UnitPrice().Amount(),
+			TaxRate:     string(line.TaxRate()),
+			Quantity:    line.Quantity(),
+			Position:    i,
+		})
+	}
+	ormTransitions := make([]model.OrderTransition, 0, len(order.Transitions()))
+	for _, transition := range order.Transitions() {
+		ormTransitions = append(ormTransitions, toOrderTransitionModel(order.OrderId(), &transition))
+	}
+	var couponCode *string
+	if order.CouponCode() != "" {
+		code := order.CouponCode()
+		couponCode = &code
+	}
+	return model.Order{
+		OrderId:     order.OrderId(),
+		UserId:      order.UserId(),
+		Status:      string(order.Status()),
+		Subtotal:    order.Subtotal().Amount(),
+		Discount:    order.Discount().Amount(),
+		Tax:         order.Tax().Amount(),
+		Total:       order.Total().Amount(),
+		Currency:    order.Subtotal().Currency(),
+		CouponCode:  couponCode,
+		CreatedAt:   order.CreatedAt(),
+		UpdatedAt:   order.UpdatedAt(),
+		Lines:       ormLines,
+		Transitions: ormTransitions,
+	}, nil
+}
+
+func toOrderTransitionModel(orderId string, transition *domain.OrderTransition) model.OrderTransition {
+	var from *string
+	if transition.From() != "" {
+		value := string(transition.From())
+		from = &value
+	}
+	return model.OrderTransition{
+		TransitionId: transition.TransitionId(),
+		OrderId:      orderId,
+		FromStatus:   from,
+		ToStatus:     string(transition.To()),
+		UserId:       transition.ActorId(),
+		CreatedAt:    transition.CreatedAt(),
+	}
+}
+
+func toDomainOrder(ormOrder model.Order) (*domain.Order, error) {
+	userId, err := domain.NewUserId(ormOrder.UserId)
+	if err != nil {
+		return nil, err
+	}
+	subtotal, err := domain.NewMoney(ormOrder.Subtotal, ormOrder.Currency)
+	if err != nil {
+		return nil, err
+	}
+	tax, err := domain.NewMoney(ormOrder.Tax, ormOrder.Currency)
+	if err != nil {
+		return nil, err
+	}
+	total, err := domain.NewMoney(ormOrder.Total, ormOrder.Currency)
+	if err != nil {
+		return nil, err
+	}
+	discount, err := domain.NewMoney(ormOrder.Discount, ormOrder.Currency)
+	if err != nil {
+		return nil, err
+	}
+	var couponCode string
+	if ormOrder.CouponCode != nil {
+		couponCode = *ormOrder.CouponCode
+	}
+
+	lines := make([]domain.OrderLine, 0, len(ormOrder.Lines))
+	for _, ormLine := range ormOrder.Lines {
+		var options map[string]string
+		if err := json.Unmarshal([]byte(ormLine.Options), &options); err != nil {
+			return nil, err
+		}
+		unitPrice, err := domain.NewMoney(ormLine.UnitPrice, ormOrder.Currency)
+		if err != nil {
+			return nil, err
+		}
+		taxRate, err := domain.NewTaxRate(ormLine.TaxRate)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, *domain.RestoreOrderLine(ormLine.OrderLineId, ormLine.ItemId, ormLine.VariantId, ormLine.ItemName, ormLine.Sku, options, *unitPrice, taxRate, ormLine.Quantity))
+	}
+
+	transitions := make([]domain.OrderTransition, 0, len(ormOrder.Transitions))
+	for _, ormTransition := range ormOrder.Transitions {
+		actor, err := domain.NewUserId(ormTransition.UserId)
+		if err != nil {
+			return nil, err
+		}
+		var from domain.OrderStatus
+		if ormTransition.FromStatus != nil {
+			from = domain.OrderStatus(*ormTransition.FromStatus)
+		}
+		transitions = append(transitions, *domain.RestoreOrderTransition(ormTransition.TransitionId, from, domain.OrderStatus(ormTransition.ToStatus), *actor, ormTransition.CreatedAt))
+	}
+	return domain.RestoreOrder(ormOrder.OrderId, *userId, domain.OrderStatus(ormOrder.Status), lines, *subtotal, *discount, *tax, *total, couponCode, transitions, ormOrder.CreatedAt, ormOrder.UpdatedAt), nil
+}
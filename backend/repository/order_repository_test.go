@@ -0,0 +1,461 @@
+package repository
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// seedOrderTestItem は在庫 onHand の商品を作成し、その商品 ID を返す
+func seedOrderTestItem(t *testing.T, db *gorm.DB, userId string, onHand int, price string) string {
+	t.Helper()
+	itemId := uuid.NewString()
+	amount, _ := domain.NewMoneyFromString(price, domain.CurrencyJPY)
+	item := model.Item{ItemId: itemId, UserId: userId, ItemName: "Hand-knit sweater", OnHandQuantity: onHand, Description: "Desc", Price: amount.Amount(), PriceCurrency: domain.CurrencyJPY}
+	if err := db.Create(&item).Error; err != nil {
+		t.Fatal(err)
+	}
+	return itemId
+}
+
+func seedOrderTestUser(t *testing.T, db *gorm.DB) *domain.UserId {
+	t.Helper()
+	userId := uuid.NewString()
+	user := model.User{Id: userId, Name: "OrderUser", Email: userId + "@example.com", Password: "password", Role: "USER"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	value, _ := domain.NewUserId(userId)
+	return value
+}
+
+func newTestOrderRequest(t *testing.T, lines ...domain.OrderRequestLine) *domain.OrderRequest {
+	t.Helper()
+	req, err := domain.NewOrderRequest(lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func newTestOrderLine(t *testing.T, itemId string, variantId string, quantity int) domain.OrderRequestLine {
+	t.Helper()
+	itemIdValue, _ := domain.NewItemId(itemId)
+	line, err := domain.NewOrderRequestLine(*itemIdValue, variantId, quantity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *line
+}
+
+// cleanupOrderTestData は並行注文のテストでコミットしたデータを削除する
+func cleanupOrderTestData(t *testing.T, userIds []string, itemIds []string) {
+	t.Helper()
+	db.Where("user_id IN ?", userIds).Delete(&model.Order{})
+	db.Where("item_id IN ?", itemIds).Delete(&model.ItemVariant{})
+	db.Unscoped().Where("item_id IN ?", itemIds).Delete(&model.Item{})
+	db.Where("user_id IN ?", userIds).Delete(&model.User{})
+}
+
+// placeOrdersConcurrently は全員が揃ってから一斉に注文し、各注文の結果を返す
+func placeOrdersConcurrently(or IOrderRepository, buyers []*domain.UserId, requests []*domain.OrderRequest) []error {
+	errs := make([]error, len(buyers))
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := range buyers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			_, errs[i] = or.PlaceOrder(buyers[i], requests[i])
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+	return errs
+}
+
+func TestOrderRepository(t *testing.T) {
+	t.Run("Place Order - Reserves Stock And Snapshots Lines", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, buyer.Value(), 5, "1200")
+		or := NewOrderRepository(tx)
+
+		order, err := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 2)))
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.OrderStatusPendingPayment, order.Status())
+		assert.Equal(t, "2400", order.Subtotal().String())
+		assert.Equal(t, "2640", order.Total().String())
+
+		var ormItem model.Item
+		tx.Where("item_id = ?", itemId).First(&ormItem)
+		assert.Equal(t, 5, ormItem.OnHandQuantity)
+		assert.Equal(t, 2, ormItem.ReservedQuantity)
+
+		found, err := or.GetOrderByID(order.OrderId())
+		assert.NoError(t, err)
+		assert.Len(t, found.Lines(), 1)
+		assert.Equal(t, "Hand-knit sweater", found.Lines()[0].ItemName())
+		assert.Equal(t, "1200", found.Lines()[0].UnitPrice().String())
+		assert.Equal(t, "264", found.Tax().String())
+	})
+
+	t.Run("Place Order - Snapshot Is Not Rewritten By UpdateItem", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, buyer.Value(), 5, "1200")
+		or := NewOrderRepository(tx)
+		order, err := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 1)))
+		assert.NoError(t, err)
+
+		itemIdValue, _ := domain.NewItemId(itemId)
+		ir := NewItemRepository(tx)
+		item, _ := ir.GetItemByID(itemIdValue)
+		newName, _ := domain.NewItemName("Renamed sweater")
+		newPrice, _ := domain.NewMoneyFromString("9800", domain.CurrencyJPY)
+		userId, _ := domain.NewUserId(item.UserId())
+		description, _ := domain.NewDescription(item.Description())
+		updated, _ := domain.NewItemWithTimestamps(itemIdValue, *userId, *newName, *item.Stock(), *description, *newPrice, item.CreatedAt(), item.UpdatedAt())
+		_, err = ir.UpdateItem(updated)
+		assert.NoError(t, err)
+
+		found, err := or.GetOrderByID(order.OrderId())
+		assert.NoError(t, err)
+		assert.Equal(t, "Hand-knit sweater", found.Lines()[0].ItemName())
+		assert.Equal(t, "1200", found.Lines()[0].UnitPrice().String())
+		assert.Equal(t, "1320", found.Total().String())
+	})
+
+	t.Run("Place Order - Variant Line Reserves Variant Stock", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, buyer.Value(), 0, "2000")
+		variant, err := NewItemVariantRepository(tx).CreateVariant(newTestVariant(t, itemId, "SWEATER-M", map[string]string{"size": "M"}, "2200"))
+		assert.NoError(t, err)
+		or := NewOrderRepository(tx)
+
+		order, err := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, variant.VariantId(), 3)))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SWEATER-M", order.Lines()[0].Sku())
+		assert.Equal(t, "6600", order.Subtotal().String())
+
+		var ormVariant model.ItemVariant
+		tx.Where("variant_id = ?", variant.VariantId()).First(&ormVariant)
+		assert.Equal(t, 3, ormVariant.ReservedQuantity)
+		var ormItem model.Item
+		tx.Where("item_id = ?", itemId).First(&ormItem)
+		assert.Equal(t, 0, ormItem.ReservedQuantity)
+
+		found, err := or.GetOrderByID(order.OrderId())
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"size": "M"}, found.Lines()[0].Options())
+	})
+
+	t.Run("Place Order - Insufficient Stock Rolls Back Every Line", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		enough := seedOrderTestItem(t, tx, buyer.Value(), 5, "500")
+		short := seedOrderTestItem(t, tx, buyer.Value(), 1, "500")
+		or := NewOrderRepository(tx)
+
+		_, err := or.PlaceOrder(buyer, newTestOrderRequest(t,
+			newTestOrderLine(t, enough, "", 2),
+			newTestOrderLine(t, short, "", 2),
+		))
+
+		assert.True(t, errors.Is(err, domain.ErrInsufficientStock))
+		var ormItem model.Item
+		tx.Where("item_id = ?", enough).First(&ormItem)
+		assert.Equal(t, 0, ormItem.ReservedQuantity)
+		var count int64
+		tx.Model(&model.Order{}).Where("user_id = ?", buyer.Value()).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("Place Order - Deleted Item Is Unavailable", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, buyer.Value(), 5, "500")
+		itemIdValue, _ := domain.NewItemId(itemId)
+		assert.NoError(t, NewItemRepository(tx).DeleteItem(itemIdValue))
+		or := NewOrderRepository(tx)
+
+		_, err := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 1)))
+
+		assert.True(t, errors.Is(err, domain.ErrOrderItemUnavailable))
+	})
+
+	t.Run("Get Orders By User ID - Newest First", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		other := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, buyer.Value(), 5, "500")
+		or := NewOrderRepository(tx)
+		first, _ := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 1)))
+		second, _ := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 1)))
+		_, _ = or.PlaceOrder(other, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 1)))
+		tx.Model(&model.Order{}).Where("order_id = ?", first.OrderId()).Update("created_at", time.Now().Add(-time.Hour))
+
+		orders, err := or.GetOrdersByUserID(buyer)
+
+		assert.NoError(t, err)
+		assert.Len(t, orders, 2)
+		assert.Equal(t, second.OrderId(), orders[0].OrderId())
+		assert.Equal(t, first.OrderId(), orders[1].OrderId())
+	})
+
+	t.Run("Get Order By ID - Not Found", func(t *testing.T) {
+		_, err := NewOrderRepository(db).GetOrderByID(uuid.NewString())
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	})
+}
+
+// transitionTestOrder は注文を順に遷移させ、最後の遷移後の注文を返す
+func transitionTestOrder(t *testing.T, or IOrderRepository, orderId string, actor *domain.UserId, statuses ...domain.OrderStatus) *domain.Order {
+	t.Helper()
+	var order *domain.Order
+	for _, status := range statuses {
+		var err error
+		order, err = or.TransitionOrder(orderId, status, actor)
+		if err != nil {
+			t.Fatalf("Failed to transition order to %s: %v", status, err)
+		}
+	}
+	return order
+}
+
+func TestOrderRepository_TransitionOrder(t *testing.T) {
+	t.Run("Records Each Transition With Actor", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		admin := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, admin.Value(), 5, "1000")
+		or := NewOrderRepository(tx)
+		order, _ := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 2)))
+
+		transitionTestOrder(t, or, order.OrderId(), admin, domain.OrderStatusPaid, domain.OrderStatusPreparing)
+
+		found, err := or.GetOrderByID(order.OrderId())
+		assert.NoError(t, err)
+		assert.Equal(t, domain.OrderStatusPreparing, found.Status())
+		transitions := found.Transitions()
+		assert.Len(t, transitions, 3)
+		assert.Equal(t, domain.OrderStatus(""), transitions[0].From())
+		assert.Equal(t, buyer.Value(), transitions[0].ActorId())
+		assert.Equal(t, domain.OrderStatusPaid, transitions[1].To())
+		assert.Equal(t, admin.Value(), transitions[2].ActorId())
+	})
+
+	t.Run("Shipping Fulfils Reservation And Records Sale", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		admin := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, admin.Value(), 5, "1000")
+		or := NewOrderRepository(tx)
+		order, _ := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 2)))
+
+		transitionTestOrder(t, or, order.OrderId(), admin, domain.OrderStatusPaid, domain.OrderStatusPreparing, domain.OrderStatusShipped)
+
+		var ormItem model.Item
+		tx.Where("item_id = ?", itemId).First(&ormItem)
+		assert.Equal(t, 3, ormItem.OnHandQuantity)
+		assert.Equal(t, 0, ormItem.ReservedQuantity)
+		var movements []model.StockMovement
+		tx.Where("item_id = ?", itemId).Find(&movements)
+		assert.Len(t, movements, 1)
+		assert.Equal(t, domain.StockMovementSale, movements[0].MovementType)
+		assert.Equal(t, -2, movements[0].QuantityDelta)
+		assert.Equal(t, admin.Value(), movements[0].UserId)
+	})
+
+	t.Run("Shipping Variant Line Updates Variant Stock", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		admin := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, admin.Value(), 0, "2000")
+		variant, _ := NewItemVariantRepository(tx).CreateVariant(newTestVariant(t, itemId, "SWEATER-L", map[string]string{"size": "L"}, ""))
+		or := NewOrderRepository(tx)
+		order, _ := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, variant.VariantId(), 1)))
+
+		transitionTestOrder(t, or, order.OrderId(), admin, domain.OrderStatusPaid, domain.OrderStatusPreparing, domain.OrderStatusShipped)
+
+		var ormVariant model.ItemVariant
+		tx.Where("variant_id = ?", variant.VariantId()).First(&ormVariant)
+		assert.Equal(t, 2, ormVariant.OnHandQuantity)
+		assert.Equal(t, 0, ormVariant.ReservedQuantity)
+
+		var sale model.StockMovement
+		assert.NoError(t, tx.Where("item_id = ? AND movement_type = ?", itemId, domain.StockMovementSale).First(&sale).Error)
+		assert.Equal(t, variant.VariantId(), sale.VariantId)
+		assert.Equal(t, -1, sale.QuantityDelta)
+	})
+
+	t.Run("Cancelling Releases Reservation", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, buyer.Value(), 5, "1000")
+		or := NewOrderRepository(tx)
+		order, _ := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 2)))
+
+		transitionTestOrder(t, or, order.OrderId(), buyer, domain.OrderStatusCancelled)
+
+		var ormItem model.Item
+		tx.Where("item_id = ?", itemId).First(&ormItem)
+		assert.Equal(t, 5, ormItem.OnHandQuantity)
+		assert.Equal(t, 0, ormItem.ReservedQuantity)
+	})
+
+	t.Run("Illegal Transition Changes Nothing", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		itemId := seedOrderTestItem(t, tx, buyer.Value(), 5, "1000")
+		or := NewOrderRepository(tx)
+		order, _ := or.PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 2)))
+
+		_, err := or.TransitionOrder(order.OrderId(), domain.OrderStatusShipped, buyer)
+
+		assert.True(t, errors.Is(err, domain.ErrIllegalOrderTransition))
+		found, _ := or.GetOrderByID(order.OrderId())
+		assert.Equal(t, domain.OrderStatusPendingPayment, found.Status())
+		assert.Len(t, found.Transitions(), 1)
+		var ormItem model.Item
+		tx.Where("item_id = ?", itemId).First(&ormItem)
+		assert.Equal(t, 2, ormItem.ReservedQuantity)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		actor, _ := domain.NewUserId(uuid.NewString())
+		_, err := NewOrderRepository(db).TransitionOrder(uuid.NewString(), domain.OrderStatusPaid, actor)
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	})
+}
+
+// 並行注文のテストはトランザクションをまたいだ行ロックを確かめるため、データをコミットして後で削除する
+func TestOrderRepository_ConcurrentOrders(t *testing.T) {
+	t.Run("Last Piece Is Sold Only Once", func(t *testing.T) {
+		const buyersCount = 10
+		seller := seedOrderTestUser(t, db)
+		itemId := seedOrderTestItem(t, db, seller.Value(), 1, "15000")
+		userIds := []string{seller.Value()}
+		buyers := make([]*domain.UserId, buyersCount)
+		requests := make([]*domain.OrderRequest, buyersCount)
+		for i := range buyers {
+			buyers[i] = seedOrderTestUser(t, db)
+			userIds = append(userIds, buyers[i].Value())
+			requests[i] = newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 1))
+		}
+		defer cleanupOrderTestData(t, userIds, []string{itemId})
+
+		errs := placeOrdersConcurrently(NewOrderRepository(db), buyers, requests)
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+				continue
+			}
+			assert.True(t, errors.Is(err, domain.ErrInsufficientStock), err)
+		}
+		assert.Equal(t, 1, succeeded)
+
+		var ormItem model.Item
+		db.Where("item_id = ?", itemId).First(&ormItem)
+		assert.Equal(t, 1, ormItem.ReservedQuantity)
+		var count int64
+		db.Model(&model.OrderLine{}).Where("item_id = ?", itemId).Count(&count)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("Reservations Never Exceed Stock", func(t *testing.T) {
+		const buyersCount = 20
+		const onHand = 5
+		seller := seedOrderTestUser(t, db)
+		itemId := seedOrderTestItem(t, db, seller.Value(), onHand, "3000")
+		userIds := []string{seller.Value()}
+		buyers := make([]*domain.UserId, buyersCount)
+		requests := make([]*domain.OrderRequest, buyersCount)
+		for i := range buyers {
+			buyers[i] = seedOrderTestUser(t, db)
+			userIds = append(userIds, buyers[i].Value())
+			requests[i] = newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 1))
+		}
+		defer cleanupOrderTestData(t, userIds, []string{itemId})
+
+		errs := placeOrdersConcurrently(NewOrderRepository(db), buyers, requests)
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+			}
+		}
+		assert.Equal(t, onHand, succeeded)
+
+		var ormItem model.Item
+		db.Where("item_id = ?", itemId).First(&ormItem)
+		assert.Equal(t, onHand, ormItem.ReservedQuantity)
+	})
+
+	t.Run("Orders Locking Items In Opposite Order Do Not Deadlock", func(t *testing.T) {
+		const buyersCount = 10
+		seller := seedOrderTestUser(t, db)
+		yarn := seedOrderTestItem(t, db, seller.Value(), buyersCount, "800")
+		needles := seedOrderTestItem(t, db, seller.Value(), buyersCount, "1500")
+		userIds := []string{seller.Value()}
+		buyers := make([]*domain.UserId, buyersCount)
+		requests := make([]*domain.OrderRequest, buyersCount)
+		for i := range buyers {
+			buyers[i] = seedOrderTestUser(t, db)
+			userIds = append(userIds, buyers[i].Value())
+			if i%2 == 0 {
+				requests[i] = newTestOrderRequest(t, newTestOrderLine(t, yarn, "", 1), newTestOrderLine(t, needles, "", 1))
+			} else {
+				requests[i] = newTestOrderRequest(t, newTestOrderLine(t, needles, "", 1), newTestOrderLine(t, yarn, "", 1))
+			}
+		}
+		defer cleanupOrderTestData(t, userIds, []string{yarn, needles})
+
+		errs := placeOrdersConcurrently(NewOrderRepository(db), buyers, requests)
+
+		for _, err := range errs {
+			assert.NoError(t, err)
+		}
+		var ormItems []model.Item
+		db.Where("item_id IN ?", []string{yarn, needles}).Find(&ormItems)
+		for _, ormItem := range ormItems {
+			assert.Equal(t, buyersCount, ormItem.ReservedQuantity)
+		}
+	})
+}
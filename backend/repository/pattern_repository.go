@@ -0,0 +1,337 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IPatternRepository は編み図と、玉数の計算に使う毛糸の商品の属性を扱う
+type IPatternRepository interface {
+	GetPatterns() ([]*domain.Pattern, error)
+	GetPatternByID(patternId string) (*domain.Pattern, error)
+	CreatePattern(pattern *domain.Pattern) (*domain.Pattern, error)
+	UpdatePattern(pattern *domain.Pattern) (*domain.Pattern, error)
+	DeletePattern(patternId string) error
+	SetItemYarn(itemId *domain.ItemId, yarn *domain.YarnAttributes) error
+}
+
+type patternRepository struct {
+	db *gorm.DB
+}
+
+func NewPatternRepository(db *gorm.DB) IPatternRepository {
+	return &patternRepository{db}
+}
+
+// GetPatterns は編み図を名前順に返す
+func (pr *patternRepository) GetPatterns() ([]*domain.Pattern, error) {
+	var ormPatterns []model.Pattern
+	err := pr.db.Scopes(preloadPatternRelations).
+		Order("name ASC").
+		Order("pattern_id ASC").
+		Find(&ormPatterns).Error
+	if err != nil {
+		return nil, err
+	}
+
+	patterns := make([]*domain.Pattern, 0, len(ormPatterns))
+	for _, ormPattern := range ormPatterns {
+		pattern, err := toDomainPattern(ormPattern)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+func (pr *patternRepository) GetPatternByID(patternId string) (*domain.Pattern, error) {
+	return getPatternByID(pr.db, patternId)
+}
+
+func (pr *patternRepository) CreatePattern(pattern *domain.Pattern) (*domain.Pattern, error) {
+	ormPattern := toPatternModel(pattern)
+	var created *domain.Pattern
+	err := pr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&ormPattern).Error; err != nil {
+			return err
+		}
+		var err error
+		created, err = getPatternByID(tx, pattern.PatternId())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdatePattern は編み図の項目と、仕上がりサイズ・使える商品をすべて置き換える
+func (pr *patternRepository) UpdatePattern(pattern *domain.Pattern) (*domain.Pattern, error) {
+	ormPattern := toPatternModel(pattern)
+	var updated *domain.Pattern
+	err := pr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("pattern_id").Where("pattern_id = ?", pattern.PatternId()).First(&model.Pattern{}).Error; err != nil {
+			return err
+		}
+		err := tx.Model(&model.Pattern{}).
+			Where("pattern_id = ?", pattern.PatternId()).
+			Select("name", "description", "difficulty", "gauge_stitches", "gauge_rows", "needle_sizes", "yarn_weight", "updated_at").
+			Updates(&ormPattern).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("pattern_id = ?", pattern.PatternId()).Delete(&model.PatternSize{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&ormPattern.Sizes).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("pattern_id = ?", pattern.PatternId()).Delete(&model.PatternItem{}).Error; err != nil {
+			return err
+		}
+		if len(ormPattern.Items) > 0 {
+			if err := tx.Create(&ormPattern.Items).Error; err != nil {
+				return err
+			}
+		}
+		updated, err = getPatternByID(tx, pattern.PatternId())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeletePattern は編み図を削除し、編み図を使っている作品の結び付けを外す
+// 編み図が存在しなければ gorm.ErrRecordNotFound を返す
+func (pr *patternRepository) DeletePattern(patternId string) error {
+	return pr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("pattern_id").Where("pattern_id = ?", patternId).First(&model.Pattern{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.Project{}).Where("pattern_id = ?", patternId).Update("pattern_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("pattern_id = ?", patternId).Delete(&model.PatternSize{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("pattern_id = ?", patternId).Delete(&model.PatternItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("pattern_id = ?", patternId).Delete(&model.Pattern{}).Error
+	})
+}
+
+// SetItemYarn は商品の毛糸の属性と素材の混率を置き換える。yarn が nil の場合は設定を外す
+// 商品が存在しないか論理削除されていれば gorm.ErrRecordNotFound を返す
+func (pr *patternRepository) SetItemYarn(itemId *domain.ItemId, yarn *domain.YarnAttributes) error {
+	return pr.db.Transaction(func(tx *gorm.DB) error {
+		// 商品の行ロックで同じ商品への設定を直列にし、item_yarns の行を重複させない
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("item_id").Where("item_id = ?", itemId.Value()).First(&model.Item{}).Error; err != nil {
+			return err
+		}
+		if yarn == nil {
+			if err := tx.Where("item_id = ?", itemId.Value()).Delete(&model.ItemYarnFiber{}).Error; err != nil {
+				return err
+			}
+			return tx.Where("item_id = ?", itemId.Value()).Delete(&model.ItemYarn{}).Error
+		}
+
+		ormYarn := toItemYarnModel(itemId, yarn)
+		var existing model.ItemYarn
+		err := tx.Where("item_id = ?", itemId.Value()).First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return tx.Create(&ormYarn).Error
+		}
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&model.ItemYarn{}).Where("item_id = ?", itemId.Value()).
+			Select("weight", "yardage_per_skein", "grams_per_skein", "needle_min_mm", "needle_max_mm", "gauge_stitches", "gauge_rows", "updated_at").
+			Updates(&ormYarn).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("item_id = ?", itemId.Value()).Delete(&model.ItemYarnFiber{}).Error; err != nil {
+			return err
+		}
+		if len(ormYarn.Fibers) == 0 {
+			return nil
+		}
+		return tx.Create(&ormYarn.Fibers).Error
+	})
+}
+
+// preloadPatternRelations は編み図と一緒に仕上がりサイズ（登録順）と使える商品を読み込む
+func preloadPatternRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Sizes", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("item_id ASC")
+		})
+}
+
+func getPatternByID(db *gorm.DB, patternId string) (*domain.Pattern, error) {
+	var ormPattern model.Pattern
+	if err := db.Scopes(preloadPatternRelations).Where("pattern_id = ?", patternId).First(&ormPattern).Error; err != nil {
+		return nil, err
+	}
+	return toDomainPattern(ormPattern)
+}
+
+func toPatternModel(pattern *domain.Pattern) model.Pattern {
+	ormPattern := model.Pattern{
+		PatternId:     pattern.PatternId(),
+		Name:          pattern.Name(),
+		Description:   pattern.Description(),
+		Difficulty:    string(pattern.Difficulty()),
+		GaugeStitches: pattern.Gauge().Stitches(),
+		GaugeRows:     pattern.Gauge().Rows(),
+		NeedleSizes:   pattern.NeedleSizes().Key(),
+		YarnWeight:    string(pattern.YarnWeight()),
+		CreatedAt:     pattern.CreatedAt(),
+		UpdatedAt:     pattern.UpdatedAt(),
+	}
+	for i, size := range pattern.Sizes() {
+		ormPattern.Sizes = append(ormPattern.Sizes, model.PatternSize{
+			PatternId:            pattern.PatternId(),
+			Position:             i,
+			Label:                size.Label,
+			FinishedMeasurements: size.FinishedMeasurements,
+			Yardage:              size.Yardage,
+		})
+	}
+	for _, itemId := range pattern.ItemIds() {
+		ormPattern.Items = append(ormPattern.Items, model.PatternItem{PatternId: pattern.PatternId(), ItemId: itemId})
+	}
+	return ormPattern
+}
+
+func toDomainPattern(ormPattern model.Pattern) (*domain.Pattern, error) {
+	difficulty, err := domain.NewPatternDifficulty(ormPattern.Difficulty)
+	if err != nil {
+		return nil, err
+	}
+	gauge, err := domain.NewGauge(ormPattern.GaugeStitches, ormPattern.GaugeRows)
+	if err != nil {
+		return nil, err
+	}
+	needleSizes, err := domain.ParseNeedleSizes(ormPattern.NeedleSizes)
+	if err != nil {
+		return nil, err
+	}
+	yarnWeight, err := domain.NewYarnWeight(ormPattern.YarnWeight)
+	if err != nil {
+		return nil, err
+	}
+	sizes := make([]domain.PatternSize, 0, len(ormPattern.Sizes))
+	for _, ormSize := range ormPattern.Sizes {
+		sizes = append(sizes, domain.PatternSize{
+			Label:                ormSize.Label,
+			FinishedMeasurements: ormSize.FinishedMeasurements,
+			Yardage:              ormSize.Yardage,
+		})
+	}
+	itemIds := make([]domain.ItemId, 0, len(ormPattern.Items))
+	for _, ormItem := range ormPattern.Items {
+		itemId, err := domain.NewItemId(ormItem.ItemId)
+		if err != nil {
+			return nil, err
+		}
+		itemIds = append(itemIds, *itemId)
+	}
+	return domain.RestorePattern(ormPattern.PatternId, domain.PatternDetails{
+		Name:        ormPattern.Name,
+		Description: ormPattern.Description,
+		Difficulty:  difficulty,
+		Gauge:       *gauge,
+		NeedleSizes: needleSizes,
+		YarnWeight:  yarnWeight,
+		Sizes:       sizes,
+		ItemIds:     itemIds,
+	}, ormPattern.CreatedAt, ormPattern.UpdatedAt), nil
+}
+
+func toItemYarnModel(itemId *domain.ItemId, yarn *domain.YarnAttributes) model.ItemYarn {
+	ormYarn := model.ItemYarn{
+		ItemId:          itemId.Value(),
+		Weight:          string(yarn.Weight()),
+		YardagePerSkein: yarn.YardagePerSkein(),
+	}
+	if grams := yarn.GramsPerSkein(); grams > 0 {
+		ormYarn.GramsPerSkein = &grams
+	}
+	if needles := yarn.NeedleRange(); needles != nil {
+		minNeedle, maxNeedle := needles.Min().Millimeters(), needles.Max().Millimeters()
+		ormYarn.NeedleMinMm, ormYarn.NeedleMaxMm = &minNeedle, &maxNeedle
+	}
+	if gauge := yarn.Gauge(); gauge != nil {
+		stitches, rows := gauge.Stitches(), gauge.Rows()
+		ormYarn.GaugeStitches, ormYarn.GaugeRows = &stitches, &rows
+	}
+	for _, content := range yarn.Fibers() {
+		ormYarn.Fibers = append(ormYarn.Fibers, model.ItemYarnFiber{
+			ItemId:     itemId.Value(),
+			Fiber:      string(content.Fiber),
+			Percentage: content.Percentage,
+		})
+	}
+	return ormYarn
+}
+
+func toDomainYarnAttributes(ormYarn *model.ItemYarn) (*domain.YarnAttributes, error) {
+	if ormYarn == nil {
+		return nil, nil
+	}
+	weight, err := domain.NewYarnWeight(ormYarn.Weight)
+	if err != nil {
+		return nil, err
+	}
+	details := domain.YarnDetails{Weight: weight, YardagePerSkein: ormYarn.YardagePerSkein}
+	if ormYarn.GramsPerSkein != nil {
+		details.GramsPerSkein = *ormYarn.GramsPerSkein
+	}
+	if len(ormYarn.Fibers) > 0 {
+		contents := make([]domain.FiberContent, 0, len(ormYarn.Fibers))
+		for _, ormFiber := range ormYarn.Fibers {
+			fiber, err := domain.NewFiber(ormFiber.Fiber)
+			if err != nil {
+				return nil, err
+			}
+			contents = append(contents, domain.FiberContent{Fiber: fiber, Percentage: ormFiber.Percentage})
+		}
+		details.Fibers, err = domain.NewFiberComposition(contents)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if ormYarn.NeedleMinMm != nil && ormYarn.NeedleMaxMm != nil {
+		minNeedle, err := domain.NewNeedleSize(*ormYarn.NeedleMinMm)
+		if err != nil {
+			return nil, err
+		}
+		maxNeedle, err := domain.NewNeedleSize(*ormYarn.NeedleMaxMm)
+		if err != nil {
+			return nil, err
+		}
+		details.NeedleRange, err = domain.NewNeedleRange(*minNeedle, *maxNeedle)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if ormYarn.GaugeStitches != nil && ormYarn.GaugeRows != nil {
+		details.Gauge, err = domain.NewGauge(*ormYarn.GaugeStitches, *ormYarn.GaugeRows)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return domain.NewYarnAttributes(details)
+}
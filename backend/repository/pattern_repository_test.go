@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func newTestPatternDetails(t *testing.T, itemIds ...domain.ItemId) domain.PatternDetails {
+	t.Helper()
+	gauge, err := domain.NewGauge(22, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	needles, err := domain.ParseNeedleSizes("4;4.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return domain.PatternDetails{
+		Name:        "Raglan sweater",
+		Difficulty:  domain.PatternDifficultyEasy,
+		Gauge:       *gauge,
+		NeedleSizes: needles,
+		YarnWeight:  domain.YarnWeightMedium,
+		Sizes:       []domain.PatternSize{{Label: "S", FinishedMeasurements: "Chest 92cm", Yardage: 950}, {Label: "M", FinishedMeasurements: "Chest 100cm", Yardage: 1100}},
+		ItemIds:     itemIds,
+	}
+}
+
+func TestPatternRepository_UpdatePattern(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	owner := seedOrderTestUser(t, tx)
+	first := seedFavoriteTestItem(t, tx, owner)
+	second := seedFavoriteTestItem(t, tx, owner)
+	pr := NewPatternRepository(tx)
+	pattern, _ := domain.NewPattern(newTestPatternDetails(t, *first))
+	created, err := pr.CreatePattern(pattern)
+	assert.NoError(t, err)
+	assert.Equal(t, "4;4.5", created.NeedleSizes().Key())
+	assert.Equal(t, []string{first.Value()}, created.ItemIds())
+
+	details := newTestPatternDetails(t, *second)
+	details.Sizes = []domain.PatternSize{{Label: "L", Yardage: 1250}, {Label: "S", Yardage: 950}}
+	edited, _ := created.Edit(details)
+	updated, err := pr.UpdatePattern(edited)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{second.Value()}, updated.ItemIds())
+	sizes := updated.Sizes()
+	assert.Len(t, sizes, 2)
+	assert.Equal(t, "L", sizes[0].Label)
+	assert.Equal(t, 1250, sizes[0].Yardage)
+}
+
+func TestPatternRepository_DeletePattern(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	knitter := seedOrderTestUser(t, tx)
+	pr := NewPatternRepository(tx)
+	pattern, _ := domain.NewPattern(newTestPatternDetails(t))
+	pr.CreatePattern(pattern)
+	projectRepo := NewProjectRepository(tx)
+	details := newTestProjectDetails(t, nil, 10, 5)
+	details.PatternId = pattern.PatternId()
+	project, _ := domain.NewProject(*knitter, details)
+	projectRepo.CreateProject(project)
+
+	assert.NoError(t, pr.DeletePattern(pattern.PatternId()))
+
+	_, err := pr.GetPatternByID(pattern.PatternId())
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	stored, _ := projectRepo.GetProjectByID(knitter, project.ProjectId())
+	assert.Equal(t, "", stored.PatternId())
+	assert.True(t, errors.Is(pr.DeletePattern(pattern.PatternId()), gorm.ErrRecordNotFound))
+}
+
+func TestPatternRepository_SetItemYarn(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	owner := seedOrderTestUser(t, tx)
+	itemId := seedFavoriteTestItem(t, tx, owner)
+	pr := NewPatternRepository(tx)
+	ir := NewItemRepository(tx)
+
+	fine, _ := domain.NewYarnAttributes(domain.YarnDetails{Weight: domain.YarnWeightFine, YardagePerSkein: 200})
+	assert.NoError(t, pr.SetItemYarn(itemId, fine))
+	medium, _ := domain.NewYarnAttributes(domain.YarnDetails{Weight: domain.YarnWeightMedium, YardagePerSkein: 120})
+	assert.NoError(t, pr.SetItemYarn(itemId, medium))
+
+	item, _ := ir.GetItemByID(itemId)
+	assert.Equal(t, domain.YarnWeightMedium, item.Yarn().Weight())
+	assert.Equal(t, 120, item.Yarn().YardagePerSkein())
+
+	assert.NoError(t, pr.SetItemYarn(itemId, nil))
+	item, _ = ir.GetItemByID(itemId)
+	assert.Nil(t, item.Yarn())
+}
+
+func TestPatternRepository_SetItemYarn_Details(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	owner := seedOrderTestUser(t, tx)
+	itemId := seedFavoriteTestItem(t, tx, owner)
+	pr := NewPatternRepository(tx)
+	ir := NewItemRepository(tx)
+
+	fibers, _ := domain.NewFiberComposition([]domain.FiberContent{{Fiber: domain.FiberMerino, Percentage: 70}, {Fiber: domain.FiberSilk, Percentage: 30}})
+	thin, _ := domain.NewNeedleSize(3.5)
+	thick, _ := domain.NewNeedleSize(4.5)
+	needles, _ := domain.NewNeedleRange(*thin, *thick)
+	gauge, _ := domain.NewGauge(22, 30)
+	yarn, _ := domain.NewYarnAttributes(domain.YarnDetails{
+		Weight:          domain.YarnWeightLight,
+		YardagePerSkein: 200,
+		GramsPerSkein:   50,
+		Fibers:          fibers,
+		NeedleRange:     needles,
+		Gauge:           gauge,
+	})
+	assert.NoError(t, pr.SetItemYarn(itemId, yarn))
+
+	item, err := ir.GetItemByID(itemId)
+	assert.NoError(t, err)
+	assert.Equal(t, 50, item.Yarn().GramsPerSkein())
+	assert.Equal(t, fibers, item.Yarn().Fibers())
+	assert.Equal(t, 4.5, item.Yarn().NeedleRange().Max().Millimeters())
+	assert.Equal(t, 22.0, item.Yarn().Gauge().Stitches())
+
+	owned := func(filter ItemFilter) int64 {
+		filter.UserId = owner
+		count, err := ir.CountItems(filter)
+		assert.NoError(t, err)
+		return count
+	}
+	middle, _ := domain.NewNeedleSize(4)
+	tooThick, _ := domain.NewNeedleSize(6)
+	assert.Equal(t, int64(1), owned(ItemFilter{YarnWeight: domain.YarnWeightLight}))
+	assert.Equal(t, int64(0), owned(ItemFilter{YarnWeight: domain.YarnWeightBulky}))
+	assert.Equal(t, int64(1), owned(ItemFilter{Fiber: domain.FiberSilk}))
+	assert.Equal(t, int64(0), owned(ItemFilter{Fiber: domain.FiberCotton}))
+	assert.Equal(t, int64(1), owned(ItemFilter{NeedleSize: middle}))
+	assert.Equal(t, int64(0), owned(ItemFilter{NeedleSize: tooThick}))
+
+	// 更新すると素材の混率も置き換わる
+	cotton, _ := domain.NewFiberComposition([]domain.FiberContent{{Fiber: domain.FiberCotton, Percentage: 100}})
+	updated, _ := domain.NewYarnAttributes(domain.YarnDetails{Weight: domain.YarnWeightLight, YardagePerSkein: 200, Fibers: cotton})
+	assert.NoError(t, pr.SetItemYarn(itemId, updated))
+	assert.Equal(t, int64(0), owned(ItemFilter{Fiber: domain.FiberSilk}))
+	assert.Equal(t, int64(1), owned(ItemFilter{Fiber: domain.FiberCotton}))
+
+	item, _ = ir.GetItemByID(itemId)
+	assert.Nil(t, item.Yarn().NeedleRange())
+	assert.Nil(t, item.Yarn().Gauge())
+}
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IPaymentRepository は決済を扱う
+// 決済を更新するときは注文、決済の順に行ロックを取り、売上確定と注文の支払い済みへの遷移を同じトランザクションで行う
+type IPaymentRepository interface {
+	CreatePayment(payment *domain.Payment) error
+	GetLatestPaymentByOrderID(orderId string) (*domain.Payment, error)
+	GetPaymentByProviderID(providerPaymentId string) (*domain.Payment, error)
+	RecordAuthorization(paymentId string, providerPaymentId string, to domain.PaymentStatus) (*domain.Payment, error)
+	TransitionPayment(paymentId string, to domain.PaymentStatus) (*domain.Payment, error)
+	ApplyWebhookEvent(paymentId string, eventId string, eventType string, to domain.PaymentStatus) (*domain.Payment, error)
+}
+
+type paymentRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) IPaymentRepository {
+	return &paymentRepository{db}
+}
+
+// CreatePayment は注文が支払い待ちで、有効な決済がまだない場合だけ決済を作成する
+// 注文の行ロックを取ってから確かめるため、同じ注文の支払いが並行しても作成されるのは1件だけになる
+func (pr *paymentRepository) CreatePayment(payment *domain.Payment) error {
+	return pr.db.Transaction(func(tx *gorm.DB) error {
+		var ormOrder model.Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("order_id", "status").Where("order_id = ?", payment.OrderId()).First(&ormOrder).Error; err != nil {
+			return err
+		}
+		if domain.OrderStatus(ormOrder.Status) != domain.OrderStatusPendingPayment {
+			return fmt.Errorf("%w: order is %s", domain.ErrOrderNotPayable, ormOrder.Status)
+		}
+		var count int64
+		err := tx.Model(&model.Payment{}).
+			Where("order_id = ? AND status NOT IN ?", payment.OrderId(), []string{string(domain.PaymentStatusDeclined), string(domain.PaymentStatusRefunded)}).
+			Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("%w: order already has an active payment", domain.ErrOrderNotPayable)
+		}
+		ormPayment := toPaymentModel(payment)
+		return tx.Create(&ormPayment).Error
+	})
+}
+
+// GetLatestPaymentByOrderID は注文の最も新しい決済を返す
+func (pr *paymentRepository) GetLatestPaymentByOrderID(orderId string) (*domain.Payment, error) {
+	var ormPayment model.Payment
+	err := pr.db.Where("order_id = ?", orderId).
+		Order("created_at DESC").
+		Order("payment_id DESC").
+		First(&ormPayment).Error
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPayment(ormPayment)
+}
+
+func (pr *paymentRepository) GetPaymentByProviderID(providerPaymentId string) (*domain.Payment, error) {
+	var ormPayment model.Payment
+	if err := pr.db.Where("provider_payment_id = ?", providerPaymentId).First(&ormPayment).Error; err != nil {
+		return nil, err
+	}
+	return toDomainPayment(ormPayment)
+}
+
+// RecordAuthorization は決済代行サービスから受け取ったオーソリの結果を記録する
+func (pr *paymentRepository) RecordAuthorization(paymentId string, providerPaymentId string, to domain.PaymentStatus) (*domain.Payment, error) {
+	return pr.updatePayment(paymentId, func(tx *gorm.DB, payment *domain.Payment) (*domain.Payment, error) {
+		return payment.Authorize(providerPaymentId, to)
+	})
+}
+
+// TransitionPayment は決済を to に遷移させる。既に to の場合は何もしない
+func (pr *paymentRepository) TransitionPayment(paymentId string, to domain.PaymentStatus) (*domain.Payment, error) {
+	return pr.updatePayment(paymentId, func(tx *gorm.DB, payment *domain.Payment) (*domain.Payment, error) {
+		if payment.Status() == to {
+			return payment, nil
+		}
+		return payment.TransitionTo(to)
+	})
+}
+
+// ApplyWebhookEvent は Webhook で通知された状態を決済に反映し、イベントを処理済みとして記録する
+// 処理済みのイベント、既に反映済みの状態、順序が入れ替わって届いた古い状態は反映せずに現在の決済を返す
+func (pr *paymentRepository) ApplyWebhookEvent(paymentId string, eventId string, eventType string, to domain.PaymentStatus) (*domain.Payment, error) {
+	return pr.updatePayment(paymentId, func(tx *gorm.DB, payment *domain.Payment) (*domain.Payment, error) {
+		var count int64
+		if err := tx.Model(&model.PaymentEvent{}).Where("event_id = ?", eventId).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return payment, nil
+		}
+		event := model.PaymentEvent{EventId: eventId, PaymentId: paymentId, Type: eventType}
+		if err := tx.Create(&event).Error; err != nil {
+			return nil, err
+		}
+		if !payment.Status().CanTransitionTo(to) {
+			return payment, nil
+		}
+		return payment.TransitionTo(to)
+	})
+}
+
+// updatePayment は行ロックを取った決済に update を適用して保存する。update が受け取った決済をそのまま返した場合は保存しない
+// 決済が売上確定になった場合は、注文を注文者の操作として支払い済みに遷移させる。注文が支払い待ちでない場合（決済中に取り消された場合など）は注文をそのままにする
+func (pr *paymentRepository) updatePayment(paymentId string, update func(tx *gorm.DB, payment *domain.Payment) (*domain.Payment, error)) (*domain.Payment, error) {
+	var next *domain.Payment
+	err := pr.db.Transaction(func(tx *gorm.DB) error {
+		var ormPayment model.Payment
+		if err := tx.Select("order_id").Where("payment_id = ?", paymentId).First(&ormPayment).Error; err != nil {
+			return err
+		}
+		var ormOrder model.Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("order_id", "user_id").Where("order_id = ?", ormPayment.OrderId).First(&ormOrder).Error; err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("payment_id = ?", paymentId).First(&ormPayment).Error; err != nil {
+			return err
+		}
+		current, err := toDomainPayment(ormPayment)
+		if err != nil {
+			return err
+		}
+
+		next, err = update(tx, current)
+		if err != nil {
+			return err
+		}
+		if next == current {
+			return nil
+		}
+
+		result := tx.Model(&model.Payment{}).
+			Where("payment_id = ?", paymentId).
+			Updates(map[string]interface{}{
+				"provider_payment_id": toProviderPaymentIdColumn(next.ProviderPaymentId()),
+				"status":              string(next.Status()),
+				"updated_at":          next.UpdatedAt(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if current.Status() != domain.PaymentStatusCaptured && next.Status() == domain.PaymentStatusCaptured {
+			userId, err := domain.NewUserId(ormOrder.UserId)
+			if err != nil {
+				return err
+			}
+			if _, err := transitionOrder(tx, ormOrder.OrderId, domain.OrderStatusPaid, userId); err != nil && !errors.Is(err, domain.ErrIllegalOrderTransition) {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+func toProviderPaymentIdColumn(providerPaymentId string) *string {
+	if providerPaymentId == "" {
+		return nil
+	}
+	return &providerPaymentId
+}
+
+func toPaymentModel(payment *domain.Payment) model.Payment {
+	return model.Payment{
+		PaymentId:         payment.PaymentId(),
+		OrderId:           payment.OrderId(),
+		ProviderPaymentId: toProviderPaymentIdColumn(payment.ProviderPaymentId()),
+		Status:            string(payment.Status()),
+		Amount:            payment.Amount().Amount(),
+		Currency:          payment.Amount().Currency(),
+		CreatedAt:         payment.CreatedAt(),
+		UpdatedAt:         payment.UpdatedAt(),
+	}
+}
+
+func toDomainPayment(ormPayment model.Payment) (*domain.Payment, error) {
+	amount, err := domain.NewMoney(ormPayment.Amount, ormPayment.Currency)
+	if err != nil {
+		return nil, err
+	}
+	providerPaymentId := ""
+	if ormPayment.ProviderPaymentId != nil {
+		providerPaymentId = *ormPayment.ProviderPaymentId
+	}
+	return domain.RestorePayment(
+		ormPayment.PaymentId,
+		ormPayment.OrderId,
+		providerPaymentId,
+		domain.PaymentStatus(ormPayment.Status),
+		*amount,
+		ormPayment.CreatedAt,
+		ormPayment.UpdatedAt,
+	), nil
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// seedPaymentTestOrder は支払い待ちの注文を作成して返す
+func seedPaymentTestOrder(t *testing.T, tx *gorm.DB) (*domain.Order, *domain.UserId) {
+	t.Helper()
+	buyer := seedOrderTestUser(t, tx)
+	itemId := seedOrderTestItem(t, tx, buyer.Value(), 5, "1000")
+	order, err := NewOrderRepository(tx).PlaceOrder(buyer, newTestOrderRequest(t, newTestOrderLine(t, itemId, "", 2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return order, buyer
+}
+
+func createTestPayment(t *testing.T, pr IPaymentRepository, order *domain.Order, providerPaymentId string, status domain.PaymentStatus) *domain.Payment {
+	t.Helper()
+	payment, err := domain.NewPayment(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pr.CreatePayment(payment); err != nil {
+		t.Fatal(err)
+	}
+	authorized, err := pr.RecordAuthorization(payment.PaymentId(), providerPaymentId, status)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return authorized
+}
+
+func TestPaymentRepository_CreatePayment(t *testing.T) {
+	t.Run("Only One Active Payment Per Order", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		order, _ := seedPaymentTestOrder(t, tx)
+		pr := NewPaymentRepository(tx)
+		declined := createTestPayment(t, pr, order, "fakepay_declined", domain.PaymentStatusDeclined)
+		active := createTestPayment(t, pr, order, "fakepay_active", domain.PaymentStatusRequiresAction)
+
+		another, _ := domain.NewPayment(order)
+		err := pr.CreatePayment(another)
+		assert.True(t, errors.Is(err, domain.ErrOrderNotPayable))
+
+		latest, err := pr.GetLatestPaymentByOrderID(order.OrderId())
+		assert.NoError(t, err)
+		assert.NotEqual(t, declined.PaymentId(), latest.PaymentId())
+		assert.Equal(t, active.PaymentId(), latest.PaymentId())
+		assert.Equal(t, "2200", latest.Amount().String())
+	})
+
+	t.Run("Order Not Pending Payment", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		order, buyer := seedPaymentTestOrder(t, tx)
+		payment, _ := domain.NewPayment(order)
+		NewOrderRepository(tx).TransitionOrder(order.OrderId(), domain.OrderStatusCancelled, buyer)
+
+		err := NewPaymentRepository(tx).CreatePayment(payment)
+		assert.True(t, errors.Is(err, domain.ErrOrderNotPayable))
+	})
+}
+
+func TestPaymentRepository_TransitionPayment(t *testing.T) {
+	t.Run("Capture Marks Order Paid By Buyer", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		order, buyer := seedPaymentTestOrder(t, tx)
+		pr := NewPaymentRepository(tx)
+		payment := createTestPayment(t, pr, order, "fakepay_1", domain.PaymentStatusAuthorized)
+
+		captured, err := pr.TransitionPayment(payment.PaymentId(), domain.PaymentStatusCaptured)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PaymentStatusCaptured, captured.Status())
+		again, err := pr.TransitionPayment(payment.PaymentId(), domain.PaymentStatusCaptured)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PaymentStatusCaptured, again.Status())
+
+		found, _ := NewOrderRepository(tx).GetOrderByID(order.OrderId())
+		assert.Equal(t, domain.OrderStatusPaid, found.Status())
+		assert.Len(t, found.Transitions(), 2)
+		assert.Equal(t, buyer.Value(), found.Transitions()[1].ActorId())
+
+		byProvider, err := pr.GetPaymentByProviderID("fakepay_1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PaymentStatusCaptured, byProvider.Status())
+	})
+
+	t.Run("Capture After Cancellation Leaves Order", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		order, buyer := seedPaymentTestOrder(t, tx)
+		pr := NewPaymentRepository(tx)
+		payment := createTestPayment(t, pr, order, "fakepay_1", domain.PaymentStatusAuthorized)
+		NewOrderRepository(tx).TransitionOrder(order.OrderId(), domain.OrderStatusCancelled, buyer)
+
+		_, err := pr.TransitionPayment(payment.PaymentId(), domain.PaymentStatusCaptured)
+		assert.NoError(t, err)
+		found, _ := NewOrderRepository(tx).GetOrderByID(order.OrderId())
+		assert.Equal(t, domain.OrderStatusCancelled, found.Status())
+	})
+
+	t.Run("Illegal Transition", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		order, _ := seedPaymentTestOrder(t, tx)
+		pr := NewPaymentRepository(tx)
+		payment := createTestPayment(t, pr, order, "fakepay_1", domain.PaymentStatusRequiresAction)
+
+		_, err := pr.TransitionPayment(payment.PaymentId(), domain.PaymentStatusRefunded)
+		assert.True(t, errors.Is(err, domain.ErrIllegalPaymentTransition))
+	})
+}
+
+func TestPaymentRepository_ApplyWebhookEvent(t *testing.T) {
+	t.Run("Same Event Is Applied Once", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		order, _ := seedPaymentTestOrder(t, tx)
+		pr := NewPaymentRepository(tx)
+		payment := createTestPayment(t, pr, order, "fakepay_1", domain.PaymentStatusRequiresAction)
+
+		applied, err := pr.ApplyWebhookEvent(payment.PaymentId(), "evt_1", "payment.authorized", domain.PaymentStatusAuthorized)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PaymentStatusAuthorized, applied.Status())
+		replayed, err := pr.ApplyWebhookEvent(payment.PaymentId(), "evt_1", "payment.authorized", domain.PaymentStatusAuthorized)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PaymentStatusAuthorized, replayed.Status())
+
+		var count int64
+		tx.Model(&model.PaymentEvent{}).Where("payment_id = ?", payment.PaymentId()).Count(&count)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("Out Of Order Event Is Ignored", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		order, _ := seedPaymentTestOrder(t, tx)
+		pr := NewPaymentRepository(tx)
+		payment := createTestPayment(t, pr, order, "fakepay_1", domain.PaymentStatusAuthorized)
+
+		captured, err := pr.ApplyWebhookEvent(payment.PaymentId(), "evt_2", "payment.captured", domain.PaymentStatusCaptured)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PaymentStatusCaptured, captured.Status())
+		stale, err := pr.ApplyWebhookEvent(payment.PaymentId(), "evt_1", "payment.authorized", domain.PaymentStatusAuthorized)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PaymentStatusCaptured, stale.Status())
+
+		found, _ := NewOrderRepository(tx).GetOrderByID(order.OrderId())
+		assert.Equal(t, domain.OrderStatusPaid, found.Status())
+	})
+}
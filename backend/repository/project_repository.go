@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IProjectRepository はユーザーの編み物の作品と進捗の履歴を扱う
+// 作品の作成・更新と進捗のスナップショットの追加は同じトランザクションで行う
+type IProjectRepository interface {
+	GetProjects(userId *domain.UserId) ([]*domain.Project, error)
+	GetProjectByID(userId *domain.UserId, projectId string) (*domain.Project, error)
+	CreateProject(project *domain.Project) (*domain.Project, error)
+	UpdateProject(project *domain.Project) (*domain.Project, error)
+	DeleteProject(userId *domain.UserId, projectId string) error
+	GetSnapshots(userId *domain.UserId, projectId string) ([]*domain.ProjectSnapshot, error)
+}
+
+type projectRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectRepository(db *gorm.DB) IProjectRepository {
+	return &projectRepository{db}
+}
+
+// GetProjects は作品を更新の新しい順に返す
+func (pr *projectRepository) GetProjects(userId *domain.UserId) ([]*domain.Project, error) {
+	var ormProjects []model.Project
+	err := pr.db.Where("user_id = ?", userId.Value()).
+		Order("updated_at DESC").
+		Order("project_id ASC").
+		Find(&ormProjects).Error
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make([]*domain.Project, 0, len(ormProjects))
+	for _, ormProject := range ormProjects {
+		project, err := toDomainProject(ormProject)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+	return projects, nil
+}
+
+func (pr *projectRepository) GetProjectByID(userId *domain.UserId, projectId string) (*domain.Project, error) {
+	var ormProject model.Project
+	if err := pr.db.Where("project_id = ? AND user_id = ?", projectId, userId.Value()).First(&ormProject).Error; err != nil {
+		return nil, err
+	}
+	return toDomainProject(ormProject)
+}
+
+// CreateProject は作品を保存し、作成時点の進捗をスナップショットとして記録する
+func (pr *projectRepository) CreateProject(project *domain.Project) (*domain.Project, error) {
+	ormProject := toProjectModel(project)
+	err := pr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&ormProject).Error; err != nil {
+			return err
+		}
+		ormSnapshot := toProjectSnapshotModel(project.Snapshot())
+		return tx.Create(&ormSnapshot).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainProject(ormProject)
+}
+
+// UpdateProject は作品の項目を置き換え、進捗率か段数が変わった場合はスナップショットを記録する
+// 作品が存在しないか他のユーザーのものであれば gorm.ErrRecordNotFound を返す
+func (pr *projectRepository) UpdateProject(project *domain.Project) (*domain.Project, error) {
+	ormProject := toProjectModel(project)
+	err := pr.db.Transaction(func(tx *gorm.DB) error {
+		// 行ロックで同じ作品の更新を直列にし、変更前の進捗と比べてからスナップショットを記録する
+		var existing model.Project
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("project_id = ? AND user_id = ?", project.ProjectId(), project.UserId()).
+			First(&existing).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.Project{}).
+			Where("project_id = ?", project.ProjectId()).
+			Select("item_id", "pattern_id", "name", "progress_percentage", "row_count", "notes", "updated_at").
+			Updates(&ormProject).Error; err != nil {
+			return err
+		}
+		if existing.ProgressPercentage == project.Progress() && existing.RowCount == project.RowCount() {
+			return nil
+		}
+		ormSnapshot := toProjectSnapshotModel(project.Snapshot())
+		return tx.Create(&ormSnapshot).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainProject(ormProject)
+}
+
+// DeleteProject は作品と進捗の履歴を削除する。作品が存在しないか他のユーザーのものであれば gorm.ErrRecordNotFound を返す
+func (pr *projectRepository) DeleteProject(userId *domain.UserId, projectId string) error {
+	return pr.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("project_id = ? AND user_id = ?", projectId, userId.Value()).Delete(&model.Project{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Where("project_id = ?", projectId).Delete(&model.ProjectSnapshot{}).Error
+	})
+}
+
+// GetSnapshots は作品の進捗の履歴を古い順に返す。作品が存在しないか他のユーザーのものであれば gorm.ErrRecordNotFound を返す
+func (pr *projectRepository) GetSnapshots(userId *domain.UserId, projectId string) ([]*domain.ProjectSnapshot, error) {
+	var ormProject model.Project
+	if err := pr.db.Select("project_id").Where("project_id = ? AND user_id = ?", projectId, userId.Value()).First(&ormProject).Error; err != nil {
+		return nil, err
+	}
+
+	var ormSnapshots []model.ProjectSnapshot
+	err := pr.db.Where("project_id = ?", projectId).
+		Order("recorded_at ASC").
+		Order("snapshot_id ASC").
+		Find(&ormSnapshots).Error
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*domain.ProjectSnapshot, 0, len(ormSnapshots))
+	for _, ormSnapshot := range ormSnapshots {
+		progress, err := domain.NewProgressPercentage(ormSnapshot.ProgressPercentage)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, domain.RestoreProjectSnapshot(ormSnapshot.SnapshotId, ormSnapshot.ProjectId, *progress, ormSnapshot.RowCount, ormSnapshot.RecordedAt))
+	}
+	return snapshots, nil
+}
+
+func toProjectModel(project *domain.Project) model.Project {
+	var itemId *string
+	if id := project.ItemId(); id != "" {
+		itemId = &id
+	}
+	var patternId *string
+	if id := project.PatternId(); id != "" {
+		patternId = &id
+	}
+	return model.Project{
+		ProjectId:          project.ProjectId(),
+		UserId:             project.UserId(),
+		ItemId:             itemId,
+		PatternId:          patternId,
+		Name:               project.Name(),
+		ProgressPercentage: project.Progress(),
+		RowCount:           project.RowCount(),
+		Notes:              project.Notes(),
+		CreatedAt:          project.CreatedAt(),
+		UpdatedAt:          project.UpdatedAt(),
+	}
+}
+
+func toProjectSnapshotModel(snapshot *domain.ProjectSnapshot) model.ProjectSnapshot {
+	return model.ProjectSnapshot{
+		SnapshotId:         snapshot.SnapshotId(),
+		ProjectId:          snapshot.ProjectId(),
+		ProgressPercentage: snapshot.Progress(),
+		RowCount:           snapshot.RowCount(),
+		RecordedAt:         snapshot.RecordedAt(),
+	}
+}
+
+func toDomainProject(ormProject model.Project) (*domain.Project, error) {
+	userId, err := domain.NewUserId(ormProject.UserId)
+	if err != nil {
+		return nil, err
+	}
+	var itemId *domain.ItemId
+	if ormProject.ItemId != nil {
+		itemId, err = domain.NewItemId(*ormProject.ItemId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var patternId string
+	if ormProject.PatternId != nil {
+		patternId = *ormProject.PatternId
+	}
+	progress, err := domain.NewProgressPercentage(ormProject.ProgressPercentage)
+	if err != nil {
+		return nil, err
+	}
+	return domain.RestoreProject(ormProject.ProjectId, *userId, domain.ProjectDetails{
+		Name:      ormProject.Name,
+		ItemId:    itemId,
+		PatternId: patternId,
+		Progress:  *progress,
+		RowCount:  ormProject.RowCount,
+		Notes:     ormProject.Notes,
+	}, ormProject.CreatedAt, ormProject.UpdatedAt), nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func newTestProjectDetails(t *testing.T, itemId *domain.ItemId, progress int, rowCount int) domain.ProjectDetails {
+	t.Helper()
+	value, err := domain.NewProgressPercentage(progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return domain.ProjectDetails{Name: "Raglan sweater", ItemId: itemId, Progress: *value, RowCount: rowCount, Notes: "Sleeves next"}
+}
+
+func TestProjectRepository_UpdateProject(t *testing.T) {
+	t.Run("Records Snapshot When Progress Changes", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		knitter := seedOrderTestUser(t, tx)
+		itemId := seedFavoriteTestItem(t, tx, knitter)
+		pr := NewProjectRepository(tx)
+		project, _ := domain.NewProject(*knitter, newTestProjectDetails(t, itemId, 0, 0))
+		created, err := pr.CreateProject(project)
+		assert.NoError(t, err)
+
+		progressed, _ := created.Edit(newTestProjectDetails(t, itemId, 40, 80))
+		_, err = pr.UpdateProject(progressed)
+		assert.NoError(t, err)
+		details := newTestProjectDetails(t, itemId, 40, 80)
+		details.Name = "Raglan cardigan"
+		renamed, _ := progressed.Edit(details)
+		_, err = pr.UpdateProject(renamed)
+		assert.NoError(t, err)
+
+		snapshots, err := pr.GetSnapshots(knitter, project.ProjectId())
+		assert.NoError(t, err)
+		assert.Len(t, snapshots, 2)
+		assert.Equal(t, 0, snapshots[0].Progress())
+		assert.Equal(t, 40, snapshots[1].Progress())
+		assert.Equal(t, 80, snapshots[1].RowCount())
+
+		stored, _ := pr.GetProjectByID(knitter, project.ProjectId())
+		assert.Equal(t, "Raglan cardigan", stored.Name())
+		assert.Equal(t, itemId.Value(), stored.ItemId())
+	})
+
+	t.Run("Another User's Project", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		knitter := seedOrderTestUser(t, tx)
+		other := seedOrderTestUser(t, tx)
+		pr := NewProjectRepository(tx)
+		project, _ := domain.NewProject(*knitter, newTestProjectDetails(t, nil, 10, 5))
+		pr.CreateProject(project)
+		stolen := domain.RestoreProject(project.ProjectId(), *other, newTestProjectDetails(t, nil, 90, 5), project.CreatedAt(), project.UpdatedAt())
+
+		_, err := pr.UpdateProject(stolen)
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+		_, err = pr.GetSnapshots(other, project.ProjectId())
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	})
+}
+
+func TestProjectRepository_DeleteProject(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	knitter := seedOrderTestUser(t, tx)
+	pr := NewProjectRepository(tx)
+	project, _ := domain.NewProject(*knitter, newTestProjectDetails(t, nil, 10, 5))
+	pr.CreateProject(project)
+
+	assert.NoError(t, pr.DeleteProject(knitter, project.ProjectId()))
+	assert.True(t, errors.Is(pr.DeleteProject(knitter, project.ProjectId()), gorm.ErrRecordNotFound))
+	projects, _ := pr.GetProjects(knitter)
+	assert.Empty(t, projects)
+}
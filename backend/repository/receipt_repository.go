@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IReceiptRepository は発行済みの領収書を扱う。領収書は追加だけで、更新・削除はしない
+type IReceiptRepository interface {
+	GetReceiptByOrderID(orderId string) (*domain.Receipt, error)
+	CreateReceipt(receipt *domain.Receipt) (*domain.Receipt, error)
+}
+
+type receiptRepository struct {
+	db *gorm.DB
+}
+
+func NewReceiptRepository(db *gorm.DB) IReceiptRepository {
+	return &receiptRepository{db}
+}
+
+func (rr *receiptRepository) GetReceiptByOrderID(orderId string) (*domain.Receipt, error) {
+	var ormReceipt model.Receipt
+	if err := rr.db.Where("order_id = ?", orderId).First(&ormReceipt).Error; err != nil {
+		return nil, err
+	}
+	return toDomainReceipt(ormReceipt)
+}
+
+// CreateReceipt は領収書を保存する。同じ注文の領収書が既にあれば保存せずに既存の領収書を返す
+// 注文の行ロックを取ってから状態を確かめるため、並行して取り消されても支払い後の注文にしか発行しない
+func (rr *receiptRepository) CreateReceipt(receipt *domain.Receipt) (*domain.Receipt, error) {
+	var created *domain.Receipt
+	err := rr.db.Transaction(func(tx *gorm.DB) error {
+		var ormOrder model.Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("order_id", "status").Where("order_id = ?", receipt.OrderId()).First(&ormOrder).Error; err != nil {
+			return err
+		}
+
+		var existing model.Receipt
+		err := tx.Where("order_id = ?", receipt.OrderId()).First(&existing).Error
+		if err == nil {
+			created, err = toDomainReceipt(existing)
+			return err
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if !domain.OrderStatus(ormOrder.Status).IsReceiptIssuable() {
+			return fmt.Errorf("%w: order is %s", domain.ErrReceiptNotIssuable, ormOrder.Status)
+		}
+		ormReceipt := toReceiptModel(receipt)
+		if err := tx.Create(&ormReceipt).Error; err != nil {
+			return err
+		}
+		created, err = toDomainReceipt(ormReceipt)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func toReceiptModel(receipt *domain.Receipt) model.Receipt {
+	return model.Receipt{
+		ReceiptId:          receipt.ReceiptId(),
+		ReceiptNumber:      receipt.ReceiptNumber(),
+		OrderId:            receipt.OrderId(),
+		UserId:             receipt.UserId(),
+		IssuerName:         receipt.IssuerName(),
+		RegistrationNumber: receipt.RegistrationNumber(),
+		Total:              receipt.Total().Amount(),
+		Currency:           receipt.Total().Currency(),
+		Html:               receipt.HTML(),
+		Text:               receipt.Text(),
+		IssuedAt:           receipt.IssuedAt(),
+	}
+}
+
+func toDomainReceipt(ormReceipt model.Receipt) (*domain.Receipt, error) {
+	userId, err := domain.NewUserId(ormReceipt.UserId)
+	if err != nil {
+		return nil, err
+	}
+	total, err := domain.NewMoney(ormReceipt.Total, ormReceipt.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return domain.RestoreReceipt(
+		ormReceipt.ReceiptId,
+		ormReceipt.ReceiptNumber,
+		ormReceipt.OrderId,
+		*userId,
+		ormReceipt.IssuerName,
+		ormReceipt.RegistrationNumber,
+		*total,
+		ormReceipt.Html,
+		ormReceipt.Text,
+		ormReceipt.IssuedAt,
+	), nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// seedReceiptTestOrder は支払い済みの注文を作成して返す
+func seedReceiptTestOrder(t *testing.T, tx *gorm.DB) *domain.Order {
+	t.Helper()
+	order, buyer := seedPaymentTestOrder(t, tx)
+	paid, err := NewOrderRepository(tx).TransitionOrder(order.OrderId(), domain.OrderStatusPaid, buyer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return paid
+}
+
+func newTestReceipt(t *testing.T, order *domain.Order, receiptNumber string) *domain.Receipt {
+	t.Helper()
+	userId, err := domain.NewUserId(order.UserId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return domain.RestoreReceipt(uuid.NewString(), receiptNumber, order.OrderId(), *userId, "株式会社サンプル毛糸店", "T7000012050002", *order.Total(), "<p>領収書</p>", "領収書", time.Now().Truncate(time.Second))
+}
+
+func TestReceiptRepository_CreateReceipt(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		order := seedReceiptTestOrder(t, tx)
+		rr := NewReceiptRepository(tx)
+		created, err := rr.CreateReceipt(newTestReceipt(t, order, "R20261018-00000001"))
+		assert.NoError(t, err)
+		assert.Equal(t, "R20261018-00000001", created.ReceiptNumber())
+
+		found, err := rr.GetReceiptByOrderID(order.OrderId())
+		assert.NoError(t, err)
+		assert.Equal(t, created.ReceiptId(), found.ReceiptId())
+		assert.Equal(t, "T7000012050002", found.RegistrationNumber())
+		assert.Equal(t, "2200", found.Total().String())
+		assert.Equal(t, "<p>領収書</p>", found.HTML())
+	})
+
+	t.Run("Returns Issued Receipt", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		order := seedReceiptTestOrder(t, tx)
+		rr := NewReceiptRepository(tx)
+		first, _ := rr.CreateReceipt(newTestReceipt(t, order, "R20261018-00000001"))
+
+		second, err := rr.CreateReceipt(newTestReceipt(t, order, "R20261019-00000001"))
+		assert.NoError(t, err)
+		assert.Equal(t, first.ReceiptId(), second.ReceiptId())
+		assert.Equal(t, "R20261018-00000001", second.ReceiptNumber())
+	})
+
+	t.Run("Order Not Paid", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		order, _ := seedPaymentTestOrder(t, tx)
+		rr := NewReceiptRepository(tx)
+		_, err := rr.CreateReceipt(newTestReceipt(t, order, "R20261018-00000001"))
+		assert.True(t, errors.Is(err, domain.ErrReceiptNotIssuable))
+
+		_, err = rr.GetReceiptByOrderID(order.OrderId())
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	})
+}
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IReviewRepository は商品のレビューを扱う
+// 追加・編集では商品の rating_count と rating_total を同じトランザクションで更新する
+type IReviewRepository interface {
+	GetReviewsByItemID(itemId *domain.ItemId) ([]*domain.Review, error)
+	GetReviewByID(reviewId string) (*domain.Review, error)
+	CreateReview(review *domain.Review) (*domain.Review, error)
+	UpdateReview(review *domain.Review) (*domain.Review, error)
+}
+
+type reviewRepository struct {
+	db *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) IReviewRepository {
+	return &reviewRepository{db}
+}
+
+// GetReviewsByItemID は商品のレビューを新しい順に返す
+// 論理削除した商品や存在しない商品では gorm.ErrRecordNotFound を返す
+func (rr *reviewRepository) GetReviewsByItemID(itemId *domain.ItemId) ([]*domain.Review, error) {
+	var ormItem model.Item
+	if err := rr.db.Select("item_id").Where("item_id = ?", itemId.Value()).First(&ormItem).Error; err != nil {
+		return nil, err
+	}
+
+	var ormReviews []model.Review
+	err := rr.db.Preload("User").
+		Where("item_id = ?", itemId.Value()).
+		Order("created_at DESC").
+		Order("review_id ASC").
+		Find(&ormReviews).Error
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]*domain.Review, 0, len(ormReviews))
+	for _, ormReview := range ormReviews {
+		review, err := toDomainReview(ormReview)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	return reviews, nil
+}
+
+func (rr *reviewRepository) GetReviewByID(reviewId string) (*domain.Review, error) {
+	return getReviewByID(rr.db, reviewId)
+}
+
+// CreateReview はレビューを保存し、商品のレビューの件数と評価の合計に加える
+// 同じユーザーのレビューが既にあれば domain.ErrDuplicateReview を、論理削除した商品では gorm.ErrRecordNotFound を返す
+func (rr *reviewRepository) CreateReview(review *domain.Review) (*domain.Review, error) {
+	var created *domain.Review
+	err := rr.db.Transaction(func(tx *gorm.DB) error {
+		// 商品の行ロックで同じ商品へのレビューの追加・編集を直列にし、件数と合計を reviews の行と一致させる
+		if err := lockReviewedItem(tx, review.ItemId()); err != nil {
+			return err
+		}
+
+		var existing model.Review
+		err := tx.Select("review_id").Where("user_id = ? AND item_id = ?", review.UserId(), review.ItemId()).First(&existing).Error
+		if err == nil {
+			return fmt.Errorf("%w: review %s already exists", domain.ErrDuplicateReview, existing.ReviewId)
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		ormReview := toReviewModel(review)
+		if err := tx.Omit("User").Create(&ormReview).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.Item{}).Where("item_id = ?", review.ItemId()).
+			UpdateColumns(map[string]interface{}{
+				"rating_count": gorm.Expr("rating_count + 1"),
+				"rating_total": gorm.Expr("rating_total + ?", review.Rating()),
+			}).Error; err != nil {
+			return err
+		}
+		created, err = getReviewByID(tx, review.ReviewId())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateReview は評価・タイトル・本文を置き換え、評価の差分を商品の評価の合計に反映する
+// 論理削除した商品のレビューは編集できず、gorm.ErrRecordNotFound を返す
+func (rr *reviewRepository) UpdateReview(review *domain.Review) (*domain.Review, error) {
+	var updated *domain.Review
+	err := rr.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockReviewedItem(tx, review.ItemId()); err != nil {
+			return err
+		}
+
+		var existing model.Review
+		if err := tx.Select("review_id", "rating").Where("review_id = ? AND item_id = ?", review.ReviewId(), review.ItemId()).First(&existing).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.Review{}).Where("review_id = ?", review.ReviewId()).
+			Updates(map[string]interface{}{
+				"rating":     review.Rating(),
+				"title":      review.Title(),
+				"body":       review.Body(),
+				"updated_at": review.UpdatedAt(),
+			}).Error; err != nil {
+			return err
+		}
+		if delta := review.Rating() - existing.Rating; delta != 0 {
+			if err := tx.Model(&model.Item{}).Where("item_id = ?", review.ItemId()).
+				UpdateColumn("rating_total", gorm.Expr("rating_total + ?", delta)).Error; err != nil {
+				return err
+			}
+		}
+		var err error
+		updated, err = getReviewByID(tx, review.ReviewId())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func lockReviewedItem(tx *gorm.DB, itemId string) error {
+	var ormItem model.Item
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("item_id").Where("item_id = ?", itemId).First(&ormItem).Error
+}
+
+func getReviewByID(db *gorm.DB, reviewId string) (*domain.Review, error) {
+	var ormReview model.Review
+	if err := db.Preload("User").Where("review_id = ?", reviewId).First(&ormReview).Error; err != nil {
+		return nil, err
+	}
+	return toDomainReview(ormReview)
+}
+
+func toReviewModel(review *domain.Review) model.Review {
+	return model.Review{
+		ReviewId:  review.ReviewId(),
+		UserId:    review.UserId(),
+		ItemId:    review.ItemId(),
+		Rating:    review.Rating(),
+		Title:     review.Title(),
+		Body:      review.Body(),
+		CreatedAt: review.CreatedAt(),
+		UpdatedAt: review.UpdatedAt(),
+	}
+}
+
+func toDomainReview(ormReview model.Review) (*domain.Review, error) {
+	userId, err := domain.NewUserId(ormReview.UserId)
+	if err != nil {
+		return nil, err
+	}
+	itemId, err := domain.NewItemId(ormReview.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	rating, err := domain.NewRating(ormReview.Rating)
+	if err != nil {
+		return nil, err
+	}
+	content := domain.ReviewContent{Rating: *rating, Title: ormReview.Title, Body: ormReview.Body}
+	return domain.RestoreReview(ormReview.ReviewId, *userId, ormReview.User.Name, *itemId, content, ormReview.CreatedAt, ormReview.UpdatedAt), nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func newTestReview(t *testing.T, userId *domain.UserId, itemId *domain.ItemId, rating int) *domain.Review {
+	t.Helper()
+	value, _ := domain.NewRating(rating)
+	review, err := domain.NewReview(*userId, *itemId, domain.ReviewContent{Rating: *value, Title: "Soft yarn", Body: "Easy to knit"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return review
+}
+
+func TestReviewRepository_CreateReview(t *testing.T) {
+	t.Run("Updates Item Rating", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		alice := seedOrderTestUser(t, tx)
+		bob := seedOrderTestUser(t, tx)
+		itemId := seedFavoriteTestItem(t, tx, alice)
+		rr := NewReviewRepository(tx)
+
+		created, err := rr.CreateReview(newTestReview(t, alice, itemId, 5))
+		assert.NoError(t, err)
+		assert.Equal(t, "OrderUser", created.AuthorName())
+		_, err = rr.CreateReview(newTestReview(t, bob, itemId, 4))
+		assert.NoError(t, err)
+
+		item, _ := NewItemRepository(tx).GetItemByID(itemId)
+		assert.Equal(t, 2, item.Rating().Count())
+		assert.Equal(t, 4.5, item.Rating().Average())
+	})
+
+	t.Run("One Review Per User", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		itemId := seedFavoriteTestItem(t, tx, buyer)
+		rr := NewReviewRepository(tx)
+		rr.CreateReview(newTestReview(t, buyer, itemId, 5))
+
+		_, err := rr.CreateReview(newTestReview(t, buyer, itemId, 1))
+		assert.True(t, errors.Is(err, domain.ErrDuplicateReview))
+		item, _ := NewItemRepository(tx).GetItemByID(itemId)
+		assert.Equal(t, 1, item.Rating().Count())
+	})
+
+	t.Run("Deleted Item", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		buyer := seedOrderTestUser(t, tx)
+		itemId := seedFavoriteTestItem(t, tx, buyer)
+		NewItemRepository(tx).DeleteItem(itemId)
+
+		_, err := NewReviewRepository(tx).CreateReview(newTestReview(t, buyer, itemId, 5))
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	})
+}
+
+func TestReviewRepository_UpdateReview(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	buyer := seedOrderTestUser(t, tx)
+	itemId := seedFavoriteTestItem(t, tx, buyer)
+	rr := NewReviewRepository(tx)
+	created, _ := rr.CreateReview(newTestReview(t, buyer, itemId, 5))
+	two, _ := domain.NewRating(2)
+	edited, _ := created.Edit(domain.ReviewContent{Rating: *two, Title: "Pills after washing", Body: "Not as durable as expected"})
+
+	updated, err := rr.UpdateReview(edited)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, updated.Rating())
+	assert.Equal(t, "Pills after washing", updated.Title())
+	item, _ := NewItemRepository(tx).GetItemByID(itemId)
+	assert.Equal(t, 1, item.Rating().Count())
+	assert.Equal(t, 2.0, item.Rating().Average())
+	reviews, _ := rr.GetReviewsByItemID(itemId)
+	assert.Len(t, reviews, 1)
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+)
+
+// IShippingAddressRepository はユーザーの配送先を扱う。他のユーザーの配送先は見つからないものとして扱う
+type IShippingAddressRepository interface {
+	GetAddresses(userId *domain.UserId) ([]*domain.ShippingAddress, error)
+	GetAddressByID(userId *domain.UserId, addressId string) (*domain.ShippingAddress, error)
+	CreateAddress(address *domain.ShippingAddress) (*domain.ShippingAddress, error)
+	UpdateAddress(address *domain.ShippingAddress) (*domain.ShippingAddress, error)
+	DeleteAddress(userId *domain.UserId, addressId string) error
+}
+
+type shippingAddressRepository struct {
+	db *gorm.DB
+}
+
+func NewShippingAddressRepository(db *gorm.DB) IShippingAddressRepository {
+	return &shippingAddressRepository{db}
+}
+
+// GetAddresses は配送先を登録した順に返す
+func (sr *shippingAddressRepository) GetAddresses(userId *domain.UserId) ([]*domain.ShippingAddress, error) {
+	var ormAddresses []model.ShippingAddress
+	err := sr.db.Where("user_id = ?", userId.Value()).
+		Order("created_at ASC").
+		Order("address_id ASC").
+		Find(&ormAddresses).Error
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]*domain.ShippingAddress, 0, len(ormAddresses))
+	for _, ormAddress := range ormAddresses {
+		address, err := toDomainShippingAddress(ormAddress)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+func (sr *shippingAddressRepository) GetAddressByID(userId *domain.UserId, addressId string) (*domain.ShippingAddress, error) {
+	var ormAddress model.ShippingAddress
+	if err := sr.db.Where("address_id = ? AND user_id = ?", addressId, userId.Value()).First(&ormAddress).Error; err != nil {
+		return nil, err
+	}
+	return toDomainShippingAddress(ormAddress)
+}
+
+func (sr *shippingAddressRepository) CreateAddress(address *domain.ShippingAddress) (*domain.ShippingAddress, error) {
+	ormAddress := toShippingAddressModel(address)
+	if err := sr.db.Create(&ormAddress).Error; err != nil {
+		return nil, err
+	}
+	return toDomainShippingAddress(ormAddress)
+}
+
+// UpdateAddress は宛名と住所を置き換える。配送先が存在しないか他のユーザーのものであれば gorm.ErrRecordNotFound を返す
+func (sr *shippingAddressRepository) UpdateAddress(address *domain.ShippingAddress) (*domain.ShippingAddress, error) {
+	ormAddress := toShippingAddressModel(address)
+	result := sr.db.Model(&model.ShippingAddress{}).
+		Where("address_id = ? AND user_id = ?", address.AddressId(), address.UserId()).
+		Select("recipient_name", "postal_code", "prefecture_code", "city", "address_line1", "address_line2", "phone_number", "updated_at").
+		Updates(&ormAddress)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return toDomainShippingAddress(ormAddress)
+}
+
+// DeleteAddress は配送先を削除する。配送先が存在しないか他のユーザーのものであれば gorm.ErrRecordNotFound を返す
+func (sr *shippingAddressRepository) DeleteAddress(userId *domain.UserId, addressId string) error {
+	result := sr.db.Where("address_id = ? AND user_id = ?", addressId, userId.Value()).Delete(&model.ShippingAddress{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func toShippingAddressModel(address *domain.ShippingAddress) model.ShippingAddress {
+	return model.ShippingAddress{
+		AddressId:      address.AddressId(),
+		UserId:         address.UserId(),
+		RecipientName:  address.RecipientName(),
+		PostalCode:     address.PostalCode().Value(),
+		PrefectureCode: address.Prefecture().Code(),
+		City:           address.City(),
+		AddressLine1:   address.AddressLine1(),
+		AddressLine2:   address.AddressLine2(),
+		PhoneNumber:    address.PhoneNumber(),
+		CreatedAt:      address.CreatedAt(),
+		UpdatedAt:      address.UpdatedAt(),
+	}
+}
+
+func toDomainShippingAddress(ormAddress model.ShippingAddress) (*domain.ShippingAddress, error) {
+	userId, err := domain.NewUserId(ormAddress.UserId)
+	if err != nil {
+		return nil, err
+	}
+	postalCode, err := domain.NewPostalCode(ormAddress.PostalCode)
+	if err != nil {
+		return nil, err
+	}
+	prefecture, err := domain.NewPrefectureFromCode(ormAddress.PrefectureCode)
+	if err != nil {
+		return nil, err
+	}
+	return domain.RestoreShippingAddress(ormAddress.AddressId, *userId, domain.ShippingAddressLines{
+		RecipientName: ormAddress.RecipientName,
+		PostalCode:    *postalCode,
+		Prefecture:    *prefecture,
+		City:          ormAddress.City,
+		AddressLine1:  ormAddress.AddressLine1,
+		AddressLine2:  ormAddress.AddressLine2,
+		PhoneNumber:   ormAddress.PhoneNumber,
+	}, ormAddress.CreatedAt, ormAddress.UpdatedAt), nil
+}
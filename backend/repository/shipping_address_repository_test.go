@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func newTestShippingAddress(t *testing.T, userId *domain.UserId, prefectureName string) *domain.ShippingAddress {
+	t.Helper()
+	postalCode, _ := domain.NewPostalCode("100-0001")
+	prefecture, err := domain.NewPrefecture(prefectureName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, err := domain.NewShippingAddress(*userId, domain.ShippingAddressLines{
+		RecipientName: "山田 花子",
+		PostalCode:    *postalCode,
+		Prefecture:    *prefecture,
+		City:          "千代田区",
+		AddressLine1:  "千代田1-1",
+		PhoneNumber:   "03-1234-5678",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return address
+}
+
+func TestShippingAddressRepository(t *testing.T) {
+	t.Run("Create And Get Addresses", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		userId := seedOrderTestUser(t, tx)
+		sr := NewShippingAddressRepository(tx)
+
+		created, err := sr.CreateAddress(newTestShippingAddress(t, userId, "東京都"))
+		assert.NoError(t, err)
+		_, err = sr.CreateAddress(newTestShippingAddress(t, userId, "沖縄県"))
+		assert.NoError(t, err)
+
+		addresses, err := sr.GetAddresses(userId)
+		assert.NoError(t, err)
+		assert.Len(t, addresses, 2)
+
+		found, err := sr.GetAddressByID(userId, created.AddressId())
+		assert.NoError(t, err)
+		assert.Equal(t, "100-0001", found.PostalCode().Value())
+		assert.Equal(t, 13, found.Prefecture().Code())
+		assert.Equal(t, "0312345678", found.PhoneNumber())
+	})
+
+	t.Run("Another User's Address Is Not Found", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		owner := seedOrderTestUser(t, tx)
+		other := seedOrderTestUser(t, tx)
+		sr := NewShippingAddressRepository(tx)
+		created, _ := sr.CreateAddress(newTestShippingAddress(t, owner, "東京都"))
+
+		_, err := sr.GetAddressByID(other, created.AddressId())
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+		err = sr.DeleteAddress(other, created.AddressId())
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+		addresses, _ := sr.GetAddresses(other)
+		assert.Empty(t, addresses)
+	})
+
+	t.Run("Update And Delete Address", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		userId := seedOrderTestUser(t, tx)
+		sr := NewShippingAddressRepository(tx)
+		created, _ := sr.CreateAddress(newTestShippingAddress(t, userId, "東京都"))
+
+		hokkaido, _ := domain.NewPrefecture("北海道")
+		postalCode, _ := domain.NewPostalCode("060-0001")
+		edited, err := created.Edit(domain.ShippingAddressLines{
+			RecipientName: "山田 花子",
+			PostalCode:    *postalCode,
+			Prefecture:    *hokkaido,
+			City:          "札幌市中央区",
+			AddressLine1:  "北一条西1-1",
+			AddressLine2:  "ニットビル 301",
+			PhoneNumber:   "011-123-4567",
+		})
+		assert.NoError(t, err)
+		_, err = sr.UpdateAddress(edited)
+		assert.NoError(t, err)
+
+		found, _ := sr.GetAddressByID(userId, created.AddressId())
+		assert.Equal(t, "北海道", found.Prefecture().Name())
+		assert.Equal(t, "ニットビル 301", found.AddressLine2())
+
+		assert.NoError(t, sr.DeleteAddress(userId, created.AddressId()))
+		_, err = sr.GetAddressByID(userId, created.AddressId())
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	})
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// shippingSettingId は shipping_settings の唯一の行の ID
+const shippingSettingId = 1
+
+// IShippingRateRepository は都道府県ごとの配送料の表を扱う
+type IShippingRateRepository interface {
+	GetRateTable() (*domain.ShippingRateTable, error)
+	ReplaceRateTable(table *domain.ShippingRateTable) (*domain.ShippingRateTable, error)
+}
+
+type shippingRateRepository struct {
+	db *gorm.DB
+}
+
+func NewShippingRateRepository(db *gorm.DB) IShippingRateRepository {
+	return &shippingRateRepository{db}
+}
+
+func (sr *shippingRateRepository) GetRateTable() (*domain.ShippingRateTable, error) {
+	return getShippingRateTable(sr.db)
+}
+
+// ReplaceRateTable は配送料の表をまとめて置き換える
+// 設定の行ロックを取ってから置き換えるため、同時に置き換えても表が混ざらない
+func (sr *shippingRateRepository) ReplaceRateTable(table *domain.ShippingRateTable) (*domain.ShippingRateTable, error) {
+	var replaced *domain.ShippingRateTable
+	err := sr.db.Transaction(func(tx *gorm.DB) error {
+		var setting model.ShippingSetting
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("setting_id = ?", shippingSettingId).First(&setting).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("1 = 1").Delete(&model.ShippingRate{}).Error; err != nil {
+			return err
+		}
+
+		ormRates := make([]model.ShippingRate, 0, len(table.Rates()))
+		for _, rate := range table.Rates() {
+			ormRates = append(ormRates, model.ShippingRate{
+				PrefectureCode: rate.Prefecture().Code(),
+				Fee:            rate.Fee().Amount(),
+				Currency:       rate.Fee().Currency(),
+				UpdatedAt:      table.UpdatedAt(),
+			})
+		}
+		if len(ormRates) > 0 {
+			if err := tx.Create(&ormRates).Error; err != nil {
+				return err
+			}
+		}
+
+		setting.FreeShippingThreshold = nil
+		setting.Currency = domain.CurrencyJPY
+		if threshold := table.FreeShippingThreshold(); threshold != nil {
+			amount := threshold.Amount()
+			setting.FreeShippingThreshold = &amount
+			setting.Currency = threshold.Currency()
+		}
+		setting.UpdatedAt = table.UpdatedAt()
+		err := tx.Model(&model.ShippingSetting{}).
+			Where("setting_id = ?", shippingSettingId).
+			Select("free_shipping_threshold", "currency", "updated_at").
+			Updates(&setting).Error
+		if err != nil {
+			return err
+		}
+
+		replaced, err = getShippingRateTable(tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return replaced, nil
+}
+
+func getShippingRateTable(db *gorm.DB) (*domain.ShippingRateTable, error) {
+	var setting model.ShippingSetting
+	if err := db.Where("setting_id = ?", shippingSettingId).First(&setting).Error; err != nil {
+		return nil, err
+	}
+	var ormRates []model.ShippingRate
+	if err := db.Order("prefecture_code ASC").Find(&ormRates).Error; err != nil {
+		return nil, err
+	}
+
+	rates := make([]domain.ShippingRate, 0, len(ormRates))
+	for _, ormRate := range ormRates {
+		prefecture, err := domain.NewPrefectureFromCode(ormRate.PrefectureCode)
+		if err != nil {
+			return nil, err
+		}
+		fee, err := domain.NewMoney(ormRate.Fee, ormRate.Currency)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, *domain.NewShippingRate(*prefecture, *fee))
+	}
+	var threshold *domain.Money
+	if setting.FreeShippingThreshold != nil {
+		var err error
+		threshold, err = domain.NewMoney(*setting.FreeShippingThreshold, setting.Currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return domain.RestoreShippingRateTable(rates, threshold, setting.UpdatedAt), nil
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestShippingRate(t *testing.T, prefectureName string, fee string) domain.ShippingRate {
+	t.Helper()
+	prefecture, err := domain.NewPrefecture(prefectureName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	money, _ := domain.NewMoneyFromString(fee, domain.CurrencyJPY)
+	return *domain.NewShippingRate(*prefecture, *money)
+}
+
+func TestShippingRateRepository(t *testing.T) {
+	t.Run("Replace Rate Table", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		sr := NewShippingRateRepository(tx)
+		threshold, _ := domain.NewMoneyFromString("10000", domain.CurrencyJPY)
+		table, _ := domain.NewShippingRateTable([]domain.ShippingRate{
+			newTestShippingRate(t, "東京都", "800"),
+			newTestShippingRate(t, "北海道", "1200"),
+		}, threshold)
+
+		replaced, err := sr.ReplaceRateTable(table)
+		assert.NoError(t, err)
+		assert.Len(t, replaced.Rates(), 2)
+		assert.Equal(t, "北海道", replaced.Rates()[0].Prefecture().Name())
+		assert.Equal(t, "10000", replaced.FreeShippingThreshold().String())
+
+		// 置き換え前の表に含まれていた都道府県は残らない
+		table, _ = domain.NewShippingRateTable([]domain.ShippingRate{newTestShippingRate(t, "沖縄県", "1500")}, nil)
+		_, err = sr.ReplaceRateTable(table)
+		assert.NoError(t, err)
+
+		found, err := sr.GetRateTable()
+		assert.NoError(t, err)
+		assert.Len(t, found.Rates(), 1)
+		assert.Equal(t, "沖縄県", found.Rates()[0].Prefecture().Name())
+		assert.Equal(t, "1500", found.Rates()[0].Fee().String())
+		assert.Nil(t, found.FreeShippingThreshold())
+	})
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+)
+
+// IStockMovementRepository は在庫移動台帳を扱う
+// 台帳への追記と items.on_hand_quantity（バリエーションの移動では item_variants.on_hand_quantity）の更新は同じトランザクションで行う
+type IStockMovementRepository interface {
+	RecordMovement(movement *domain.StockMovement) (*domain.Item, error)
+	GetMovementsByItemID(itemId *domain.ItemId) ([]*domain.StockMovement, error)
+	FindStockDrifts() ([]*domain.StockDrift, error)
+}
+
+type stockMovementRepository struct {
+	db *gorm.DB
+}
+
+func NewStockMovementRepository(db *gorm.DB) IStockMovementRepository {
+	return &stockMovementRepository{db}
+}
+
+// RecordMovement は移動を台帳に記録し、反映後の商品をバリエーションごと返す
+// バリエーションが商品に属していなければ gorm.ErrRecordNotFound を返す
+func (smr *stockMovementRepository) RecordMovement(movement *domain.StockMovement) (*domain.Item, error) {
+	var updated *domain.Item
+	err := smr.db.Transaction(func(tx *gorm.DB) error {
+		// 同じ商品への移動が並行しても在庫数を取りこぼさないよう、商品とそのバリエーションの行ロックを取る
+		items, err := lockItems(tx, []string{movement.ItemId()})
+		if err != nil {
+			return err
+		}
+		item, ok := items[movement.ItemId()]
+		if !ok {
+			return gorm.ErrRecordNotFound
+		}
+
+		if movement.VariantId() == "" {
+			stock, err := item.Stock().Apply(movement)
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(&model.Item{}).Where("item_id = ?", movement.ItemId()).Update("on_hand_quantity", stock.OnHand()).Error; err != nil {
+				return err
+			}
+			updated = item.WithStock(*stock)
+		} else {
+			variant := item.Variants().FindByID(movement.VariantId())
+			if variant == nil {
+				return gorm.ErrRecordNotFound
+			}
+			stock, err := variant.Stock().Apply(movement)
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(&model.ItemVariant{}).Where("variant_id = ?", movement.VariantId()).Update("on_hand_quantity", stock.OnHand()).Error; err != nil {
+				return err
+			}
+			updated, err = item.WithVariantStock(movement.VariantId(), *stock)
+			if err != nil {
+				return err
+			}
+		}
+
+		ormMovement := toStockMovementModel(movement)
+		if err := tx.Create(&ormMovement).Error; err != nil {
+			return err
+		}
+
+		// 在庫切れから販売可能になった場合は、申し込んだユーザーへの通知を同じトランザクションで積む
+		// バリエーションのある商品では、いずれかのバリエーションが販売可能になった時点で通知する
+		if !item.InStock() && updated.InStock() {
+			if err := queueStockNotifications(tx, movement.ItemId()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (smr *stockMovementRepository) GetMovementsByItemID(itemId *domain.ItemId) ([]*domain.StockMovement, error) {
+	var oms []model.StockMovement
+	err := smr.db.
+		Where("item_id = ?", itemId.Value()).
+		Order("created_at ASC").
+		Order("movement_id ASC").
+		Find(&oms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	movements := make([]*domain.StockMovement, 0, len(oms))
+	for _, v := range oms {
+		movement, err := toDomainStockMovement(v)
+		if err != nil {
+			return nil, err
+		}
+		movements = append(movements, movement)
+	}
+	return movements, nil
+}
+
+type stockDriftRow struct {
+	ItemId         string
+	VariantId      string
+	OnHandQuantity int
+	LedgerTotal    int
+}
+
+// FindStockDrifts は実在庫数と台帳の合計が一致しない商品とバリエーションを返す
+// 商品の実在庫数はバリエーションのない移動だけ、バリエーションの実在庫数はそのバリエーションの移動だけと比べる
+func (smr *stockMovementRepository) FindStockDrifts() ([]*domain.StockDrift, error) {
+	var itemRows []stockDriftRow
+	err := smr.db.
+		Table("items").
+		Select("items.item_id, '' AS variant_id, items.on_hand_quantity, COALESCE(SUM(stock_movements.quantity_delta), 0) AS ledger_total").
+		Joins("LEFT JOIN stock_movements ON stock_movements.item_id = items.item_id AND stock_movements.variant_id = ''").
+		Where("items.deleted_at IS NULL").
+		Group("items.item_id, items.on_hand_quantity").
+		Having("items.on_hand_quantity <> COALESCE(SUM(stock_movements.quantity_delta), 0)").
+		Order("items.item_id ASC").
+		Scan(&itemRows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var variantRows []stockDriftRow
+	err = smr.db.
+		Table("item_variants").
+		Select("item_variants.item_id, item_variants.variant_id, item_variants.on_hand_quantity, COALESCE(SUM(stock_movements.quantity_delta), 0) AS ledger_total").
+		Joins("JOIN items ON items.item_id = item_variants.item_id").
+		Joins("LEFT JOIN stock_movements ON stock_movements.variant_id = item_variants.variant_id").
+		Where("items.deleted_at IS NULL").
+		Group("item_variants.item_id, item_variants.variant_id, item_variants.on_hand_quantity").
+		Having("item_variants.on_hand_quantity <> COALESCE(SUM(stock_movements.quantity_delta), 0)").
+		Order("item_variants.item_id ASC").
+		Order("item_variants.variant_id ASC").
+		Scan(&variantRows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := make([]*domain.StockDrift, 0, len(itemRows)+len(variantRows))
+	for _, row := range append(itemRows, variantRows...) {
+		drifts = append(drifts, domain.NewStockDrift(row.ItemId, row.VariantId, row.OnHandQuantity, row.LedgerTotal))
+	}
+	return drifts, nil
+}
+
+func toStockMovementModel(movement *domain.StockMovement) model.StockMovement {
+	return model.StockMovement{
+		MovementId:    movement.MovementId(),
+		ItemId:        movement.ItemId(),
+		VariantId:     movement.VariantId(),
+		MovementType:  movement.MovementType(),
+		QuantityDelta: movement.QuantityDelta(),
+		Reason:        movement.Reason(),
+		UserId:        movement.UserId(),
+		CreatedAt:     movement.CreatedAt(),
+	}
+}
+
+func toDomainStockMovement(om model.StockMovement) (*domain.StockMovement, error) {
+	itemId, err := domain.NewItemId(om.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	movementType, err := domain.NewStockMovementType(om.MovementType)
+	if err != nil {
+		return nil, err
+	}
+	userId, err := domain.NewUserId(om.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return domain.RestoreStockMovement(om.MovementId, *itemId, om.VariantId, *movementType, om.QuantityDelta, om.Reason, *userId, om.CreatedAt), nil
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func seedStockMovementTestItem(t *testing.T, tx *gorm.DB, onHand int, reserved int) (string, string) {
+	t.Helper()
+	userId := uuid.NewString()
+	user := model.User{Id: userId, Name: "StockUser", Email: userId + "@example.com", Password: "password", Role: "ADMINISTRATOR", IsAdmin: true}
+	if err := tx.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	itemId := uuid.NewString()
+	item := model.Item{ItemId: itemId, UserId: userId, ItemName: "Merino Wool", OnHandQuantity: onHand, ReservedQuantity: reserved, Description: "Desc"}
+	if err := tx.Create(&item).Error; err != nil {
+		t.Fatal(err)
+	}
+	if onHand > 0 {
+		opening := model.StockMovement{MovementId: uuid.NewString(), ItemId: itemId, MovementType: domain.StockMovementAdjustment, QuantityDelta: onHand, Reason: "opening balance", UserId: userId}
+		if err := tx.Create(&opening).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	return itemId, userId
+}
+
+func newTestMovement(t *testing.T, itemId string, userId string, movementType string, delta int) *domain.StockMovement {
+	t.Helper()
+	itemIdValue, _ := domain.NewItemId(itemId)
+	userIdValue, _ := domain.NewUserId(userId)
+	mt, _ := domain.NewStockMovementType(movementType)
+	movement, err := domain.NewStockMovement(*itemIdValue, *mt, delta, "test", *userIdValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return movement
+}
+
+func TestRecordMovement(t *testing.T) {
+	t.Run("Record Movement - Updates On Hand", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, userId := seedStockMovementTestItem(t, tx, 5, 0)
+		smr := NewStockMovementRepository(tx)
+
+		item, err := smr.RecordMovement(newTestMovement(t, itemId, userId, domain.StockMovementSale, -2))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, item.Stock().OnHand())
+
+		var savedItem model.Item
+		assert.NoError(t, tx.Where("item_id = ?", itemId).First(&savedItem).Error)
+		assert.Equal(t, 3, savedItem.OnHandQuantity)
+
+		itemIdValue, _ := domain.NewItemId(itemId)
+		movements, err := smr.GetMovementsByItemID(itemIdValue)
+		assert.NoError(t, err)
+		assert.Len(t, movements, 2)
+		assert.Equal(t, domain.StockMovementSale, movements[1].MovementType())
+
+		drifts, err := smr.FindStockDrifts()
+		assert.NoError(t, err)
+		for _, drift := range drifts {
+			assert.NotEqual(t, itemId, drift.ItemId())
+		}
+	})
+
+	t.Run("Record Movement - Insufficient Stock", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, userId := seedStockMovementTestItem(t, tx, 3, 2)
+		smr := NewStockMovementRepository(tx)
+
+		item, err := smr.RecordMovement(newTestMovement(t, itemId, userId, domain.StockMovementDamage, -2))
+		assert.ErrorIs(t, err, domain.ErrInsufficientStock)
+		assert.Nil(t, item)
+
+		var count int64
+		tx.Model(&model.StockMovement{}).Where("item_id = ?", itemId).Count(&count)
+		assert.Equal(t, int64(1), count)
+	})
+}
+
+// seedStockMovementTestVariant は台帳に期首残高を記録したバリエーションを作る
+func seedStockMovementTestVariant(t *testing.T, tx *gorm.DB, itemId string, userId string, onHand int) string {
+	t.Helper()
+	variantId := uuid.NewString()
+	variant := model.ItemVariant{VariantId: variantId, ItemId: itemId, Sku: "SKU-" + variantId[:8], Options: `{"color":"red"}`, OptionKey: "color=red", OnHandQuantity: onHand, CreatedAt: time.Now()}
+	if err := tx.Create(&variant).Error; err != nil {
+		t.Fatal(err)
+	}
+	if onHand > 0 {
+		opening := model.StockMovement{MovementId: uuid.NewString(), ItemId: itemId, VariantId: variantId, MovementType: domain.StockMovementAdjustment, QuantityDelta: onHand, Reason: "opening balance", UserId: userId}
+		if err := tx.Create(&opening).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	return variantId
+}
+
+func TestRecordMovement_Variant(t *testing.T) {
+	t.Run("Record Movement - Updates Variant On Hand", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, userId := seedStockMovementTestItem(t, tx, 5, 0)
+		variantId := seedStockMovementTestVariant(t, tx, itemId, userId, 2)
+		smr := NewStockMovementRepository(tx)
+
+		item, err := smr.RecordMovement(newTestMovement(t, itemId, userId, domain.StockMovementReceive, 3).WithVariantId(variantId))
+		assert.NoError(t, err)
+		assert.Equal(t, 5, item.Stock().OnHand())
+		assert.Equal(t, 5, item.Variants().FindByID(variantId).Stock().OnHand())
+
+		var savedVariant model.ItemVariant
+		assert.NoError(t, tx.Where("variant_id = ?", variantId).First(&savedVariant).Error)
+		assert.Equal(t, 5, savedVariant.OnHandQuantity)
+		var savedItem model.Item
+		assert.NoError(t, tx.Where("item_id = ?", itemId).First(&savedItem).Error)
+		assert.Equal(t, 5, savedItem.OnHandQuantity)
+
+		itemIdValue, _ := domain.NewItemId(itemId)
+		movements, err := smr.GetMovementsByItemID(itemIdValue)
+		assert.NoError(t, err)
+		if assert.Len(t, movements, 3) {
+			assert.Equal(t, variantId, movements[2].VariantId())
+		}
+
+		drifts, err := smr.FindStockDrifts()
+		assert.NoError(t, err)
+		for _, drift := range drifts {
+			assert.NotEqual(t, itemId, drift.ItemId())
+		}
+	})
+
+	t.Run("Record Movement - Variant Of Another Item", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, userId := seedStockMovementTestItem(t, tx, 0, 0)
+		otherItemId, otherUserId := seedStockMovementTestItem(t, tx, 0, 0)
+		variantId := seedStockMovementTestVariant(t, tx, otherItemId, otherUserId, 1)
+
+		item, err := NewStockMovementRepository(tx).RecordMovement(newTestMovement(t, itemId, userId, domain.StockMovementReceive, 1).WithVariantId(variantId))
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.Nil(t, item)
+	})
+}
+
+func TestFindStockDrifts(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	itemId, _ := seedStockMovementTestItem(t, tx, 4, 0)
+	// 台帳を経由せずに在庫数を書き換えるとずれとして検出される
+	if err := tx.Model(&model.Item{}).Where("item_id = ?", itemId).Update("on_hand_quantity", 6).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	drifts, err := NewStockMovementRepository(tx).FindStockDrifts()
+	assert.NoError(t, err)
+
+	var found *domain.StockDrift
+	for _, drift := range drifts {
+		if drift.ItemId() == itemId {
+			found = drift
+		}
+	}
+	if assert.NotNil(t, found) {
+		assert.Equal(t, 6, found.OnHand())
+		assert.Equal(t, 4, found.LedgerTotal())
+		assert.Equal(t, 2, found.Difference())
+	}
+}
+
+func TestFindStockDrifts_Variant(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	itemId, userId := seedStockMovementTestItem(t, tx, 1, 0)
+	variantId := seedStockMovementTestVariant(t, tx, itemId, userId, 3)
+	// バリエーションの在庫を台帳を経由せずに書き換えると、商品ではなくバリエーションのずれとして検出される
+	if err := tx.Model(&model.ItemVariant{}).Where("variant_id = ?", variantId).Update("on_hand_quantity", 2).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	drifts, err := NewStockMovementRepository(tx).FindStockDrifts()
+	assert.NoError(t, err)
+
+	var found []*domain.StockDrift
+	for _, drift := range drifts {
+		if drift.ItemId() == itemId {
+			found = append(found, drift)
+		}
+	}
+	if assert.Len(t, found, 1) {
+		assert.Equal(t, variantId, found[0].VariantId())
+		assert.Equal(t, 2, found[0].OnHand())
+		assert.Equal(t, 3, found[0].LedgerTotal())
+	}
+}
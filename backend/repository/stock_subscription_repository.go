@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	stockNotificationPending = "pending"
+	stockNotificationSent    = "sent"
+	stockNotificationFailed  = "failed"
+)
+
+// IStockSubscriptionRepository は再入荷通知の申し込みと、再入荷時に積んだ通知を扱う
+// 通知は在庫を増やしたトランザクションの中で queueStockNotifications が積む
+type IStockSubscriptionRepository interface {
+	Subscribe(userId *domain.UserId, itemId *domain.ItemId) error
+	Unsubscribe(userId *domain.UserId, itemId *domain.ItemId) error
+	GetSubscriptions(userId *domain.UserId) ([]*domain.StockSubscription, error)
+	GetDemand() ([]*domain.ItemDemand, error)
+	GetPendingNotifications(limit int) ([]*domain.StockNotification, error)
+	MarkNotificationSent(notificationId string) error
+	RecordNotificationFailure(notificationId string, cause error) error
+}
+
+type stockSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewStockSubscriptionRepository(db *gorm.DB) IStockSubscriptionRepository {
+	return &stockSubscriptionRepository{db}
+}
+
+// Subscribe は在庫切れの商品の再入荷通知を申し込む。申し込み済みの場合は何もしない
+// 販売可能な在庫がある商品では domain.ErrItemInStock を、論理削除した商品や存在しない商品では gorm.ErrRecordNotFound を返す
+func (ssr *stockSubscriptionRepository) Subscribe(userId *domain.UserId, itemId *domain.ItemId) error {
+	return ssr.db.Transaction(func(tx *gorm.DB) error {
+		// 在庫を変更するトランザクションと商品の行ロックで直列にし、再入荷と同時の申し込みを取りこぼさない
+		var ormItem model.Item
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("item_id = ?", itemId.Value()).First(&ormItem).Error; err != nil {
+			return err
+		}
+		item, err := toDomainItem(ormItem)
+		if err != nil {
+			return err
+		}
+		subscription, err := domain.NewStockSubscription(*userId, item)
+		if err != nil {
+			return err
+		}
+
+		var existing model.StockSubscription
+		err = tx.Where("user_id = ? AND item_id = ?", userId.Value(), itemId.Value()).First(&existing).Error
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		return tx.Create(&model.StockSubscription{
+			UserId:    subscription.UserId(),
+			ItemId:    subscription.ItemId(),
+			CreatedAt: subscription.CreatedAt(),
+		}).Error
+	})
+}
+
+// Unsubscribe は申し込みを取り消す。申し込んでいない場合は何もしない
+func (ssr *stockSubscriptionRepository) Unsubscribe(userId *domain.UserId, itemId *domain.ItemId) error {
+	return ssr.db.Where("user_id = ? AND item_id = ?", userId.Value(), itemId.Value()).Delete(&model.StockSubscription{}).Error
+}
+
+type stockSubscriptionRow struct {
+	ItemId    string
+	ItemName  string
+	CreatedAt time.Time
+}
+
+// GetSubscriptions はユーザーの申し込みを新しい順に返す。論理削除した商品の申し込みは含めない
+func (ssr *stockSubscriptionRepository) GetSubscriptions(userId *domain.UserId) ([]*domain.StockSubscription, error) {
+	var rows []stockSubscriptionRow
+	err := ssr.db.
+		Table("stock_subscriptions").
+		Select("stock_subscriptions.item_id, items.item_name, stock_subscriptions.created_at").
+		Joins("JOIN items ON items.item_id = stock_subscriptions.item_id AND items.deleted_at IS NULL").
+		Where("stock_subscriptions.user_id = ?", userId.Value()).
+		Order("stock_subscriptions.created_at DESC").
+		Order("stock_subscriptions.item_id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]*domain.StockSubscription, 0, len(rows))
+	for _, row := range rows {
+		itemId, err := domain.NewItemId(row.ItemId)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, domain.RestoreStockSubscription(*userId, *itemId, row.ItemName, row.CreatedAt))
+	}
+	return subscriptions, nil
+}
+
+type itemDemandRow struct {
+	ItemId            string
+	ItemName          string
+	SubscriberCount   int
+	FirstSubscribedAt time.Time
+	LastSubscribedAt  time.Time
+}
+
+// GetDemand は申し込みのある商品を、申し込みの多い順に返す。論理削除した商品は含めない
+func (ssr *stockSubscriptionRepository) GetDemand() ([]*domain.ItemDemand, error) {
+	var rows []itemDemandRow
+	err := ssr.db.
+		Table("stock_subscriptions").
+		Select("items.item_id, items.item_name, COUNT(*) AS subscriber_count, MIN(stock_subscriptions.created_at) AS first_subscribed_at, MAX(stock_subscriptions.created_at) AS last_subscribed_at").
+		Joins("JOIN items ON items.item_id = stock_subscriptions.item_id AND items.deleted_at IS NULL").
+		Group("items.item_id, items.item_name").
+		Order("subscriber_count DESC").
+		Order("first_subscribed_at ASC").
+		Order("items.item_id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	demand := make([]*domain.ItemDemand, 0, len(rows))
+	for _, row := range rows {
+		itemId, err := domain.NewItemId(row.ItemId)
+		if err != nil {
+			return nil, err
+		}
+		demand = append(demand, domain.RestoreItemDemand(*itemId, row.ItemName, row.SubscriberCount, row.FirstSubscribedAt, row.LastSubscribedAt))
+	}
+	return demand, nil
+}
+
+type stockNotificationRow struct {
+	NotificationId string
+	UserId         string
+	Email          string
+	UserName       string
+	ItemId         string
+	ItemName       string
+	Attempts       int
+	CreatedAt      time.Time
+}
+
+// GetPendingNotifications はまだ送っていない通知を、積んだ順に最大 limit 件返す
+func (ssr *stockSubscriptionRepository) GetPendingNotifications(limit int) ([]*domain.StockNotification, error) {
+	var rows []stockNotificationRow
+	err := ssr.db.
+		Table("stock_notifications").
+		Select("stock_notifications.notification_id, stock_notifications.user_id, users.email, users.name AS user_name, stock_notifications.item_id, items.item_name, stock_notifications.attempts, stock_notifications.created_at").
+		Joins("JOIN users ON users.user_id = stock_notifications.user_id").
+		Joins("JOIN items ON items.item_id = stock_notifications.item_id").
+		Where("stock_notifications.status = ?", stockNotificationPending).
+		Order("stock_notifications.created_at ASC").
+		Order("stock_notifications.notification_id ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*domain.StockNotification, 0, len(rows))
+	for _, row := range rows {
+		userId, err := domain.NewUserId(row.UserId)
+		if err != nil {
+			return nil, err
+		}
+		itemId, err := domain.NewItemId(row.ItemId)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, domain.RestoreStockNotification(row.NotificationId, *userId, row.Email, row.UserName, *itemId, row.ItemName, row.Attempts, row.CreatedAt))
+	}
+	return notifications, nil
+}
+
+// MarkNotificationSent は通知を送信済みにする。送信済みの通知では何もしない
+func (ssr *stockSubscriptionRepository) MarkNotificationSent(notificationId string) error {
+	return ssr.db.Model(&model.StockNotification{}).
+		Where("notification_id = ? AND status = ?", notificationId, stockNotificationPending).
+		Updates(map[string]interface{}{"status": stockNotificationSent, "sent_at": time.Now()}).Error
+}
+
+// RecordNotificationFailure は送信の失敗を記録する
+// 失敗が domain.MaxStockNotificationAttempts 回に達した通知は送信を諦め、以降は送り直さない
+func (ssr *stockSubscriptionRepository) RecordNotificationFailure(notificationId string, cause error) error {
+	message := cause.Error()
+	if utf8.RuneCountInString(message) > 500 {
+		message = string([]rune(message)[:500])
+	}
+	return ssr.db.Model(&model.StockNotification{}).
+		Where("notification_id = ? AND status = ?", notificationId, stockNotificationPending).
+		Updates(map[string]interface{}{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": message,
+			"status":     gorm.Expr("CASE WHEN attempts + 1 >= ? THEN ? ELSE status END", domain.MaxStockNotificationAttempts, stockNotificationFailed),
+		}).Error
+}
+
+// queueStockNotifications は再入荷した商品の申し込みごとに通知を積み、申し込みを消す
+// 在庫を増やしたトランザクションの中で、商品の行ロックを取った後に呼ぶ
+func queueStockNotifications(tx *gorm.DB, itemId string) error {
+	var subscriptions []model.StockSubscription
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("item_id = ?", itemId).Order("created_at ASC").Find(&subscriptions).Error; err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	notifications := make([]model.StockNotification, len(subscriptions))
+	for i, subscription := range subscriptions {
+		notifications[i] = model.StockNotification{
+			NotificationId: domain.NewStockNotificationId(),
+			UserId:         subscription.UserId,
+			ItemId:         itemId,
+			Status:         stockNotificationPending,
+			CreatedAt:      now,
+		}
+	}
+	if err := tx.Create(&notifications).Error; err != nil {
+		return fmt.Errorf("failed to queue stock notifications for item %s: %w", itemId, err)
+	}
+	return tx.Where("item_id = ?", itemId).Delete(&model.StockSubscription{}).Error
+}
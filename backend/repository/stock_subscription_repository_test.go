@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStockSubscriptionRepository_Subscribe(t *testing.T) {
+	t.Run("Sold Out", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, _ := seedStockMovementTestItem(t, tx, 0, 0)
+		buyer := seedOrderTestUser(t, tx)
+		itemIdValue, _ := domain.NewItemId(itemId)
+		ssr := NewStockSubscriptionRepository(tx)
+
+		assert.NoError(t, ssr.Subscribe(buyer, itemIdValue))
+		assert.NoError(t, ssr.Subscribe(buyer, itemIdValue))
+
+		subscriptions, err := ssr.GetSubscriptions(buyer)
+		assert.NoError(t, err)
+		assert.Len(t, subscriptions, 1)
+		assert.Equal(t, "Merino Wool", subscriptions[0].ItemName())
+	})
+
+	t.Run("In Stock", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, _ := seedStockMovementTestItem(t, tx, 2, 0)
+		buyer := seedOrderTestUser(t, tx)
+		itemIdValue, _ := domain.NewItemId(itemId)
+
+		err := NewStockSubscriptionRepository(tx).Subscribe(buyer, itemIdValue)
+		assert.True(t, errors.Is(err, domain.ErrItemInStock))
+	})
+}
+
+func TestStockSubscriptionRepository_GetDemand(t *testing.T) {
+	tx := db.Begin()
+	defer tx.Rollback()
+
+	popular, _ := seedStockMovementTestItem(t, tx, 0, 0)
+	other, _ := seedStockMovementTestItem(t, tx, 1, 1)
+	popularId, _ := domain.NewItemId(popular)
+	otherId, _ := domain.NewItemId(other)
+	ssr := NewStockSubscriptionRepository(tx)
+	ssr.Subscribe(seedOrderTestUser(t, tx), popularId)
+	ssr.Subscribe(seedOrderTestUser(t, tx), popularId)
+	ssr.Subscribe(seedOrderTestUser(t, tx), otherId)
+
+	demand, err := ssr.GetDemand()
+	assert.NoError(t, err)
+	var counts []int
+	for _, d := range demand {
+		if d.ItemId() == popular || d.ItemId() == other {
+			counts = append(counts, d.SubscriberCount())
+		}
+	}
+	assert.Equal(t, []int{2, 1}, counts)
+}
+
+func TestStockSubscriptionRepository_Restock(t *testing.T) {
+	t.Run("Receive Queues One Notification Per Subscriber", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, adminId := seedStockMovementTestItem(t, tx, 0, 0)
+		itemIdValue, _ := domain.NewItemId(itemId)
+		buyer := seedOrderTestUser(t, tx)
+		ssr := NewStockSubscriptionRepository(tx)
+		ssr.Subscribe(buyer, itemIdValue)
+
+		smr := NewStockMovementRepository(tx)
+		_, err := smr.RecordMovement(newTestMovement(t, itemId, adminId, domain.StockMovementReceive, 3))
+		assert.NoError(t, err)
+		// 在庫がある間の入荷では通知を積まない
+		_, err = smr.RecordMovement(newTestMovement(t, itemId, adminId, domain.StockMovementReceive, 1))
+		assert.NoError(t, err)
+
+		var notifications []model.StockNotification
+		tx.Where("item_id = ?", itemId).Find(&notifications)
+		assert.Len(t, notifications, 1)
+		assert.Equal(t, buyer.Value(), notifications[0].UserId)
+		subscriptions, _ := ssr.GetSubscriptions(buyer)
+		assert.Empty(t, subscriptions)
+	})
+
+	t.Run("Variant Receive Queues Notification", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, adminId := seedStockMovementTestItem(t, tx, 0, 0)
+		soldOutId := seedStockMovementTestVariant(t, tx, itemId, adminId, 0)
+		itemIdValue, _ := domain.NewItemId(itemId)
+		buyer := seedOrderTestUser(t, tx)
+		ssr := NewStockSubscriptionRepository(tx)
+		assert.NoError(t, ssr.Subscribe(buyer, itemIdValue))
+
+		_, err := NewStockMovementRepository(tx).RecordMovement(newTestMovement(t, itemId, adminId, domain.StockMovementReceive, 2).WithVariantId(soldOutId))
+		assert.NoError(t, err)
+
+		var notifications []model.StockNotification
+		tx.Where("item_id = ?", itemId).Find(&notifications)
+		assert.Len(t, notifications, 1)
+	})
+
+	t.Run("Failures Give Up After Max Attempts", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		itemId, adminId := seedStockMovementTestItem(t, tx, 0, 0)
+		itemIdValue, _ := domain.NewItemId(itemId)
+		buyer := seedOrderTestUser(t, tx)
+		ssr := NewStockSubscriptionRepository(tx)
+		ssr.Subscribe(buyer, itemIdValue)
+		NewStockMovementRepository(tx).RecordMovement(newTestMovement(t, itemId, adminId, domain.StockMovementReceive, 1))
+
+		var notification model.StockNotification
+		tx.Where("item_id = ?", itemId).First(&notification)
+		for i := 0; i < domain.MaxStockNotificationAttempts; i++ {
+			assert.NoError(t, ssr.RecordNotificationFailure(notification.NotificationId, errors.New("smtp unavailable")))
+		}
+
+		tx.Where("notification_id = ?", notification.NotificationId).First(&notification)
+		assert.Equal(t, "failed", notification.Status)
+		assert.Equal(t, domain.MaxStockNotificationAttempts, notification.Attempts)
+	})
+}
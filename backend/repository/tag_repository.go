@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/internal/orm/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ITagRepository は商品に付けるタグを扱う
+// タグは商品に付けたときに作成し、名前で一意になる
+type ITagRepository interface {
+	GetAllTags() ([]domain.Tag, error)
+	GetTagByID(tagId string) (*domain.Tag, error)
+	ReplaceItemTags(itemId *domain.ItemId, names []domain.TagName) error
+	DeleteTag(tagId string) error
+}
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) ITagRepository {
+	return &tagRepository{db}
+}
+
+func (tr *tagRepository) GetAllTags() ([]domain.Tag, error) {
+	var ots []model.Tag
+	if err := tr.db.Order("name ASC").Find(&ots).Error; err != nil {
+		return nil, err
+	}
+	return toDomainTags(ots)
+}
+
+func (tr *tagRepository) GetTagByID(tagId string) (*domain.Tag, error) {
+	var ot model.Tag
+	if err := tr.db.Where("tag_id = ?", tagId).First(&ot).Error; err != nil {
+		return nil, err
+	}
+	tags, err := toDomainTags([]model.Tag{ot})
+	if err != nil {
+		return nil, err
+	}
+	return &tags[0], nil
+}
+
+// ReplaceItemTags は商品のタグを names に置き換える。まだないタグはこのとき作成する
+func (tr *tagRepository) ReplaceItemTags(itemId *domain.ItemId, names []domain.TagName) error {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&model.Item{}).Where("item_id = ?", itemId.Value()).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		itemTags := make([]model.ItemTag, 0, len(names))
+		for _, name := range names {
+			tagId, err := findOrCreateTag(tx, name)
+			if err != nil {
+				return err
+			}
+			itemTags = append(itemTags, model.ItemTag{ItemId: itemId.Value(), TagId: tagId})
+		}
+
+		if err := tx.Where("item_id = ?", itemId.Value()).Delete(&model.ItemTag{}).Error; err != nil {
+			return err
+		}
+		if len(itemTags) == 0 {
+			return nil
+		}
+		return tx.Create(&itemTags).Error
+	})
+}
+
+func (tr *tagRepository) DeleteTag(tagId string) error {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", tagId).Delete(&model.ItemTag{}).Error; err != nil {
+			return err
+		}
+		result := tx.Where("tag_id = ?", tagId).Delete(&model.Tag{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// findOrCreateTag は名前が一致するタグの ID を返し、なければ作成する
+// 同じ名前のタグを並行して作成してもユニーク制約で失敗しないよう、作成は INSERT IGNORE で行う
+func findOrCreateTag(tx *gorm.DB, name domain.TagName) (string, error) {
+	tag := domain.NewTag(name)
+	ormTag := model.Tag{TagId: tag.TagId(), Name: tag.Name()}
+	if err := tx.Clauses(clause.Insert{Modifier: "IGNORE"}).Create(&ormTag).Error; err != nil {
+		return "", err
+	}
+
+	var saved model.Tag
+	if err := tx.Where("name = ?", name.Value()).First(&saved).Error; err != nil {
+		return "", err
+	}
+	return saved.TagId, nil
+}
+
+func toDomainTags(ots []model.Tag) ([]domain.Tag, error) {
+	tags := make([]domain.Tag, 0, len(ots))
+	for _, ot := range ots {
+		name, err := domain.NewTagName(ot.Name)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, *domain.RestoreTag(ot.TagId, *name))
+	}
+	return tags, nil
+}
@@ -9,7 +9,42 @@ import (
 	"github.com/posiposi/project/backend/validator"
 )
 
-func NewRouter(uc controller.IUserController, ic controller.IItemController, aic controller.IAdminItemController, aac controller.IAdminAuthController, userRepo authMiddleware.UserRepository) *echo.Echo {
+// Controllers は NewRouter に渡すコントローラー
+// Payment・AdminPayment・Receipt・AdminReceipt は nil にでき、その場合は対応するルートを登録しない
+type Controllers struct {
+	User                   controller.IUserController
+	Item                   controller.IItemController
+	ItemSearch             controller.IItemSearchController
+	AdminItem              controller.IAdminItemController
+	AdminItemVariant       controller.IAdminItemVariantController
+	AdminItemImage         controller.IAdminItemImageController
+	AdminCategory          controller.IAdminCategoryController
+	AdminTag               controller.IAdminTagController
+	AdminStockMovement     controller.IAdminStockMovementController
+	AdminAuth              controller.IAdminAuthController
+	Cart                   controller.ICartController
+	Order                  controller.IOrderController
+	AdminOrder             controller.IAdminOrderController
+	Payment                controller.IPaymentController
+	AdminPayment           controller.IAdminPaymentController
+	Coupon                 controller.ICouponController
+	AdminCoupon            controller.IAdminCouponController
+	ShippingAddress        controller.IShippingAddressController
+	Shipping               controller.IShippingController
+	AdminShippingRate      controller.IAdminShippingRateController
+	Receipt                controller.IReceiptController
+	AdminReceipt           controller.IAdminReceiptController
+	Favorite               controller.IFavoriteController
+	StockSubscription      controller.IStockSubscriptionController
+	AdminStockSubscription controller.IAdminStockSubscriptionController
+	Review                 controller.IReviewController
+	Project                controller.IProjectController
+	Pattern                controller.IPatternController
+	AdminPattern           controller.IAdminPatternController
+	Gauge                  controller.IGaugeController
+}
+
+func NewRouter(c Controllers, userRepo authMiddleware.UserRepository) *echo.Echo {
 	e := echo.New()
 	e.Validator = validator.NewValidator()
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
@@ -19,22 +54,113 @@ func NewRouter(uc controller.IUserController, ic controller.IItemController, aic
 	}))
 
 	g := e.Group("/v1")
-	g.POST("/signup", uc.SignUp)
-	g.POST("/login", uc.LogIn)
-	g.POST("/logout", uc.LogOut)
-	g.GET("/auth/check", uc.CheckAuth, authMiddleware.AuthMiddleware())
+	g.POST("/signup", c.User.SignUp)
+	g.POST("/login", c.User.LogIn)
+	g.POST("/logout", c.User.LogOut)
+	g.GET("/auth/check", c.User.CheckAuth, authMiddleware.AuthMiddleware())
 	i := g.Group("/items")
-	i.GET("", ic.GetAllItems)
-	i.POST("", ic.CreateItem, authMiddleware.AuthMiddleware())
+	// ログインしていれば商品ごとに is_favorited を返す
+	i.GET("", c.Item.GetAllItems, authMiddleware.OptionalAuthMiddleware())
+	i.GET("/search", c.ItemSearch.SearchItems, authMiddleware.OptionalAuthMiddleware())
+	i.POST("", c.Item.CreateItem, authMiddleware.AuthMiddleware())
+	i.GET("/:id", c.Item.GetItemByID, authMiddleware.AuthMiddleware())
+	i.PUT("/:id", c.Item.UpdateItem, authMiddleware.AuthMiddleware())
+	i.DELETE("/:id", c.Item.DeleteItem, authMiddleware.AuthMiddleware())
+	i.GET("/:id/reviews", c.Review.GetReviews)
+	i.POST("/:id/reviews", c.Review.CreateReview, authMiddleware.AuthMiddleware())
+	i.PUT("/:id/reviews/:reviewId", c.Review.UpdateReview, authMiddleware.AuthMiddleware())
+	g.GET("/patterns", c.Pattern.GetPatterns)
+	g.GET("/patterns/:id", c.Pattern.GetPattern)
+	g.POST("/tools/gauge", c.Gauge.CalculateGauge)
+	cart := g.Group("/cart", authMiddleware.OptionalAuthMiddleware())
+	cart.GET("/items", c.Cart.GetCart)
+	cart.POST("/items", c.Cart.AddItem)
+	cart.PATCH("/items/:lineId", c.Cart.UpdateItem)
+	cart.DELETE("/items/:lineId", c.Cart.RemoveItem)
+	orders := g.Group("/orders", authMiddleware.AuthMiddleware())
+	orders.GET("", c.Order.GetOrders)
+	orders.POST("", c.Order.PlaceOrder)
+	orders.GET("/:id", c.Order.GetOrder)
+	orders.POST("/:id/cancel", c.Order.CancelOrder)
+	// 決済代行サービスが設定されていない場合、決済の API は登録しない
+	if c.Payment != nil {
+		orders.GET("/:id/payment", c.Payment.GetPayment)
+		orders.POST("/:id/payment", c.Payment.PayOrder)
+		// Webhook は決済代行サービスから届くため認証せず、署名で送り主を確かめる
+		g.POST("/payments/webhook", c.Payment.HandleWebhook)
+	}
+	// 領収書の発行者が設定されていない場合、領収書の API は登録しない
+	if c.Receipt != nil {
+		orders.GET("/:id/receipt", c.Receipt.GetReceipt)
+	}
+	g.POST("/coupons/validate", c.Coupon.ValidateCoupon, authMiddleware.AuthMiddleware())
+	addresses := g.Group("/addresses", authMiddleware.AuthMiddleware())
+	addresses.GET("", c.ShippingAddress.GetAddresses)
+	addresses.POST("", c.ShippingAddress.CreateAddress)
+	addresses.GET("/:id", c.ShippingAddress.GetAddress)
+	addresses.PUT("/:id", c.ShippingAddress.UpdateAddress)
+	addresses.DELETE("/:id", c.ShippingAddress.DeleteAddress)
+	g.POST("/shipping/quote", c.Shipping.QuoteShipping, authMiddleware.AuthMiddleware())
+	me := g.Group("/me", authMiddleware.AuthMiddleware())
+	me.GET("/favorites", c.Favorite.GetFavorites)
+	me.PUT("/favorites/:itemId", c.Favorite.AddFavorite)
+	me.DELETE("/favorites/:itemId", c.Favorite.RemoveFavorite)
+	me.GET("/stock-subscriptions", c.StockSubscription.GetSubscriptions)
+	me.PUT("/stock-subscriptions/:itemId", c.StockSubscription.Subscribe)
+	me.DELETE("/stock-subscriptions/:itemId", c.StockSubscription.Unsubscribe)
+	me.GET("/projects", c.Project.GetProjects)
+	me.POST("/projects", c.Project.CreateProject)
+	me.GET("/projects/:id", c.Project.GetProject)
+	me.PUT("/projects/:id", c.Project.UpdateProject)
+	me.DELETE("/projects/:id", c.Project.DeleteProject)
+	me.GET("/projects/:id/history", c.Project.GetHistory)
 	
 	admin := g.Group("/admin", authMiddleware.AuthMiddleware(), authMiddleware.AdminMiddleware(userRepo))
-	admin.GET("/auth/check", aac.CheckAdminAuth)
+	admin.GET("/auth/check", c.AdminAuth.CheckAdminAuth)
 	adminItems := admin.Group("/items")
-	adminItems.GET("", aic.GetAllItems)
-	adminItems.GET("/:id", aic.GetItemByID)
-	adminItems.POST("", aic.CreateItem)
-	adminItems.PUT("/:id", aic.UpdateItem)
-	adminItems.DELETE("/:id", aic.DeleteItem)
+	adminItems.GET("", c.AdminItem.GetAllItems)
+	adminItems.GET("/:id", c.AdminItem.GetItemByID)
+	adminItems.POST("", c.AdminItem.CreateItem)
+	adminItems.PUT("/:id", c.AdminItem.UpdateItem)
+	adminItems.DELETE("/:id", c.AdminItem.DeleteItem)
+	adminItems.GET("/:id/variants", c.AdminItemVariant.GetVariants)
+	adminItems.POST("/:id/variants", c.AdminItemVariant.CreateVariant)
+	adminItems.GET("/:id/variants/:variantId", c.AdminItemVariant.GetVariant)
+	adminItems.PUT("/:id/variants/:variantId", c.AdminItemVariant.UpdateVariant)
+	adminItems.DELETE("/:id/variants/:variantId", c.AdminItemVariant.DeleteVariant)
+	adminItems.POST("/:id/images", c.AdminItemImage.UploadImage, middleware.BodyLimit("12M"))
+	adminItems.PUT("/:id/images/order", c.AdminItemImage.ReorderImages)
+	adminItems.DELETE("/:id/images/:imageId", c.AdminItemImage.DeleteImage)
+	adminItems.PUT("/:id/category", c.AdminCategory.SetItemCategory)
+	adminItems.PUT("/:id/tags", c.AdminTag.ReplaceItemTags)
+	adminItems.PUT("/:id/yarn", c.AdminPattern.SetItemYarn)
+	adminItems.GET("/:id/stock-movements", c.AdminStockMovement.GetMovements)
+	adminItems.POST("/:id/stock-movements", c.AdminStockMovement.RecordMovement)
+	admin.GET("/inventory/reconciliation", c.AdminStockMovement.Reconcile)
+	admin.GET("/categories", c.AdminCategory.GetCategories)
+	admin.POST("/categories", c.AdminCategory.CreateCategory)
+	admin.PUT("/categories/:id", c.AdminCategory.UpdateCategory)
+	admin.DELETE("/categories/:id", c.AdminCategory.DeleteCategory)
+	admin.GET("/tags", c.AdminTag.GetTags)
+	admin.DELETE("/tags/:id", c.AdminTag.DeleteTag)
+	admin.POST("/orders/:id/transitions", c.AdminOrder.TransitionOrder)
+	if c.AdminPayment != nil {
+		admin.POST("/orders/:id/refund", c.AdminPayment.RefundOrder)
+	}
+	if c.AdminReceipt != nil {
+		admin.GET("/orders/:id/receipt", c.AdminReceipt.GetReceipt)
+	}
+	admin.GET("/coupons", c.AdminCoupon.GetCoupons)
+	admin.POST("/coupons", c.AdminCoupon.CreateCoupon)
+	admin.GET("/coupons/:id", c.AdminCoupon.GetCoupon)
+	admin.PUT("/coupons/:id", c.AdminCoupon.UpdateCoupon)
+	admin.DELETE("/coupons/:id", c.AdminCoupon.DeleteCoupon)
+	admin.GET("/shipping-rates", c.AdminShippingRate.GetRates)
+	admin.PUT("/shipping-rates", c.AdminShippingRate.ReplaceRates)
+	admin.GET("/stock-subscriptions", c.AdminStockSubscription.GetDemand)
+	admin.POST("/patterns", c.AdminPattern.CreatePattern)
+	admin.PUT("/patterns/:id", c.AdminPattern.UpdatePattern)
+	admin.DELETE("/patterns/:id", c.AdminPattern.DeletePattern)
 	
 	return e
 }
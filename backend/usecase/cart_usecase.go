@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+	"gorm.io/gorm"
+)
+
+// DefaultGuestCartTTL はゲストのカートを最後に変更してから破棄するまでの期間の既定値
+const DefaultGuestCartTTL = 30 * 24 * time.Hour
+
+// guestCartTokenType はゲストのカートのトークンをログイン用のトークンと区別するためのクレームの値
+const guestCartTokenType = "guest_cart"
+
+type ICartUsecase interface {
+	GetCart(owner request.CartOwner) (*domain.CartSummary, error)
+	AddItem(req request.AddCartItemRequest) (*domain.CartSummary, error)
+	UpdateItemQuantity(req request.UpdateCartItemRequest) (*domain.CartSummary, error)
+	RemoveItem(owner request.CartOwner, lineId string) error
+	IssueGuestCartToken(guestCartId string) (string, string, error)
+	VerifyGuestCartToken(token string) (string, error)
+	GuestCartTTL() time.Duration
+	MergeGuestCart(guestCartId string, userId string) error
+	PurgeExpiredGuestCarts() (int64, error)
+}
+
+type cartUsecase struct {
+	cr           repository.ICartRepository
+	ir           repository.IItemRepository
+	guestCartTTL time.Duration
+}
+
+func NewCartUsecase(cr repository.ICartRepository, ir repository.IItemRepository, guestCartTTL time.Duration) ICartUsecase {
+	return &cartUsecase{cr, ir, guestCartTTL}
+}
+
+// GuestCartTTLFromEnv は GUEST_CART_TTL（"720h" のような time.ParseDuration の形式）からゲストのカートの有効期間を読み取る
+// 未設定の場合は DefaultGuestCartTTL を返す
+func GuestCartTTLFromEnv() (time.Duration, error) {
+	value := os.Getenv("GUEST_CART_TTL")
+	if value == "" {
+		return DefaultGuestCartTTL, nil
+	}
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid GUEST_CART_TTL: %w", err)
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("GUEST_CART_TTL must be positive: %s", value)
+	}
+	return ttl, nil
+}
+
+// GetCart はカートを現在の商品の状態で評価して返す
+// 削除された商品や在庫が足りない行もカートには残し、行ごとの状態で購入できないことを示す
+func (cu *cartUsecase) GetCart(owner request.CartOwner) (*domain.CartSummary, error) {
+	cart, err := cu.findCart(owner)
+	if err != nil {
+		return nil, err
+	}
+	return cu.priceCart(cart)
+}
+
+// AddItem は商品をカートに入れる。合算後の数量が販売可能数を超える場合は受け付けない
+func (cu *cartUsecase) AddItem(req request.AddCartItemRequest) (*domain.CartSummary, error) {
+	cart, err := cu.findCart(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+	itemId, err := domain.NewItemId(req.ItemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	item, err := cu.ir.GetItemByID(itemId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+		}
+		return nil, err
+	}
+
+	line, err := cart.AddLine(*itemId, req.VariantId, req.Quantity)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCartItem, err)
+	}
+	if err := checkCartLine(item, line); err != nil {
+		return nil, err
+	}
+	// 通貨の異なる商品を同じカートに入れると合計を計算できない
+	current, err := cu.priceCart(cart)
+	if err != nil {
+		return nil, err
+	}
+	if current.ItemCount() > 0 && current.Subtotal().Currency() != item.Price().Currency() {
+		return nil, fmt.Errorf("%w: currency %s does not match the cart", ErrInvalidCartItem, item.Price().Currency())
+	}
+	if err := cu.cr.SaveLine(cart, line); err != nil {
+		return nil, err
+	}
+	return cu.GetCart(req.Owner)
+}
+
+// UpdateItemQuantity は行の数量を置き換える。減らす場合も在庫を確認し、購入できない数量のままにはしない
+func (cu *cartUsecase) UpdateItemQuantity(req request.UpdateCartItemRequest) (*domain.CartSummary, error) {
+	cart, err := cu.findCart(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+	existing := cart.FindLine(req.LineId)
+	if existing == nil {
+		return nil, fmt.Errorf("%w: %s", ErrCartLineNotFound, req.LineId)
+	}
+	quantity, err := domain.NewCartQuantity(req.Quantity)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCartItem, err)
+	}
+
+	itemId, err := domain.NewItemId(existing.ItemId())
+	if err != nil {
+		return nil, err
+	}
+	item, err := cu.ir.GetItemByID(itemId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+		}
+		return nil, err
+	}
+	line := existing.WithQuantity(*quantity)
+	if err := checkCartLine(item, line); err != nil {
+		return nil, err
+	}
+	if err := cu.cr.SaveLine(cart, line); err != nil {
+		return nil, err
+	}
+	return cu.GetCart(req.Owner)
+}
+
+// RemoveItem は行を削除する。削除された商品の行もこの操作で取り除く
+func (cu *cartUsecase) RemoveItem(owner request.CartOwner, lineId string) error {
+	cart, err := cu.findCart(owner)
+	if err != nil {
+		return err
+	}
+	if cart.FindLine(lineId) == nil {
+		return fmt.Errorf("%w: %s", ErrCartLineNotFound, lineId)
+	}
+	return cu.cr.DeleteLine(cart.Owner(), lineId)
+}
+
+// IssueGuestCartToken はゲストのカート ID を署名したトークンを返す。guestCartId が空の場合は新しい ID を発行する
+// トークンの有効期限は発行のたびに GuestCartTTL だけ延びる
+func (cu *cartUsecase) IssueGuestCartToken(guestCartId string) (string, string, error) {
+	if guestCartId == "" {
+		guestCartId = uuid.NewString()
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"guest_cart_id": guestCartId,
+		"typ":           guestCartTokenType,
+		"exp":           time.Now().Add(cu.guestCartTTL).Unix(),
+	})
+	tokenString, err := token.SignedString([]byte(os.Getenv("SECRET")))
+	if err != nil {
+		return "", "", err
+	}
+	return guestCartId, tokenString, nil
+}
+
+// VerifyGuestCartToken はトークンの署名と有効期限を確認し、ゲストのカート ID を返す
+func (cu *cartUsecase) VerifyGuestCartToken(tokenString string) (string, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(os.Getenv("SECRET")), nil
+	})
+	if err != nil || !token.Valid {
+		return "", fmt.Errorf("%w: %v", ErrInvalidGuestCartToken, err)
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["typ"] != guestCartTokenType {
+		return "", fmt.Errorf("%w: unexpected token type", ErrInvalidGuestCartToken)
+	}
+	guestCartId, _ := claims["guest_cart_id"].(string)
+	if _, err := domain.GuestCartOwner(guestCartId); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidGuestCartToken, err)
+	}
+	return guestCartId, nil
+}
+
+func (cu *cartUsecase) GuestCartTTL() time.Duration {
+	return cu.guestCartTTL
+}
+
+// MergeGuestCart はログインしたユーザーのカートにゲストのカートの行を取り込む
+// 行の合算と上限の扱いは domain.Cart.MergeLines に従う。期限切れのゲストのカートは取り込まずに破棄する
+func (cu *cartUsecase) MergeGuestCart(guestCartId string, userId string) error {
+	guestCart, err := cu.findCart(request.CartOwner{GuestCartId: guestCartId})
+	if err != nil {
+		return err
+	}
+	if len(guestCart.Lines()) == 0 {
+		return cu.cr.DeleteCart(guestCart.CartId())
+	}
+	userIdDomain, err := domain.NewUserId(userId)
+	if err != nil {
+		return err
+	}
+	dropped, err := cu.cr.MergeGuestCart(guestCartId, userIdDomain)
+	if err != nil {
+		return err
+	}
+	if dropped > 0 {
+		log.Printf("dropped %d guest cart lines over the limit when merging into user %s", dropped, userId)
+	}
+	return nil
+}
+
+// PurgeExpiredGuestCarts は GuestCartTTL の間変更されていないゲストのカートを削除する
+func (cu *cartUsecase) PurgeExpiredGuestCarts() (int64, error) {
+	return cu.cr.DeleteGuestCartsUpdatedBefore(time.Now().Add(-cu.guestCartTTL))
+}
+
+// findCart は持ち主のカートを返す。期限切れのゲストのカートは削除し、空のカートとして扱う
+func (cu *cartUsecase) findCart(owner request.CartOwner) (*domain.Cart, error) {
+	cartOwner, err := toCartOwner(owner)
+	if err != nil {
+		return nil, err
+	}
+	cart, err := cu.cr.GetCart(cartOwner)
+	if err != nil {
+		return nil, err
+	}
+	if cart.IsExpired(time.Now(), cu.guestCartTTL) {
+		if err := cu.cr.DeleteCart(cart.CartId()); err != nil {
+			return nil, err
+		}
+		return domain.NewCart(cartOwner), nil
+	}
+	return cart, nil
+}
+
+func (cu *cartUsecase) priceCart(cart *domain.Cart) (*domain.CartSummary, error) {
+	lines := cart.Lines()
+	itemIds := make([]*domain.ItemId, 0, len(lines))
+	seen := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		if seen[line.ItemId()] {
+			continue
+		}
+		seen[line.ItemId()] = true
+		itemId, err := domain.NewItemId(line.ItemId())
+		if err != nil {
+			return nil, err
+		}
+		itemIds = append(itemIds, itemId)
+	}
+	items, err := cu.ir.GetItemsByIDs(itemIds)
+	if err != nil {
+		return nil, err
+	}
+	return domain.PriceCart(cart, items)
+}
+
+// checkCartLine は行のバリエーションの指定と、数量分の販売可能数があるかを確認する
+func checkCartLine(item *domain.Item, line *domain.CartLine) error {
+	stock := item.Stock()
+	switch {
+	case line.VariantId() != "":
+		variant := item.Variants().FindByID(line.VariantId())
+		if variant == nil {
+			return fmt.Errorf("%w: %s", ErrVariantNotFound, line.VariantId())
+		}
+		stock = variant.Stock()
+	case len(item.Variants()) > 0:
+		return fmt.Errorf("%w: variant_id is required for items with variants", ErrInvalidCartItem)
+	}
+
+	if stock.Available() < line.Quantity() {
+		return fmt.Errorf("%w: requested %d, available %d", ErrInsufficientStock, line.Quantity(), stock.Available())
+	}
+	return nil
+}
+
+func toCartOwner(owner request.CartOwner) (domain.CartOwner, error) {
+	if owner.UserId != "" {
+		userId, err := domain.NewUserId(owner.UserId)
+		if err != nil {
+			return domain.CartOwner{}, err
+		}
+		return domain.UserCartOwner(*userId), nil
+	}
+	return domain.GuestCartOwner(owner.GuestCartId)
+}
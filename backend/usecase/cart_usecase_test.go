@@ -0,0 +1,389 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockCartRepository struct {
+	mock.Mock
+}
+
+func (m *MockCartRepository) GetCart(owner domain.CartOwner) (*domain.Cart, error) {
+	args := m.Called(owner)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Cart), args.Error(1)
+}
+
+func (m *MockCartRepository) SaveLine(cart *domain.Cart, line *domain.CartLine) error {
+	args := m.Called(cart, line)
+	return args.Error(0)
+}
+
+func (m *MockCartRepository) DeleteLine(owner domain.CartOwner, lineId string) error {
+	args := m.Called(owner, lineId)
+	return args.Error(0)
+}
+
+func (m *MockCartRepository) DeleteCart(cartId string) error {
+	args := m.Called(cartId)
+	return args.Error(0)
+}
+
+func (m *MockCartRepository) MergeGuestCart(guestCartId string, userId *domain.UserId) (int, error) {
+	args := m.Called(guestCartId, userId)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCartRepository) DeleteGuestCartsUpdatedBefore(before time.Time) (int64, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+const (
+	cartTestUserId  = "f47ac10b-58cc-4372-a567-0e02b2c3d901"
+	cartTestItemId  = "f47ac10b-58cc-4372-a567-0e02b2c3d902"
+	cartTestLineId  = "f47ac10b-58cc-4372-a567-0e02b2c3d903"
+	cartTestGuestId = "f47ac10b-58cc-4372-a567-0e02b2c3d905"
+)
+
+var cartTestOwner = request.CartOwner{UserId: cartTestUserId}
+
+// cartTestUserOwner は cartTestUserId のユーザーのカートの持ち主を返す
+func cartTestUserOwner() domain.CartOwner {
+	userId, _ := domain.NewUserId(cartTestUserId)
+	return domain.UserCartOwner(*userId)
+}
+
+// createCartTestItem は販売可能数が available 個の500円の商品を返す
+func createCartTestItem(available int) *domain.Item {
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d904")
+	itemName, _ := domain.NewItemName("Alpaca Yarn")
+	stock, _ := domain.NewStock(available, 0, 0)
+	description, _ := domain.NewDescription("Test Description")
+	price, _ := domain.NewMoneyFromString("500", domain.CurrencyJPY)
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, *price)
+	return item
+}
+
+// createTestCart は cartTestItemId の商品を quantity 個入れたカートを返す。quantity が0なら空のカート
+func createTestCart(quantity int) *domain.Cart {
+	if quantity == 0 {
+		return domain.NewCart(cartTestUserOwner())
+	}
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	q, _ := domain.NewCartQuantity(quantity)
+	line := domain.RestoreCartLine(cartTestLineId, *itemId, "", *q, time.Now(), time.Now())
+	return domain.RestoreCart("cart-1", cartTestUserOwner(), []domain.CartLine{*line}, time.Now(), time.Now())
+}
+
+func TestGetCart_PricesLinesWithCurrentStock(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	mockCartRepo.On("GetCart", cartTestUserOwner()).Return(createTestCart(3), nil)
+	mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{*createCartTestItem(2)}, nil)
+
+	summary, err := uc.GetCart(cartTestOwner)
+
+	assert.NoError(t, err)
+	assert.Len(t, summary.Lines(), 1)
+	assert.Equal(t, domain.CartLineInsufficientStock, summary.Lines()[0].Status())
+	assert.Equal(t, "0", summary.Subtotal().String())
+	assert.False(t, summary.CheckoutReady())
+}
+
+func TestGetCart_DeletedItemIsUnavailable(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	mockCartRepo.On("GetCart", cartTestUserOwner()).Return(createTestCart(1), nil)
+	mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{}, nil)
+
+	summary, err := uc.GetCart(cartTestOwner)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.CartLineUnavailable, summary.Lines()[0].Status())
+	assert.Nil(t, summary.Lines()[0].LineTotal())
+	assert.False(t, summary.CheckoutReady())
+}
+
+func TestAddItem_MergesWithExistingLine(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	item := createCartTestItem(5)
+	mockCartRepo.On("GetCart", cartTestUserOwner()).Return(createTestCart(2), nil)
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+	mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{*item}, nil)
+	mockCartRepo.On("SaveLine", mock.Anything, mock.MatchedBy(func(line *domain.CartLine) bool {
+		return line.LineId() == cartTestLineId && line.Quantity() == 5
+	})).Return(nil)
+
+	_, err := uc.AddItem(request.AddCartItemRequest{Owner: cartTestOwner, ItemId: cartTestItemId, Quantity: 3})
+
+	assert.NoError(t, err)
+	mockCartRepo.AssertExpectations(t)
+}
+
+func TestAddItem_InsufficientStock(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	mockCartRepo.On("GetCart", cartTestUserOwner()).Return(createTestCart(2), nil)
+	mockItemRepo.On("GetItemByID", itemId).Return(createCartTestItem(3), nil)
+
+	_, err := uc.AddItem(request.AddCartItemRequest{Owner: cartTestOwner, ItemId: cartTestItemId, Quantity: 2})
+
+	assert.True(t, errors.Is(err, ErrInsufficientStock))
+	mockCartRepo.AssertNotCalled(t, "SaveLine", mock.Anything, mock.Anything)
+}
+
+func TestAddItem_ItemNotFound(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	mockCartRepo.On("GetCart", cartTestUserOwner()).Return(createTestCart(0), nil)
+	mockItemRepo.On("GetItemByID", itemId).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := uc.AddItem(request.AddCartItemRequest{Owner: cartTestOwner, ItemId: cartTestItemId, Quantity: 1})
+
+	assert.True(t, errors.Is(err, ErrItemNotFound))
+}
+
+func TestAddItem_RepositoryError(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	dbErr := errors.New("connection refused")
+	mockCartRepo.On("GetCart", cartTestUserOwner()).Return(createTestCart(0), nil)
+	mockItemRepo.On("GetItemByID", itemId).Return(nil, dbErr)
+
+	_, err := uc.AddItem(request.AddCartItemRequest{Owner: cartTestOwner, ItemId: cartTestItemId, Quantity: 1})
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.False(t, errors.Is(err, ErrItemNotFound))
+	mockCartRepo.AssertNotCalled(t, "SaveLine", mock.Anything, mock.Anything)
+}
+
+func TestAddItem_VariantRequired(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	item, variant := createVariantTestItem(t, 0)
+	itemId, _ := domain.NewItemId(variantTestItemId)
+	mockCartRepo.On("GetCart", cartTestUserOwner()).Return(createTestCart(0), nil)
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+
+	_, err := uc.AddItem(request.AddCartItemRequest{Owner: cartTestOwner, ItemId: variantTestItemId, Quantity: 1})
+	assert.True(t, errors.Is(err, ErrInvalidCartItem))
+
+	_, err = uc.AddItem(request.AddCartItemRequest{Owner: cartTestOwner, ItemId: variantTestItemId, VariantId: "unknown", Quantity: 1})
+	assert.True(t, errors.Is(err, ErrVariantNotFound))
+
+	_, err = uc.AddItem(request.AddCartItemRequest{Owner: cartTestOwner, ItemId: variantTestItemId, VariantId: variant.VariantId(), Quantity: 6})
+	assert.True(t, errors.Is(err, ErrInsufficientStock))
+}
+
+func TestAddItem_InvalidQuantity(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	mockCartRepo.On("GetCart", cartTestUserOwner()).Return(createTestCart(0), nil)
+	mockItemRepo.On("GetItemByID", itemId).Return(createCartTestItem(5), nil)
+
+	_, err := uc.AddItem(request.AddCartItemRequest{Owner: cartTestOwner, ItemId: cartTestItemId, Quantity: 0})
+
+	assert.True(t, errors.Is(err, ErrInvalidCartItem))
+}
+
+func TestUpdateItemQuantity_ReplacesQuantity(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	item := createCartTestItem(5)
+	mockCartRepo.On("GetCart", cartTestUserOwner()).Return(createTestCart(2), nil)
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+	mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{*item}, nil)
+	mockCartRepo.On("SaveLine", mock.Anything, mock.MatchedBy(func(line *domain.CartLine) bool {
+		return line.LineId() == cartTestLineId && line.Quantity() == 1
+	})).Return(nil)
+
+	_, err := uc.UpdateItemQuantity(request.UpdateCartItemRequest{Owner: cartTestOwner, LineId: cartTestLineId, Quantity: 1})
+
+	assert.NoError(t, err)
+	mockCartRepo.AssertExpectations(t)
+}
+
+func TestUpdateItemQuantity_LineNotFound(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	mockCartRepo.On("GetCart", cartTestUserOwner()).Return(createTestCart(2), nil)
+
+	_, err := uc.UpdateItemQuantity(request.UpdateCartItemRequest{Owner: cartTestOwner, LineId: "unknown", Quantity: 1})
+
+	assert.True(t, errors.Is(err, ErrCartLineNotFound))
+}
+
+func TestRemoveItem(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	mockCartRepo.On("GetCart", cartTestUserOwner()).Return(createTestCart(2), nil)
+	mockCartRepo.On("DeleteLine", cartTestUserOwner(), cartTestLineId).Return(nil)
+
+	assert.NoError(t, uc.RemoveItem(cartTestOwner, cartTestLineId))
+	assert.True(t, errors.Is(uc.RemoveItem(cartTestOwner, "unknown"), ErrCartLineNotFound))
+}
+
+// createGuestTestCart は最後の変更が updatedAt のゲストのカートに cartTestItemId の商品を1個入れて返す
+func createGuestTestCart(updatedAt time.Time) *domain.Cart {
+	owner, _ := domain.GuestCartOwner(cartTestGuestId)
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	q, _ := domain.NewCartQuantity(1)
+	line := domain.RestoreCartLine(cartTestLineId, *itemId, "", *q, updatedAt, updatedAt)
+	return domain.RestoreCart(cartTestGuestId, owner, []domain.CartLine{*line}, updatedAt, updatedAt)
+}
+
+func TestGetCart_ExpiredGuestCartIsDiscarded(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, 24*time.Hour)
+
+	owner, _ := domain.GuestCartOwner(cartTestGuestId)
+	mockCartRepo.On("GetCart", owner).Return(createGuestTestCart(time.Now().Add(-25*time.Hour)), nil)
+	mockCartRepo.On("DeleteCart", cartTestGuestId).Return(nil)
+	mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{}, nil)
+
+	summary, err := uc.GetCart(request.CartOwner{GuestCartId: cartTestGuestId})
+
+	assert.NoError(t, err)
+	assert.Empty(t, summary.Lines())
+	mockCartRepo.AssertExpectations(t)
+}
+
+func TestGuestCartToken(t *testing.T) {
+	t.Setenv("SECRET", "test-secret")
+	uc := NewCartUsecase(new(MockCartRepository), new(MockItemRepository), DefaultGuestCartTTL)
+
+	guestCartId, token, err := uc.IssueGuestCartToken("")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, guestCartId)
+
+	verified, err := uc.VerifyGuestCartToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, guestCartId, verified)
+
+	// 同じ ID で再発行すると有効期限だけが延びる
+	reissuedId, _, err := uc.IssueGuestCartToken(guestCartId)
+	assert.NoError(t, err)
+	assert.Equal(t, guestCartId, reissuedId)
+
+	_, err = uc.VerifyGuestCartToken(token + "x")
+	assert.True(t, errors.Is(err, ErrInvalidGuestCartToken))
+
+	t.Setenv("SECRET", "another-secret")
+	_, err = uc.VerifyGuestCartToken(token)
+	assert.True(t, errors.Is(err, ErrInvalidGuestCartToken))
+}
+
+func TestVerifyGuestCartToken_RejectsLoginToken(t *testing.T) {
+	t.Setenv("SECRET", "test-secret")
+	uc := NewCartUsecase(new(MockCartRepository), new(MockItemRepository), DefaultGuestCartTTL)
+
+	loginToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": cartTestUserId,
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte("test-secret"))
+
+	_, err := uc.VerifyGuestCartToken(loginToken)
+	assert.True(t, errors.Is(err, ErrInvalidGuestCartToken))
+}
+
+func TestMergeGuestCart(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, DefaultGuestCartTTL)
+
+	owner, _ := domain.GuestCartOwner(cartTestGuestId)
+	userId, _ := domain.NewUserId(cartTestUserId)
+	mockCartRepo.On("GetCart", owner).Return(createGuestTestCart(time.Now()), nil)
+	mockCartRepo.On("MergeGuestCart", cartTestGuestId, userId).Return(0, nil)
+
+	assert.NoError(t, uc.MergeGuestCart(cartTestGuestId, cartTestUserId))
+	mockCartRepo.AssertExpectations(t)
+}
+
+func TestMergeGuestCart_ExpiredGuestCartIsNotMerged(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCartUsecase(mockCartRepo, mockItemRepo, time.Hour)
+
+	owner, _ := domain.GuestCartOwner(cartTestGuestId)
+	mockCartRepo.On("GetCart", owner).Return(createGuestTestCart(time.Now().Add(-2*time.Hour)), nil)
+	mockCartRepo.On("DeleteCart", cartTestGuestId).Return(nil)
+
+	assert.NoError(t, uc.MergeGuestCart(cartTestGuestId, cartTestUserId))
+	mockCartRepo.AssertNotCalled(t, "MergeGuestCart", mock.Anything, mock.Anything)
+}
+
+func TestPurgeExpiredGuestCarts(t *testing.T) {
+	mockCartRepo := new(MockCartRepository)
+	uc := NewCartUsecase(mockCartRepo, new(MockItemRepository), time.Hour)
+
+	mockCartRepo.On("DeleteGuestCartsUpdatedBefore", mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= time.Hour && time.Since(before) < time.Hour+time.Minute
+	})).Return(int64(3), nil)
+
+	purged, err := uc.PurgeExpiredGuestCarts()
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), purged)
+}
+
+func TestGuestCartTTLFromEnv(t *testing.T) {
+	t.Setenv("GUEST_CART_TTL", "")
+	ttl, err := GuestCartTTLFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultGuestCartTTL, ttl)
+
+	t.Setenv("GUEST_CART_TTL", "72h")
+	ttl, err = GuestCartTTLFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, 72*time.Hour, ttl)
+
+	for _, value := range []string{"three days", "-1h", "0s"} {
+		t.Setenv("GUEST_CART_TTL", value)
+		_, err = GuestCartTTLFromEnv()
+		assert.Error(t, err, value)
+	}
+}
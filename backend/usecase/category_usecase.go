@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type ICategoryUsecase interface {
+	GetCategoryTree() ([]*domain.CategoryNode, error)
+	CreateCategory(req request.CreateCategoryRequest) (*domain.Category, error)
+	UpdateCategory(req request.UpdateCategoryRequest) (*domain.Category, error)
+	DeleteCategory(categoryId string) error
+	SetItemCategory(req request.SetItemCategoryRequest) (*domain.Item, error)
+}
+
+type categoryUsecase struct {
+	cr repository.ICategoryRepository
+	ir repository.IItemRepository
+}
+
+func NewCategoryUsecase(cr repository.ICategoryRepository, ir repository.IItemRepository) ICategoryUsecase {
+	return &categoryUsecase{cr, ir}
+}
+
+func (cu *categoryUsecase) GetCategoryTree() ([]*domain.CategoryNode, error) {
+	categories, err := cu.cr.GetAllCategories()
+	if err != nil {
+		return nil, err
+	}
+	return categories.Tree(), nil
+}
+
+func (cu *categoryUsecase) CreateCategory(req request.CreateCategoryRequest) (*domain.Category, error) {
+	categories, err := cu.cr.GetAllCategories()
+	if err != nil {
+		return nil, err
+	}
+
+	slug, parentId, err := newCategoryAttributes(categories, req.Slug, req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	category, err := domain.NewCategory(parentId, req.Name, *slug)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCategory, err)
+	}
+
+	created, err := cu.cr.CreateCategory(category)
+	if err != nil {
+		return nil, translateCategoryError(err)
+	}
+	return created, nil
+}
+
+func (cu *categoryUsecase) UpdateCategory(req request.UpdateCategoryRequest) (*domain.Category, error) {
+	categories, err := cu.cr.GetAllCategories()
+	if err != nil {
+		return nil, err
+	}
+	existing := categories.FindByID(req.CategoryId)
+	if existing == nil {
+		return nil, fmt.Errorf("%w: %s", ErrCategoryNotFound, req.CategoryId)
+	}
+
+	slug, parentId, err := newCategoryAttributes(categories, req.Slug, req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	// 親を付け替えるときは、自身の子孫の下に移動して循環しないかを確認する
+	if err := categories.CheckParent(existing.CategoryId(), req.ParentId); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCategory, err)
+	}
+
+	categoryId, err := domain.NewCategoryId(existing.CategoryId())
+	if err != nil {
+		return nil, err
+	}
+	category, err := domain.RestoreCategory(*categoryId, parentId, req.Name, *slug, existing.CreatedAt(), time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCategory, err)
+	}
+
+	updated, err := cu.cr.UpdateCategory(category)
+	if err != nil {
+		return nil, translateCategoryError(err)
+	}
+	return updated, nil
+}
+
+func (cu *categoryUsecase) DeleteCategory(categoryId string) error {
+	categories, err := cu.cr.GetAllCategories()
+	if err != nil {
+		return err
+	}
+	if categories.FindByID(categoryId) == nil {
+		return fmt.Errorf("%w: %s", ErrCategoryNotFound, categoryId)
+	}
+
+	categoryIdDomain, err := domain.NewCategoryId(categoryId)
+	if err != nil {
+		return err
+	}
+	if err := cu.cr.DeleteCategory(categoryIdDomain); err != nil {
+		return translateCategoryError(err)
+	}
+	return nil
+}
+
+func (cu *categoryUsecase) SetItemCategory(req request.SetItemCategoryRequest) (*domain.Item, error) {
+	itemId, err := domain.NewItemId(req.ItemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	if _, err := cu.ir.GetItemByID(itemId); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+
+	var categoryId *domain.CategoryId
+	if req.CategoryId != "" {
+		categories, err := cu.cr.GetAllCategories()
+		if err != nil {
+			return nil, err
+		}
+		if categories.FindByID(req.CategoryId) == nil {
+			return nil, fmt.Errorf("%w: %s", ErrCategoryNotFound, req.CategoryId)
+		}
+		categoryId, err = domain.NewCategoryId(req.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cu.cr.SetItemCategory(itemId, categoryId); err != nil {
+		return nil, err
+	}
+	return cu.ir.GetItemByID(itemId)
+}
+
+// newCategoryAttributes はスラッグと親カテゴリの指定を検証する
+func newCategoryAttributes(categories domain.Categories, slugValue string, parentValue string) (*domain.CategorySlug, *domain.CategoryId, error) {
+	slug, err := domain.NewCategorySlug(slugValue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidCategory, err)
+	}
+	if parentValue == "" {
+		return slug, nil, nil
+	}
+	if categories.FindByID(parentValue) == nil {
+		return nil, nil, fmt.Errorf("%w: parent category %s not found", ErrInvalidCategory, parentValue)
+	}
+	parentId, err := domain.NewCategoryId(parentValue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidCategory, err)
+	}
+	return slug, parentId, nil
+}
+
+func translateCategoryError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrDuplicateCategorySlug):
+		return fmt.Errorf("%w: %v", ErrDuplicateCategory, err)
+	case errors.Is(err, domain.ErrCategoryInUse), errors.Is(err, domain.ErrCategoryHasChildren):
+		return fmt.Errorf("%w: %v", ErrCategoryInUse, err)
+	}
+	return err
+}
@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockCategoryRepository) GetAllCategories() (domain.Categories, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(domain.Categories), args.Error(1)
+}
+
+func (m *MockCategoryRepository) CreateCategory(category *domain.Category) (*domain.Category, error) {
+	args := m.Called(category)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) UpdateCategory(category *domain.Category) (*domain.Category, error) {
+	args := m.Called(category)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) DeleteCategory(categoryId *domain.CategoryId) error {
+	args := m.Called(categoryId)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) SetItemCategory(itemId *domain.ItemId, categoryId *domain.CategoryId) error {
+	args := m.Called(itemId, categoryId)
+	return args.Error(0)
+}
+
+// createTestCategories は yarn > wool > fingering と finished-goods のツリーを返す
+func createTestCategories(t *testing.T) domain.Categories {
+	t.Helper()
+	newCategory := func(parent *domain.Category, slugValue string) *domain.Category {
+		var parentId *domain.CategoryId
+		if parent != nil {
+			parentId, _ = domain.NewCategoryId(parent.CategoryId())
+		}
+		slug, _ := domain.NewCategorySlug(slugValue)
+		category, err := domain.NewCategory(parentId, slugValue, *slug)
+		if err != nil {
+			t.Fatalf("Failed to create category: %v", err)
+		}
+		return category
+	}
+	yarn := newCategory(nil, "yarn")
+	wool := newCategory(yarn, "wool")
+	fingering := newCategory(wool, "fingering")
+	goods := newCategory(nil, "finished-goods")
+	return domain.Categories{*yarn, *wool, *fingering, *goods}
+}
+
+func TestCreateCategory_Success(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	uc := NewCategoryUsecase(mockCategoryRepo, new(MockItemRepository))
+
+	categories := createTestCategories(t)
+	yarn := categories[0]
+	mockCategoryRepo.On("GetAllCategories").Return(categories, nil)
+	mockCategoryRepo.On("CreateCategory", mock.MatchedBy(func(c *domain.Category) bool {
+		return c.Name() == "コットン" && c.Slug() == "cotton" && c.ParentId() == yarn.CategoryId()
+	})).Return(&yarn, nil)
+
+	_, err := uc.CreateCategory(request.CreateCategoryRequest{Name: "コットン", Slug: "Cotton", ParentId: yarn.CategoryId()})
+
+	assert.NoError(t, err)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestCreateCategory_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		req  request.CreateCategoryRequest
+	}{
+		{"invalid slug", request.CreateCategoryRequest{Name: "Cotton", Slug: "cotton yarn"}},
+		{"empty name", request.CreateCategoryRequest{Name: " ", Slug: "cotton"}},
+		{"unknown parent", request.CreateCategoryRequest{Name: "Cotton", Slug: "cotton", ParentId: "f47ac10b-58cc-4372-a567-0e02b2c3da99"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepo := new(MockCategoryRepository)
+			uc := NewCategoryUsecase(mockCategoryRepo, new(MockItemRepository))
+			mockCategoryRepo.On("GetAllCategories").Return(createTestCategories(t), nil)
+
+			_, err := uc.CreateCategory(tt.req)
+
+			assert.ErrorIs(t, err, ErrInvalidCategory)
+			mockCategoryRepo.AssertNotCalled(t, "CreateCategory", mock.Anything)
+		})
+	}
+}
+
+func TestCreateCategory_DuplicateSlug(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	uc := NewCategoryUsecase(mockCategoryRepo, new(MockItemRepository))
+	mockCategoryRepo.On("GetAllCategories").Return(createTestCategories(t), nil)
+	mockCategoryRepo.On("CreateCategory", mock.Anything).Return(nil, fmt.Errorf("%w: wool", domain.ErrDuplicateCategorySlug))
+
+	_, err := uc.CreateCategory(request.CreateCategoryRequest{Name: "Wool", Slug: "wool"})
+
+	assert.ErrorIs(t, err, ErrDuplicateCategory)
+}
+
+func TestUpdateCategory_RejectsCycle(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	uc := NewCategoryUsecase(mockCategoryRepo, new(MockItemRepository))
+	categories := createTestCategories(t)
+	yarn, fingering := categories[0], categories[2]
+	mockCategoryRepo.On("GetAllCategories").Return(categories, nil)
+
+	_, err := uc.UpdateCategory(request.UpdateCategoryRequest{
+		CategoryId: yarn.CategoryId(),
+		Name:       "Yarn",
+		Slug:       "yarn",
+		ParentId:   fingering.CategoryId(),
+	})
+
+	assert.ErrorIs(t, err, ErrInvalidCategory)
+	mockCategoryRepo.AssertNotCalled(t, "UpdateCategory", mock.Anything)
+}
+
+func TestUpdateCategory_MoveToAnotherParent(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	uc := NewCategoryUsecase(mockCategoryRepo, new(MockItemRepository))
+	categories := createTestCategories(t)
+	wool, goods := categories[1], categories[3]
+	mockCategoryRepo.On("GetAllCategories").Return(categories, nil)
+	mockCategoryRepo.On("UpdateCategory", mock.MatchedBy(func(c *domain.Category) bool {
+		return c.CategoryId() == wool.CategoryId() &&
+			c.ParentId() == goods.CategoryId() &&
+			c.CreatedAt().Equal(wool.CreatedAt())
+	})).Return(&wool, nil)
+
+	_, err := uc.UpdateCategory(request.UpdateCategoryRequest{
+		CategoryId: wool.CategoryId(),
+		Name:       "Wool",
+		Slug:       "wool",
+		ParentId:   goods.CategoryId(),
+	})
+
+	assert.NoError(t, err)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestUpdateCategory_NotFound(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	uc := NewCategoryUsecase(mockCategoryRepo, new(MockItemRepository))
+	mockCategoryRepo.On("GetAllCategories").Return(createTestCategories(t), nil)
+
+	_, err := uc.UpdateCategory(request.UpdateCategoryRequest{CategoryId: "f47ac10b-58cc-4372-a567-0e02b2c3da99", Name: "Wool", Slug: "wool"})
+
+	assert.ErrorIs(t, err, ErrCategoryNotFound)
+}
+
+func TestDeleteCategory_InUse(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"has items", domain.ErrCategoryInUse},
+		{"has children", domain.ErrCategoryHasChildren},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepo := new(MockCategoryRepository)
+			uc := NewCategoryUsecase(mockCategoryRepo, new(MockItemRepository))
+			categories := createTestCategories(t)
+			wool := categories[1]
+			categoryId, _ := domain.NewCategoryId(wool.CategoryId())
+			mockCategoryRepo.On("GetAllCategories").Return(categories, nil)
+			mockCategoryRepo.On("DeleteCategory", categoryId).Return(fmt.Errorf("%w: 1", tt.err))
+
+			err := uc.DeleteCategory(wool.CategoryId())
+
+			assert.ErrorIs(t, err, ErrCategoryInUse)
+		})
+	}
+}
+
+func TestSetItemCategory(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCategoryUsecase(mockCategoryRepo, mockItemRepo)
+	categories := createTestCategories(t)
+	wool := categories[1]
+	item := createStockTestItem(0)
+	itemId, _ := domain.NewItemId(item.ItemId())
+	categoryId, _ := domain.NewCategoryId(wool.CategoryId())
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+	mockCategoryRepo.On("GetAllCategories").Return(categories, nil)
+	mockCategoryRepo.On("SetItemCategory", itemId, categoryId).Return(nil)
+	mockCategoryRepo.On("SetItemCategory", itemId, (*domain.CategoryId)(nil)).Return(nil)
+
+	_, err := uc.SetItemCategory(request.SetItemCategoryRequest{ItemId: item.ItemId(), CategoryId: wool.CategoryId()})
+	assert.NoError(t, err)
+
+	_, err = uc.SetItemCategory(request.SetItemCategoryRequest{ItemId: item.ItemId()})
+	assert.NoError(t, err)
+
+	_, err = uc.SetItemCategory(request.SetItemCategoryRequest{ItemId: item.ItemId(), CategoryId: "f47ac10b-58cc-4372-a567-0e02b2c3da99"})
+	assert.ErrorIs(t, err, ErrCategoryNotFound)
+	mockCategoryRepo.AssertNumberOfCalls(t, "SetItemCategory", 2)
+}
@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type ICouponUsecase interface {
+	GetCoupons() ([]*domain.Coupon, error)
+	GetCoupon(couponId string) (*domain.Coupon, error)
+	CreateCoupon(req request.CreateCouponRequest) (*domain.Coupon, error)
+	UpdateCoupon(req request.UpdateCouponRequest) (*domain.Coupon, error)
+	DeleteCoupon(couponId string) error
+	ValidateCoupon(req request.ValidateCouponRequest) (*domain.CouponPreview, error)
+}
+
+type couponUsecase struct {
+	cr repository.ICouponRepository
+	ir repository.IItemRepository
+}
+
+func NewCouponUsecase(cr repository.ICouponRepository, ir repository.IItemRepository) ICouponUsecase {
+	return &couponUsecase{cr, ir}
+}
+
+func (cu *couponUsecase) GetCoupons() ([]*domain.Coupon, error) {
+	return cu.cr.GetAllCoupons()
+}
+
+func (cu *couponUsecase) GetCoupon(couponId string) (*domain.Coupon, error) {
+	coupon, err := cu.cr.GetCouponByID(couponId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCouponNotFound, err)
+	}
+	return coupon, nil
+}
+
+func (cu *couponUsecase) CreateCoupon(req request.CreateCouponRequest) (*domain.Coupon, error) {
+	attributes, err := cu.newCouponAttributes(couponAttributesRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	coupon := domain.NewCoupon(attributes.code, attributes.discount, attributes.validity, attributes.limit, attributes.minimumSpend, attributes.itemIds)
+
+	created, err := cu.cr.CreateCoupon(coupon)
+	if err != nil {
+		return nil, translateCouponError(err)
+	}
+	return created, nil
+}
+
+// UpdateCoupon は利用回数を引き継いでクーポンの内容を置き換える
+func (cu *couponUsecase) UpdateCoupon(req request.UpdateCouponRequest) (*domain.Coupon, error) {
+	existing, err := cu.GetCoupon(req.CouponId)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := cu.newCouponAttributes(couponAttributesRequest{
+		Code:         req.Code,
+		DiscountType: req.DiscountType,
+		PercentOff:   req.PercentOff,
+		AmountOff:    req.AmountOff,
+		MinimumSpend: req.MinimumSpend,
+		Currency:     req.Currency,
+		StartsAt:     req.StartsAt,
+		EndsAt:       req.EndsAt,
+		UsageLimit:   req.UsageLimit,
+		PerUserLimit: req.PerUserLimit,
+		ItemIds:      req.ItemIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	coupon := domain.RestoreCoupon(existing.CouponId(), attributes.code, attributes.discount, attributes.validity, attributes.limit, attributes.minimumSpend, attributes.itemIds, existing.UsedCount(), existing.CreatedAt(), time.Now())
+
+	updated, err := cu.cr.UpdateCoupon(coupon)
+	if err != nil {
+		return nil, translateCouponError(err)
+	}
+	return updated, nil
+}
+
+func (cu *couponUsecase) DeleteCoupon(couponId string) error {
+	if _, err := cu.GetCoupon(couponId); err != nil {
+		return err
+	}
+	if err := cu.cr.DeleteCoupon(couponId); err != nil {
+		return translateCouponError(err)
+	}
+	return nil
+}
+
+// ValidateCoupon は明細にクーポンを使った場合の金額を返す。在庫の引当や利用回数の記録はしない
+func (cu *couponUsecase) ValidateCoupon(req request.ValidateCouponRequest) (*domain.CouponPreview, error) {
+	userId, err := domain.NewUserId(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	code, err := domain.NewCouponCode(req.Code)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCouponNotFound, err)
+	}
+	orderRequest, err := newOrderRequest(req.Lines)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := priceOrderLines(cu.ir, orderRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	coupon, err := cu.cr.GetCouponByCode(code)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCouponNotFound, err)
+	}
+	redemptions, err := cu.cr.CountRedemptions(coupon.CouponId(), userId)
+	if err != nil {
+		return nil, err
+	}
+	preview, err := coupon.Preview(lines, redemptions, time.Now())
+	if err != nil {
+		if errors.Is(err, domain.ErrCouponNotApplicable) {
+			return nil, fmt.Errorf("%w: %v", ErrCouponNotApplicable, err)
+		}
+		return nil, err
+	}
+	return preview, nil
+}
+
+// couponAttributesRequest は作成と更新で共通のクーポンの内容
+type couponAttributesRequest request.CreateCouponRequest
+
+type couponAttributes struct {
+	code         domain.CouponCode
+	discount     domain.Discount
+	validity     domain.CouponValidity
+	limit        domain.CouponUsageLimit
+	minimumSpend *domain.Money
+	itemIds      []domain.ItemId
+}
+
+// newCouponAttributes はクーポンの内容を検証する。対象商品は存在する（削除されていない）ものだけを受け付ける
+func (cu *couponUsecase) newCouponAttributes(req couponAttributesRequest) (*couponAttributes, error) {
+	code, err := domain.NewCouponCode(req.Code)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCoupon, err)
+	}
+
+	var discount *domain.Discount
+	switch domain.DiscountType(req.DiscountType) {
+	case domain.DiscountPercent:
+		discount, err = domain.NewPercentDiscount(req.PercentOff)
+	case domain.DiscountFixed:
+		var amountOff *domain.Money
+		amountOff, err = domain.NewMoneyFromString(req.AmountOff, req.Currency)
+		if err == nil {
+			discount, err = domain.NewFixedDiscount(*amountOff)
+		}
+	default:
+		err = fmt.Errorf("discount type must be percent or fixed: %q", req.DiscountType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCoupon, err)
+	}
+
+	validity, err := domain.NewCouponValidity(req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCoupon, err)
+	}
+	limit, err := domain.NewCouponUsageLimit(req.UsageLimit, req.PerUserLimit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCoupon, err)
+	}
+	var minimumSpend *domain.Money
+	if req.MinimumSpend != "" {
+		minimumSpend, err = domain.NewMoneyFromString(req.MinimumSpend, req.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("%w: minimum spend: %v", ErrInvalidCoupon, err)
+		}
+	}
+
+	itemIds, err := cu.checkCouponItems(req.ItemIds)
+	if err != nil {
+		return nil, err
+	}
+	return &couponAttributes{
+		code:         *code,
+		discount:     *discount,
+		validity:     *validity,
+		limit:        *limit,
+		minimumSpend: minimumSpend,
+		itemIds:      itemIds,
+	}, nil
+}
+
+// checkCouponItems は対象商品の ID を重複なく検証する
+func (cu *couponUsecase) checkCouponItems(values []string) ([]domain.ItemId, error) {
+	seen := make(map[string]bool, len(values))
+	itemIds := make([]*domain.ItemId, 0, len(values))
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		itemId, err := domain.NewItemId(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCoupon, err)
+		}
+		itemIds = append(itemIds, itemId)
+	}
+	if len(itemIds) == 0 {
+		return nil, nil
+	}
+
+	items, err := cu.ir.GetItemsByIDs(itemIds)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool, len(items))
+	for _, item := range items {
+		found[item.ItemId()] = true
+	}
+	result := make([]domain.ItemId, 0, len(itemIds))
+	for _, itemId := range itemIds {
+		if !found[itemId.Value()] {
+			return nil, fmt.Errorf("%w: item %s not found", ErrInvalidCoupon, itemId.Value())
+		}
+		result = append(result, *itemId)
+	}
+	return result, nil
+}
+
+func translateCouponError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrDuplicateCouponCode):
+		return fmt.Errorf("%w: %v", ErrDuplicateCoupon, err)
+	case errors.Is(err, domain.ErrCouponInUse):
+		return fmt.Errorf("%w: %v", ErrCouponInUse, err)
+	}
+	return err
+}
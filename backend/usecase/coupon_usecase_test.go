@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockCouponRepository struct {
+	mock.Mock
+}
+
+func (m *MockCouponRepository) GetAllCoupons() ([]*domain.Coupon, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Coupon), args.Error(1)
+}
+
+func (m *MockCouponRepository) GetCouponByID(couponId string) (*domain.Coupon, error) {
+	args := m.Called(couponId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Coupon), args.Error(1)
+}
+
+func (m *MockCouponRepository) GetCouponByCode(code *domain.CouponCode) (*domain.Coupon, error) {
+	args := m.Called(code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Coupon), args.Error(1)
+}
+
+func (m *MockCouponRepository) CountRedemptions(couponId string, userId *domain.UserId) (int, error) {
+	args := m.Called(couponId, userId)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCouponRepository) CreateCoupon(coupon *domain.Coupon) (*domain.Coupon, error) {
+	args := m.Called(coupon)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Coupon), args.Error(1)
+}
+
+func (m *MockCouponRepository) UpdateCoupon(coupon *domain.Coupon) (*domain.Coupon, error) {
+	args := m.Called(coupon)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Coupon), args.Error(1)
+}
+
+func (m *MockCouponRepository) DeleteCoupon(couponId string) error {
+	args := m.Called(couponId)
+	return args.Error(0)
+}
+
+const couponTestCouponId = "f47ac10b-58cc-4372-a567-0e02b2c3d910"
+
+// createTestCoupon は perUserLimit 回まで使える percentOff% 引きのクーポンを返す
+func createTestCoupon(percentOff int, perUserLimit int, itemIds ...string) *domain.Coupon {
+	code, _ := domain.NewCouponCode("WELCOME10")
+	discount, _ := domain.NewPercentDiscount(percentOff)
+	validity, _ := domain.NewCouponValidity(nil, nil)
+	limit, _ := domain.NewCouponUsageLimit(0, perUserLimit)
+	ids := make([]domain.ItemId, 0, len(itemIds))
+	for _, itemId := range itemIds {
+		id, _ := domain.NewItemId(itemId)
+		ids = append(ids, *id)
+	}
+	return domain.RestoreCoupon(couponTestCouponId, *code, *discount, *validity, *limit, nil, ids, 3, time.Now(), time.Now())
+}
+
+func newCreateCouponRequest() request.CreateCouponRequest {
+	return request.CreateCouponRequest{Code: "welcome10", DiscountType: "percent", PercentOff: 10}
+}
+
+func TestCreateCoupon_Success(t *testing.T) {
+	mockCouponRepo := new(MockCouponRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCouponUsecase(mockCouponRepo, mockItemRepo)
+	item := createCartTestItem(5)
+	mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{*item}, nil)
+	mockCouponRepo.On("CreateCoupon", mock.MatchedBy(func(coupon *domain.Coupon) bool {
+		return coupon.Code() == "WELCOME10" &&
+			coupon.Discount().PercentOff() == 10 &&
+			len(coupon.ItemIds()) == 1 && coupon.ItemIds()[0] == cartTestItemId
+	})).Return(createTestCoupon(10, 0, cartTestItemId), nil)
+
+	req := newCreateCouponRequest()
+	req.ItemIds = []string{cartTestItemId, cartTestItemId}
+	coupon, err := uc.CreateCoupon(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, couponTestCouponId, coupon.CouponId())
+	mockCouponRepo.AssertExpectations(t)
+}
+
+func TestCreateCoupon_Invalid(t *testing.T) {
+	startsAt := time.Now()
+	endsAt := startsAt.Add(-time.Hour)
+	tests := []struct {
+		name   string
+		modify func(req *request.CreateCouponRequest)
+	}{
+		{name: "malformed code", modify: func(req *request.CreateCouponRequest) { req.Code = "no spaces" }},
+		{name: "unknown discount type", modify: func(req *request.CreateCouponRequest) { req.DiscountType = "bogo" }},
+		{name: "percent over 100", modify: func(req *request.CreateCouponRequest) { req.PercentOff = 120 }},
+		{name: "fixed without amount", modify: func(req *request.CreateCouponRequest) { req.DiscountType = "fixed" }},
+		{name: "ends before start", modify: func(req *request.CreateCouponRequest) { req.StartsAt, req.EndsAt = &startsAt, &endsAt }},
+		{name: "negative limit", modify: func(req *request.CreateCouponRequest) { req.UsageLimit = -1 }},
+		{name: "malformed minimum spend", modify: func(req *request.CreateCouponRequest) { req.MinimumSpend = "abc" }},
+		{name: "malformed item id", modify: func(req *request.CreateCouponRequest) { req.ItemIds = []string{"invalid"} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCouponRepo := new(MockCouponRepository)
+			uc := NewCouponUsecase(mockCouponRepo, new(MockItemRepository))
+
+			req := newCreateCouponRequest()
+			tt.modify(&req)
+			_, err := uc.CreateCoupon(req)
+
+			assert.True(t, errors.Is(err, ErrInvalidCoupon), err)
+			mockCouponRepo.AssertNotCalled(t, "CreateCoupon", mock.Anything)
+		})
+	}
+}
+
+func TestCreateCoupon_UnknownItem(t *testing.T) {
+	mockCouponRepo := new(MockCouponRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCouponUsecase(mockCouponRepo, mockItemRepo)
+	mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{}, nil)
+
+	req := newCreateCouponRequest()
+	req.ItemIds = []string{cartTestItemId}
+	_, err := uc.CreateCoupon(req)
+
+	assert.True(t, errors.Is(err, ErrInvalidCoupon))
+	mockCouponRepo.AssertNotCalled(t, "CreateCoupon", mock.Anything)
+}
+
+func TestCreateCoupon_DuplicateCode(t *testing.T) {
+	mockCouponRepo := new(MockCouponRepository)
+	uc := NewCouponUsecase(mockCouponRepo, new(MockItemRepository))
+	mockCouponRepo.On("CreateCoupon", mock.Anything).Return(nil, fmt.Errorf("%w: WELCOME10", domain.ErrDuplicateCouponCode))
+
+	_, err := uc.CreateCoupon(newCreateCouponRequest())
+
+	assert.True(t, errors.Is(err, ErrDuplicateCoupon))
+}
+
+func TestUpdateCoupon_KeepsUsedCount(t *testing.T) {
+	mockCouponRepo := new(MockCouponRepository)
+	uc := NewCouponUsecase(mockCouponRepo, new(MockItemRepository))
+	existing := createTestCoupon(10, 0)
+	mockCouponRepo.On("GetCouponByID", couponTestCouponId).Return(existing, nil)
+	mockCouponRepo.On("UpdateCoupon", mock.MatchedBy(func(coupon *domain.Coupon) bool {
+		return coupon.CouponId() == couponTestCouponId &&
+			coupon.Discount().Type() == domain.DiscountFixed &&
+			coupon.Discount().AmountOff().String() == "500" &&
+			coupon.UsedCount() == existing.UsedCount()
+	})).Return(existing, nil)
+
+	_, err := uc.UpdateCoupon(request.UpdateCouponRequest{CouponId: couponTestCouponId, Code: "WELCOME10", DiscountType: "fixed", AmountOff: "500"})
+
+	assert.NoError(t, err)
+	mockCouponRepo.AssertExpectations(t)
+}
+
+func TestUpdateCoupon_NotFound(t *testing.T) {
+	mockCouponRepo := new(MockCouponRepository)
+	uc := NewCouponUsecase(mockCouponRepo, new(MockItemRepository))
+	mockCouponRepo.On("GetCouponByID", couponTestCouponId).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := uc.UpdateCoupon(request.UpdateCouponRequest{CouponId: couponTestCouponId, Code: "WELCOME10", DiscountType: "percent", PercentOff: 10})
+
+	assert.True(t, errors.Is(err, ErrCouponNotFound))
+	mockCouponRepo.AssertNotCalled(t, "UpdateCoupon", mock.Anything)
+}
+
+func TestDeleteCoupon_InUse(t *testing.T) {
+	mockCouponRepo := new(MockCouponRepository)
+	uc := NewCouponUsecase(mockCouponRepo, new(MockItemRepository))
+	mockCouponRepo.On("GetCouponByID", couponTestCouponId).Return(createTestCoupon(10, 0), nil)
+	mockCouponRepo.On("DeleteCoupon", couponTestCouponId).Return(fmt.Errorf("%w: 3 redemptions", domain.ErrCouponInUse))
+
+	err := uc.DeleteCoupon(couponTestCouponId)
+
+	assert.True(t, errors.Is(err, ErrCouponInUse))
+}
+
+func newValidateCouponRequest() request.ValidateCouponRequest {
+	return request.ValidateCouponRequest{
+		UserId: orderTestUserId,
+		Code:   "welcome10",
+		Lines:  []request.PlaceOrderLine{{ItemId: cartTestItemId, Quantity: 4}},
+	}
+}
+
+func TestValidateCoupon_Success(t *testing.T) {
+	mockCouponRepo := new(MockCouponRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewCouponUsecase(mockCouponRepo, mockItemRepo)
+	mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{*createCartTestItem(1)}, nil)
+	mockCouponRepo.On("GetCouponByCode", mock.MatchedBy(func(code *domain.CouponCode) bool {
+		return code.Value() == "WELCOME10"
+	})).Return(createTestCoupon(10, 0), nil)
+	mockCouponRepo.On("CountRedemptions", couponTestCouponId, mock.Anything).Return(0, nil)
+
+	preview, err := uc.ValidateCoupon(newValidateCouponRequest())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2000", preview.Subtotal().String())
+	assert.Equal(t, "200", preview.Discount().String())
+	assert.Equal(t, "180", preview.Tax().String())
+	assert.Equal(t, "1980", preview.Total().String())
+}
+
+func TestValidateCoupon_Errors(t *testing.T) {
+	t.Run("unknown code", func(t *testing.T) {
+		mockCouponRepo := new(MockCouponRepository)
+		mockItemRepo := new(MockItemRepository)
+		uc := NewCouponUsecase(mockCouponRepo, mockItemRepo)
+		mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{*createCartTestItem(1)}, nil)
+		mockCouponRepo.On("GetCouponByCode", mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := uc.ValidateCoupon(newValidateCouponRequest())
+
+		assert.True(t, errors.Is(err, ErrCouponNotFound))
+	})
+
+	t.Run("per-user limit reached", func(t *testing.T) {
+		mockCouponRepo := new(MockCouponRepository)
+		mockItemRepo := new(MockItemRepository)
+		uc := NewCouponUsecase(mockCouponRepo, mockItemRepo)
+		mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{*createCartTestItem(1)}, nil)
+		mockCouponRepo.On("GetCouponByCode", mock.Anything).Return(createTestCoupon(10, 1), nil)
+		mockCouponRepo.On("CountRedemptions", couponTestCouponId, mock.Anything).Return(1, nil)
+
+		_, err := uc.ValidateCoupon(newValidateCouponRequest())
+
+		assert.True(t, errors.Is(err, ErrCouponNotApplicable))
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		mockCouponRepo := new(MockCouponRepository)
+		mockItemRepo := new(MockItemRepository)
+		uc := NewCouponUsecase(mockCouponRepo, mockItemRepo)
+		mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{}, nil)
+
+		_, err := uc.ValidateCoupon(newValidateCouponRequest())
+
+		assert.True(t, errors.Is(err, ErrItemNotFound))
+		mockCouponRepo.AssertNotCalled(t, "GetCouponByCode", mock.Anything)
+	})
+}
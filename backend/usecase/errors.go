@@ -0,0 +1,110 @@
+package usecase
+
+import "errors"
+
+var (
+	// ErrForbidden is returned when the requester is neither the owner of the resource nor an administrator.
+	ErrForbidden = errors.New("you do not have permission to access this item")
+	// ErrInvalidQuery is returned when list parameters such as limit or cursor cannot be interpreted.
+	ErrInvalidQuery = errors.New("invalid query parameter")
+	// ErrInvalidPrice is returned when a price is not a non-negative decimal in a supported currency.
+	ErrInvalidPrice = errors.New("invalid price")
+	// ErrItemNotFound is returned when the target item does not exist.
+	ErrItemNotFound = errors.New("item not found")
+	// ErrInvalidItemId is returned when an item ID is not a valid UUID.
+	ErrInvalidItemId = errors.New("invalid item id")
+	// ErrInvalidStockMovement is returned when a stock movement has an unknown type, a zero delta or a delta in the wrong direction.
+	ErrInvalidStockMovement = errors.New("invalid stock movement")
+	// ErrInsufficientStock is returned when a movement would take on-hand stock below zero or below the reserved quantity.
+	ErrInsufficientStock = errors.New("insufficient stock")
+	// ErrVariantNotFound is returned when the variant does not exist or belongs to another item.
+	ErrVariantNotFound = errors.New("variant not found")
+	// ErrInvalidVariant is returned when a variant has a malformed SKU code, options, stock or price override.
+	ErrInvalidVariant = errors.New("invalid variant")
+	// ErrDuplicateVariant is returned when the item already has a variant with the same option combination or the SKU code is taken.
+	ErrDuplicateVariant = errors.New("duplicate variant")
+	// ErrCategoryNotFound is returned when the target category does not exist.
+	ErrCategoryNotFound = errors.New("category not found")
+	// ErrInvalidCategory is returned when a category has a malformed name or slug, or its parent would create a cycle.
+	ErrInvalidCategory = errors.New("invalid category")
+	// ErrDuplicateCategory is returned when the category slug is already taken.
+	ErrDuplicateCategory = errors.New("duplicate category")
+	// ErrCategoryInUse is returned when deleting a category that still has items or child categories.
+	ErrCategoryInUse = errors.New("category in use")
+	// ErrTagNotFound is returned when the target tag does not exist.
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrInvalidTag is returned when a tag name is empty, too long or contains a comma.
+	ErrInvalidTag = errors.New("invalid tag")
+	// ErrImageNotFound is returned when the image does not exist or belongs to another item.
+	ErrImageNotFound = errors.New("image not found")
+	// ErrInvalidImage is returned when an upload is not a supported image, cannot be decoded or is too large.
+	ErrInvalidImage = errors.New("invalid image")
+	// ErrTooManyImages is returned when the item already has the maximum number of images.
+	ErrTooManyImages = errors.New("too many images")
+	// ErrCartLineNotFound is returned when the cart line does not exist in the user's cart.
+	ErrCartLineNotFound = errors.New("cart line not found")
+	// ErrInvalidCartItem is returned when a cart line has an invalid quantity or variant, or the cart is full.
+	ErrInvalidCartItem = errors.New("invalid cart item")
+	// ErrInvalidGuestCartToken is returned when a guest cart cookie has a bad signature, has expired or is not a guest cart token.
+	ErrInvalidGuestCartToken = errors.New("invalid guest cart token")
+	// ErrOrderNotFound is returned when the order does not exist or was placed by another user.
+	ErrOrderNotFound = errors.New("order not found")
+	// ErrInvalidOrder is returned when an order has no lines, too many or duplicate lines, an invalid quantity or variant, or mixed currencies.
+	ErrInvalidOrder = errors.New("invalid order")
+	// ErrInvalidOrderStatus is returned when a transition targets an unknown order status.
+	ErrInvalidOrderStatus = errors.New("invalid order status")
+	// ErrIllegalOrderTransition is returned when the order cannot move from its current status to the requested one, such as cancelling after shipment.
+	ErrIllegalOrderTransition = errors.New("illegal order transition")
+	// ErrPaymentNotFound is returned when the order has no payment or the webhook refers to an unknown payment.
+	ErrPaymentNotFound = errors.New("payment not found")
+	// ErrOrderNotPayable is returned when the order is not awaiting payment or already has a payment in progress or captured.
+	ErrOrderNotPayable = errors.New("order is not payable")
+	// ErrPaymentDeclined is returned when the payment provider declines the payment.
+	ErrPaymentDeclined = errors.New("payment declined")
+	// ErrInvalidWebhook is returned when a webhook has a bad signature or a malformed payload.
+	ErrInvalidWebhook = errors.New("invalid webhook")
+	// ErrPaymentNotRefundable is returned when refunding an order whose latest payment has not been captured.
+	ErrPaymentNotRefundable = errors.New("payment not refundable")
+	// ErrCouponNotFound is returned when no coupon has the given ID or code.
+	ErrCouponNotFound = errors.New("coupon not found")
+	// ErrInvalidCoupon is returned when a coupon has a malformed code, discount, validity window, usage limit or minimum spend, or targets an unknown item.
+	ErrInvalidCoupon = errors.New("invalid coupon")
+	// ErrDuplicateCoupon is returned when the coupon code is already taken.
+	ErrDuplicateCoupon = errors.New("duplicate coupon")
+	// ErrCouponInUse is returned when deleting a coupon that has already been redeemed.
+	ErrCouponInUse = errors.New("coupon in use")
+	// ErrCouponNotApplicable is returned when a coupon is outside its validity window, has reached a usage limit, or the order does not meet its conditions.
+	ErrCouponNotApplicable = errors.New("coupon not applicable")
+	// ErrShippingAddressNotFound is returned when the shipping address does not exist or belongs to another user.
+	ErrShippingAddressNotFound = errors.New("shipping address not found")
+	// ErrInvalidShippingAddress is returned when a shipping address has a malformed postal code, an unknown prefecture, a missing or too long field, or an invalid phone number.
+	ErrInvalidShippingAddress = errors.New("invalid shipping address")
+	// ErrInvalidShippingRate is returned when a rate table has an unknown or duplicate prefecture, a malformed fee or threshold, or mixed currencies.
+	ErrInvalidShippingRate = errors.New("invalid shipping rate")
+	// ErrShippingUnavailable is returned when no shipping rate is set for the destination prefecture.
+	ErrShippingUnavailable = errors.New("shipping unavailable")
+	// ErrReceiptNotIssuable is returned when requesting a receipt for an order that has not been paid, or was cancelled or refunded before a receipt was issued.
+	ErrReceiptNotIssuable = errors.New("receipt not issuable")
+	// ErrItemInStock is returned when subscribing to a back-in-stock notification for an item that is still available.
+	ErrItemInStock = errors.New("item in stock")
+	// ErrReviewNotFound is returned when the review does not exist, belongs to another item, or was written by another user.
+	ErrReviewNotFound = errors.New("review not found")
+	// ErrInvalidReview is returned when a review has a rating outside 1-5, or an empty or too long title or body.
+	ErrInvalidReview = errors.New("invalid review")
+	// ErrDuplicateReview is returned when the user has already reviewed the item.
+	ErrDuplicateReview = errors.New("duplicate review")
+	// ErrProjectNotFound is returned when the knitting project does not exist or belongs to another user.
+	ErrProjectNotFound = errors.New("project not found")
+	// ErrInvalidProject is returned when a project has an empty or too long name, a progress outside 0-100, a negative row count, or too long notes.
+	ErrInvalidProject = errors.New("invalid project")
+	// ErrPatternNotFound is returned when the knitting pattern does not exist.
+	ErrPatternNotFound = errors.New("pattern not found")
+	// ErrInvalidPattern is returned when a pattern has an unknown difficulty or yarn weight, an invalid gauge or needle size, or missing, duplicate or invalid sizes.
+	ErrInvalidPattern = errors.New("invalid pattern")
+	// ErrInvalidPatternSize is returned when estimating skeins for a size the pattern does not have.
+	ErrInvalidPatternSize = errors.New("invalid pattern size")
+	// ErrInvalidYarn is returned when yarn attributes have an unknown weight or fiber, a non-positive yardage per skein, fiber percentages that do not total 100, or an invalid needle range or gauge.
+	ErrInvalidYarn = errors.New("invalid yarn")
+	// ErrInvalidGaugeCalculation is returned when a gauge calculation has an unknown unit, non-positive or out-of-range swatch or target measurements, or only one of the pattern stitches and rows.
+	ErrInvalidGaugeCalculation = errors.New("invalid gauge calculation")
+)
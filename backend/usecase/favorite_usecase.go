@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+)
+
+type IFavoriteUsecase interface {
+	GetFavorites(userId string) ([]*domain.Favorite, error)
+	GetFavoritedItemIds(userId string, itemIds []string) (map[string]bool, error)
+	AddFavorite(userId string, itemId string) error
+	RemoveFavorite(userId string, itemId string) error
+}
+
+type favoriteUsecase struct {
+	fr repository.IFavoriteRepository
+}
+
+func NewFavoriteUsecase(fr repository.IFavoriteRepository) IFavoriteUsecase {
+	return &favoriteUsecase{fr}
+}
+
+// GetFavorites はお気に入りを登録の新しい順に返す。論理削除した商品は含めない
+func (fu *favoriteUsecase) GetFavorites(userId string) ([]*domain.Favorite, error) {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return nil, err
+	}
+	return fu.fr.GetFavorites(id)
+}
+
+// GetFavoritedItemIds は商品一覧などに表示する商品のうち、ユーザーがお気に入りに登録している商品の ID を返す
+func (fu *favoriteUsecase) GetFavoritedItemIds(userId string, itemIds []string) (map[string]bool, error) {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return nil, err
+	}
+	return fu.fr.GetFavoritedItemIDs(id, itemIds)
+}
+
+// AddFavorite は商品をお気に入りに登録する。登録済みでもエラーにしない
+func (fu *favoriteUsecase) AddFavorite(userId string, itemId string) error {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return err
+	}
+	item, err := domain.NewItemId(itemId)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	if err := fu.fr.AddFavorite(id, item); err != nil {
+		return fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	return nil
+}
+
+// RemoveFavorite はお気に入りを解除する。登録していなくてもエラーにしない
+func (fu *favoriteUsecase) RemoveFavorite(userId string, itemId string) error {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return err
+	}
+	item, err := domain.NewItemId(itemId)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	return fu.fr.RemoveFavorite(id, item)
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockFavoriteRepository struct {
+	mock.Mock
+}
+
+func (m *MockFavoriteRepository) GetFavorites(userId *domain.UserId) ([]*domain.Favorite, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Favorite), args.Error(1)
+}
+
+func (m *MockFavoriteRepository) GetFavoritedItemIDs(userId *domain.UserId, itemIds []string) (map[string]bool, error) {
+	args := m.Called(userId, itemIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
+func (m *MockFavoriteRepository) AddFavorite(userId *domain.UserId, itemId *domain.ItemId) error {
+	args := m.Called(userId, itemId)
+	return args.Error(0)
+}
+
+func (m *MockFavoriteRepository) RemoveFavorite(userId *domain.UserId, itemId *domain.ItemId) error {
+	args := m.Called(userId, itemId)
+	return args.Error(0)
+}
+
+func TestFavoriteUsecase_GetFavorites(t *testing.T) {
+	mockRepo := new(MockFavoriteRepository)
+	fu := NewFavoriteUsecase(mockRepo)
+
+	userId, _ := domain.NewUserId(orderTestUserId)
+	favorites := []*domain.Favorite{domain.RestoreFavorite(*userId, *createCartTestItem(3), time.Now())}
+	mockRepo.On("GetFavorites", userId).Return(favorites, nil)
+
+	result, err := fu.GetFavorites(orderTestUserId)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, cartTestItemId, result[0].Item().ItemId())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestFavoriteUsecase_GetFavoritedItemIds(t *testing.T) {
+	mockRepo := new(MockFavoriteRepository)
+	fu := NewFavoriteUsecase(mockRepo)
+
+	userId, _ := domain.NewUserId(orderTestUserId)
+	itemIds := []string{orderTestItemId, "f47ac10b-58cc-4372-a567-0e02b2c3d999"}
+	mockRepo.On("GetFavoritedItemIDs", userId, itemIds).Return(map[string]bool{orderTestItemId: true}, nil)
+
+	result, err := fu.GetFavoritedItemIds(orderTestUserId, itemIds)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{orderTestItemId: true}, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestFavoriteUsecase_AddFavorite(t *testing.T) {
+	userId, _ := domain.NewUserId(orderTestUserId)
+	itemId, _ := domain.NewItemId(orderTestItemId)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockFavoriteRepository)
+		fu := NewFavoriteUsecase(mockRepo)
+		mockRepo.On("AddFavorite", userId, itemId).Return(nil)
+
+		err := fu.AddFavorite(orderTestUserId, orderTestItemId)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Item Not Found", func(t *testing.T) {
+		mockRepo := new(MockFavoriteRepository)
+		fu := NewFavoriteUsecase(mockRepo)
+		mockRepo.On("AddFavorite", userId, itemId).Return(gorm.ErrRecordNotFound)
+
+		err := fu.AddFavorite(orderTestUserId, orderTestItemId)
+
+		assert.True(t, errors.Is(err, ErrItemNotFound))
+	})
+
+	t.Run("Invalid Item Id", func(t *testing.T) {
+		mockRepo := new(MockFavoriteRepository)
+		fu := NewFavoriteUsecase(mockRepo)
+
+		err := fu.AddFavorite(orderTestUserId, "not-a-uuid")
+
+		assert.True(t, errors.Is(err, ErrItemNotFound))
+		mockRepo.AssertNotCalled(t, "AddFavorite", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFavoriteUsecase_RemoveFavorite(t *testing.T) {
+	mockRepo := new(MockFavoriteRepository)
+	fu := NewFavoriteUsecase(mockRepo)
+
+	userId, _ := domain.NewUserId(orderTestUserId)
+	itemId, _ := domain.NewItemId(orderTestItemId)
+	mockRepo.On("RemoveFavorite", userId, itemId).Return(nil)
+
+	err := fu.RemoveFavorite(orderTestUserId, orderTestItemId)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IGaugeUsecase interface {
+	CalculateGauge(req request.CalculateGaugeRequest) (*domain.GaugeCalculation, error)
+}
+
+// gaugeUsecase は保存せずに計算だけを行うため、リポジトリを持たない
+type gaugeUsecase struct{}
+
+func NewGaugeUsecase() IGaugeUsecase {
+	return &gaugeUsecase{}
+}
+
+// CalculateGauge は試し編みのゲージで目標の寸法を編むための作り目の数と段数を計算する
+// 編み図のゲージを指定した場合は、編み図どおりの目数・段数とゲージの差も求める
+func (gu *gaugeUsecase) CalculateGauge(req request.CalculateGaugeRequest) (*domain.GaugeCalculation, error) {
+	unit, err := domain.NewLengthUnit(req.Unit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGaugeCalculation, err)
+	}
+	swatch, err := domain.MeasureSwatch(req.SwatchStitches, req.SwatchRows, req.SwatchWidth, req.SwatchHeight, unit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGaugeCalculation, err)
+	}
+
+	var pattern *domain.KnittingGauge
+	if req.PatternStitches != 0 || req.PatternRows != 0 {
+		pattern, err = domain.NewSpanGauge(req.PatternStitches, req.PatternRows, unit)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidGaugeCalculation, err)
+		}
+	}
+
+	calculation, err := domain.CalculateGauge(*swatch, req.TargetWidth, req.TargetHeight, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGaugeCalculation, err)
+	}
+	return calculation, nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestGaugeRequest() request.CalculateGaugeRequest {
+	return request.CalculateGaugeRequest{
+		Unit:            "in",
+		SwatchStitches:  27,
+		SwatchRows:      36,
+		SwatchWidth:     6,
+		SwatchHeight:    6,
+		TargetWidth:     20,
+		TargetHeight:    24,
+		PatternStitches: 20,
+		PatternRows:     28,
+	}
+}
+
+func TestGaugeUsecase_CalculateGauge(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		calculation, err := NewGaugeUsecase().CalculateGauge(newTestGaugeRequest())
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.LengthUnitInch, calculation.Swatch().Unit())
+		assert.Equal(t, 18.0, calculation.Swatch().SpanStitches())
+		assert.Equal(t, 90, calculation.CastOnStitches())
+		assert.Equal(t, 144, calculation.Rows())
+		stitches, rows, ok := calculation.PatternCounts()
+		assert.True(t, ok)
+		assert.Equal(t, 100, stitches)
+		assert.Equal(t, 168, rows)
+		stitchDifference, rowDifference, ok := calculation.Difference()
+		assert.True(t, ok)
+		assert.Equal(t, -10.0, stitchDifference)
+		assert.Equal(t, -14.3, rowDifference)
+	})
+
+	t.Run("Without Pattern Gauge", func(t *testing.T) {
+		req := newTestGaugeRequest()
+		req.PatternStitches, req.PatternRows = 0, 0
+
+		calculation, err := NewGaugeUsecase().CalculateGauge(req)
+
+		assert.NoError(t, err)
+		assert.Nil(t, calculation.Pattern())
+	})
+
+	tests := []struct {
+		name   string
+		modify func(req *request.CalculateGaugeRequest)
+	}{
+		{"Unknown Unit", func(req *request.CalculateGaugeRequest) { req.Unit = "mm" }},
+		{"Zero Swatch Stitches", func(req *request.CalculateGaugeRequest) { req.SwatchStitches = 0 }},
+		{"Negative Swatch Height", func(req *request.CalculateGaugeRequest) { req.SwatchHeight = -6 }},
+		{"Zero Target Width", func(req *request.CalculateGaugeRequest) { req.TargetWidth = 0 }},
+		{"Pattern Stitches Without Rows", func(req *request.CalculateGaugeRequest) { req.PatternRows = 0 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newTestGaugeRequest()
+			tt.modify(&req)
+
+			_, err := NewGaugeUsecase().CalculateGauge(req)
+
+			assert.True(t, errors.Is(err, ErrInvalidGaugeCalculation))
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"log"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+const (
+	// MaxImageBytes はアップロードを受け付ける画像ファイルのサイズの上限
+	MaxImageBytes = 10 << 20
+	// ThumbnailSize はサムネイルの長辺のピクセル数
+	ThumbnailSize = 320
+)
+
+// ImageStorage は画像ファイルを保存し、公開 URL を返す
+type ImageStorage interface {
+	Put(key string, body []byte, contentType string) (string, error)
+	Delete(key string) error
+}
+
+// ImageProcessor は画像の形式の判定とデコード、サムネイルの作成を行う
+type ImageProcessor interface {
+	DetectContentType(data []byte) string
+	Decode(data []byte) (image.Image, error)
+	// Thumbnail は長辺が maxSize 以下になるよう縮小し、contentType の形式でエンコードする
+	Thumbnail(img image.Image, maxSize int, contentType string) ([]byte, error)
+}
+
+type IItemImageUsecase interface {
+	UploadImage(req request.UploadItemImageRequest) (*domain.ItemImage, error)
+	DeleteImage(itemId string, imageId string) error
+	ReorderImages(req request.ReorderItemImagesRequest) (*domain.Item, error)
+}
+
+type itemImageUsecase struct {
+	ir  repository.IItemRepository
+	iir repository.IItemImageRepository
+	st  ImageStorage
+	ip  ImageProcessor
+}
+
+func NewItemImageUsecase(ir repository.IItemRepository, iir repository.IItemImageRepository, st ImageStorage, ip ImageProcessor) IItemImageUsecase {
+	return &itemImageUsecase{ir, iir, st, ip}
+}
+
+// UploadImage は画像の形式を内容から判定し、元画像とサムネイルをストレージに保存してから商品の末尾に登録する
+func (iiu *itemImageUsecase) UploadImage(req request.UploadItemImageRequest) (*domain.ItemImage, error) {
+	item, err := iiu.findItem(req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	if len(item.Images()) >= domain.MaxItemImages {
+		return nil, fmt.Errorf("%w: up to %d images per item", ErrTooManyImages, domain.MaxItemImages)
+	}
+	if len(req.Data) > MaxImageBytes {
+		return nil, fmt.Errorf("%w: file must be %d bytes or less", ErrInvalidImage, MaxImageBytes)
+	}
+
+	contentType, err := domain.NewImageContentType(iiu.ip.DetectContentType(req.Data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	decoded, err := iiu.ip.Decode(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	thumbnail, err := iiu.ip.Thumbnail(decoded, ThumbnailSize, contentType.ThumbnailType().Value())
+	if err != nil {
+		return nil, err
+	}
+
+	itemId, err := domain.NewItemId(item.ItemId())
+	if err != nil {
+		return nil, err
+	}
+	image, err := domain.NewItemImage(*itemId, *contentType, decoded.Bounds().Dx(), decoded.Bounds().Dy())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+
+	originalURL, err := iiu.st.Put(image.OriginalKey(), req.Data, contentType.Value())
+	if err != nil {
+		return nil, err
+	}
+	thumbnailURL, err := iiu.st.Put(image.ThumbnailKey(), thumbnail, contentType.ThumbnailType().Value())
+	if err != nil {
+		iiu.deleteFiles(image.OriginalKey())
+		return nil, err
+	}
+	image = image.WithStoredFiles(
+		domain.StoredImage{Key: image.OriginalKey(), URL: originalURL},
+		domain.StoredImage{Key: image.ThumbnailKey(), URL: thumbnailURL},
+	)
+
+	created, err := iiu.iir.CreateImage(image)
+	if err != nil {
+		iiu.deleteFiles(image.OriginalKey(), image.ThumbnailKey())
+		if errors.Is(err, domain.ErrTooManyItemImages) {
+			return nil, fmt.Errorf("%w: %v", ErrTooManyImages, err)
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// DeleteImage は画像の登録を削除してからファイルを消す。ファイルの削除に失敗しても登録の削除は取り消さない
+func (iiu *itemImageUsecase) DeleteImage(itemId string, imageId string) error {
+	item, err := iiu.findItem(itemId)
+	if err != nil {
+		return err
+	}
+	image := item.Images().FindByID(imageId)
+	if image == nil {
+		return fmt.Errorf("%w: %s", ErrImageNotFound, imageId)
+	}
+
+	itemIdDomain, err := domain.NewItemId(item.ItemId())
+	if err != nil {
+		return err
+	}
+	if err := iiu.iir.DeleteImage(itemIdDomain, image.ImageId()); err != nil {
+		return err
+	}
+	iiu.deleteFiles(image.Original().Key, image.Thumbnail().Key)
+	return nil
+}
+
+func (iiu *itemImageUsecase) ReorderImages(req request.ReorderItemImagesRequest) (*domain.Item, error) {
+	item, err := iiu.findItem(req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	if err := item.Images().CheckOrder(req.ImageIds); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+
+	itemId, err := domain.NewItemId(item.ItemId())
+	if err != nil {
+		return nil, err
+	}
+	if err := iiu.iir.ReorderImages(itemId, req.ImageIds); err != nil {
+		return nil, err
+	}
+	return iiu.findItem(req.ItemId)
+}
+
+func (iiu *itemImageUsecase) findItem(itemId string) (*domain.Item, error) {
+	itemIdDomain, err := domain.NewItemId(itemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	item, err := iiu.ir.GetItemByID(itemIdDomain)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	return item, nil
+}
+
+// deleteFiles は不要になったファイルを削除する。失敗しても呼び出し元の処理は続けるためログだけ残す
+func (iiu *itemImageUsecase) deleteFiles(keys ...string) {
+	for _, key := range keys {
+		if err := iiu.st.Delete(key); err != nil {
+			log.Printf("failed to delete stored image %s: %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/infrastructure/imaging"
+	"github.com/posiposi/project/backend/infrastructure/storage"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockItemImageRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemImageRepository) CreateImage(image *domain.ItemImage) (*domain.ItemImage, error) {
+	args := m.Called(image)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemImage), args.Error(1)
+}
+
+func (m *MockItemImageRepository) DeleteImage(itemId *domain.ItemId, imageId string) error {
+	args := m.Called(itemId, imageId)
+	return args.Error(0)
+}
+
+func (m *MockItemImageRepository) ReorderImages(itemId *domain.ItemId, imageIds []string) error {
+	args := m.Called(itemId, imageIds)
+	return args.Error(0)
+}
+
+func encodeTestPNG(t *testing.T, width int, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// createImageTestItem は count 枚の画像を持つ商品を返す
+func createImageTestItem(count int) *domain.Item {
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	contentType, _ := domain.NewImageContentType("image/png")
+	images := domain.ItemImages{}
+	for i := 1; i <= count; i++ {
+		imageId := fmt.Sprintf("img-%d", i)
+		images = append(images, *domain.RestoreItemImage(imageId, *itemId, i, *contentType, 10, 10,
+			domain.StoredImage{Key: "items/" + stockTestItemId + "/" + imageId + ".png"},
+			domain.StoredImage{Key: "items/" + stockTestItemId + "/" + imageId + "_thumb.png"},
+			time.Now()))
+	}
+	return createStockTestItem(0).WithImages(images)
+}
+
+func TestUploadImage_Success(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockImageRepo := new(MockItemImageRepository)
+	dir := t.TempDir()
+	uc := NewItemImageUsecase(mockItemRepo, mockImageRepo, storage.NewLocalStorage(dir, "https://cdn.example.com"), imaging.NewProcessor())
+
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(createImageTestItem(0), nil)
+	var stored *domain.ItemImage
+	mockImageRepo.On("CreateImage", mock.MatchedBy(func(image *domain.ItemImage) bool {
+		stored = image
+		return image.ContentType() == "image/png" && image.Width() == 800 && image.Height() == 400
+	})).Return(createImageTestItem(1).Images().FindByID("img-1"), nil)
+
+	_, err := uc.UploadImage(request.UploadItemImageRequest{ItemId: stockTestItemId, Data: encodeTestPNG(t, 800, 400)})
+
+	assert.NoError(t, err)
+	mockImageRepo.AssertExpectations(t)
+	assert.Equal(t, "https://cdn.example.com/"+stored.OriginalKey(), stored.Original().URL)
+	thumbnail, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(stored.Thumbnail().Key)))
+	assert.NoError(t, err)
+	config, err := png.DecodeConfig(bytes.NewReader(thumbnail))
+	assert.NoError(t, err)
+	assert.Equal(t, ThumbnailSize, config.Width)
+	assert.Equal(t, ThumbnailSize/2, config.Height)
+}
+
+func TestUploadImage_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		images  int
+		data    []byte
+		wantErr error
+	}{
+		{"not an image", 0, []byte("%PDF-1.4 not an image"), ErrInvalidImage},
+		{"unsupported format", 0, []byte("RIFF\x00\x00\x00\x00WEBPVP8 "), ErrInvalidImage},
+		{"corrupted png", 0, encodeTestPNG(t, 50, 50)[:40], ErrInvalidImage},
+		{"too many images", domain.MaxItemImages, encodeTestPNG(t, 10, 10), ErrTooManyImages},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockItemRepo := new(MockItemRepository)
+			mockImageRepo := new(MockItemImageRepository)
+			dir := t.TempDir()
+			uc := NewItemImageUsecase(mockItemRepo, mockImageRepo, storage.NewLocalStorage(dir, "https://cdn.example.com"), imaging.NewProcessor())
+
+			itemId, _ := domain.NewItemId(stockTestItemId)
+			mockItemRepo.On("GetItemByID", itemId).Return(createImageTestItem(tt.images), nil)
+
+			_, err := uc.UploadImage(request.UploadItemImageRequest{ItemId: stockTestItemId, Data: tt.data})
+
+			assert.True(t, errors.Is(err, tt.wantErr), err)
+			mockImageRepo.AssertNotCalled(t, "CreateImage", mock.Anything)
+			entries, _ := os.ReadDir(dir)
+			assert.Empty(t, entries)
+		})
+	}
+}
+
+func TestUploadImage_RepositoryFailureRemovesFiles(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockImageRepo := new(MockItemImageRepository)
+	dir := t.TempDir()
+	uc := NewItemImageUsecase(mockItemRepo, mockImageRepo, storage.NewLocalStorage(dir, "https://cdn.example.com"), imaging.NewProcessor())
+
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(createImageTestItem(0), nil)
+	mockImageRepo.On("CreateImage", mock.Anything).Return(nil, domain.ErrTooManyItemImages)
+
+	_, err := uc.UploadImage(request.UploadItemImageRequest{ItemId: stockTestItemId, Data: encodeTestPNG(t, 10, 10)})
+
+	assert.True(t, errors.Is(err, ErrTooManyImages))
+	files, _ := filepath.Glob(filepath.Join(dir, "items", stockTestItemId, "*"))
+	assert.Empty(t, files)
+}
+
+func TestDeleteImage(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockImageRepo := new(MockItemImageRepository)
+	dir := t.TempDir()
+	st := storage.NewLocalStorage(dir, "https://cdn.example.com")
+	uc := NewItemImageUsecase(mockItemRepo, mockImageRepo, st, imaging.NewProcessor())
+
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	item := createImageTestItem(2)
+	image := item.Images().FindByID("img-2")
+	_, _ = st.Put(image.Original().Key, []byte("png"), "image/png")
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+	mockImageRepo.On("DeleteImage", itemId, "img-2").Return(nil)
+
+	assert.NoError(t, uc.DeleteImage(stockTestItemId, "img-2"))
+	_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(image.Original().Key)))
+	assert.True(t, os.IsNotExist(err))
+
+	err = uc.DeleteImage(stockTestItemId, "unknown")
+	assert.True(t, errors.Is(err, ErrImageNotFound))
+}
+
+func TestReorderImages(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockImageRepo := new(MockItemImageRepository)
+	uc := NewItemImageUsecase(mockItemRepo, mockImageRepo, storage.NewLocalStorage(t.TempDir(), "https://cdn.example.com"), imaging.NewProcessor())
+
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(createImageTestItem(2), nil)
+	mockImageRepo.On("ReorderImages", itemId, []string{"img-2", "img-1"}).Return(nil)
+
+	_, err := uc.ReorderImages(request.ReorderItemImagesRequest{ItemId: stockTestItemId, ImageIds: []string{"img-2", "img-1"}})
+	assert.NoError(t, err)
+
+	_, err = uc.ReorderImages(request.ReorderItemImagesRequest{ItemId: stockTestItemId, ImageIds: []string{"img-2"}})
+	assert.True(t, errors.Is(err, ErrInvalidImage))
+	mockImageRepo.AssertNumberOfCalls(t, "ReorderImages", 1)
+}
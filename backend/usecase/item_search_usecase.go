@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IItemSearchUsecase interface {
+	SearchItems(req request.SearchItemsRequest) ([]*domain.ItemSearchHit, error)
+}
+
+type itemSearchUsecase struct {
+	is repository.IItemSearcher
+}
+
+func NewItemSearchUsecase(is repository.IItemSearcher) IItemSearchUsecase {
+	return &itemSearchUsecase{is}
+}
+
+func (isu *itemSearchUsecase) SearchItems(req request.SearchItemsRequest) ([]*domain.ItemSearchHit, error) {
+	query, err := domain.NewSearchQuery(req.Query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQuery, err)
+	}
+
+	limit, err := domain.NewPageLimit(req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQuery, err)
+	}
+
+	return isu.is.SearchItems(*query, *limit)
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func createSearchTestItems() domain.Items {
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+	var items domain.Items
+	for _, v := range []struct{ id, name, description string }{
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d401", "メリノウールの毛糸", "柔らかい毛糸です"},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d402", "コットン糸", "夏向けの毛糸"},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d403", "手編みの帽子", "ウールで編みました"},
+	} {
+		itemId, _ := domain.NewItemId(v.id)
+		itemName, _ := domain.NewItemName(v.name)
+		stock, _ := domain.NewStock(1, 0, 0)
+		description, _ := domain.NewDescription(v.description)
+		item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
+		items = append(items, *item)
+	}
+	return items
+}
+
+func TestSearchItems_RanksByRelevance(t *testing.T) {
+	uc := NewItemSearchUsecase(repository.NewInMemoryItemSearcher(createSearchTestItems()))
+
+	hits, err := uc.SearchItems(request.SearchItemsRequest{Query: "毛糸"})
+
+	assert.NoError(t, err)
+	assert.Len(t, hits, 2)
+	assert.Equal(t, "メリノウールの毛糸", hits[0].Item().ItemName())
+	assert.Equal(t, "コットン糸", hits[1].Item().ItemName())
+	assert.Greater(t, hits[0].Score(), hits[1].Score())
+	assert.Equal(t, "メリノウールの<mark>毛糸</mark>", hits[0].ItemNameHighlight())
+	assert.Equal(t, "", hits[1].ItemNameHighlight())
+	assert.Equal(t, "夏向けの<mark>毛糸</mark>", hits[1].DescriptionHighlight())
+}
+
+func TestSearchItems_AppliesLimit(t *testing.T) {
+	uc := NewItemSearchUsecase(repository.NewInMemoryItemSearcher(createSearchTestItems()))
+
+	hits, err := uc.SearchItems(request.SearchItemsRequest{Query: "毛糸 ウール", Limit: 1})
+
+	assert.NoError(t, err)
+	assert.Len(t, hits, 1)
+}
+
+func TestSearchItems_InvalidQuery(t *testing.T) {
+	uc := NewItemSearchUsecase(repository.NewInMemoryItemSearcher(createSearchTestItems()))
+
+	_, err := uc.SearchItems(request.SearchItemsRequest{Query: "  "})
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+
+	_, err = uc.SearchItems(request.SearchItemsRequest{Query: "毛糸", Limit: domain.MaxPageLimit + 1})
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+}
@@ -2,38 +2,142 @@
 package usecase
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/posiposi/project/backend/domain"
 	"github.com/posiposi/project/backend/repository"
 	"github.com/posiposi/project/backend/usecase/request"
+	"gorm.io/gorm"
 )
 
 type IItemUsecase interface {
-	GetAllItems() ([]*domain.Item, error)
+	GetAllItems(req request.ListItemsRequest) (*domain.ItemPage, error)
 	GetItemByID(itemId string) (*domain.Item, error)
 	CreateItem(req request.CreateItemRequest) (*domain.Item, error)
 	UpdateItem(req request.UpdateItemRequest) (*domain.Item, error)
 	DeleteItem(itemId string) error
+	GetOwnItemByID(itemId string, userId string) (*domain.Item, error)
+	UpdateOwnItem(req request.UpdateItemRequest, userId string) (*domain.Item, error)
+	DeleteOwnItem(itemId string, userId string) error
 }
 
 type itemUsecase struct {
 	ir repository.IItemRepository
+	ur repository.IUserRepository
 }
 
-func NewItemUsecase(ir repository.IItemRepository) IItemUsecase {
-	return &itemUsecase{ir}
+func NewItemUsecase(ir repository.IItemRepository, ur repository.IUserRepository) IItemUsecase {
+	return &itemUsecase{ir, ur}
 }
 
-func (iu *itemUsecase) GetAllItems() ([]*domain.Item, error) {
-	items, err := iu.ir.GetAllItems()
+func (iu *itemUsecase) GetAllItems(req request.ListItemsRequest) (*domain.ItemPage, error) {
+	query, err := newItemListQuery(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQuery, err)
+	}
+
+	page, err := iu.ir.GetAllItems(*query)
 	if err != nil {
 		return nil, err
 	}
-	result := make([]*domain.Item, len(items))
-	for i, item := range items {
-		itemCopy := item
-		result[i] = &itemCopy
+	if !req.WithTotal {
+		return page, nil
+	}
+
+	total, err := iu.ir.CountItems(query.Filter)
+	if err != nil {
+		return nil, err
 	}
-	return result, nil
+	return domain.NewItemPage(page.Items(), page.NextCursor(), &total), nil
+}
+
+// newItemListQuery は一覧取得リクエストをドメインの値で検証し、リポジトリ用の検索条件に変換する
+func newItemListQuery(req request.ListItemsRequest) (*repository.ItemListQuery, error) {
+	limit, err := domain.NewPageLimit(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]domain.ItemSortKey, 0, len(req.Sort))
+	for _, field := range req.Sort {
+		key, err := domain.NewItemSortKey(field.Field, field.Descending)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+	sort, err := domain.NewItemSort(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor *domain.ItemCursor
+	if req.Cursor != "" {
+		cursor, err = domain.DecodeItemCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if !cursor.MatchesSort(*sort) {
+			return nil, fmt.Errorf("cursor was issued for a different sort order")
+		}
+	}
+
+	filter := repository.ItemFilter{
+		Stock:         req.Filter.Stock,
+		CreatedAfter:  req.Filter.CreatedAfter,
+		CreatedBefore: req.Filter.CreatedBefore,
+		NameContains:  req.Filter.NameContains,
+	}
+	if req.Filter.UserId != "" {
+		userId, err := domain.NewUserId(req.Filter.UserId)
+		if err != nil {
+			return nil, err
+		}
+		filter.UserId = userId
+	}
+	if req.Filter.Category != "" {
+		category, err := domain.NewCategorySlug(req.Filter.Category)
+		if err != nil {
+			return nil, err
+		}
+		filter.Category = category
+	}
+	if req.Filter.Tag != "" {
+		tag, err := domain.NewTagName(req.Filter.Tag)
+		if err != nil {
+			return nil, err
+		}
+		filter.Tag = tag
+	}
+	if req.Filter.YarnWeight != "" {
+		filter.YarnWeight, err = domain.NewYarnWeight(req.Filter.YarnWeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.Filter.Fiber != "" {
+		filter.Fiber, err = domain.NewFiber(req.Filter.Fiber)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.Filter.NeedleSize != nil {
+		filter.NeedleSize, err = domain.NewNeedleSize(*req.Filter.NeedleSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && !filter.CreatedAfter.Before(*filter.CreatedBefore) {
+		return nil, fmt.Errorf("created_after must be earlier than created_before")
+	}
+
+	return &repository.ItemListQuery{
+		Limit:  *limit,
+		Cursor: cursor,
+		Sort:   *sort,
+		Filter: filter,
+	}, nil
 }
 
 func (iu *itemUsecase) CreateItem(req request.CreateItemRequest) (*domain.Item, error) {
@@ -47,7 +151,7 @@ func (iu *itemUsecase) CreateItem(req request.CreateItemRequest) (*domain.Item,
 		return nil, err
 	}
 	
-	stock, err := domain.NewStock(req.Stock)
+	stock, err := domain.NewStock(req.OnHandQuantity, 0, req.LowStockThreshold)
 	if err != nil {
 		return nil, err
 	}
@@ -57,12 +161,26 @@ func (iu *itemUsecase) CreateItem(req request.CreateItemRequest) (*domain.Item,
 		return nil, err
 	}
 	
-	domainItem, err := domain.NewItem(nil, *userId, *itemName, *stock, *description)
+	amount := req.Price
+	if amount == "" {
+		amount = "0"
+	}
+	price, err := domain.NewMoneyFromString(amount, req.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrice, err)
+	}
+
+	taxRate, err := domain.NewTaxRate(req.TaxRate)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrice, err)
+	}
+	
+	domainItem, err := domain.NewItem(nil, *userId, *itemName, *stock, *description, *price)
 	if err != nil {
 		return nil, err
 	}
 	
-	createdItem, err := iu.ir.CreateItem(domainItem)
+	createdItem, err := iu.ir.CreateItem(domainItem.WithTaxRate(taxRate))
 	if err != nil {
 		return nil, err
 	}
@@ -88,17 +206,18 @@ func (iu *itemUsecase) UpdateItem(req request.UpdateItemRequest) (*domain.Item,
 		return nil, err
 	}
 	
-	stock, err := domain.NewStock(req.Stock)
+	description, err := domain.NewDescription(req.Description)
 	if err != nil {
 		return nil, err
 	}
 	
-	description, err := domain.NewDescription(req.Description)
+	existingItem, err := iu.ir.GetItemByID(itemId)
 	if err != nil {
 		return nil, err
 	}
 	
-	existingItem, err := iu.ir.GetItemByID(itemId)
+	// 実在庫数と引当済み数量は既存の値を引き継ぐ
+	stock, err := domain.NewStock(existingItem.Stock().OnHand(), existingItem.Stock().Reserved(), req.LowStockThreshold)
 	if err != nil {
 		return nil, err
 	}
@@ -108,12 +227,28 @@ func (iu *itemUsecase) UpdateItem(req request.UpdateItemRequest) (*domain.Item,
 		return nil, err
 	}
 	
-	updatedDomainItem, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description)
+	price := existingItem.Price()
+	if req.Price != "" {
+		price, err = domain.NewMoneyFromString(req.Price, req.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPrice, err)
+		}
+	}
+
+	taxRate := existingItem.TaxRate()
+	if req.TaxRate != "" {
+		taxRate, err = domain.NewTaxRate(req.TaxRate)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPrice, err)
+		}
+	}
+	
+	updatedDomainItem, err := domain.NewItem(itemId, *userId, *itemName, *stock, *description, *price)
 	if err != nil {
 		return nil, err
 	}
 	
-	return iu.ir.UpdateItem(updatedDomainItem)
+	return iu.ir.UpdateItem(updatedDomainItem.WithTaxRate(taxRate))
 }
 
 func (iu *itemUsecase) DeleteItem(itemId string) error {
@@ -123,3 +258,59 @@ func (iu *itemUsecase) DeleteItem(itemId string) error {
 	}
 	return iu.ir.DeleteItem(itemIdDomain)
 }
+
+func (iu *itemUsecase) GetOwnItemByID(itemId string, userId string) (*domain.Item, error) {
+	itemIdDomain, err := domain.NewItemId(itemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidItemId, err)
+	}
+	item, err := iu.ir.GetItemByID(itemIdDomain)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := iu.authorizeItemAccess(item, userId); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (iu *itemUsecase) UpdateOwnItem(req request.UpdateItemRequest, userId string) (*domain.Item, error) {
+	if _, err := iu.GetOwnItemByID(req.ItemId, userId); err != nil {
+		return nil, err
+	}
+	return iu.UpdateItem(req)
+}
+
+func (iu *itemUsecase) DeleteOwnItem(itemId string, userId string) error {
+	if _, err := iu.GetOwnItemByID(itemId, userId); err != nil {
+		return err
+	}
+	return iu.DeleteItem(itemId)
+}
+
+// authorizeItemAccess は出品者本人または管理者のみ商品を操作できるようにする
+func (iu *itemUsecase) authorizeItemAccess(item *domain.Item, userId string) error {
+	if item.IsOwnedBy(userId) {
+		return nil
+	}
+
+	userIdDomain, err := domain.NewUserId(userId)
+	if err != nil {
+		return err
+	}
+	user, err := iu.ur.GetUserById(userIdDomain)
+	if err != nil {
+		return err
+	}
+	adminPermission, err := domain.NewPermission("ADMIN")
+	if err != nil {
+		return err
+	}
+	if user.Role() != nil && user.Role().HasPermission(adminPermission) {
+		return nil
+	}
+	return ErrForbidden
+}
@@ -3,20 +3,31 @@ package usecase
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
 	"github.com/posiposi/project/backend/usecase/request"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 type MockItemRepository struct {
 	mock.Mock
 }
 
-func (m *MockItemRepository) GetAllItems() (domain.Items, error) {
-	args := m.Called()
-	return args.Get(0).(domain.Items), args.Error(1)
+func (m *MockItemRepository) GetAllItems(query repository.ItemListQuery) (*domain.ItemPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemPage), args.Error(1)
+}
+
+func (m *MockItemRepository) CountItems(filter repository.ItemFilter) (int64, error) {
+	args := m.Called(filter)
+	return args.Get(0).(int64), args.Error(1)
 }
 
 func (m *MockItemRepository) GetItemByID(itemId *domain.ItemId) (*domain.Item, error) {
@@ -27,6 +38,14 @@ func (m *MockItemRepository) GetItemByID(itemId *domain.ItemId) (*domain.Item, e
 	return args.Get(0).(*domain.Item), args.Error(1)
 }
 
+func (m *MockItemRepository) GetItemsByIDs(itemIds []*domain.ItemId) (domain.Items, error) {
+	args := m.Called(itemIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(domain.Items), args.Error(1)
+}
+
 func (m *MockItemRepository) CreateItem(item *domain.Item) (*domain.Item, error) {
 	args := m.Called(item)
 	if args.Get(0) == nil {
@@ -48,33 +67,181 @@ func (m *MockItemRepository) DeleteItem(itemId *domain.ItemId) error {
 	return args.Error(0)
 }
 
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) GetUserByEmail(email *domain.Email) (*domain.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.User), args.Error(1)
+}
+
+func (m *MockUserRepository) CreateUser(user *domain.User) error {
+	args := m.Called(user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) GetUserById(userId *domain.UserId) (*domain.User, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.User), args.Error(1)
+}
+
+func createTestUserWithRole(id string, roleValue string) *domain.User {
+	userId, _ := domain.NewUserId(id)
+	email, _ := domain.NewEmail("user@example.com")
+	password, _ := domain.NewPassword("password123")
+	role, _ := domain.NewRole(roleValue)
+	user, _ := domain.NewUserWithRole(userId, "Test User", email, password, role)
+	return user
+}
+
 func TestGetAllItems_ReturnsItems(t *testing.T) {
 	mockRepo := new(MockItemRepository)
-	uc := NewItemUsecase(mockRepo)
-	result := domain.Items{}
-	mockRepo.On("GetAllItems").Return(result, nil)
-	items, err := uc.GetAllItems()
-	expected := []*domain.Item{}
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
+	limit, _ := domain.NewPageLimit(0)
+	sort, _ := domain.NewItemSort(nil)
+	result := domain.NewItemPage(domain.Items{}, nil, nil)
+	mockRepo.On("GetAllItems", repository.ItemListQuery{Limit: *limit, Sort: *sort}).Return(result, nil)
+	page, err := uc.GetAllItems(request.ListItemsRequest{})
 	assert.NoError(t, err)
-	assert.Equal(t, expected, items)
+	assert.Equal(t, domain.Items{}, page.Items())
+	assert.Nil(t, page.Total())
+	mockRepo.AssertNotCalled(t, "CountItems", mock.Anything)
+}
+
+func TestGetAllItems_WithCursorAndTotal(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+	itemName, _ := domain.NewItemName("Test Item")
+	stock, _ := domain.NewStock(1, 0, 0)
+	description, _ := domain.NewDescription("Test Description")
+	item, _ := domain.NewItem(nil, *userId, *itemName, *stock, *description, domain.ZeroYen())
+	sort, _ := domain.NewItemSort(nil)
+	cursor := domain.NewItemCursor(item, *sort)
+	result := domain.NewItemPage(domain.Items{}, nil, nil)
+	mockRepo.On("GetAllItems", mock.MatchedBy(func(q repository.ItemListQuery) bool {
+		return q.Limit.Value() == 10 && q.Cursor != nil && q.Cursor.ItemId() == item.ItemId()
+	})).Return(result, nil)
+	mockRepo.On("CountItems", repository.ItemFilter{}).Return(int64(42), nil)
+
+	page, err := uc.GetAllItems(request.ListItemsRequest{Limit: 10, Cursor: cursor.Encode(), WithTotal: true})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), *page.Total())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetAllItems_WithFiltersAndSort(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
+	inStock := true
+	after := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	needleSize := 4.5
+	req := request.ListItemsRequest{
+		Filter: request.ItemListFilter{
+			Stock:         &inStock,
+			UserId:        "f47ac10b-58cc-4372-a567-0e02b2c3d400",
+			CreatedAfter:  &after,
+			CreatedBefore: &before,
+			NameContains:  "ウール",
+			Category:      "Wool",
+			Tag:           " Hand  Knit ",
+			YarnWeight:    "light",
+			Fiber:         "merino",
+			NeedleSize:    &needleSize,
+		},
+		Sort: []request.ItemSortField{
+			{Field: "created_at", Descending: true},
+			{Field: "item_name"},
+		},
+	}
+	result := domain.NewItemPage(domain.Items{}, nil, nil)
+	mockRepo.On("GetAllItems", mock.MatchedBy(func(q repository.ItemListQuery) bool {
+		return q.Sort.String() == "-created_at,item_name" &&
+			*q.Filter.Stock &&
+			q.Filter.UserId.Value() == req.Filter.UserId &&
+			q.Filter.CreatedAfter.Equal(after) &&
+			q.Filter.CreatedBefore.Equal(before) &&
+			q.Filter.NameContains == "ウール" &&
+			q.Filter.Category.Value() == "wool" &&
+			q.Filter.Tag.Value() == "hand knit" &&
+			q.Filter.YarnWeight == domain.YarnWeightLight &&
+			q.Filter.Fiber == domain.FiberMerino &&
+			q.Filter.NeedleSize.Millimeters() == 4.5
+	})).Return(result, nil)
+
+	_, err := uc.GetAllItems(req)
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetAllItems_InvalidQuery(t *testing.T) {
+	after := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	invalidNeedleSize := 4.1
+	otherSortCursor := func() string {
+		userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+		itemName, _ := domain.NewItemName("Test Item")
+		stock, _ := domain.NewStock(1, 0, 0)
+		description, _ := domain.NewDescription("Test Description")
+		item, _ := domain.NewItem(nil, *userId, *itemName, *stock, *description, domain.ZeroYen())
+		key, _ := domain.NewItemSortKey(domain.ItemSortItemName, false)
+		sort, _ := domain.NewItemSort([]domain.ItemSortKey{*key})
+		return domain.NewItemCursor(item, *sort).Encode()
+	}()
+
+	tests := []struct {
+		name string
+		req  request.ListItemsRequest
+	}{
+		{"broken cursor", request.ListItemsRequest{Cursor: "not-a-cursor"}},
+		{"limit too large", request.ListItemsRequest{Limit: domain.MaxPageLimit + 1}},
+		{"unknown sort field", request.ListItemsRequest{Sort: []request.ItemSortField{{Field: "password"}}}},
+		{"invalid user id", request.ListItemsRequest{Filter: request.ItemListFilter{UserId: "invalid"}}},
+		{"inverted date range", request.ListItemsRequest{Filter: request.ItemListFilter{CreatedAfter: &after, CreatedBefore: &before}}},
+		{"cursor for another sort", request.ListItemsRequest{Cursor: otherSortCursor}},
+		{"invalid category slug", request.ListItemsRequest{Filter: request.ItemListFilter{Category: "yarn/wool"}}},
+		{"invalid tag", request.ListItemsRequest{Filter: request.ItemListFilter{Tag: "wool,cotton"}}},
+		{"unknown yarn weight", request.ListItemsRequest{Filter: request.ItemListFilter{YarnWeight: "worsted"}}},
+		{"unknown fiber", request.ListItemsRequest{Filter: request.ItemListFilter{Fiber: "yak"}}},
+		{"invalid needle size", request.ListItemsRequest{Filter: request.ItemListFilter{NeedleSize: &invalidNeedleSize}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockItemRepository)
+			uc := NewItemUsecase(mockRepo, new(MockUserRepository))
+			page, err := uc.GetAllItems(tt.req)
+			assert.ErrorIs(t, err, ErrInvalidQuery)
+			assert.Nil(t, page)
+			mockRepo.AssertNotCalled(t, "GetAllItems", mock.Anything)
+		})
+	}
 }
 
 func TestCreateItem_Success(t *testing.T) {
 	mockRepo := new(MockItemRepository)
-	uc := NewItemUsecase(mockRepo)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
 
 	req := request.CreateItemRequest{
-		ItemName:    "Test Item",
-		Stock:       true,
-		Description: "Test Description",
-		UserId:      "f47ac10b-58cc-4372-a567-0e02b2c3d400",
+		ItemName:       "Test Item",
+		OnHandQuantity: 1,
+		Description:    "Test Description",
+		UserId:         "f47ac10b-58cc-4372-a567-0e02b2c3d400",
 	}
 	itemId, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
 	userIdValue, _ := domain.NewUserId(req.UserId)
 	itemName, _ := domain.NewItemName(req.ItemName)
-	stock, _ := domain.NewStock(req.Stock)
+	stock, _ := domain.NewStock(req.OnHandQuantity, 0, req.LowStockThreshold)
 	description, _ := domain.NewDescription(req.Description)
-	domainItem, _ := domain.NewItem(itemId, *userIdValue, *itemName, *stock, *description)
+	domainItem, _ := domain.NewItem(itemId, *userIdValue, *itemName, *stock, *description, domain.ZeroYen())
 
 	mockRepo.On("CreateItem", mock.AnythingOfType("*domain.Item")).Return(domainItem, nil)
 	result, err := uc.CreateItem(req)
@@ -89,13 +256,13 @@ func TestCreateItem_Success(t *testing.T) {
 
 func TestCreateItem_InvalidItemName(t *testing.T) {
 	mockRepo := new(MockItemRepository)
-	uc := NewItemUsecase(mockRepo)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
 
 	req := request.CreateItemRequest{
-		ItemName:    "",
-		Stock:       true,
-		Description: "Test Description",
-		UserId:      "f47ac10b-58cc-4372-a567-0e02b2c3d400",
+		ItemName:       "",
+		OnHandQuantity: 1,
+		Description:    "Test Description",
+		UserId:         "f47ac10b-58cc-4372-a567-0e02b2c3d400",
 	}
 	result, err := uc.CreateItem(req)
 
@@ -105,15 +272,70 @@ func TestCreateItem_InvalidItemName(t *testing.T) {
 	mockRepo.AssertNotCalled(t, "CreateItem")
 }
 
-func TestCreateItem_InvalidUserId(t *testing.T) {
+func TestCreateItem_NegativeQuantity(t *testing.T) {
 	mockRepo := new(MockItemRepository)
-	uc := NewItemUsecase(mockRepo)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
 
 	req := request.CreateItemRequest{
+		ItemName:       "Test Item",
+		OnHandQuantity: -1,
+		Description:    "Test Description",
+		UserId:         "f47ac10b-58cc-4372-a567-0e02b2c3d400",
+	}
+	result, err := uc.CreateItem(req)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "on hand quantity must not be negative")
+	mockRepo.AssertNotCalled(t, "CreateItem")
+}
+
+func TestCreateItem_Price(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
+
+	mockRepo.On("CreateItem", mock.MatchedBy(func(item *domain.Item) bool {
+		return item.Price().String() == "1980" && item.Price().Currency() == domain.CurrencyJPY
+	})).Return(nil, errors.New("stop"))
+
+	_, err := uc.CreateItem(request.CreateItemRequest{
 		ItemName:    "Test Item",
-		Stock:       true,
 		Description: "Test Description",
-		UserId:      "invalid-uuid",
+		Price:       "1980",
+		UserId:      "f47ac10b-58cc-4372-a567-0e02b2c3d400",
+	})
+
+	assert.EqualError(t, err, "stop")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateItem_InvalidPrice(t *testing.T) {
+	for _, price := range []string{"-100", "100.5", "abc"} {
+		mockRepo := new(MockItemRepository)
+		uc := NewItemUsecase(mockRepo, new(MockUserRepository))
+
+		result, err := uc.CreateItem(request.CreateItemRequest{
+			ItemName:    "Test Item",
+			Description: "Test Description",
+			Price:       price,
+			UserId:      "f47ac10b-58cc-4372-a567-0e02b2c3d400",
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidPrice, price)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "CreateItem", mock.Anything)
+	}
+}
+
+func TestCreateItem_InvalidUserId(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
+
+	req := request.CreateItemRequest{
+		ItemName:       "Test Item",
+		OnHandQuantity: 1,
+		Description:    "Test Description",
+		UserId:         "invalid-uuid",
 	}
 	result, err := uc.CreateItem(req)
 
@@ -125,13 +347,13 @@ func TestCreateItem_InvalidUserId(t *testing.T) {
 
 func TestCreateItem_RepositoryError(t *testing.T) {
 	mockRepo := new(MockItemRepository)
-	uc := NewItemUsecase(mockRepo)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
 
 	req := request.CreateItemRequest{
-		ItemName:    "Test Item",
-		Stock:       true,
-		Description: "Test Description",
-		UserId:      "f47ac10b-58cc-4372-a567-0e02b2c3d400",
+		ItemName:       "Test Item",
+		OnHandQuantity: 1,
+		Description:    "Test Description",
+		UserId:         "f47ac10b-58cc-4372-a567-0e02b2c3d400",
 	}
 	mockRepo.On("CreateItem", mock.AnythingOfType("*domain.Item")).Return(nil, errors.New("database error"))
 	result, err := uc.CreateItem(req)
@@ -144,14 +366,14 @@ func TestCreateItem_RepositoryError(t *testing.T) {
 
 func TestGetItemByID_Success(t *testing.T) {
 	mockRepo := new(MockItemRepository)
-	uc := NewItemUsecase(mockRepo)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
 
 	itemId, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
 	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
 	itemName, _ := domain.NewItemName("Test Item")
-	stock, _ := domain.NewStock(true)
+	stock, _ := domain.NewStock(1, 0, 0)
 	description, _ := domain.NewDescription("Test Description")
-	domainItem, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description)
+	domainItem, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
 
 	itemIdValue, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
 	mockRepo.On("GetItemByID", itemIdValue).Return(domainItem, nil)
@@ -165,26 +387,25 @@ func TestGetItemByID_Success(t *testing.T) {
 
 func TestUpdateItem_Success(t *testing.T) {
 	mockRepo := new(MockItemRepository)
-	uc := NewItemUsecase(mockRepo)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
 
 	req := request.UpdateItemRequest{
 		ItemId:      "f47ac10b-58cc-4372-a567-0e02b2c3d401",
 		ItemName:    "Updated Item",
-		Stock:       false,
 		Description: "Updated Description",
 	}
 
 	itemId, _ := domain.NewItemId(req.ItemId)
 	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
 	existingItemName, _ := domain.NewItemName("Existing Item")
-	existingStock, _ := domain.NewStock(true)
+	existingStock, _ := domain.NewStock(1, 0, 0)
 	existingDescription, _ := domain.NewDescription("Existing Description")
-	existingItem, _ := domain.NewItem(itemId, *userId, *existingItemName, *existingStock, *existingDescription)
+	existingItem, _ := domain.NewItem(itemId, *userId, *existingItemName, *existingStock, *existingDescription, domain.ZeroYen())
 
 	updatedItemName, _ := domain.NewItemName(req.ItemName)
-	updatedStock, _ := domain.NewStock(req.Stock)
+	updatedStock, _ := domain.NewStock(1, 0, req.LowStockThreshold)
 	updatedDescription, _ := domain.NewDescription(req.Description)
-	updatedItem, _ := domain.NewItem(itemId, *userId, *updatedItemName, *updatedStock, *updatedDescription)
+	updatedItem, _ := domain.NewItem(itemId, *userId, *updatedItemName, *updatedStock, *updatedDescription, domain.ZeroYen())
 
 	mockRepo.On("GetItemByID", itemId).Return(existingItem, nil)
 	mockRepo.On("UpdateItem", mock.AnythingOfType("*domain.Item")).Return(updatedItem, nil)
@@ -195,9 +416,65 @@ func TestUpdateItem_Success(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestUpdateItem_KeepsStockQuantities(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
+
+	req := request.UpdateItemRequest{
+		ItemId:            "f47ac10b-58cc-4372-a567-0e02b2c3d401",
+		ItemName:          "Updated Item",
+		LowStockThreshold: 3,
+		Description:       "Updated Description",
+	}
+
+	itemId, _ := domain.NewItemId(req.ItemId)
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+	existingItemName, _ := domain.NewItemName("Existing Item")
+	existingStock, _ := domain.NewStock(5, 2, 0)
+	existingDescription, _ := domain.NewDescription("Existing Description")
+	existingItem, _ := domain.NewItem(itemId, *userId, *existingItemName, *existingStock, *existingDescription, domain.ZeroYen())
+
+	mockRepo.On("GetItemByID", itemId).Return(existingItem, nil)
+	mockRepo.On("UpdateItem", mock.MatchedBy(func(item *domain.Item) bool {
+		stock := item.Stock()
+		return stock.OnHand() == 5 && stock.Reserved() == 2 && stock.LowStockThreshold() == 3
+	})).Return(existingItem, nil)
+	_, err := uc.UpdateItem(req)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateItem_KeepsPriceWhenOmitted(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
+
+	itemId, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+	itemName, _ := domain.NewItemName("Existing Item")
+	stock, _ := domain.NewStock(1, 0, 0)
+	description, _ := domain.NewDescription("Existing Description")
+	price, _ := domain.NewMoneyFromString("1500", domain.CurrencyJPY)
+	existingItem, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, *price)
+
+	mockRepo.On("GetItemByID", itemId).Return(existingItem, nil)
+	mockRepo.On("UpdateItem", mock.MatchedBy(func(item *domain.Item) bool {
+		return item.Price().Equals(price)
+	})).Return(existingItem, nil)
+
+	_, err := uc.UpdateItem(request.UpdateItemRequest{
+		ItemId:      itemId.Value(),
+		ItemName:    "Updated Item",
+		Description: "Updated Description",
+	})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestDeleteItem_Success(t *testing.T) {
 	mockRepo := new(MockItemRepository)
-	uc := NewItemUsecase(mockRepo)
+	uc := NewItemUsecase(mockRepo, new(MockUserRepository))
 
 	itemIdValue, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
 	mockRepo.On("DeleteItem", itemIdValue).Return(nil)
@@ -206,3 +483,139 @@ func TestDeleteItem_Success(t *testing.T) {
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestGetOwnItemByID_Owner(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	mockUserRepo := new(MockUserRepository)
+	uc := NewItemUsecase(mockRepo, mockUserRepo)
+
+	itemId, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+	itemName, _ := domain.NewItemName("Test Item")
+	stock, _ := domain.NewStock(1, 0, 0)
+	description, _ := domain.NewDescription("Test Description")
+	domainItem, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
+
+	mockRepo.On("GetItemByID", itemId).Return(domainItem, nil)
+	result, err := uc.GetOwnItemByID(itemId.Value(), userId.Value())
+
+	assert.NoError(t, err)
+	assert.Equal(t, domainItem, result)
+	mockRepo.AssertExpectations(t)
+	mockUserRepo.AssertNotCalled(t, "GetUserById")
+}
+
+func TestGetOwnItemByID_OtherUserIsForbidden(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	mockUserRepo := new(MockUserRepository)
+	uc := NewItemUsecase(mockRepo, mockUserRepo)
+
+	itemId, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
+	ownerId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+	itemName, _ := domain.NewItemName("Test Item")
+	stock, _ := domain.NewStock(1, 0, 0)
+	description, _ := domain.NewDescription("Test Description")
+	domainItem, _ := domain.NewItem(itemId, *ownerId, *itemName, *stock, *description, domain.ZeroYen())
+
+	otherUser := createTestUserWithRole("f47ac10b-58cc-4372-a567-0e02b2c3d402", "USER")
+	mockRepo.On("GetItemByID", itemId).Return(domainItem, nil)
+	mockUserRepo.On("GetUserById", otherUser.Id()).Return(otherUser, nil)
+	result, err := uc.GetOwnItemByID(itemId.Value(), otherUser.Id().Value())
+
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestGetOwnItemByID_NotFound(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	mockUserRepo := new(MockUserRepository)
+	uc := NewItemUsecase(mockRepo, mockUserRepo)
+
+	itemId, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
+	mockRepo.On("GetItemByID", itemId).Return(nil, gorm.ErrRecordNotFound)
+	result, err := uc.GetOwnItemByID(itemId.Value(), "f47ac10b-58cc-4372-a567-0e02b2c3d400")
+
+	assert.ErrorIs(t, err, ErrItemNotFound)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetOwnItemByID_InvalidItemId(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	mockUserRepo := new(MockUserRepository)
+	uc := NewItemUsecase(mockRepo, mockUserRepo)
+
+	result, err := uc.GetOwnItemByID("invalid", "f47ac10b-58cc-4372-a567-0e02b2c3d400")
+
+	assert.ErrorIs(t, err, ErrInvalidItemId)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "GetItemByID", mock.Anything)
+}
+
+func TestGetOwnItemByID_RepositoryError(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	mockUserRepo := new(MockUserRepository)
+	uc := NewItemUsecase(mockRepo, mockUserRepo)
+
+	itemId, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
+	dbErr := errors.New("connection refused")
+	mockRepo.On("GetItemByID", itemId).Return(nil, dbErr)
+	result, err := uc.GetOwnItemByID(itemId.Value(), "f47ac10b-58cc-4372-a567-0e02b2c3d400")
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.NotErrorIs(t, err, ErrItemNotFound)
+	assert.Nil(t, result)
+}
+
+func TestUpdateOwnItem_AdminBypassesOwnership(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	mockUserRepo := new(MockUserRepository)
+	uc := NewItemUsecase(mockRepo, mockUserRepo)
+
+	req := request.UpdateItemRequest{
+		ItemId:      "f47ac10b-58cc-4372-a567-0e02b2c3d401",
+		ItemName:    "Updated Item",
+		Description: "Updated Description",
+	}
+	itemId, _ := domain.NewItemId(req.ItemId)
+	ownerId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+	itemName, _ := domain.NewItemName("Existing Item")
+	stock, _ := domain.NewStock(1, 0, 0)
+	description, _ := domain.NewDescription("Existing Description")
+	existingItem, _ := domain.NewItem(itemId, *ownerId, *itemName, *stock, *description, domain.ZeroYen())
+
+	admin := createTestUserWithRole("f47ac10b-58cc-4372-a567-0e02b2c3d403", "ADMINISTRATOR")
+	mockRepo.On("GetItemByID", itemId).Return(existingItem, nil)
+	mockUserRepo.On("GetUserById", admin.Id()).Return(admin, nil)
+	mockRepo.On("UpdateItem", mock.AnythingOfType("*domain.Item")).Return(existingItem, nil)
+	result, err := uc.UpdateOwnItem(req, admin.Id().Value())
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	mockRepo.AssertExpectations(t)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestDeleteOwnItem_OtherUserIsForbidden(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	mockUserRepo := new(MockUserRepository)
+	uc := NewItemUsecase(mockRepo, mockUserRepo)
+
+	itemId, _ := domain.NewItemId("f47ac10b-58cc-4372-a567-0e02b2c3d401")
+	ownerId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d400")
+	itemName, _ := domain.NewItemName("Test Item")
+	stock, _ := domain.NewStock(1, 0, 0)
+	description, _ := domain.NewDescription("Test Description")
+	domainItem, _ := domain.NewItem(itemId, *ownerId, *itemName, *stock, *description, domain.ZeroYen())
+
+	otherUser := createTestUserWithRole("f47ac10b-58cc-4372-a567-0e02b2c3d402", "USER")
+	mockRepo.On("GetItemByID", itemId).Return(domainItem, nil)
+	mockUserRepo.On("GetUserById", otherUser.Id()).Return(otherUser, nil)
+	err := uc.DeleteOwnItem(itemId.Value(), otherUser.Id().Value())
+
+	assert.ErrorIs(t, err, ErrForbidden)
+	mockRepo.AssertNotCalled(t, "DeleteItem", mock.Anything)
+	mockUserRepo.AssertExpectations(t)
+}
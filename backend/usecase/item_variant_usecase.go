@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IItemVariantUsecase interface {
+	GetVariants(itemId string) (*domain.Item, error)
+	GetVariant(itemId string, variantId string) (*domain.ItemVariant, *domain.Item, error)
+	CreateVariant(req request.CreateItemVariantRequest) (*domain.ItemVariant, *domain.Item, error)
+	UpdateVariant(req request.UpdateItemVariantRequest) (*domain.ItemVariant, *domain.Item, error)
+	DeleteVariant(itemId string, variantId string) error
+}
+
+type itemVariantUsecase struct {
+	ir  repository.IItemRepository
+	ivr repository.IItemVariantRepository
+}
+
+func NewItemVariantUsecase(ir repository.IItemRepository, ivr repository.IItemVariantRepository) IItemVariantUsecase {
+	return &itemVariantUsecase{ir, ivr}
+}
+
+// GetVariants はバリエーションを読み込んだ商品を返す。価格の表示に商品の価格が必要なため商品ごと返している
+func (ivu *itemVariantUsecase) GetVariants(itemId string) (*domain.Item, error) {
+	return ivu.findItem(itemId)
+}
+
+func (ivu *itemVariantUsecase) GetVariant(itemId string, variantId string) (*domain.ItemVariant, *domain.Item, error) {
+	item, err := ivu.findItem(itemId)
+	if err != nil {
+		return nil, nil, err
+	}
+	variant := item.Variants().FindByID(variantId)
+	if variant == nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrVariantNotFound, variantId)
+	}
+	return variant, item, nil
+}
+
+func (ivu *itemVariantUsecase) CreateVariant(req request.CreateItemVariantRequest) (*domain.ItemVariant, *domain.Item, error) {
+	item, err := ivu.findItem(req.ItemId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stock, err := domain.NewStock(req.OnHandQuantity, 0, req.LowStockThreshold)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidVariant, err)
+	}
+	sku, options, priceOverride, err := newVariantAttributes(item, req.Sku, req.Options, req.PriceOverride, req.Currency)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	itemId, err := domain.NewItemId(item.ItemId())
+	if err != nil {
+		return nil, nil, err
+	}
+	variant := domain.NewItemVariant(*itemId, *sku, *options, *stock, priceOverride)
+	created, err := ivu.ivr.CreateVariant(variant)
+	if err != nil {
+		return nil, nil, translateVariantError(err)
+	}
+	return created, item, nil
+}
+
+func (ivu *itemVariantUsecase) UpdateVariant(req request.UpdateItemVariantRequest) (*domain.ItemVariant, *domain.Item, error) {
+	existing, item, err := ivu.GetVariant(req.ItemId, req.VariantId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 実在庫数と引当済み数量は既存の値を引き継ぐ。実在庫数は在庫移動で台帳に記録して変更する
+	stock, err := domain.NewStock(existing.Stock().OnHand(), existing.Stock().Reserved(), req.LowStockThreshold)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidVariant, err)
+	}
+	sku, options, priceOverride, err := newVariantAttributes(item, req.Sku, req.Options, req.PriceOverride, req.Currency)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	itemId, err := domain.NewItemId(item.ItemId())
+	if err != nil {
+		return nil, nil, err
+	}
+	variant := domain.RestoreItemVariant(existing.VariantId(), *itemId, *sku, *options, *stock, priceOverride, existing.CreatedAt(), time.Now())
+	updated, err := ivu.ivr.UpdateVariant(variant)
+	if err != nil {
+		return nil, nil, translateVariantError(err)
+	}
+	return updated, item, nil
+}
+
+func (ivu *itemVariantUsecase) DeleteVariant(itemId string, variantId string) error {
+	variant, _, err := ivu.GetVariant(itemId, variantId)
+	if err != nil {
+		return err
+	}
+	itemIdDomain, err := domain.NewItemId(variant.ItemId())
+	if err != nil {
+		return err
+	}
+	return ivu.ivr.DeleteVariant(itemIdDomain, variant.VariantId())
+}
+
+// findItem は対象の商品をバリエーションごと取得する
+func (ivu *itemVariantUsecase) findItem(itemId string) (*domain.Item, error) {
+	itemIdDomain, err := domain.NewItemId(itemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	item, err := ivu.ir.GetItemByID(itemIdDomain)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	return item, nil
+}
+
+// newVariantAttributes は SKU コード・オプション・上書き価格を検証する
+// 上書き価格は商品の価格と同じ通貨でなければならない
+func newVariantAttributes(item *domain.Item, skuValue string, optionValues map[string]string, priceValue string, currency string) (*domain.SkuCode, *domain.VariantOptions, *domain.Money, error) {
+	sku, err := domain.NewSkuCode(skuValue)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %v", ErrInvalidVariant, err)
+	}
+	options, err := domain.NewVariantOptions(optionValues)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %v", ErrInvalidVariant, err)
+	}
+	if priceValue == "" {
+		return sku, options, nil, nil
+	}
+
+	if currency == "" {
+		currency = item.Price().Currency()
+	}
+	priceOverride, err := domain.NewMoneyFromString(priceValue, currency)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %v", ErrInvalidPrice, err)
+	}
+	if priceOverride.Currency() != item.Price().Currency() {
+		return nil, nil, nil, fmt.Errorf("%w: %v: item is priced in %s", ErrInvalidPrice, domain.ErrCurrencyMismatch, item.Price().Currency())
+	}
+	return sku, options, priceOverride, nil
+}
+
+func translateVariantError(err error) error {
+	if errors.Is(err, domain.ErrDuplicateVariantOptions) || errors.Is(err, domain.ErrDuplicateSkuCode) {
+		return fmt.Errorf("%w: %v", ErrDuplicateVariant, err)
+	}
+	return err
+}
@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockItemVariantRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemVariantRepository) CreateVariant(variant *domain.ItemVariant) (*domain.ItemVariant, error) {
+	args := m.Called(variant)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemVariant), args.Error(1)
+}
+
+func (m *MockItemVariantRepository) UpdateVariant(variant *domain.ItemVariant) (*domain.ItemVariant, error) {
+	args := m.Called(variant)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemVariant), args.Error(1)
+}
+
+func (m *MockItemVariantRepository) DeleteVariant(itemId *domain.ItemId, variantId string) error {
+	args := m.Called(itemId, variantId)
+	return args.Error(0)
+}
+
+const variantTestItemId = "f47ac10b-58cc-4372-a567-0e02b2c3d801"
+
+// createVariantTestItem は1,000円の商品に reserved 個引当済みの M サイズのバリエーションを持たせて返す
+func createVariantTestItem(t *testing.T, reserved int) (*domain.Item, *domain.ItemVariant) {
+	t.Helper()
+	itemId, _ := domain.NewItemId(variantTestItemId)
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d802")
+	itemName, _ := domain.NewItemName("Hand-knit socks")
+	stock, _ := domain.NewStock(0, 0, 0)
+	description, _ := domain.NewDescription("Wool socks")
+	price, _ := domain.NewMoneyFromString("1000", domain.CurrencyJPY)
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, *price)
+
+	sku, _ := domain.NewSkuCode("SOCK-M")
+	options, _ := domain.NewVariantOptions(map[string]string{"size": "M"})
+	variantStock, _ := domain.NewStock(5, reserved, 1)
+	variant := domain.NewItemVariant(*itemId, *sku, *options, *variantStock, nil)
+	item, err := item.WithVariants(domain.ItemVariants{*variant})
+	if err != nil {
+		t.Fatalf("Failed to attach variants: %v", err)
+	}
+	return item, variant
+}
+
+func newVariantForTest(t *testing.T, skuValue string, size string, onHand int, reserved int, priceOverride string) *domain.ItemVariant {
+	t.Helper()
+	itemId, _ := domain.NewItemId(variantTestItemId)
+	sku, _ := domain.NewSkuCode(skuValue)
+	options, _ := domain.NewVariantOptions(map[string]string{"size": size})
+	stock, _ := domain.NewStock(onHand, reserved, 0)
+	var price *domain.Money
+	if priceOverride != "" {
+		price, _ = domain.NewMoneyFromString(priceOverride, domain.CurrencyJPY)
+	}
+	return domain.NewItemVariant(*itemId, *sku, *options, *stock, price)
+}
+
+func TestCreateVariant_Success(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockVariantRepo := new(MockItemVariantRepository)
+	uc := NewItemVariantUsecase(mockItemRepo, mockVariantRepo)
+
+	item, _ := createVariantTestItem(t, 0)
+	itemId, _ := domain.NewItemId(variantTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+	mockVariantRepo.On("CreateVariant", mock.MatchedBy(func(v *domain.ItemVariant) bool {
+		return v.ItemId() == variantTestItemId &&
+			v.Sku() == "SOCK-L" &&
+			v.Options().Key() == "size=l" &&
+			v.Stock().OnHand() == 3 &&
+			v.PriceOverride().String() == "1200"
+	})).Return(newVariantForTest(t, "SOCK-L", "L", 3, 0, "1200"), nil)
+
+	variant, returnedItem, err := uc.CreateVariant(request.CreateItemVariantRequest{
+		ItemId:         variantTestItemId,
+		Sku:            "sock-l",
+		Options:        map[string]string{"Size": "L"},
+		OnHandQuantity: 3,
+		PriceOverride:  "1200",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SOCK-L", variant.Sku())
+	assert.Equal(t, "JPY", variant.PriceOverride().Currency())
+	assert.Equal(t, variantTestItemId, returnedItem.ItemId())
+	mockVariantRepo.AssertExpectations(t)
+}
+
+func TestCreateVariant_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     request.CreateItemVariantRequest
+		wantErr error
+	}{
+		{"empty sku", request.CreateItemVariantRequest{Options: map[string]string{"size": "L"}}, ErrInvalidVariant},
+		{"no options", request.CreateItemVariantRequest{Sku: "SOCK-L"}, ErrInvalidVariant},
+		{"negative stock", request.CreateItemVariantRequest{Sku: "SOCK-L", Options: map[string]string{"size": "L"}, OnHandQuantity: -1}, ErrInvalidVariant},
+		{"invalid price", request.CreateItemVariantRequest{Sku: "SOCK-L", Options: map[string]string{"size": "L"}, PriceOverride: "-5"}, ErrInvalidPrice},
+		{"currency differs from item", request.CreateItemVariantRequest{Sku: "SOCK-L", Options: map[string]string{"size": "L"}, PriceOverride: "12.00", Currency: "USD"}, ErrInvalidPrice},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockItemRepo := new(MockItemRepository)
+			mockVariantRepo := new(MockItemVariantRepository)
+			uc := NewItemVariantUsecase(mockItemRepo, mockVariantRepo)
+
+			item, _ := createVariantTestItem(t, 0)
+			itemId, _ := domain.NewItemId(variantTestItemId)
+			mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+
+			tt.req.ItemId = variantTestItemId
+			_, _, err := uc.CreateVariant(tt.req)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			mockVariantRepo.AssertNotCalled(t, "CreateVariant", mock.Anything)
+		})
+	}
+}
+
+func TestCreateVariant_Duplicate(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockVariantRepo := new(MockItemVariantRepository)
+	uc := NewItemVariantUsecase(mockItemRepo, mockVariantRepo)
+
+	item, _ := createVariantTestItem(t, 0)
+	itemId, _ := domain.NewItemId(variantTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+	mockVariantRepo.On("CreateVariant", mock.Anything).Return(nil, fmt.Errorf("%w: size=m", domain.ErrDuplicateVariantOptions))
+
+	_, _, err := uc.CreateVariant(request.CreateItemVariantRequest{
+		ItemId:  variantTestItemId,
+		Sku:     "SOCK-M-2",
+		Options: map[string]string{"size": "m"},
+	})
+
+	assert.ErrorIs(t, err, ErrDuplicateVariant)
+}
+
+func TestCreateVariant_ItemNotFound(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockVariantRepo := new(MockItemVariantRepository)
+	uc := NewItemVariantUsecase(mockItemRepo, mockVariantRepo)
+
+	_, _, err := uc.CreateVariant(request.CreateItemVariantRequest{ItemId: "not-a-uuid"})
+
+	assert.ErrorIs(t, err, ErrItemNotFound)
+}
+
+func TestUpdateVariant_KeepsStockQuantities(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockVariantRepo := new(MockItemVariantRepository)
+	uc := NewItemVariantUsecase(mockItemRepo, mockVariantRepo)
+
+	item, existing := createVariantTestItem(t, 2)
+	itemId, _ := domain.NewItemId(variantTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+	mockVariantRepo.On("UpdateVariant", mock.MatchedBy(func(v *domain.ItemVariant) bool {
+		return v.VariantId() == existing.VariantId() &&
+			v.Stock().OnHand() == existing.Stock().OnHand() &&
+			v.Stock().Reserved() == 2 &&
+			v.Stock().LowStockThreshold() == 3 &&
+			v.PriceOverride() == nil &&
+			v.CreatedAt().Equal(existing.CreatedAt())
+	})).Return(newVariantForTest(t, "SOCK-M", "M", existing.Stock().OnHand(), 2, ""), nil)
+
+	variant, _, err := uc.UpdateVariant(request.UpdateItemVariantRequest{
+		ItemId:            variantTestItemId,
+		VariantId:         existing.VariantId(),
+		Sku:               "SOCK-M",
+		Options:           map[string]string{"size": "M"},
+		LowStockThreshold: 3,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, existing.Stock().OnHand()-2, variant.Stock().Available())
+	mockVariantRepo.AssertExpectations(t)
+}
+
+func TestUpdateVariant_NotFound(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockVariantRepo := new(MockItemVariantRepository)
+	uc := NewItemVariantUsecase(mockItemRepo, mockVariantRepo)
+
+	item, _ := createVariantTestItem(t, 0)
+	itemId, _ := domain.NewItemId(variantTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+
+	_, _, err := uc.UpdateVariant(request.UpdateItemVariantRequest{
+		ItemId:    variantTestItemId,
+		VariantId: "f47ac10b-58cc-4372-a567-0e02b2c3d899",
+		Sku:       "SOCK-M",
+		Options:   map[string]string{"size": "M"},
+	})
+
+	assert.ErrorIs(t, err, ErrVariantNotFound)
+}
+
+func TestDeleteVariant(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockVariantRepo := new(MockItemVariantRepository)
+	uc := NewItemVariantUsecase(mockItemRepo, mockVariantRepo)
+
+	item, existing := createVariantTestItem(t, 0)
+	itemId, _ := domain.NewItemId(variantTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+	mockVariantRepo.On("DeleteVariant", itemId, existing.VariantId()).Return(nil)
+
+	assert.NoError(t, uc.DeleteVariant(variantTestItemId, existing.VariantId()))
+	assert.ErrorIs(t, uc.DeleteVariant(variantTestItemId, "f47ac10b-58cc-4372-a567-0e02b2c3d899"), ErrVariantNotFound)
+	mockVariantRepo.AssertNumberOfCalls(t, "DeleteVariant", 1)
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+	"gorm.io/gorm"
+)
+
+type IOrderUsecase interface {
+	PlaceOrder(req request.PlaceOrderRequest) (*domain.Order, error)
+	GetOrders(userId string) ([]*domain.Order, error)
+	GetOrder(userId string, orderId string) (*domain.Order, error)
+	CancelOrder(userId string, orderId string) (*domain.Order, error)
+	TransitionOrder(req request.TransitionOrderRequest) (*domain.Order, error)
+}
+
+type orderUsecase struct {
+	or repository.IOrderRepository
+}
+
+func NewOrderUsecase(or repository.IOrderRepository) IOrderUsecase {
+	return &orderUsecase{or}
+}
+
+// PlaceOrder は明細の在庫を引き当てて注文を作成する
+// 1つでも引き当てられない明細があれば注文全体を受け付けない
+func (ou *orderUsecase) PlaceOrder(req request.PlaceOrderRequest) (*domain.Order, error) {
+	userId, err := domain.NewUserId(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	orderRequest, err := newOrderRequest(req.Lines)
+	if err != nil {
+		return nil, err
+	}
+	if req.CouponCode != "" {
+		code, err := domain.NewCouponCode(req.CouponCode)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCouponNotFound, err)
+		}
+		orderRequest = orderRequest.WithCouponCode(*code)
+	}
+
+	order, err := ou.or.PlaceOrder(userId, orderRequest)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCouponNotFound):
+			return nil, fmt.Errorf("%w: %v", ErrCouponNotFound, err)
+		case errors.Is(err, domain.ErrCouponNotApplicable):
+			return nil, fmt.Errorf("%w: %v", ErrCouponNotApplicable, err)
+		case errors.Is(err, domain.ErrOrderItemUnavailable):
+			return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+		case errors.Is(err, domain.ErrInsufficientStock):
+			return nil, fmt.Errorf("%w: %v", ErrInsufficientStock, err)
+		case errors.Is(err, domain.ErrInvalidOrderLine):
+			return nil, fmt.Errorf("%w: %v", ErrInvalidOrder, err)
+		}
+		return nil, err
+	}
+	return order, nil
+}
+
+// GetOrders はユーザーの注文を新しい順に返す
+func (ou *orderUsecase) GetOrders(userId string) ([]*domain.Order, error) {
+	userIdDomain, err := domain.NewUserId(userId)
+	if err != nil {
+		return nil, err
+	}
+	return ou.or.GetOrdersByUserID(userIdDomain)
+}
+
+// GetOrder は注文を返す。他のユーザーの注文は存在を明かさないよう見つからないものとして扱う
+func (ou *orderUsecase) GetOrder(userId string, orderId string) (*domain.Order, error) {
+	order, err := ou.or.GetOrderByID(orderId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrOrderNotFound, err)
+		}
+		return nil, err
+	}
+	if !order.IsPlacedBy(userId) {
+		return nil, fmt.Errorf("%w: %s", ErrOrderNotFound, orderId)
+	}
+	return order, nil
+}
+
+// CancelOrder は注文したユーザーが発送前の注文を取り消す。引き当てた在庫は解除する
+func (ou *orderUsecase) CancelOrder(userId string, orderId string) (*domain.Order, error) {
+	if _, err := ou.GetOrder(userId, orderId); err != nil {
+		return nil, err
+	}
+	return ou.transition(orderId, domain.OrderStatusCancelled, userId)
+}
+
+// TransitionOrder は管理者が注文を指定した状態に進める
+func (ou *orderUsecase) TransitionOrder(req request.TransitionOrderRequest) (*domain.Order, error) {
+	status, err := domain.NewOrderStatus(req.Status)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOrderStatus, err)
+	}
+	if _, err := ou.or.GetOrderByID(req.OrderId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrOrderNotFound, err)
+		}
+		return nil, err
+	}
+	return ou.transition(req.OrderId, status, req.UserId)
+}
+
+func (ou *orderUsecase) transition(orderId string, status domain.OrderStatus, actorId string) (*domain.Order, error) {
+	actor, err := domain.NewUserId(actorId)
+	if err != nil {
+		return nil, err
+	}
+	order, err := ou.or.TransitionOrder(orderId, status, actor)
+	if err != nil {
+		if errors.Is(err, domain.ErrIllegalOrderTransition) {
+			return nil, fmt.Errorf("%w: %v", ErrIllegalOrderTransition, err)
+		}
+		return nil, err
+	}
+	return order, nil
+}
+
+// newOrderRequest は注文する明細を検証する。クーポンの確認や配送料の見積もりでも同じ検証を使う
+func newOrderRequest(reqLines []request.PlaceOrderLine) (*domain.OrderRequest, error) {
+	lines := make([]domain.OrderRequestLine, 0, len(reqLines))
+	for _, reqLine := range reqLines {
+		itemId, err := domain.NewItemId(reqLine.ItemId)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+		}
+		line, err := domain.NewOrderRequestLine(*itemId, reqLine.VariantId, reqLine.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidOrder, err)
+		}
+		lines = append(lines, *line)
+	}
+	orderRequest, err := domain.NewOrderRequest(lines)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOrder, err)
+	}
+	return orderRequest, nil
+}
+
+// priceOrderLines は商品の現在の価格で明細を作る。在庫は引き当てない
+func priceOrderLines(ir repository.IItemRepository, orderRequest *domain.OrderRequest) ([]domain.OrderLine, error) {
+	itemIds := make([]*domain.ItemId, 0, len(orderRequest.ItemIds()))
+	for _, id := range orderRequest.ItemIds() {
+		itemId, err := domain.NewItemId(id)
+		if err != nil {
+			return nil, err
+		}
+		itemIds = append(itemIds, itemId)
+	}
+	items, err := ir.GetItemsByIDs(itemIds)
+	if err != nil {
+		return nil, err
+	}
+	itemsById := make(map[string]*domain.Item, len(items))
+	for i := range items {
+		itemsById[items[i].ItemId()] = &items[i]
+	}
+
+	lines := make([]domain.OrderLine, 0, len(orderRequest.Lines()))
+	for _, reqLine := range orderRequest.Lines() {
+		item, ok := itemsById[reqLine.ItemId()]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrItemNotFound, reqLine.ItemId())
+		}
+		line, err := domain.PriceOrderLine(item, reqLine)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrOrderItemUnavailable):
+				return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+			case errors.Is(err, domain.ErrInvalidOrderLine):
+				return nil, fmt.Errorf("%w: %v", ErrInvalidOrder, err)
+			}
+			return nil, err
+		}
+		lines = append(lines, *line)
+	}
+	return lines, nil
+}
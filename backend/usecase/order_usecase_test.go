@@ -0,0 +1,342 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockOrderRepository struct {
+	mock.Mock
+}
+
+func (m *MockOrderRepository) PlaceOrder(userId *domain.UserId, req *domain.OrderRequest) (*domain.Order, error) {
+	args := m.Called(userId, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetOrderByID(orderId string) (*domain.Order, error) {
+	args := m.Called(orderId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) TransitionOrder(orderId string, to domain.OrderStatus, actor *domain.UserId) (*domain.Order, error) {
+	args := m.Called(orderId, to, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetOrdersByUserID(userId *domain.UserId) ([]*domain.Order, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Order), args.Error(1)
+}
+
+const orderTestUserId = "f47ac10b-58cc-4372-a567-0e02b2c3d900"
+const orderTestItemId = "f47ac10b-58cc-4372-a567-0e02b2c3d901"
+const orderTestOrderId = "f47ac10b-58cc-4372-a567-0e02b2c3d902"
+
+func createTestOrder(userId string) *domain.Order {
+	userIdDomain, _ := domain.NewUserId(userId)
+	unitPrice, _ := domain.NewMoneyFromString("1200", domain.CurrencyJPY)
+	line := domain.RestoreOrderLine("line-1", orderTestItemId, "", "Hand-knit sweater", "", nil, *unitPrice, domain.TaxRateStandard, 2)
+	subtotal, _ := domain.NewMoneyFromString("2400", domain.CurrencyJPY)
+	now := time.Now()
+	return domain.RestoreOrder(orderTestOrderId, *userIdDomain, domain.OrderStatusPendingPayment, []domain.OrderLine{*line}, *subtotal, domain.ZeroYen(), *subtotal.TaxAmount(), *subtotal.TaxIncluded(), "", nil, now, now)
+}
+
+func newPlaceOrderRequest(lines ...request.PlaceOrderLine) request.PlaceOrderRequest {
+	return request.PlaceOrderRequest{UserId: orderTestUserId, Lines: lines}
+}
+
+func TestPlaceOrder_Success(t *testing.T) {
+	mockOrderRepo := new(MockOrderRepository)
+	uc := NewOrderUsecase(mockOrderRepo)
+	expected := createTestOrder(orderTestUserId)
+	mockOrderRepo.On("PlaceOrder", mock.MatchedBy(func(userId *domain.UserId) bool {
+		return userId.Value() == orderTestUserId
+	}), mock.MatchedBy(func(req *domain.OrderRequest) bool {
+		lines := req.Lines()
+		return len(lines) == 1 && lines[0].ItemId() == orderTestItemId && lines[0].Quantity() == 2
+	})).Return(expected, nil)
+
+	order, err := uc.PlaceOrder(newPlaceOrderRequest(request.PlaceOrderLine{ItemId: orderTestItemId, Quantity: 2}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, order)
+	mockOrderRepo.AssertExpectations(t)
+}
+
+func TestPlaceOrder_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		lines    []request.PlaceOrderLine
+		expected error
+	}{
+		{name: "no lines", lines: nil, expected: ErrInvalidOrder},
+		{name: "zero quantity", lines: []request.PlaceOrderLine{{ItemId: orderTestItemId, Quantity: 0}}, expected: ErrInvalidOrder},
+		{name: "quantity over limit", lines: []request.PlaceOrderLine{{ItemId: orderTestItemId, Quantity: domain.MaxOrderLineQuantity + 1}}, expected: ErrInvalidOrder},
+		{name: "duplicate lines", lines: []request.PlaceOrderLine{{ItemId: orderTestItemId, Quantity: 1}, {ItemId: orderTestItemId, Quantity: 1}}, expected: ErrInvalidOrder},
+		{name: "malformed item id", lines: []request.PlaceOrderLine{{ItemId: "invalid", Quantity: 1}}, expected: ErrItemNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrderRepo := new(MockOrderRepository)
+			uc := NewOrderUsecase(mockOrderRepo)
+
+			_, err := uc.PlaceOrder(newPlaceOrderRequest(tt.lines...))
+
+			assert.True(t, errors.Is(err, tt.expected))
+			mockOrderRepo.AssertNotCalled(t, "PlaceOrder", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPlaceOrder_RepositoryErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		repoErr  error
+		expected error
+	}{
+		{name: "insufficient stock", repoErr: fmt.Errorf("item x: %w", domain.ErrInsufficientStock), expected: ErrInsufficientStock},
+		{name: "item unavailable", repoErr: fmt.Errorf("%w: item x", domain.ErrOrderItemUnavailable), expected: ErrItemNotFound},
+		{name: "variant required", repoErr: fmt.Errorf("%w: variant_id is required", domain.ErrInvalidOrderLine), expected: ErrInvalidOrder},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrderRepo := new(MockOrderRepository)
+			uc := NewOrderUsecase(mockOrderRepo)
+			mockOrderRepo.On("PlaceOrder", mock.Anything, mock.Anything).Return(nil, tt.repoErr)
+
+			_, err := uc.PlaceOrder(newPlaceOrderRequest(request.PlaceOrderLine{ItemId: orderTestItemId, Quantity: 1}))
+
+			assert.True(t, errors.Is(err, tt.expected))
+		})
+	}
+}
+
+func TestGetOrder(t *testing.T) {
+	t.Run("Own Order", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder(orderTestUserId), nil)
+
+		order, err := uc.GetOrder(orderTestUserId, orderTestOrderId)
+
+		assert.NoError(t, err)
+		assert.Equal(t, orderTestOrderId, order.OrderId())
+	})
+
+	t.Run("Another User's Order Is Not Found", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder("f47ac10b-58cc-4372-a567-0e02b2c3d999"), nil)
+
+		_, err := uc.GetOrder(orderTestUserId, orderTestOrderId)
+
+		assert.True(t, errors.Is(err, ErrOrderNotFound))
+	})
+
+	t.Run("Missing Order", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := uc.GetOrder(orderTestUserId, orderTestOrderId)
+
+		assert.True(t, errors.Is(err, ErrOrderNotFound))
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+		dbErr := errors.New("connection refused")
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(nil, dbErr)
+
+		_, err := uc.GetOrder(orderTestUserId, orderTestOrderId)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.False(t, errors.Is(err, ErrOrderNotFound))
+	})
+}
+
+func TestGetOrders(t *testing.T) {
+	mockOrderRepo := new(MockOrderRepository)
+	uc := NewOrderUsecase(mockOrderRepo)
+	userId, _ := domain.NewUserId(orderTestUserId)
+	mockOrderRepo.On("GetOrdersByUserID", userId).Return([]*domain.Order{createTestOrder(orderTestUserId)}, nil)
+
+	orders, err := uc.GetOrders(orderTestUserId)
+
+	assert.NoError(t, err)
+	assert.Len(t, orders, 1)
+}
+
+const orderTestAdminId = "f47ac10b-58cc-4372-a567-0e02b2c3d903"
+
+func TestCancelOrder(t *testing.T) {
+	t.Run("Own Order", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+		userId, _ := domain.NewUserId(orderTestUserId)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder(orderTestUserId), nil)
+		mockOrderRepo.On("TransitionOrder", orderTestOrderId, domain.OrderStatusCancelled, userId).Return(createTestOrder(orderTestUserId), nil)
+
+		_, err := uc.CancelOrder(orderTestUserId, orderTestOrderId)
+
+		assert.NoError(t, err)
+		mockOrderRepo.AssertExpectations(t)
+	})
+
+	t.Run("Another User's Order Is Not Found", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder("f47ac10b-58cc-4372-a567-0e02b2c3d999"), nil)
+
+		_, err := uc.CancelOrder(orderTestUserId, orderTestOrderId)
+
+		assert.True(t, errors.Is(err, ErrOrderNotFound))
+		mockOrderRepo.AssertNotCalled(t, "TransitionOrder", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("After Shipment", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder(orderTestUserId), nil)
+		mockOrderRepo.On("TransitionOrder", orderTestOrderId, domain.OrderStatusCancelled, mock.Anything).
+			Return(nil, &domain.IllegalOrderTransitionError{From: domain.OrderStatusShipped, To: domain.OrderStatusCancelled})
+
+		_, err := uc.CancelOrder(orderTestUserId, orderTestOrderId)
+
+		assert.True(t, errors.Is(err, ErrIllegalOrderTransition))
+	})
+}
+
+func TestTransitionOrder(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+		adminId, _ := domain.NewUserId(orderTestAdminId)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder(orderTestUserId), nil)
+		mockOrderRepo.On("TransitionOrder", orderTestOrderId, domain.OrderStatusPaid, adminId).Return(createTestOrder(orderTestUserId), nil)
+
+		_, err := uc.TransitionOrder(request.TransitionOrderRequest{OrderId: orderTestOrderId, Status: "paid", UserId: orderTestAdminId})
+
+		assert.NoError(t, err)
+		mockOrderRepo.AssertExpectations(t)
+	})
+
+	t.Run("Unknown Status", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+
+		_, err := uc.TransitionOrder(request.TransitionOrderRequest{OrderId: orderTestOrderId, Status: "lost", UserId: orderTestAdminId})
+
+		assert.True(t, errors.Is(err, ErrInvalidOrderStatus))
+		mockOrderRepo.AssertNotCalled(t, "TransitionOrder", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Missing Order", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := uc.TransitionOrder(request.TransitionOrderRequest{OrderId: orderTestOrderId, Status: "paid", UserId: orderTestAdminId})
+
+		assert.True(t, errors.Is(err, ErrOrderNotFound))
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+		dbErr := errors.New("connection refused")
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(nil, dbErr)
+
+		_, err := uc.TransitionOrder(request.TransitionOrderRequest{OrderId: orderTestOrderId, Status: "paid", UserId: orderTestAdminId})
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.False(t, errors.Is(err, ErrOrderNotFound))
+		mockOrderRepo.AssertNotCalled(t, "TransitionOrder", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Illegal Transition", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder(orderTestUserId), nil)
+		mockOrderRepo.On("TransitionOrder", orderTestOrderId, domain.OrderStatusDelivered, mock.Anything).
+			Return(nil, &domain.IllegalOrderTransitionError{From: domain.OrderStatusPendingPayment, To: domain.OrderStatusDelivered})
+
+		_, err := uc.TransitionOrder(request.TransitionOrderRequest{OrderId: orderTestOrderId, Status: "delivered", UserId: orderTestAdminId})
+
+		assert.True(t, errors.Is(err, ErrIllegalOrderTransition))
+	})
+}
+
+func TestPlaceOrder_WithCoupon(t *testing.T) {
+	mockOrderRepo := new(MockOrderRepository)
+	uc := NewOrderUsecase(mockOrderRepo)
+	expected := createTestOrder(orderTestUserId)
+	mockOrderRepo.On("PlaceOrder", mock.Anything, mock.MatchedBy(func(req *domain.OrderRequest) bool {
+		return req.CouponCode() == "WELCOME10"
+	})).Return(expected, nil)
+
+	req := newPlaceOrderRequest(request.PlaceOrderLine{ItemId: orderTestItemId, Quantity: 2})
+	req.CouponCode = " welcome10 "
+	_, err := uc.PlaceOrder(req)
+
+	assert.NoError(t, err)
+	mockOrderRepo.AssertExpectations(t)
+}
+
+func TestPlaceOrder_CouponErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		repoErr  error
+		expected error
+	}{
+		{name: "unknown code", repoErr: fmt.Errorf("%w: WELCOME10", domain.ErrCouponNotFound), expected: ErrCouponNotFound},
+		{name: "usage limit reached", repoErr: fmt.Errorf("%w: coupon has reached its usage limit", domain.ErrCouponNotApplicable), expected: ErrCouponNotApplicable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrderRepo := new(MockOrderRepository)
+			uc := NewOrderUsecase(mockOrderRepo)
+			mockOrderRepo.On("PlaceOrder", mock.Anything, mock.Anything).Return(nil, tt.repoErr)
+
+			req := newPlaceOrderRequest(request.PlaceOrderLine{ItemId: orderTestItemId, Quantity: 1})
+			req.CouponCode = "WELCOME10"
+			_, err := uc.PlaceOrder(req)
+
+			assert.True(t, errors.Is(err, tt.expected))
+		})
+	}
+
+	t.Run("malformed code", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewOrderUsecase(mockOrderRepo)
+
+		req := newPlaceOrderRequest(request.PlaceOrderLine{ItemId: orderTestItemId, Quantity: 1})
+		req.CouponCode = "no spaces allowed"
+		_, err := uc.PlaceOrder(req)
+
+		assert.True(t, errors.Is(err, ErrCouponNotFound))
+		mockOrderRepo.AssertNotCalled(t, "PlaceOrder", mock.Anything, mock.Anything)
+	})
+}
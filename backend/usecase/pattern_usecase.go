@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IPatternUsecase interface {
+	GetPatterns() ([]*domain.Pattern, error)
+	GetPattern(patternId string, sizeLabel string) (*domain.PatternGuide, error)
+	CreatePattern(req request.CreatePatternRequest) (*domain.Pattern, error)
+	UpdatePattern(req request.UpdatePatternRequest) (*domain.Pattern, error)
+	DeletePattern(patternId string) error
+	SetItemYarn(req request.SetItemYarnRequest) (*domain.Item, error)
+}
+
+type patternUsecase struct {
+	pr repository.IPatternRepository
+	ir repository.IItemRepository
+}
+
+func NewPatternUsecase(pr repository.IPatternRepository, ir repository.IItemRepository) IPatternUsecase {
+	return &patternUsecase{pr, ir}
+}
+
+// GetPatterns は編み図を名前順に返す
+func (pu *patternUsecase) GetPatterns() ([]*domain.Pattern, error) {
+	return pu.pr.GetPatterns()
+}
+
+// GetPattern は編み図と使える商品を返す。sizeLabel を指定すると商品ごとに必要な玉数を計算できる
+// 使える商品のうち削除されたものは返さない
+func (pu *patternUsecase) GetPattern(patternId string, sizeLabel string) (*domain.PatternGuide, error) {
+	pattern, err := pu.pr.GetPatternByID(patternId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPatternNotFound, err)
+	}
+
+	itemIds := make([]*domain.ItemId, 0, len(pattern.ItemIds()))
+	for _, value := range pattern.ItemIds() {
+		itemId, err := domain.NewItemId(value)
+		if err != nil {
+			return nil, err
+		}
+		itemIds = append(itemIds, itemId)
+	}
+	items, err := pu.ir.GetItemsByIDs(itemIds)
+	if err != nil {
+		return nil, err
+	}
+
+	guide, err := domain.NewPatternGuide(pattern, items, sizeLabel)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnknownPatternSize) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPatternSize, err)
+		}
+		return nil, err
+	}
+	return guide, nil
+}
+
+func (pu *patternUsecase) CreatePattern(req request.CreatePatternRequest) (*domain.Pattern, error) {
+	details, err := pu.newPatternDetails(patternDetailsRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := domain.NewPattern(*details)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+	}
+	return pu.pr.CreatePattern(pattern)
+}
+
+func (pu *patternUsecase) UpdatePattern(req request.UpdatePatternRequest) (*domain.Pattern, error) {
+	existing, err := pu.pr.GetPatternByID(req.PatternId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPatternNotFound, err)
+	}
+	details, err := pu.newPatternDetails(patternDetailsRequest{
+		Name:          req.Name,
+		Description:   req.Description,
+		Difficulty:    req.Difficulty,
+		GaugeStitches: req.GaugeStitches,
+		GaugeRows:     req.GaugeRows,
+		NeedleSizes:   req.NeedleSizes,
+		YarnWeight:    req.YarnWeight,
+		Sizes:         req.Sizes,
+		ItemIds:       req.ItemIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := existing.Edit(*details)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+	}
+
+	updated, err := pu.pr.UpdatePattern(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPatternNotFound, err)
+	}
+	return updated, nil
+}
+
+// DeletePattern は編み図を削除する。編み図を使っている作品は結び付けを外して残す
+func (pu *patternUsecase) DeletePattern(patternId string) error {
+	if err := pu.pr.DeletePattern(patternId); err != nil {
+		return fmt.Errorf("%w: %v", ErrPatternNotFound, err)
+	}
+	return nil
+}
+
+// SetItemYarn は商品の毛糸の属性を置き換える。太さが空の場合は設定を外す
+func (pu *patternUsecase) SetItemYarn(req request.SetItemYarnRequest) (*domain.Item, error) {
+	itemId, err := domain.NewItemId(req.ItemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+
+	var yarn *domain.YarnAttributes
+	if req.Weight != "" {
+		yarn, err = newYarnAttributes(req)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidYarn, err)
+		}
+	}
+
+	if err := pu.pr.SetItemYarn(itemId, yarn); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	return pu.ir.GetItemByID(itemId)
+}
+
+// newYarnAttributes は太さ・素材の混率・針の太さの範囲・推奨ゲージを値オブジェクトにする
+// 針の太さとゲージは両方の値が 0 なら未登録として扱う
+func newYarnAttributes(req request.SetItemYarnRequest) (*domain.YarnAttributes, error) {
+	weight, err := domain.NewYarnWeight(req.Weight)
+	if err != nil {
+		return nil, err
+	}
+	details := domain.YarnDetails{Weight: weight, YardagePerSkein: req.YardagePerSkein, GramsPerSkein: req.GramsPerSkein}
+	if len(req.Fibers) > 0 {
+		contents := make([]domain.FiberContent, 0, len(req.Fibers))
+		for _, content := range req.Fibers {
+			fiber, err := domain.NewFiber(content.Fiber)
+			if err != nil {
+				return nil, err
+			}
+			contents = append(contents, domain.FiberContent{Fiber: fiber, Percentage: content.Percentage})
+		}
+		details.Fibers, err = domain.NewFiberComposition(contents)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.NeedleMin != 0 || req.NeedleMax != 0 {
+		minNeedle, err := domain.NewNeedleSize(req.NeedleMin)
+		if err != nil {
+			return nil, err
+		}
+		maxNeedle, err := domain.NewNeedleSize(req.NeedleMax)
+		if err != nil {
+			return nil, err
+		}
+		details.NeedleRange, err = domain.NewNeedleRange(*minNeedle, *maxNeedle)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.GaugeStitches != 0 || req.GaugeRows != 0 {
+		details.Gauge, err = domain.NewGauge(req.GaugeStitches, req.GaugeRows)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return domain.NewYarnAttributes(details)
+}
+
+// patternDetailsRequest は作成と更新で共通の編み図の項目
+type patternDetailsRequest request.CreatePatternRequest
+
+// newPatternDetails は難易度・ゲージ・針の太さ・糸の太さを値オブジェクトにし、使える商品の存在を確かめる
+// 名前・説明・仕上がりサイズは domain.NewPattern で検証する
+func (pu *patternUsecase) newPatternDetails(req patternDetailsRequest) (*domain.PatternDetails, error) {
+	difficulty, err := domain.NewPatternDifficulty(req.Difficulty)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+	}
+	gauge, err := domain.NewGauge(req.GaugeStitches, req.GaugeRows)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+	}
+	needles := make([]domain.NeedleSize, 0, len(req.NeedleSizes))
+	for _, value := range req.NeedleSizes {
+		needle, err := domain.NewNeedleSize(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+		}
+		needles = append(needles, *needle)
+	}
+	needleSizes, err := domain.NewNeedleSizes(needles)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+	}
+	yarnWeight, err := domain.NewYarnWeight(req.YarnWeight)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+	}
+	sizes := make([]domain.PatternSize, len(req.Sizes))
+	for i, size := range req.Sizes {
+		sizes[i] = domain.PatternSize{Label: size.Label, FinishedMeasurements: size.FinishedMeasurements, Yardage: size.Yardage}
+	}
+	itemIds, err := pu.checkPatternItems(req.ItemIds)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.PatternDetails{
+		Name:        req.Name,
+		Description: req.Description,
+		Difficulty:  difficulty,
+		Gauge:       *gauge,
+		NeedleSizes: needleSizes,
+		YarnWeight:  yarnWeight,
+		Sizes:       sizes,
+		ItemIds:     itemIds,
+	}, nil
+}
+
+// checkPatternItems は使える商品の ID を重複なく検証する
+func (pu *patternUsecase) checkPatternItems(values []string) ([]domain.ItemId, error) {
+	seen := make(map[string]bool, len(values))
+	itemIds := make([]*domain.ItemId, 0, len(values))
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		itemId, err := domain.NewItemId(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+		}
+		itemIds = append(itemIds, itemId)
+	}
+	if len(itemIds) == 0 {
+		return nil, nil
+	}
+
+	items, err := pu.ir.GetItemsByIDs(itemIds)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool, len(items))
+	for _, item := range items {
+		found[item.ItemId()] = true
+	}
+	result := make([]domain.ItemId, 0, len(itemIds))
+	for _, itemId := range itemIds {
+		if !found[itemId.Value()] {
+			return nil, fmt.Errorf("%w: item %s not found", ErrInvalidPattern, itemId.Value())
+		}
+		result = append(result, *itemId)
+	}
+	return result, nil
+}
@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockPatternRepository struct {
+	mock.Mock
+}
+
+func (m *MockPatternRepository) GetPatterns() ([]*domain.Pattern, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Pattern), args.Error(1)
+}
+
+func (m *MockPatternRepository) GetPatternByID(patternId string) (*domain.Pattern, error) {
+	args := m.Called(patternId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Pattern), args.Error(1)
+}
+
+func (m *MockPatternRepository) CreatePattern(pattern *domain.Pattern) (*domain.Pattern, error) {
+	args := m.Called(pattern)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Pattern), args.Error(1)
+}
+
+func (m *MockPatternRepository) UpdatePattern(pattern *domain.Pattern) (*domain.Pattern, error) {
+	args := m.Called(pattern)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Pattern), args.Error(1)
+}
+
+func (m *MockPatternRepository) DeletePattern(patternId string) error {
+	args := m.Called(patternId)
+	return args.Error(0)
+}
+
+func (m *MockPatternRepository) SetItemYarn(itemId *domain.ItemId, yarn *domain.YarnAttributes) error {
+	args := m.Called(itemId, yarn)
+	return args.Error(0)
+}
+
+const patternTestPatternId = "pattern-1"
+
+func createTestPattern(t *testing.T) *domain.Pattern {
+	t.Helper()
+	gauge, _ := domain.NewGauge(22, 30)
+	needles, _ := domain.ParseNeedleSizes("4;4.5")
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	return domain.RestorePattern(patternTestPatternId, domain.PatternDetails{
+		Name:        "ラグランセーター",
+		Difficulty:  domain.PatternDifficultyEasy,
+		Gauge:       *gauge,
+		NeedleSizes: needles,
+		YarnWeight:  domain.YarnWeightMedium,
+		Sizes:       []domain.PatternSize{{Label: "S", Yardage: 950}, {Label: "M", Yardage: 1100}},
+		ItemIds:     []domain.ItemId{*itemId},
+	}, time.Now(), time.Now())
+}
+
+func newTestPatternRequest() request.CreatePatternRequest {
+	return request.CreatePatternRequest{
+		Name:          "ラグランセーター",
+		Difficulty:    "easy",
+		GaugeStitches: 22,
+		GaugeRows:     30,
+		NeedleSizes:   []float64{4.5, 4},
+		YarnWeight:    "medium",
+		Sizes:         []request.PatternSizeRequest{{Label: "S", Yardage: 950}, {Label: "M", Yardage: 1100}},
+		ItemIds:       []string{cartTestItemId},
+	}
+}
+
+func TestPatternUsecase_GetPattern(t *testing.T) {
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	yarn, _ := domain.NewYarnAttributes(domain.YarnDetails{Weight: domain.YarnWeightMedium, YardagePerSkein: 120})
+	yarnItem := createCartTestItem(3).WithYarn(yarn)
+
+	t.Run("Estimates Skeins For Size", func(t *testing.T) {
+		mockRepo := new(MockPatternRepository)
+		mockItemRepo := new(MockItemRepository)
+		mockRepo.On("GetPatternByID", patternTestPatternId).Return(createTestPattern(t), nil)
+		mockItemRepo.On("GetItemsByIDs", []*domain.ItemId{itemId}).Return(domain.Items{*yarnItem}, nil)
+
+		guide, err := NewPatternUsecase(mockRepo, mockItemRepo).GetPattern(patternTestPatternId, "M")
+
+		assert.NoError(t, err)
+		items := guide.Items()
+		assert.Len(t, items, 1)
+		skeins, ok := guide.Skeins(&items[0])
+		assert.True(t, ok)
+		assert.Equal(t, 10, skeins)
+	})
+
+	t.Run("Unknown Size", func(t *testing.T) {
+		mockRepo := new(MockPatternRepository)
+		mockItemRepo := new(MockItemRepository)
+		mockRepo.On("GetPatternByID", patternTestPatternId).Return(createTestPattern(t), nil)
+		mockItemRepo.On("GetItemsByIDs", []*domain.ItemId{itemId}).Return(domain.Items{*yarnItem}, nil)
+
+		_, err := NewPatternUsecase(mockRepo, mockItemRepo).GetPattern(patternTestPatternId, "XL")
+
+		assert.True(t, errors.Is(err, ErrInvalidPatternSize))
+	})
+
+	t.Run("Pattern Not Found", func(t *testing.T) {
+		mockRepo := new(MockPatternRepository)
+		mockRepo.On("GetPatternByID", patternTestPatternId).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := NewPatternUsecase(mockRepo, new(MockItemRepository)).GetPattern(patternTestPatternId, "")
+
+		assert.True(t, errors.Is(err, ErrPatternNotFound))
+	})
+}
+
+func TestPatternUsecase_CreatePattern(t *testing.T) {
+	itemId, _ := domain.NewItemId(cartTestItemId)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockPatternRepository)
+		mockItemRepo := new(MockItemRepository)
+		mockItemRepo.On("GetItemsByIDs", []*domain.ItemId{itemId}).Return(domain.Items{*createCartTestItem(3)}, nil)
+		mockRepo.On("CreatePattern", mock.MatchedBy(func(p *domain.Pattern) bool {
+			return p.NeedleSizes().Key() == "4;4.5" && len(p.Sizes()) == 2 && len(p.ItemIds()) == 1
+		})).Return(createTestPattern(t), nil)
+
+		_, err := NewPatternUsecase(mockRepo, mockItemRepo).CreatePattern(newTestPatternRequest())
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Linked Item Not Found", func(t *testing.T) {
+		mockRepo := new(MockPatternRepository)
+		mockItemRepo := new(MockItemRepository)
+		mockItemRepo.On("GetItemsByIDs", []*domain.ItemId{itemId}).Return(domain.Items{}, nil)
+
+		_, err := NewPatternUsecase(mockRepo, mockItemRepo).CreatePattern(newTestPatternRequest())
+
+		assert.True(t, errors.Is(err, ErrInvalidPattern))
+		mockRepo.AssertNotCalled(t, "CreatePattern", mock.Anything)
+	})
+
+	tests := []struct {
+		name   string
+		modify func(req *request.CreatePatternRequest)
+	}{
+		{"Unknown Difficulty", func(req *request.CreatePatternRequest) { req.Difficulty = "expert" }},
+		{"Invalid Gauge", func(req *request.CreatePatternRequest) { req.GaugeRows = 0 }},
+		{"Invalid Needle Size", func(req *request.CreatePatternRequest) { req.NeedleSizes = []float64{4.1} }},
+		{"Unknown Yarn Weight", func(req *request.CreatePatternRequest) { req.YarnWeight = "worsted" }},
+		{"Without Sizes", func(req *request.CreatePatternRequest) { req.Sizes = nil; req.ItemIds = nil }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockPatternRepository)
+			req := newTestPatternRequest()
+			tt.modify(&req)
+
+			_, err := NewPatternUsecase(mockRepo, new(MockItemRepository)).CreatePattern(req)
+
+			assert.True(t, errors.Is(err, ErrInvalidPattern))
+			mockRepo.AssertNotCalled(t, "CreatePattern", mock.Anything)
+		})
+	}
+}
+
+func TestPatternUsecase_SetItemYarn(t *testing.T) {
+	itemId, _ := domain.NewItemId(cartTestItemId)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockPatternRepository)
+		mockItemRepo := new(MockItemRepository)
+		mockRepo.On("SetItemYarn", itemId, mock.MatchedBy(func(y *domain.YarnAttributes) bool {
+			return y.Weight() == domain.YarnWeightMedium && y.YardagePerSkein() == 120
+		})).Return(nil)
+		mockItemRepo.On("GetItemByID", itemId).Return(createCartTestItem(3), nil)
+
+		_, err := NewPatternUsecase(mockRepo, mockItemRepo).SetItemYarn(request.SetItemYarnRequest{ItemId: cartTestItemId, Weight: "medium", YardagePerSkein: 120})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Clears Without Weight", func(t *testing.T) {
+		mockRepo := new(MockPatternRepository)
+		mockItemRepo := new(MockItemRepository)
+		mockRepo.On("SetItemYarn", itemId, (*domain.YarnAttributes)(nil)).Return(nil)
+		mockItemRepo.On("GetItemByID", itemId).Return(createCartTestItem(3), nil)
+
+		_, err := NewPatternUsecase(mockRepo, mockItemRepo).SetItemYarn(request.SetItemYarnRequest{ItemId: cartTestItemId})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success With Details", func(t *testing.T) {
+		mockRepo := new(MockPatternRepository)
+		mockItemRepo := new(MockItemRepository)
+		mockRepo.On("SetItemYarn", itemId, mock.MatchedBy(func(y *domain.YarnAttributes) bool {
+			return y.GramsPerSkein() == 50 &&
+				len(y.Fibers()) == 2 && y.Fibers()[0].Fiber == domain.FiberMerino &&
+				y.NeedleRange().Min().Millimeters() == 3.5 && y.NeedleRange().Max().Millimeters() == 4.5 &&
+				y.Gauge().Stitches() == 22 && y.Gauge().Rows() == 30
+		})).Return(nil)
+		mockItemRepo.On("GetItemByID", itemId).Return(createCartTestItem(3), nil)
+
+		_, err := NewPatternUsecase(mockRepo, mockItemRepo).SetItemYarn(request.SetItemYarnRequest{
+			ItemId:          cartTestItemId,
+			Weight:          "light",
+			YardagePerSkein: 200,
+			GramsPerSkein:   50,
+			Fibers:          []request.FiberContentRequest{{Fiber: "silk", Percentage: 30}, {Fiber: "merino", Percentage: 70}},
+			NeedleMin:       3.5,
+			NeedleMax:       4.5,
+			GaugeStitches:   22,
+			GaugeRows:       30,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name string
+		req  request.SetItemYarnRequest
+	}{
+		{"Invalid Yardage", request.SetItemYarnRequest{Weight: "medium"}},
+		{"Unknown Weight", request.SetItemYarnRequest{Weight: "worsted", YardagePerSkein: 120}},
+		{"Fibers Not Totaling 100", request.SetItemYarnRequest{Weight: "medium", YardagePerSkein: 120, Fibers: []request.FiberContentRequest{{Fiber: "wool", Percentage: 80}}}},
+		{"Unknown Fiber", request.SetItemYarnRequest{Weight: "medium", YardagePerSkein: 120, Fibers: []request.FiberContentRequest{{Fiber: "yak", Percentage: 100}}}},
+		{"Needle Range Without Maximum", request.SetItemYarnRequest{Weight: "medium", YardagePerSkein: 120, NeedleMin: 4}},
+		{"Inverted Needle Range", request.SetItemYarnRequest{Weight: "medium", YardagePerSkein: 120, NeedleMin: 5, NeedleMax: 4}},
+		{"Gauge Without Rows", request.SetItemYarnRequest{Weight: "medium", YardagePerSkein: 120, GaugeStitches: 22}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockPatternRepository)
+			tt.req.ItemId = cartTestItemId
+
+			_, err := NewPatternUsecase(mockRepo, new(MockItemRepository)).SetItemYarn(tt.req)
+
+			assert.True(t, errors.Is(err, ErrInvalidYarn))
+			mockRepo.AssertNotCalled(t, "SetItemYarn", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// PaymentAuthorizationStatus は決済代行サービスでのオーソリ（与信枠の確保）の結果
+type PaymentAuthorizationStatus string
+
+const (
+	PaymentAuthorizationAuthorized PaymentAuthorizationStatus = "authorized"
+	PaymentAuthorizationDeclined   PaymentAuthorizationStatus = "declined"
+	// PaymentAuthorizationRequiresAction は 3D セキュアなどの本人確認が必要な状態。結果は Webhook で届く
+	PaymentAuthorizationRequiresAction PaymentAuthorizationStatus = "requires_action"
+)
+
+// PaymentEventType は決済代行サービスの Webhook で届くイベントの種類
+type PaymentEventType string
+
+const (
+	PaymentEventAuthorized PaymentEventType = "payment.authorized"
+	PaymentEventDeclined   PaymentEventType = "payment.declined"
+	PaymentEventCaptured   PaymentEventType = "payment.captured"
+	PaymentEventRefunded   PaymentEventType = "payment.refunded"
+)
+
+// PaymentAuthorizeRequest の IdempotencyKey が同じリクエストは、何度送っても同じ決済として扱われる
+// Token はフロントエンドが決済代行サービスから受け取った支払い方法のトークン
+type PaymentAuthorizeRequest struct {
+	IdempotencyKey string
+	Amount         decimal.Decimal
+	Currency       string
+	Token          string
+}
+
+// PaymentAuthorizeResult の DeclineCode は拒否された理由。拒否された場合だけ設定される
+type PaymentAuthorizeResult struct {
+	ProviderPaymentId string
+	Status            PaymentAuthorizationStatus
+	DeclineCode       string
+}
+
+// PaymentWebhookEvent は署名を検証した Webhook の内容。EventId は再送されても変わらない
+type PaymentWebhookEvent struct {
+	EventId           string
+	Type              PaymentEventType
+	ProviderPaymentId string
+}
+
+// PaymentGateway は決済代行サービスとのやり取り
+// Capture と Refund は既に売上確定・返金済みの決済に対してはエラーにしない
+type PaymentGateway interface {
+	Authorize(req PaymentAuthorizeRequest) (*PaymentAuthorizeResult, error)
+	Capture(providerPaymentId string) error
+	Refund(providerPaymentId string) error
+	VerifyWebhook(payload []byte, signature string) (*PaymentWebhookEvent, error)
+}
+
+type IPaymentUsecase interface {
+	PayOrder(req request.PayOrderRequest) (*domain.Payment, error)
+	GetPayment(userId string, orderId string) (*domain.Payment, error)
+	HandleWebhook(payload []byte, signature string) error
+	RefundOrder(req request.RefundOrderRequest) (*domain.Payment, error)
+}
+
+type paymentUsecase struct {
+	pr repository.IPaymentRepository
+	or repository.IOrderRepository
+	gw PaymentGateway
+}
+
+func NewPaymentUsecase(pr repository.IPaymentRepository, or repository.IOrderRepository, gw PaymentGateway) IPaymentUsecase {
+	return &paymentUsecase{pr, or, gw}
+}
+
+// webhookPaymentStatuses は Webhook のイベントごとに反映する決済の状態
+var webhookPaymentStatuses = map[PaymentEventType]domain.PaymentStatus{
+	PaymentEventAuthorized: domain.PaymentStatusAuthorized,
+	PaymentEventDeclined:   domain.PaymentStatusDeclined,
+	PaymentEventCaptured:   domain.PaymentStatusCaptured,
+	PaymentEventRefunded:   domain.PaymentStatusRefunded,
+}
+
+var authorizationPaymentStatuses = map[PaymentAuthorizationStatus]domain.PaymentStatus{
+	PaymentAuthorizationAuthorized:     domain.PaymentStatusAuthorized,
+	PaymentAuthorizationDeclined:       domain.PaymentStatusDeclined,
+	PaymentAuthorizationRequiresAction: domain.PaymentStatusRequiresAction,
+}
+
+// PayOrder は注文の税込合計をオーソリし、オーソリできた場合はそのまま売上を確定して注文を支払い済みにする
+// 本人確認が必要な場合は requires_action の決済を返し、結果は Webhook で受け取る
+func (pu *paymentUsecase) PayOrder(req request.PayOrderRequest) (*domain.Payment, error) {
+	order, err := pu.or.GetOrderByID(req.OrderId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrOrderNotFound, err)
+		}
+		return nil, err
+	}
+	if !order.IsPlacedBy(req.UserId) {
+		return nil, fmt.Errorf("%w: %s", ErrOrderNotFound, req.OrderId)
+	}
+	p, err := domain.NewPayment(order)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOrderNotPayable, err)
+	}
+	if err := pu.pr.CreatePayment(p); err != nil {
+		if errors.Is(err, domain.ErrOrderNotPayable) {
+			return nil, fmt.Errorf("%w: %v", ErrOrderNotPayable, err)
+		}
+		return nil, err
+	}
+
+	result, err := pu.gw.Authorize(PaymentAuthorizeRequest{
+		IdempotencyKey: p.PaymentId(),
+		Amount:         p.Amount().Amount(),
+		Currency:       p.Amount().Currency(),
+		Token:          req.PaymentToken,
+	})
+	if err != nil {
+		// 決済代行サービスに届かなかった決済は拒否として記録し、改めて支払えるようにする
+		if _, recordErr := pu.pr.RecordAuthorization(p.PaymentId(), "", domain.PaymentStatusDeclined); recordErr != nil {
+			log.Printf("failed to record failed authorization of payment %s: %v", p.PaymentId(), recordErr)
+		}
+		return nil, err
+	}
+	status, ok := authorizationPaymentStatuses[result.Status]
+	if !ok {
+		return nil, fmt.Errorf("unknown authorization status: %s", result.Status)
+	}
+	p, err = pu.pr.RecordAuthorization(p.PaymentId(), result.ProviderPaymentId, status)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p.Status() {
+	case domain.PaymentStatusDeclined:
+		return nil, fmt.Errorf("%w: %s", ErrPaymentDeclined, result.DeclineCode)
+	case domain.PaymentStatusAuthorized:
+		return pu.capture(p)
+	}
+	return p, nil
+}
+
+// GetPayment は注文の最も新しい決済を返す。他のユーザーの注文は見つからないものとして扱う
+func (pu *paymentUsecase) GetPayment(userId string, orderId string) (*domain.Payment, error) {
+	order, err := pu.or.GetOrderByID(orderId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrOrderNotFound, err)
+		}
+		return nil, err
+	}
+	if !order.IsPlacedBy(userId) {
+		return nil, fmt.Errorf("%w: %s", ErrOrderNotFound, orderId)
+	}
+	p, err := pu.pr.GetLatestPaymentByOrderID(orderId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrPaymentNotFound, err)
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// HandleWebhook は署名を検証した Webhook の内容を決済に反映する。同じイベントが再送されても1回だけ反映する
+// 本人確認を終えてオーソリされた決済は、ここで売上を確定する
+func (pu *paymentUsecase) HandleWebhook(payload []byte, signature string) error {
+	event, err := pu.gw.VerifyWebhook(payload, signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWebhook, err)
+	}
+	status, ok := webhookPaymentStatuses[event.Type]
+	if !ok {
+		// 扱わない種類のイベントは受け取ったことだけを返し、再送させない
+		return nil
+	}
+	p, err := pu.pr.GetPaymentByProviderID(event.ProviderPaymentId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: %v", ErrPaymentNotFound, err)
+		}
+		return err
+	}
+	p, err = pu.pr.ApplyWebhookEvent(p.PaymentId(), event.EventId, string(event.Type), status)
+	if err != nil {
+		return err
+	}
+	if p.Status() == domain.PaymentStatusAuthorized {
+		_, err = pu.capture(p)
+		return err
+	}
+	return nil
+}
+
+// RefundOrder は売上を確定した決済を返金し、注文を返金済みにする
+// 注文を返金済みにできない場合（発送済みで配達前など）は決済代行サービスに返金を依頼しない
+func (pu *paymentUsecase) RefundOrder(req request.RefundOrderRequest) (*domain.Payment, error) {
+	actor, err := domain.NewUserId(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	order, err := pu.or.GetOrderByID(req.OrderId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrOrderNotFound, err)
+		}
+		return nil, err
+	}
+	if !order.Status().CanTransitionTo(domain.OrderStatusRefunded) {
+		return nil, fmt.Errorf("%w: %v", ErrIllegalOrderTransition, &domain.IllegalOrderTransitionError{From: order.Status(), To: domain.OrderStatusRefunded})
+	}
+	p, err := pu.pr.GetLatestPaymentByOrderID(req.OrderId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrPaymentNotFound, err)
+		}
+		return nil, err
+	}
+	if p.Status() != domain.PaymentStatusCaptured {
+		return nil, fmt.Errorf("%w: payment is %s", ErrPaymentNotRefundable, p.Status())
+	}
+
+	if err := pu.gw.Refund(p.ProviderPaymentId()); err != nil {
+		return nil, err
+	}
+	p, err = pu.pr.TransitionPayment(p.PaymentId(), domain.PaymentStatusRefunded)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := pu.or.TransitionOrder(req.OrderId, domain.OrderStatusRefunded, actor); err != nil {
+		if errors.Is(err, domain.ErrIllegalOrderTransition) {
+			return nil, fmt.Errorf("%w: %v", ErrIllegalOrderTransition, err)
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// capture はオーソリ済みの決済の売上を確定する。注文はリポジトリが同じトランザクションで支払い済みにする
+func (pu *paymentUsecase) capture(p *domain.Payment) (*domain.Payment, error) {
+	if err := pu.gw.Capture(p.ProviderPaymentId()); err != nil {
+		return nil, err
+	}
+	return pu.pr.TransitionPayment(p.PaymentId(), domain.PaymentStatusCaptured)
+}
@@ -0,0 +1,409 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockPaymentRepository struct {
+	mock.Mock
+}
+
+func (m *MockPaymentRepository) CreatePayment(p *domain.Payment) error {
+	args := m.Called(p)
+	return args.Error(0)
+}
+
+func (m *MockPaymentRepository) GetLatestPaymentByOrderID(orderId string) (*domain.Payment, error) {
+	args := m.Called(orderId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) GetPaymentByProviderID(providerPaymentId string) (*domain.Payment, error) {
+	args := m.Called(providerPaymentId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) RecordAuthorization(paymentId string, providerPaymentId string, to domain.PaymentStatus) (*domain.Payment, error) {
+	args := m.Called(paymentId, providerPaymentId, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) TransitionPayment(paymentId string, to domain.PaymentStatus) (*domain.Payment, error) {
+	args := m.Called(paymentId, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) ApplyWebhookEvent(paymentId string, eventId string, eventType string, to domain.PaymentStatus) (*domain.Payment, error) {
+	args := m.Called(paymentId, eventId, eventType, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+type MockPaymentGateway struct {
+	mock.Mock
+}
+
+func (m *MockPaymentGateway) Authorize(req PaymentAuthorizeRequest) (*PaymentAuthorizeResult, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PaymentAuthorizeResult), args.Error(1)
+}
+
+func (m *MockPaymentGateway) Capture(providerPaymentId string) error {
+	args := m.Called(providerPaymentId)
+	return args.Error(0)
+}
+
+func (m *MockPaymentGateway) Refund(providerPaymentId string) error {
+	args := m.Called(providerPaymentId)
+	return args.Error(0)
+}
+
+func (m *MockPaymentGateway) VerifyWebhook(payload []byte, signature string) (*PaymentWebhookEvent, error) {
+	args := m.Called(payload, signature)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PaymentWebhookEvent), args.Error(1)
+}
+
+const (
+	paymentTestPaymentId = "f47ac10b-58cc-4372-a567-0e02b2c3d910"
+	paymentTestToken     = "tok_test"
+)
+
+func createTestPayment(providerPaymentId string, status domain.PaymentStatus) *domain.Payment {
+	amount, _ := domain.NewMoneyFromString("2640", domain.CurrencyJPY)
+	now := time.Now()
+	return domain.RestorePayment(paymentTestPaymentId, orderTestOrderId, providerPaymentId, status, *amount, now, now)
+}
+
+func createTestOrderWithStatus(status domain.OrderStatus) *domain.Order {
+	order := createTestOrder(orderTestUserId)
+	userId, _ := domain.NewUserId(orderTestUserId)
+	return domain.RestoreOrder(order.OrderId(), *userId, status, order.Lines(), *order.Subtotal(), *order.Discount(), *order.Tax(), *order.Total(), order.CouponCode(), nil, order.CreatedAt(), order.UpdatedAt())
+}
+
+func newPayOrderRequest(token string) request.PayOrderRequest {
+	return request.PayOrderRequest{UserId: orderTestUserId, OrderId: orderTestOrderId, PaymentToken: token}
+}
+
+func TestPayOrder(t *testing.T) {
+	t.Run("Authorized Payment Is Captured", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockOrderRepo := new(MockOrderRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, mockGateway)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder(orderTestUserId), nil)
+		mockPaymentRepo.On("CreatePayment", mock.Anything).Return(nil)
+		mockGateway.On("Authorize", mock.MatchedBy(func(req PaymentAuthorizeRequest) bool {
+			return req.Amount.String() == "2640" && req.Currency == domain.CurrencyJPY && req.Token == paymentTestToken && req.IdempotencyKey != ""
+		})).Return(&PaymentAuthorizeResult{ProviderPaymentId: "fakepay_1", Status: PaymentAuthorizationAuthorized}, nil)
+		mockPaymentRepo.On("RecordAuthorization", mock.Anything, "fakepay_1", domain.PaymentStatusAuthorized).Return(createTestPayment("fakepay_1", domain.PaymentStatusAuthorized), nil)
+		mockGateway.On("Capture", "fakepay_1").Return(nil)
+		mockPaymentRepo.On("TransitionPayment", paymentTestPaymentId, domain.PaymentStatusCaptured).Return(createTestPayment("fakepay_1", domain.PaymentStatusCaptured), nil)
+
+		p, err := uc.PayOrder(newPayOrderRequest(paymentTestToken))
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PaymentStatusCaptured, p.Status())
+		mockPaymentRepo.AssertExpectations(t)
+		mockGateway.AssertExpectations(t)
+	})
+
+	t.Run("Requires Action", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockOrderRepo := new(MockOrderRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, mockGateway)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder(orderTestUserId), nil)
+		mockPaymentRepo.On("CreatePayment", mock.Anything).Return(nil)
+		mockGateway.On("Authorize", mock.Anything).Return(&PaymentAuthorizeResult{ProviderPaymentId: "fakepay_1", Status: PaymentAuthorizationRequiresAction}, nil)
+		mockPaymentRepo.On("RecordAuthorization", mock.Anything, "fakepay_1", domain.PaymentStatusRequiresAction).Return(createTestPayment("fakepay_1", domain.PaymentStatusRequiresAction), nil)
+
+		p, err := uc.PayOrder(newPayOrderRequest(paymentTestToken))
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PaymentStatusRequiresAction, p.Status())
+		mockGateway.AssertNotCalled(t, "Capture", mock.Anything)
+	})
+
+	t.Run("Declined", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockOrderRepo := new(MockOrderRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, mockGateway)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder(orderTestUserId), nil)
+		mockPaymentRepo.On("CreatePayment", mock.Anything).Return(nil)
+		mockGateway.On("Authorize", mock.Anything).Return(&PaymentAuthorizeResult{ProviderPaymentId: "fakepay_1", Status: PaymentAuthorizationDeclined, DeclineCode: "card_declined"}, nil)
+		mockPaymentRepo.On("RecordAuthorization", mock.Anything, "fakepay_1", domain.PaymentStatusDeclined).Return(createTestPayment("fakepay_1", domain.PaymentStatusDeclined), nil)
+
+		_, err := uc.PayOrder(newPayOrderRequest(paymentTestToken))
+
+		assert.True(t, errors.Is(err, ErrPaymentDeclined))
+		assert.Contains(t, err.Error(), "card_declined")
+	})
+
+	t.Run("Provider Error Is Recorded As Declined", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockOrderRepo := new(MockOrderRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, mockGateway)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder(orderTestUserId), nil)
+		mockPaymentRepo.On("CreatePayment", mock.Anything).Return(nil)
+		mockGateway.On("Authorize", mock.Anything).Return(nil, errors.New("connection refused"))
+		mockPaymentRepo.On("RecordAuthorization", mock.Anything, "", domain.PaymentStatusDeclined).Return(createTestPayment("", domain.PaymentStatusDeclined), nil)
+
+		_, err := uc.PayOrder(newPayOrderRequest(paymentTestToken))
+
+		assert.Error(t, err)
+		mockPaymentRepo.AssertExpectations(t)
+	})
+
+	t.Run("Not Payable", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			order   *domain.Order
+			repoErr error
+		}{
+			{name: "already paid", order: createTestOrderWithStatus(domain.OrderStatusPaid)},
+			{name: "payment in progress", order: createTestOrder(orderTestUserId), repoErr: domain.ErrOrderNotPayable},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockPaymentRepo := new(MockPaymentRepository)
+				mockOrderRepo := new(MockOrderRepository)
+				mockGateway := new(MockPaymentGateway)
+				uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, mockGateway)
+				mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(tt.order, nil)
+				mockPaymentRepo.On("CreatePayment", mock.Anything).Return(tt.repoErr)
+
+				_, err := uc.PayOrder(newPayOrderRequest(paymentTestToken))
+
+				assert.True(t, errors.Is(err, ErrOrderNotPayable))
+				mockGateway.AssertNotCalled(t, "Authorize", mock.Anything)
+			})
+		}
+	})
+
+	t.Run("Another User's Order Is Not Found", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, new(MockPaymentGateway))
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder("f47ac10b-58cc-4372-a567-0e02b2c3d999"), nil)
+
+		_, err := uc.PayOrder(newPayOrderRequest(paymentTestToken))
+
+		assert.True(t, errors.Is(err, ErrOrderNotFound))
+		mockPaymentRepo.AssertNotCalled(t, "CreatePayment", mock.Anything)
+	})
+
+	t.Run("Order Lookup Error", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, new(MockPaymentGateway))
+		dbErr := errors.New("connection refused")
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(nil, dbErr)
+
+		_, err := uc.PayOrder(newPayOrderRequest(paymentTestToken))
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.False(t, errors.Is(err, ErrOrderNotFound))
+		mockPaymentRepo.AssertNotCalled(t, "CreatePayment", mock.Anything)
+	})
+}
+
+func TestGetPayment(t *testing.T) {
+	mockPaymentRepo := new(MockPaymentRepository)
+	mockOrderRepo := new(MockOrderRepository)
+	uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, new(MockPaymentGateway))
+	mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder(orderTestUserId), nil)
+	mockPaymentRepo.On("GetLatestPaymentByOrderID", orderTestOrderId).Return(nil, gorm.ErrRecordNotFound).Once()
+	mockPaymentRepo.On("GetLatestPaymentByOrderID", orderTestOrderId).Return(createTestPayment("fakepay_1", domain.PaymentStatusCaptured), nil)
+
+	_, err := uc.GetPayment(orderTestUserId, orderTestOrderId)
+	assert.True(t, errors.Is(err, ErrPaymentNotFound))
+
+	p, err := uc.GetPayment(orderTestUserId, orderTestOrderId)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PaymentStatusCaptured, p.Status())
+
+	_, err = uc.GetPayment("f47ac10b-58cc-4372-a567-0e02b2c3d999", orderTestOrderId)
+	assert.True(t, errors.Is(err, ErrOrderNotFound))
+}
+
+func TestGetPayment_LookupErrors(t *testing.T) {
+	dbErr := errors.New("connection refused")
+
+	t.Run("Order Lookup Error", func(t *testing.T) {
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewPaymentUsecase(new(MockPaymentRepository), mockOrderRepo, new(MockPaymentGateway))
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(nil, dbErr)
+
+		_, err := uc.GetPayment(orderTestUserId, orderTestOrderId)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.False(t, errors.Is(err, ErrOrderNotFound))
+	})
+
+	t.Run("Payment Lookup Error", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockOrderRepo := new(MockOrderRepository)
+		uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, new(MockPaymentGateway))
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrder(orderTestUserId), nil)
+		mockPaymentRepo.On("GetLatestPaymentByOrderID", orderTestOrderId).Return(nil, dbErr)
+
+		_, err := uc.GetPayment(orderTestUserId, orderTestOrderId)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.False(t, errors.Is(err, ErrPaymentNotFound))
+	})
+}
+
+func TestHandleWebhook(t *testing.T) {
+	t.Run("Confirmed Payment Is Captured", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, new(MockOrderRepository), mockGateway)
+		event := &PaymentWebhookEvent{EventId: "evt_1", Type: PaymentEventAuthorized, ProviderPaymentId: "fakepay_1"}
+		mockGateway.On("VerifyWebhook", mock.Anything, "signature").Return(event, nil)
+		mockGateway.On("Capture", "fakepay_1").Return(nil)
+		mockPaymentRepo.On("GetPaymentByProviderID", "fakepay_1").Return(createTestPayment("fakepay_1", domain.PaymentStatusRequiresAction), nil)
+		mockPaymentRepo.On("ApplyWebhookEvent", paymentTestPaymentId, "evt_1", "payment.authorized", domain.PaymentStatusAuthorized).Return(createTestPayment("fakepay_1", domain.PaymentStatusAuthorized), nil)
+		mockPaymentRepo.On("TransitionPayment", paymentTestPaymentId, domain.PaymentStatusCaptured).Return(createTestPayment("fakepay_1", domain.PaymentStatusCaptured), nil)
+
+		err := uc.HandleWebhook([]byte("{}"), "signature")
+
+		assert.NoError(t, err)
+		mockPaymentRepo.AssertExpectations(t)
+		mockGateway.AssertExpectations(t)
+	})
+
+	t.Run("Replayed Event After Capture", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, new(MockOrderRepository), mockGateway)
+		event := &PaymentWebhookEvent{EventId: "evt_1", Type: PaymentEventAuthorized, ProviderPaymentId: "fakepay_1"}
+		mockGateway.On("VerifyWebhook", mock.Anything, "signature").Return(event, nil)
+		mockPaymentRepo.On("GetPaymentByProviderID", "fakepay_1").Return(createTestPayment("fakepay_1", domain.PaymentStatusCaptured), nil)
+		mockPaymentRepo.On("ApplyWebhookEvent", paymentTestPaymentId, "evt_1", "payment.authorized", domain.PaymentStatusAuthorized).Return(createTestPayment("fakepay_1", domain.PaymentStatusCaptured), nil)
+
+		err := uc.HandleWebhook([]byte("{}"), "signature")
+
+		assert.NoError(t, err)
+		mockGateway.AssertNotCalled(t, "Capture", mock.Anything)
+	})
+
+	t.Run("Invalid Signature", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, new(MockOrderRepository), mockGateway)
+		mockGateway.On("VerifyWebhook", mock.Anything, "forged").Return(nil, errors.New("invalid webhook signature"))
+
+		err := uc.HandleWebhook([]byte("{}"), "forged")
+
+		assert.True(t, errors.Is(err, ErrInvalidWebhook))
+		mockPaymentRepo.AssertNotCalled(t, "GetPaymentByProviderID", mock.Anything)
+	})
+
+	t.Run("Unknown Payment", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, new(MockOrderRepository), mockGateway)
+		event := &PaymentWebhookEvent{EventId: "evt_1", Type: PaymentEventCaptured, ProviderPaymentId: "fakepay_1"}
+		mockGateway.On("VerifyWebhook", mock.Anything, "signature").Return(event, nil)
+		mockPaymentRepo.On("GetPaymentByProviderID", "fakepay_1").Return(nil, gorm.ErrRecordNotFound)
+
+		err := uc.HandleWebhook([]byte("{}"), "signature")
+
+		assert.True(t, errors.Is(err, ErrPaymentNotFound))
+	})
+
+	t.Run("Unhandled Event Type", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, new(MockOrderRepository), mockGateway)
+		event := &PaymentWebhookEvent{EventId: "evt_1", Type: "payout.paid", ProviderPaymentId: "fakepay_1"}
+		mockGateway.On("VerifyWebhook", mock.Anything, "signature").Return(event, nil)
+
+		err := uc.HandleWebhook([]byte("{}"), "signature")
+
+		assert.NoError(t, err)
+		mockPaymentRepo.AssertNotCalled(t, "GetPaymentByProviderID", mock.Anything)
+	})
+}
+
+func TestRefundOrder(t *testing.T) {
+	refundRequest := request.RefundOrderRequest{OrderId: orderTestOrderId, UserId: orderTestAdminId}
+
+	t.Run("Success", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockOrderRepo := new(MockOrderRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, mockGateway)
+		adminId, _ := domain.NewUserId(orderTestAdminId)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrderWithStatus(domain.OrderStatusPaid), nil)
+		mockPaymentRepo.On("GetLatestPaymentByOrderID", orderTestOrderId).Return(createTestPayment("fakepay_1", domain.PaymentStatusCaptured), nil)
+		mockGateway.On("Refund", "fakepay_1").Return(nil)
+		mockPaymentRepo.On("TransitionPayment", paymentTestPaymentId, domain.PaymentStatusRefunded).Return(createTestPayment("fakepay_1", domain.PaymentStatusRefunded), nil)
+		mockOrderRepo.On("TransitionOrder", orderTestOrderId, domain.OrderStatusRefunded, adminId).Return(createTestOrderWithStatus(domain.OrderStatusRefunded), nil)
+
+		p, err := uc.RefundOrder(refundRequest)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PaymentStatusRefunded, p.Status())
+		mockOrderRepo.AssertExpectations(t)
+		mockGateway.AssertExpectations(t)
+	})
+
+	t.Run("Order Cannot Be Refunded", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockOrderRepo := new(MockOrderRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, mockGateway)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrderWithStatus(domain.OrderStatusShipped), nil)
+
+		_, err := uc.RefundOrder(refundRequest)
+
+		assert.True(t, errors.Is(err, ErrIllegalOrderTransition))
+		mockGateway.AssertNotCalled(t, "Refund", mock.Anything)
+	})
+
+	t.Run("Payment Not Captured", func(t *testing.T) {
+		mockPaymentRepo := new(MockPaymentRepository)
+		mockOrderRepo := new(MockOrderRepository)
+		mockGateway := new(MockPaymentGateway)
+		uc := NewPaymentUsecase(mockPaymentRepo, mockOrderRepo, mockGateway)
+		mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrderWithStatus(domain.OrderStatusPaid), nil)
+		mockPaymentRepo.On("GetLatestPaymentByOrderID", orderTestOrderId).Return(createTestPayment("fakepay_1", domain.PaymentStatusRefunded), nil)
+
+		_, err := uc.RefundOrder(refundRequest)
+
+		assert.True(t, errors.Is(err, ErrPaymentNotRefundable))
+		mockGateway.AssertNotCalled(t, "Refund", mock.Anything)
+	})
+}
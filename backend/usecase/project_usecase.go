@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IProjectUsecase interface {
+	GetProjects(userId string) ([]*domain.Project, error)
+	GetProject(userId string, projectId string) (*domain.Project, error)
+	CreateProject(req request.CreateProjectRequest) (*domain.Project, error)
+	UpdateProject(req request.UpdateProjectRequest) (*domain.Project, error)
+	DeleteProject(userId string, projectId string) error
+	GetHistory(userId string, projectId string) ([]*domain.ProjectSnapshot, error)
+}
+
+type projectUsecase struct {
+	pr  repository.IProjectRepository
+	ir  repository.IItemRepository
+	ptr repository.IPatternRepository
+}
+
+func NewProjectUsecase(pr repository.IProjectRepository, ir repository.IItemRepository, ptr repository.IPatternRepository) IProjectUsecase {
+	return &projectUsecase{pr: pr, ir: ir, ptr: ptr}
+}
+
+// GetProjects は作品を更新の新しい順に返す
+func (pu *projectUsecase) GetProjects(userId string) ([]*domain.Project, error) {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return nil, err
+	}
+	return pu.pr.GetProjects(id)
+}
+
+// GetProject は作品を返す。他のユーザーの作品は見つからないものとして扱う
+func (pu *projectUsecase) GetProject(userId string, projectId string) (*domain.Project, error) {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return nil, err
+	}
+	project, err := pu.pr.GetProjectByID(id, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	return project, nil
+}
+
+func (pu *projectUsecase) CreateProject(req request.CreateProjectRequest) (*domain.Project, error) {
+	userId, err := domain.NewUserId(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	details, err := newProjectDetails(req.Name, req.ProgressPercentage, req.RowCount, req.Notes)
+	if err != nil {
+		return nil, err
+	}
+	if err := pu.linkItem(details, req.ItemId, ""); err != nil {
+		return nil, err
+	}
+	if err := pu.linkPattern(details, req.PatternId, ""); err != nil {
+		return nil, err
+	}
+	project, err := domain.NewProject(*userId, *details)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProject, err)
+	}
+	return pu.pr.CreateProject(project)
+}
+
+// UpdateProject は作品の項目をすべて置き換える。進捗率か段数が変わった場合は履歴に記録する
+func (pu *projectUsecase) UpdateProject(req request.UpdateProjectRequest) (*domain.Project, error) {
+	existing, err := pu.GetProject(req.UserId, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	details, err := newProjectDetails(req.Name, req.ProgressPercentage, req.RowCount, req.Notes)
+	if err != nil {
+		return nil, err
+	}
+	if err := pu.linkItem(details, req.ItemId, existing.ItemId()); err != nil {
+		return nil, err
+	}
+	if err := pu.linkPattern(details, req.PatternId, existing.PatternId()); err != nil {
+		return nil, err
+	}
+	project, err := existing.Edit(*details)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProject, err)
+	}
+
+	updated, err := pu.pr.UpdateProject(project)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	return updated, nil
+}
+
+func (pu *projectUsecase) DeleteProject(userId string, projectId string) error {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return err
+	}
+	if err := pu.pr.DeleteProject(id, projectId); err != nil {
+		return fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	return nil
+}
+
+// GetHistory は作品の進捗率と段数の履歴を古い順に返す
+func (pu *projectUsecase) GetHistory(userId string, projectId string) ([]*domain.ProjectSnapshot, error) {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := pu.pr.GetSnapshots(id, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	return snapshots, nil
+}
+
+// newProjectDetails は進捗率を値オブジェクトにする。名前・段数・メモは domain.NewProject で検証する
+func newProjectDetails(name string, progress int, rowCount int, notes string) (*domain.ProjectDetails, error) {
+	value, err := domain.NewProgressPercentage(progress)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProject, err)
+	}
+	return &domain.ProjectDetails{Name: name, Progress: *value, RowCount: rowCount, Notes: notes}, nil
+}
+
+// linkItem は作品に商品を結び付ける。商品は linkedItemId から変わった場合だけ存在を確かめるため、結び付けた後に削除された商品はそのまま残せる
+func (pu *projectUsecase) linkItem(details *domain.ProjectDetails, itemId string, linkedItemId string) error {
+	if itemId == "" {
+		return nil
+	}
+	id, err := domain.NewItemId(itemId)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	if itemId != linkedItemId {
+		if _, err := pu.ir.GetItemByID(id); err != nil {
+			return fmt.Errorf("%w: %v", ErrItemNotFound, err)
+		}
+	}
+	details.ItemId = id
+	return nil
+}
+
+// linkPattern は作品に編み図を結び付ける。編み図は linkedPatternId から変わった場合だけ存在を確かめる
+func (pu *projectUsecase) linkPattern(details *domain.ProjectDetails, patternId string, linkedPatternId string) error {
+	if patternId != "" && patternId != linkedPatternId {
+		if _, err := pu.ptr.GetPatternByID(patternId); err != nil {
+			return fmt.Errorf("%w: %v", ErrPatternNotFound, err)
+		}
+	}
+	details.PatternId = patternId
+	return nil
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockProjectRepository struct {
+	mock.Mock
+}
+
+func (m *MockProjectRepository) GetProjects(userId *domain.UserId) ([]*domain.Project, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Project), args.Error(1)
+}
+
+func (m *MockProjectRepository) GetProjectByID(userId *domain.UserId, projectId string) (*domain.Project, error) {
+	args := m.Called(userId, projectId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Project), args.Error(1)
+}
+
+func (m *MockProjectRepository) CreateProject(project *domain.Project) (*domain.Project, error) {
+	args := m.Called(project)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Project), args.Error(1)
+}
+
+func (m *MockProjectRepository) UpdateProject(project *domain.Project) (*domain.Project, error) {
+	args := m.Called(project)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Project), args.Error(1)
+}
+
+func (m *MockProjectRepository) DeleteProject(userId *domain.UserId, projectId string) error {
+	args := m.Called(userId, projectId)
+	return args.Error(0)
+}
+
+func (m *MockProjectRepository) GetSnapshots(userId *domain.UserId, projectId string) ([]*domain.ProjectSnapshot, error) {
+	args := m.Called(userId, projectId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ProjectSnapshot), args.Error(1)
+}
+
+const projectTestProjectId = "project-1"
+
+func createTestProject(t *testing.T, itemId string) *domain.Project {
+	t.Helper()
+	userId, _ := domain.NewUserId(orderTestUserId)
+	details := domain.ProjectDetails{Name: "ラグランセーター"}
+	if itemId != "" {
+		details.ItemId, _ = domain.NewItemId(itemId)
+	}
+	return domain.RestoreProject(projectTestProjectId, *userId, details, time.Now(), time.Now())
+}
+
+func TestProjectUsecase_CreateProject(t *testing.T) {
+	t.Run("Linked To Item", func(t *testing.T) {
+		mockRepo := new(MockProjectRepository)
+		mockItemRepo := new(MockItemRepository)
+		itemId, _ := domain.NewItemId(cartTestItemId)
+		mockItemRepo.On("GetItemByID", itemId).Return(createCartTestItem(3), nil)
+		mockRepo.On("CreateProject", mock.MatchedBy(func(p *domain.Project) bool {
+			return p.ItemId() == cartTestItemId && p.Progress() == 25 && p.RowCount() == 10
+		})).Return(createTestProject(t, cartTestItemId), nil)
+
+		_, err := NewProjectUsecase(mockRepo, mockItemRepo, new(MockPatternRepository)).CreateProject(request.CreateProjectRequest{
+			UserId: orderTestUserId, Name: "ラグランセーター", ItemId: cartTestItemId, ProgressPercentage: 25, RowCount: 10,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockItemRepo.AssertExpectations(t)
+	})
+
+	t.Run("Item Not Found", func(t *testing.T) {
+		mockRepo := new(MockProjectRepository)
+		mockItemRepo := new(MockItemRepository)
+		itemId, _ := domain.NewItemId(cartTestItemId)
+		mockItemRepo.On("GetItemByID", itemId).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := NewProjectUsecase(mockRepo, mockItemRepo, new(MockPatternRepository)).CreateProject(request.CreateProjectRequest{
+			UserId: orderTestUserId, Name: "ラグランセーター", ItemId: cartTestItemId,
+		})
+
+		assert.True(t, errors.Is(err, ErrItemNotFound))
+		mockRepo.AssertNotCalled(t, "CreateProject", mock.Anything)
+	})
+
+	t.Run("Pattern Not Found", func(t *testing.T) {
+		mockRepo := new(MockProjectRepository)
+		mockPatternRepo := new(MockPatternRepository)
+		mockPatternRepo.On("GetPatternByID", patternTestPatternId).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := NewProjectUsecase(mockRepo, new(MockItemRepository), mockPatternRepo).CreateProject(request.CreateProjectRequest{
+			UserId: orderTestUserId, Name: "ラグランセーター", PatternId: patternTestPatternId,
+		})
+
+		assert.True(t, errors.Is(err, ErrPatternNotFound))
+		mockRepo.AssertNotCalled(t, "CreateProject", mock.Anything)
+	})
+
+	t.Run("Invalid Progress", func(t *testing.T) {
+		mockRepo := new(MockProjectRepository)
+
+		_, err := NewProjectUsecase(mockRepo, new(MockItemRepository), new(MockPatternRepository)).CreateProject(request.CreateProjectRequest{
+			UserId: orderTestUserId, Name: "ラグランセーター", ProgressPercentage: 120,
+		})
+
+		assert.True(t, errors.Is(err, ErrInvalidProject))
+	})
+}
+
+func TestProjectUsecase_UpdateProject(t *testing.T) {
+	userId, _ := domain.NewUserId(orderTestUserId)
+
+	t.Run("Keeps Linked Item Without Lookup", func(t *testing.T) {
+		mockRepo := new(MockProjectRepository)
+		mockItemRepo := new(MockItemRepository)
+		mockRepo.On("GetProjectByID", userId, projectTestProjectId).Return(createTestProject(t, cartTestItemId), nil)
+		mockRepo.On("UpdateProject", mock.MatchedBy(func(p *domain.Project) bool {
+			return p.ProjectId() == projectTestProjectId && p.Progress() == 80
+		})).Return(createTestProject(t, cartTestItemId), nil)
+
+		_, err := NewProjectUsecase(mockRepo, mockItemRepo, new(MockPatternRepository)).UpdateProject(request.UpdateProjectRequest{
+			UserId: orderTestUserId, ProjectId: projectTestProjectId, Name: "ラグランセーター", ItemId: cartTestItemId, ProgressPercentage: 80,
+		})
+
+		assert.NoError(t, err)
+		mockItemRepo.AssertNotCalled(t, "GetItemByID", mock.Anything)
+	})
+
+	t.Run("Project Not Found", func(t *testing.T) {
+		mockRepo := new(MockProjectRepository)
+		mockRepo.On("GetProjectByID", userId, projectTestProjectId).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := NewProjectUsecase(mockRepo, new(MockItemRepository), new(MockPatternRepository)).UpdateProject(request.UpdateProjectRequest{
+			UserId: orderTestUserId, ProjectId: projectTestProjectId, Name: "ラグランセーター",
+		})
+
+		assert.True(t, errors.Is(err, ErrProjectNotFound))
+	})
+}
+
+func TestProjectUsecase_GetHistory(t *testing.T) {
+	userId, _ := domain.NewUserId(orderTestUserId)
+	mockRepo := new(MockProjectRepository)
+	mockRepo.On("GetSnapshots", userId, projectTestProjectId).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := NewProjectUsecase(mockRepo, new(MockItemRepository), new(MockPatternRepository)).GetHistory(orderTestUserId, projectTestProjectId)
+
+	assert.True(t, errors.Is(err, ErrProjectNotFound))
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"gorm.io/gorm"
+)
+
+// ReceiptRenderer は領収書の記載事項を HTML とプレーンテキストにする
+type ReceiptRenderer interface {
+	RenderHTML(content *domain.ReceiptContent) (string, error)
+	RenderText(content *domain.ReceiptContent) (string, error)
+}
+
+type IReceiptUsecase interface {
+	GetReceipt(userId string, orderId string) (*domain.Receipt, error)
+	GetReceiptAsAdmin(orderId string) (*domain.Receipt, error)
+}
+
+type receiptUsecase struct {
+	rr     repository.IReceiptRepository
+	or     repository.IOrderRepository
+	ur     repository.IUserRepository
+	issuer domain.ReceiptIssuer
+	rd     ReceiptRenderer
+}
+
+// NewReceiptUsecase の issuer は領収書に記載する適格請求書発行事業者の名称と登録番号
+func NewReceiptUsecase(rr repository.IReceiptRepository, or repository.IOrderRepository, ur repository.IUserRepository, issuer domain.ReceiptIssuer, rd ReceiptRenderer) IReceiptUsecase {
+	return &receiptUsecase{rr: rr, or: or, ur: ur, issuer: issuer, rd: rd}
+}
+
+// ReceiptIssuerFromEnv は INVOICE_ISSUER_NAME と INVOICE_REGISTRATION_NUMBER から領収書の発行者を読み取る
+// どちらも必須で、登録番号のチェックデジットが一致しない場合もエラーを返す
+func ReceiptIssuerFromEnv() (*domain.ReceiptIssuer, error) {
+	registrationNumber, err := domain.NewInvoiceRegistrationNumber(os.Getenv("INVOICE_REGISTRATION_NUMBER"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid INVOICE_REGISTRATION_NUMBER: %w", err)
+	}
+	issuer, err := domain.NewReceiptIssuer(os.Getenv("INVOICE_ISSUER_NAME"), *registrationNumber)
+	if err != nil {
+		return nil, fmt.Errorf("invalid INVOICE_ISSUER_NAME: %w", err)
+	}
+	return issuer, nil
+}
+
+// GetReceipt は注文したユーザーに領収書を返す。他のユーザーの注文は見つからないものとして扱う
+func (ru *receiptUsecase) GetReceipt(userId string, orderId string) (*domain.Receipt, error) {
+	order, err := ru.or.GetOrderByID(orderId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOrderNotFound, err)
+	}
+	if !order.IsPlacedBy(userId) {
+		return nil, fmt.Errorf("%w: %s", ErrOrderNotFound, orderId)
+	}
+	return ru.issue(order)
+}
+
+// GetReceiptAsAdmin は管理者に注文の領収書を返す
+func (ru *receiptUsecase) GetReceiptAsAdmin(orderId string) (*domain.Receipt, error) {
+	order, err := ru.or.GetOrderByID(orderId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOrderNotFound, err)
+	}
+	return ru.issue(order)
+}
+
+// issue は発行済みの領収書を返し、まだなければ発行する
+// 発行済みの領収書は発行後に注文が返金されても、発行時の内容のまま返す
+func (ru *receiptUsecase) issue(order *domain.Order) (*domain.Receipt, error) {
+	issued, err := ru.rr.GetReceiptByOrderID(order.OrderId())
+	if err == nil {
+		return issued, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	userId, err := domain.NewUserId(order.UserId())
+	if err != nil {
+		return nil, err
+	}
+	buyer, err := ru.ur.GetUserById(userId)
+	if err != nil {
+		return nil, err
+	}
+	content, err := domain.NewReceiptContent(order, ru.issuer, buyer.Name(), time.Now())
+	if err != nil {
+		if errors.Is(err, domain.ErrReceiptNotIssuable) {
+			return nil, fmt.Errorf("%w: %v", ErrReceiptNotIssuable, err)
+		}
+		return nil, err
+	}
+	html, err := ru.rd.RenderHTML(content)
+	if err != nil {
+		return nil, err
+	}
+	text, err := ru.rd.RenderText(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同じ注文の領収書が並行して発行された場合、CreateReceipt は先に保存された領収書を返す
+	issued, err = ru.rr.CreateReceipt(domain.NewReceipt(content, html, text))
+	if err != nil {
+		if errors.Is(err, domain.ErrReceiptNotIssuable) {
+			return nil, fmt.Errorf("%w: %v", ErrReceiptNotIssuable, err)
+		}
+		return nil, err
+	}
+	return issued, nil
+}
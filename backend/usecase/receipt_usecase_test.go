@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/infrastructure/receipt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockReceiptRepository struct {
+	mock.Mock
+}
+
+func (m *MockReceiptRepository) GetReceiptByOrderID(orderId string) (*domain.Receipt, error) {
+	args := m.Called(orderId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Receipt), args.Error(1)
+}
+
+func (m *MockReceiptRepository) CreateReceipt(receipt *domain.Receipt) (*domain.Receipt, error) {
+	args := m.Called(receipt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Receipt), args.Error(1)
+}
+
+func newTestReceiptIssuer() domain.ReceiptIssuer {
+	number, _ := domain.NewInvoiceRegistrationNumber("T7000012050002")
+	issuer, _ := domain.NewReceiptIssuer("Posiposi Yarn", *number)
+	return *issuer
+}
+
+func createTestReceipt(userId string) *domain.Receipt {
+	userIdDomain, _ := domain.NewUserId(userId)
+	total, _ := domain.NewMoneyFromString("2640", domain.CurrencyJPY)
+	return domain.RestoreReceipt("receipt-1", "R20261018-F47AC10B", orderTestOrderId, *userIdDomain, "Posiposi Yarn", "T7000012050002", *total, "<p>issued</p>", "issued", time.Now())
+}
+
+func newReceiptUsecaseMocks() (*MockReceiptRepository, *MockOrderRepository, *MockUserRepository, IReceiptUsecase) {
+	mockReceiptRepo := new(MockReceiptRepository)
+	mockOrderRepo := new(MockOrderRepository)
+	mockUserRepo := new(MockUserRepository)
+	return mockReceiptRepo, mockOrderRepo, mockUserRepo, NewReceiptUsecase(mockReceiptRepo, mockOrderRepo, mockUserRepo, newTestReceiptIssuer(), receipt.NewRenderer())
+}
+
+func TestGetReceipt_ReturnsIssuedReceipt(t *testing.T) {
+	mockReceiptRepo, mockOrderRepo, mockUserRepo, uc := newReceiptUsecaseMocks()
+	issued := createTestReceipt(orderTestUserId)
+	mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrderWithStatus(domain.OrderStatusRefunded), nil)
+	mockReceiptRepo.On("GetReceiptByOrderID", orderTestOrderId).Return(issued, nil)
+
+	receipt, err := uc.GetReceipt(orderTestUserId, orderTestOrderId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, issued, receipt)
+	mockReceiptRepo.AssertNotCalled(t, "CreateReceipt", mock.Anything)
+	mockUserRepo.AssertNotCalled(t, "GetUserById", mock.Anything)
+}
+
+func TestGetReceipt_IssuesReceipt(t *testing.T) {
+	mockReceiptRepo, mockOrderRepo, mockUserRepo, uc := newReceiptUsecaseMocks()
+	buyer := createTestUserWithRole(orderTestUserId, "USER")
+	mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrderWithStatus(domain.OrderStatusPaid), nil)
+	mockReceiptRepo.On("GetReceiptByOrderID", orderTestOrderId).Return(nil, gorm.ErrRecordNotFound)
+	mockUserRepo.On("GetUserById", mock.MatchedBy(func(userId *domain.UserId) bool {
+		return userId.Value() == orderTestUserId
+	})).Return(buyer, nil)
+	var created *domain.Receipt
+	stored := createTestReceipt(orderTestUserId)
+	mockReceiptRepo.On("CreateReceipt", mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(0).(*domain.Receipt)
+	}).Return(stored, nil)
+
+	receipt, err := uc.GetReceipt(orderTestUserId, orderTestOrderId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, stored, receipt)
+	assert.Equal(t, orderTestOrderId, created.OrderId())
+	assert.Equal(t, "T7000012050002", created.RegistrationNumber())
+	assert.Equal(t, "2640", created.Total().String())
+	assert.True(t, strings.HasSuffix(created.ReceiptNumber(), "-F47AC10B"))
+	assert.Contains(t, created.HTML(), "Test User 様")
+	assert.Contains(t, created.Text(), "10%対象: ¥2,400（税抜） 消費税: ¥240")
+	mockReceiptRepo.AssertExpectations(t)
+}
+
+func TestGetReceipt_LookupError(t *testing.T) {
+	mockReceiptRepo, mockOrderRepo, mockUserRepo, uc := newReceiptUsecaseMocks()
+	lookupErr := errors.New("connection refused")
+	mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrderWithStatus(domain.OrderStatusPaid), nil)
+	mockReceiptRepo.On("GetReceiptByOrderID", orderTestOrderId).Return(nil, lookupErr)
+
+	_, err := uc.GetReceipt(orderTestUserId, orderTestOrderId)
+
+	assert.ErrorIs(t, err, lookupErr)
+	mockReceiptRepo.AssertNotCalled(t, "CreateReceipt", mock.Anything)
+	mockUserRepo.AssertNotCalled(t, "GetUserById", mock.Anything)
+}
+
+func TestGetReceipt_OtherUsersOrder(t *testing.T) {
+	mockReceiptRepo, mockOrderRepo, _, uc := newReceiptUsecaseMocks()
+	mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrderWithStatus(domain.OrderStatusPaid), nil)
+
+	_, err := uc.GetReceipt("f47ac10b-58cc-4372-a567-0e02b2c3d999", orderTestOrderId)
+
+	assert.True(t, errors.Is(err, ErrOrderNotFound))
+	mockReceiptRepo.AssertNotCalled(t, "GetReceiptByOrderID", mock.Anything)
+}
+
+func TestGetReceipt_OrderNotFound(t *testing.T) {
+	_, mockOrderRepo, _, uc := newReceiptUsecaseMocks()
+	mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := uc.GetReceipt(orderTestUserId, orderTestOrderId)
+
+	assert.True(t, errors.Is(err, ErrOrderNotFound))
+}
+
+func TestGetReceipt_NotIssuable(t *testing.T) {
+	for _, status := range []domain.OrderStatus{domain.OrderStatusPendingPayment, domain.OrderStatusCancelled, domain.OrderStatusRefunded} {
+		t.Run(string(status), func(t *testing.T) {
+			mockReceiptRepo, mockOrderRepo, mockUserRepo, uc := newReceiptUsecaseMocks()
+			mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrderWithStatus(status), nil)
+			mockReceiptRepo.On("GetReceiptByOrderID", orderTestOrderId).Return(nil, gorm.ErrRecordNotFound)
+			mockUserRepo.On("GetUserById", mock.Anything).Return(createTestUserWithRole(orderTestUserId, "USER"), nil)
+
+			_, err := uc.GetReceipt(orderTestUserId, orderTestOrderId)
+
+			assert.True(t, errors.Is(err, ErrReceiptNotIssuable))
+			mockReceiptRepo.AssertNotCalled(t, "CreateReceipt", mock.Anything)
+		})
+	}
+}
+
+func TestGetReceipt_CancelledWhileIssuing(t *testing.T) {
+	mockReceiptRepo, mockOrderRepo, mockUserRepo, uc := newReceiptUsecaseMocks()
+	mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrderWithStatus(domain.OrderStatusPaid), nil)
+	mockReceiptRepo.On("GetReceiptByOrderID", orderTestOrderId).Return(nil, gorm.ErrRecordNotFound)
+	mockUserRepo.On("GetUserById", mock.Anything).Return(createTestUserWithRole(orderTestUserId, "USER"), nil)
+	mockReceiptRepo.On("CreateReceipt", mock.Anything).Return(nil, fmt.Errorf("%w: order is cancelled", domain.ErrReceiptNotIssuable))
+
+	_, err := uc.GetReceipt(orderTestUserId, orderTestOrderId)
+
+	assert.True(t, errors.Is(err, ErrReceiptNotIssuable))
+}
+
+func TestGetReceiptAsAdmin(t *testing.T) {
+	mockReceiptRepo, mockOrderRepo, _, uc := newReceiptUsecaseMocks()
+	issued := createTestReceipt(orderTestUserId)
+	mockOrderRepo.On("GetOrderByID", orderTestOrderId).Return(createTestOrderWithStatus(domain.OrderStatusShipped), nil)
+	mockReceiptRepo.On("GetReceiptByOrderID", orderTestOrderId).Return(issued, nil)
+
+	receipt, err := uc.GetReceiptAsAdmin(orderTestOrderId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, issued, receipt)
+}
+
+func TestReceiptIssuerFromEnv(t *testing.T) {
+	t.Setenv("INVOICE_ISSUER_NAME", "Posiposi Yarn")
+	t.Setenv("INVOICE_REGISTRATION_NUMBER", "T7000012050002")
+	issuer, err := ReceiptIssuerFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, "Posiposi Yarn", issuer.Name())
+	assert.Equal(t, "T7000012050002", issuer.RegistrationNumber())
+
+	t.Setenv("INVOICE_REGISTRATION_NUMBER", "T8000012050002")
+	_, err = ReceiptIssuerFromEnv()
+	assert.Error(t, err)
+
+	t.Setenv("INVOICE_REGISTRATION_NUMBER", "T7000012050002")
+	t.Setenv("INVOICE_ISSUER_NAME", "")
+	_, err = ReceiptIssuerFromEnv()
+	assert.Error(t, err)
+}
@@ -0,0 +1,22 @@
+package request
+
+// CartOwner はカートの持ち主。ログイン中は UserId を、ゲストは署名付きクッキーから取り出した GuestCartId を指定する
+type CartOwner struct {
+	UserId      string
+	GuestCartId string
+}
+
+// AddCartItemRequest の VariantId はバリエーションのある商品でのみ指定する
+// 同じ商品・バリエーションが既にカートにある場合は数量を合算する
+type AddCartItemRequest struct {
+	Owner     CartOwner
+	ItemId    string
+	VariantId string
+	Quantity  int
+}
+
+type UpdateCartItemRequest struct {
+	Owner    CartOwner
+	LineId   string
+	Quantity int
+}
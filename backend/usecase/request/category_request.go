@@ -0,0 +1,22 @@
+package request
+
+// ParentId が空の場合は根のカテゴリとして作成する
+type CreateCategoryRequest struct {
+	Name     string
+	Slug     string
+	ParentId string
+}
+
+// UpdateCategoryRequest は名前・スラッグ・親を置き換える。ParentId を空にすると根に移動する
+type UpdateCategoryRequest struct {
+	CategoryId string
+	Name       string
+	Slug       string
+	ParentId   string
+}
+
+// CategoryId が空の場合は商品のカテゴリ設定を外す
+type SetItemCategoryRequest struct {
+	ItemId     string
+	CategoryId string
+}
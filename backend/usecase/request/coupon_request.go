@@ -0,0 +1,42 @@
+package request
+
+import "time"
+
+// CreateCouponRequest の DiscountType は "percent" なら PercentOff を、"fixed" なら AmountOff を使う
+// MinimumSpend・StartsAt・EndsAt は省略でき、上限の 0 は無制限を表す。ItemIds が空の場合はすべての商品を対象にする
+type CreateCouponRequest struct {
+	Code         string
+	DiscountType string
+	PercentOff   int
+	AmountOff    string
+	MinimumSpend string
+	Currency     string
+	StartsAt     *time.Time
+	EndsAt       *time.Time
+	UsageLimit   int
+	PerUserLimit int
+	ItemIds      []string
+}
+
+// UpdateCouponRequest は利用回数以外を置き換える
+type UpdateCouponRequest struct {
+	CouponId     string
+	Code         string
+	DiscountType string
+	PercentOff   int
+	AmountOff    string
+	MinimumSpend string
+	Currency     string
+	StartsAt     *time.Time
+	EndsAt       *time.Time
+	UsageLimit   int
+	PerUserLimit int
+	ItemIds      []string
+}
+
+// ValidateCouponRequest は注文する予定の明細にクーポンを使えるかを確かめる
+type ValidateCouponRequest struct {
+	UserId string
+	Code   string
+	Lines  []PlaceOrderLine
+}
@@ -0,0 +1,16 @@
+package request
+
+// CalculateGaugeRequest の寸法はすべて Unit（"cm" または "in"）で指定する
+// Swatch* は試し編みの目数・段数と実測した幅・高さ、Target* は編みたい幅と高さ
+// PatternStitches・PatternRows は編み図のゲージ（10cm または4インチあたりの目数と段数）で、両方 0 なら指定しない
+type CalculateGaugeRequest struct {
+	Unit            string
+	SwatchStitches  float64
+	SwatchRows      float64
+	SwatchWidth     float64
+	SwatchHeight    float64
+	TargetWidth     float64
+	TargetHeight    float64
+	PatternStitches float64
+	PatternRows     float64
+}
@@ -0,0 +1,13 @@
+package request
+
+// UploadItemImageRequest の Data はアップロードされたファイルの中身。形式はファイルの内容から判定する
+type UploadItemImageRequest struct {
+	ItemId string
+	Data   []byte
+}
+
+// ReorderItemImagesRequest の ImageIds には商品の画像をすべて表示したい順に並べる
+type ReorderItemImagesRequest struct {
+	ItemId   string
+	ImageIds []string
+}
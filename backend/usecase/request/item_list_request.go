@@ -0,0 +1,157 @@
+package request
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// listItemsParams は商品一覧で受け付けるクエリパラメータの一覧
+// ここにないキー（created_at[gt] のような演算子付きのキーを含む）は拒否する
+var listItemsParams = map[string]bool{
+	"limit":          true,
+	"cursor":         true,
+	"include_total":  true,
+	"stock":          true,
+	"user_id":        true,
+	"created_after":  true,
+	"created_before": true,
+	"name":           true,
+	"category":       true,
+	"tag":            true,
+	"yarn_weight":    true,
+	"fiber":          true,
+	"needle_size":    true,
+	"sort":           true,
+}
+
+type ItemSortField struct {
+	Field      string
+	Descending bool
+}
+
+type ItemListFilter struct {
+	Stock         *bool
+	UserId        string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	NameContains  string
+	Category      string
+	Tag           string
+	YarnWeight    string
+	Fiber         string
+	NeedleSize    *float64
+}
+
+type ListItemsRequest struct {
+	Limit     int
+	Cursor    string
+	WithTotal bool
+	Filter    ItemListFilter
+	Sort      []ItemSortField
+}
+
+// NewListItemsRequest はクエリパラメータを型付きの一覧取得リクエストに変換する
+func NewListItemsRequest(params url.Values) (ListItemsRequest, error) {
+	var req ListItemsRequest
+
+	for key, values := range params {
+		if !listItemsParams[key] {
+			return ListItemsRequest{}, fmt.Errorf("unknown query parameter: %s", key)
+		}
+		if len(values) > 1 {
+			return ListItemsRequest{}, fmt.Errorf("query parameter must not be repeated: %s", key)
+		}
+	}
+
+	if v := params.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return ListItemsRequest{}, fmt.Errorf("limit must be an integer: %s", v)
+		}
+		req.Limit = limit
+	}
+
+	req.Cursor = params.Get("cursor")
+
+	if v := params.Get("include_total"); v != "" {
+		withTotal, err := strconv.ParseBool(v)
+		if err != nil {
+			return ListItemsRequest{}, fmt.Errorf("include_total must be a boolean: %s", v)
+		}
+		req.WithTotal = withTotal
+	}
+
+	if v := params.Get("stock"); v != "" {
+		stock, err := strconv.ParseBool(v)
+		if err != nil {
+			return ListItemsRequest{}, fmt.Errorf("stock must be a boolean: %s", v)
+		}
+		req.Filter.Stock = &stock
+	}
+
+	req.Filter.UserId = params.Get("user_id")
+	req.Filter.NameContains = params.Get("name")
+	req.Filter.Category = params.Get("category")
+	req.Filter.Tag = params.Get("tag")
+	req.Filter.YarnWeight = params.Get("yarn_weight")
+	req.Filter.Fiber = params.Get("fiber")
+
+	if v := params.Get("needle_size"); v != "" {
+		needleSize, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return ListItemsRequest{}, fmt.Errorf("needle_size must be a number: %s", v)
+		}
+		req.Filter.NeedleSize = &needleSize
+	}
+
+	if v := params.Get("created_after"); v != "" {
+		createdAfter, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return ListItemsRequest{}, fmt.Errorf("created_after must be RFC3339: %s", v)
+		}
+		req.Filter.CreatedAfter = &createdAfter
+	}
+
+	if v := params.Get("created_before"); v != "" {
+		createdBefore, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return ListItemsRequest{}, fmt.Errorf("created_before must be RFC3339: %s", v)
+		}
+		req.Filter.CreatedBefore = &createdBefore
+	}
+
+	if v := params.Get("sort"); v != "" {
+		sort, err := parseItemSort(v)
+		if err != nil {
+			return ListItemsRequest{}, err
+		}
+		req.Sort = sort
+	}
+
+	return req, nil
+}
+
+// parseItemSort は "-created_at,item_name" 形式の並び順を解釈する
+// 先頭の "-" は降順、"+" または記号なしは昇順を表す
+func parseItemSort(value string) ([]ItemSortField, error) {
+	var fields []ItemSortField
+	for _, token := range strings.Split(value, ",") {
+		token = strings.TrimSpace(token)
+		descending := false
+		switch {
+		case strings.HasPrefix(token, "-"):
+			descending = true
+			token = token[1:]
+		case strings.HasPrefix(token, "+"):
+			token = token[1:]
+		}
+		if token == "" {
+			return nil, fmt.Errorf("invalid sort: %s", value)
+		}
+		fields = append(fields, ItemSortField{Field: token, Descending: descending})
+	}
+	return fields, nil
+}
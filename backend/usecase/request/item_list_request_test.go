@@ -0,0 +1,68 @@
+package request
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewListItemsRequest_Empty(t *testing.T) {
+	req, err := NewListItemsRequest(url.Values{})
+	assert.NoError(t, err)
+	assert.Equal(t, ListItemsRequest{}, req)
+}
+
+func TestNewListItemsRequest_AllParameters(t *testing.T) {
+	params, _ := url.ParseQuery("limit=10&cursor=abc&include_total=true&stock=false" +
+		"&user_id=f47ac10b-58cc-4372-a567-0e02b2c3d400&created_after=2025-07-01T00:00:00Z" +
+		"&created_before=2025-08-01T00:00:00%2B09:00&name=wool&category=fingering&tag=handmade&yarn_weight=light&fiber=merino&needle_size=4.5&sort=-created_at,%2Bitem_name")
+
+	req, err := NewListItemsRequest(params)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, req.Limit)
+	assert.Equal(t, "abc", req.Cursor)
+	assert.True(t, req.WithTotal)
+	assert.False(t, *req.Filter.Stock)
+	assert.Equal(t, "f47ac10b-58cc-4372-a567-0e02b2c3d400", req.Filter.UserId)
+	assert.True(t, req.Filter.CreatedAfter.Equal(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, req.Filter.CreatedBefore.Equal(time.Date(2025, 7, 31, 15, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "wool", req.Filter.NameContains)
+	assert.Equal(t, "fingering", req.Filter.Category)
+	assert.Equal(t, "handmade", req.Filter.Tag)
+	assert.Equal(t, "light", req.Filter.YarnWeight)
+	assert.Equal(t, "merino", req.Filter.Fiber)
+	assert.Equal(t, 4.5, *req.Filter.NeedleSize)
+	assert.Equal(t, []ItemSortField{
+		{Field: "created_at", Descending: true},
+		{Field: "item_name", Descending: false},
+	}, req.Sort)
+}
+
+func TestNewListItemsRequest_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"unknown field", "color=red"},
+		{"operator suffix", "created_at[gt]=2025-07-01T00:00:00Z"},
+		{"repeated parameter", "stock=true&stock=false"},
+		{"non integer limit", "limit=ten"},
+		{"non boolean stock", "stock=maybe"},
+		{"non boolean include_total", "include_total=yes"},
+		{"invalid created_after", "created_after=2025-07-01"},
+		{"invalid created_before", "created_before=yesterday"},
+		{"non numeric needle_size", "needle_size=large"},
+		{"empty sort token", "sort=created_at,,item_name"},
+		{"sign only sort token", "sort=-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, _ := url.ParseQuery(tt.query)
+			_, err := NewListItemsRequest(params)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,15 +1,31 @@
 package request
 
+// Price は税抜価格の10進数文字列。空の場合は0円として扱う
+// TaxRate は "standard" または "reduced"。空の場合は標準税率として扱う
 type CreateItemRequest struct {
-	ItemName    string
-	Stock       bool
-	Description string
-	UserId      string
+	ItemName          string
+	OnHandQuantity    int
+	LowStockThreshold int
+	Description       string
+	Price             string
+	Currency          string
+	TaxRate           string
+	UserId            string
 }
 
+// UpdateItemRequest では実在庫数を変更しない。在庫の増減は在庫移動として記録する
+// Price・TaxRate が空の場合は既存の価格・税率を引き継ぐ
 type UpdateItemRequest struct {
-	ItemId      string
-	ItemName    string
-	Stock       bool
-	Description string
-}
\ No newline at end of file
+	ItemId            string
+	ItemName          string
+	LowStockThreshold int
+	Description       string
+	Price             string
+	Currency          string
+	TaxRate           string
+}
+
+type SearchItemsRequest struct {
+	Query string
+	Limit int
+}
@@ -0,0 +1,25 @@
+package request
+
+// PriceOverride は税抜の上書き価格。空の場合は商品の価格で販売する
+// Currency が空の場合は商品の通貨として扱う
+type CreateItemVariantRequest struct {
+	ItemId            string
+	Sku               string
+	Options           map[string]string
+	OnHandQuantity    int
+	LowStockThreshold int
+	PriceOverride     string
+	Currency          string
+}
+
+// UpdateItemVariantRequest は在庫数量以外を置き換える。実在庫数は在庫移動で変更する
+// PriceOverride を空にすると上書き価格を解除する
+type UpdateItemVariantRequest struct {
+	ItemId            string
+	VariantId         string
+	Sku               string
+	Options           map[string]string
+	LowStockThreshold int
+	PriceOverride     string
+	Currency          string
+}
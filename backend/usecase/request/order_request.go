@@ -0,0 +1,22 @@
+package request
+
+// PlaceOrderLine の VariantId はバリエーションのある商品でのみ指定する
+type PlaceOrderLine struct {
+	ItemId    string
+	VariantId string
+	Quantity  int
+}
+
+// PlaceOrderRequest の CouponCode はクーポンを使わない場合は空
+type PlaceOrderRequest struct {
+	UserId     string
+	Lines      []PlaceOrderLine
+	CouponCode string
+}
+
+// TransitionOrderRequest の UserId は遷移させる管理者
+type TransitionOrderRequest struct {
+	OrderId string
+	Status  string
+	UserId  string
+}
@@ -0,0 +1,56 @@
+package request
+
+// PatternSizeRequest の Yardage はそのサイズを編むのに必要な糸の長さ（m）
+type PatternSizeRequest struct {
+	Label                string
+	FinishedMeasurements string
+	Yardage              int
+}
+
+// CreatePatternRequest の GaugeStitches・GaugeRows は10cm四方の目数と段数、NeedleSizes は針の太さ（mm）
+// ItemIds はパターンを編むのに使える商品で、空でもよい
+type CreatePatternRequest struct {
+	Name          string
+	Description   string
+	Difficulty    string
+	GaugeStitches float64
+	GaugeRows     float64
+	NeedleSizes   []float64
+	YarnWeight    string
+	Sizes         []PatternSizeRequest
+	ItemIds       []string
+}
+
+// UpdatePatternRequest は仕上がりサイズと使える商品を含め、項目をすべて置き換える
+type UpdatePatternRequest struct {
+	PatternId     string
+	Name          string
+	Description   string
+	Difficulty    string
+	GaugeStitches float64
+	GaugeRows     float64
+	NeedleSizes   []float64
+	YarnWeight    string
+	Sizes         []PatternSizeRequest
+	ItemIds       []string
+}
+
+// FiberContentRequest の Percentage は素材の混率（%）
+type FiberContentRequest struct {
+	Fiber      string
+	Percentage int
+}
+
+// SetItemYarnRequest の YardagePerSkein は1玉の長さ（m）、GramsPerSkein は1玉の重さ（g）。Weight が空の場合は毛糸の属性を外す
+// NeedleMin・NeedleMax は合う針の太さ（mm）、GaugeStitches・GaugeRows は推奨ゲージ。0 の項目と空の Fibers は未登録として扱う
+type SetItemYarnRequest struct {
+	ItemId          string
+	Weight          string
+	YardagePerSkein int
+	GramsPerSkein   int
+	Fibers          []FiberContentRequest
+	NeedleMin       float64
+	NeedleMax       float64
+	GaugeStitches   float64
+	GaugeRows       float64
+}
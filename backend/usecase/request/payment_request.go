@@ -0,0 +1,14 @@
+package request
+
+// PayOrderRequest の PaymentToken はフロントエンドが決済代行サービスから受け取った支払い方法のトークン
+type PayOrderRequest struct {
+	UserId       string
+	OrderId      string
+	PaymentToken string
+}
+
+// RefundOrderRequest の UserId は返金する管理者
+type RefundOrderRequest struct {
+	OrderId string
+	UserId  string
+}
@@ -0,0 +1,24 @@
+package request
+
+// CreateProjectRequest の ItemId・PatternId は使っている商品・編み図で、空の場合は結び付けない
+type CreateProjectRequest struct {
+	UserId             string
+	Name               string
+	ItemId             string
+	PatternId          string
+	ProgressPercentage int
+	RowCount           int
+	Notes              string
+}
+
+// UpdateProjectRequest は作品の項目をすべて置き換える
+type UpdateProjectRequest struct {
+	UserId             string
+	ProjectId          string
+	Name               string
+	ItemId             string
+	PatternId          string
+	ProgressPercentage int
+	RowCount           int
+	Notes              string
+}
@@ -0,0 +1,20 @@
+package request
+
+// CreateReviewRequest の Rating は1〜5の評価
+type CreateReviewRequest struct {
+	UserId string
+	ItemId string
+	Rating int
+	Title  string
+	Body   string
+}
+
+// UpdateReviewRequest は評価・タイトル・本文をすべて置き換える
+type UpdateReviewRequest struct {
+	UserId   string
+	ItemId   string
+	ReviewId string
+	Rating   int
+	Title    string
+	Body     string
+}
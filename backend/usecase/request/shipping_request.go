@@ -0,0 +1,47 @@
+package request
+
+// CreateShippingAddressRequest の Prefecture は "東京都" のような正式な都道府県名で、AddressLine2 だけ省略できる
+type CreateShippingAddressRequest struct {
+	UserId        string
+	RecipientName string
+	PostalCode    string
+	Prefecture    string
+	City          string
+	AddressLine1  string
+	AddressLine2  string
+	PhoneNumber   string
+}
+
+// UpdateShippingAddressRequest は宛名と住所をすべて置き換える
+type UpdateShippingAddressRequest struct {
+	UserId        string
+	AddressId     string
+	RecipientName string
+	PostalCode    string
+	Prefecture    string
+	City          string
+	AddressLine1  string
+	AddressLine2  string
+	PhoneNumber   string
+}
+
+// ShippingRateRequest の Fee は税抜の配送料
+type ShippingRateRequest struct {
+	Prefecture string
+	Fee        string
+}
+
+// ReplaceShippingRatesRequest は配送料の表をまとめて置き換える
+// Rates に含まれない都道府県には配送せず、FreeShippingThreshold が空の場合は送料無料にしない
+type ReplaceShippingRatesRequest struct {
+	Rates                 []ShippingRateRequest
+	FreeShippingThreshold string
+	Currency              string
+}
+
+// QuoteShippingRequest は保存した配送先へ明細の商品を配送する場合の配送料を見積もる
+type QuoteShippingRequest struct {
+	UserId    string
+	AddressId string
+	Lines     []PlaceOrderLine
+}
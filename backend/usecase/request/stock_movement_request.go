@@ -0,0 +1,11 @@
+package request
+
+// RecordStockMovementRequest の VariantId を指定した場合はバリエーションの在庫を増減する。空の場合は商品の在庫を増減する
+type RecordStockMovementRequest struct {
+	ItemId        string
+	VariantId     string
+	MovementType  string
+	QuantityDelta int
+	Reason        string
+	UserId        string
+}
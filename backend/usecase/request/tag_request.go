@@ -0,0 +1,7 @@
+package request
+
+// ReplaceItemTagsRequest は商品のタグを Tags に置き換える。空にするとすべて外す
+type ReplaceItemTagsRequest struct {
+	ItemId string
+	Tags   []string
+}
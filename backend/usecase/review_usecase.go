@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+	"gorm.io/gorm"
+)
+
+type IReviewUsecase interface {
+	GetReviews(itemId string) ([]*domain.Review, error)
+	CreateReview(req request.CreateReviewRequest) (*domain.Review, error)
+	UpdateReview(req request.UpdateReviewRequest) (*domain.Review, error)
+}
+
+type reviewUsecase struct {
+	rr repository.IReviewRepository
+}
+
+func NewReviewUsecase(rr repository.IReviewRepository) IReviewUsecase {
+	return &reviewUsecase{rr}
+}
+
+// GetReviews は商品のレビューを新しい順に返す
+func (ru *reviewUsecase) GetReviews(itemId string) ([]*domain.Review, error) {
+	id, err := domain.NewItemId(itemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	reviews, err := ru.rr.GetReviewsByItemID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+		}
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// CreateReview は商品にレビューを書く。同じ商品に書けるのは1件だけ
+func (ru *reviewUsecase) CreateReview(req request.CreateReviewRequest) (*domain.Review, error) {
+	userId, err := domain.NewUserId(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	itemId, err := domain.NewItemId(req.ItemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	content, err := newReviewContent(req.Rating, req.Title, req.Body)
+	if err != nil {
+		return nil, err
+	}
+	review, err := domain.NewReview(*userId, *itemId, *content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidReview, err)
+	}
+
+	created, err := ru.rr.CreateReview(review)
+	if err != nil {
+		if errors.Is(err, domain.ErrDuplicateReview) {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateReview, err)
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateReview は評価・タイトル・本文を置き換える。他のユーザーのレビューは見つからないものとして扱う
+func (ru *reviewUsecase) UpdateReview(req request.UpdateReviewRequest) (*domain.Review, error) {
+	existing, err := ru.rr.GetReviewByID(req.ReviewId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrReviewNotFound, err)
+		}
+		return nil, err
+	}
+	if existing.ItemId() != req.ItemId || !existing.IsWrittenBy(req.UserId) {
+		return nil, fmt.Errorf("%w: %s", ErrReviewNotFound, req.ReviewId)
+	}
+	content, err := newReviewContent(req.Rating, req.Title, req.Body)
+	if err != nil {
+		return nil, err
+	}
+	review, err := existing.Edit(*content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidReview, err)
+	}
+
+	updated, err := ru.rr.UpdateReview(review)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrReviewNotFound, err)
+		}
+		return nil, err
+	}
+	return updated, nil
+}
+
+// newReviewContent は評価を値オブジェクトにする。タイトルと本文は domain.NewReview で検証する
+func newReviewContent(rating int, title string, body string) (*domain.ReviewContent, error) {
+	value, err := domain.NewRating(rating)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidReview, err)
+	}
+	return &domain.ReviewContent{Rating: *value, Title: title, Body: body}, nil
+}
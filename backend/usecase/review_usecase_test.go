@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockReviewRepository struct {
+	mock.Mock
+}
+
+func (m *MockReviewRepository) GetReviewsByItemID(itemId *domain.ItemId) ([]*domain.Review, error) {
+	args := m.Called(itemId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Review), args.Error(1)
+}
+
+func (m *MockReviewRepository) GetReviewByID(reviewId string) (*domain.Review, error) {
+	args := m.Called(reviewId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+func (m *MockReviewRepository) CreateReview(review *domain.Review) (*domain.Review, error) {
+	args := m.Called(review)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+func (m *MockReviewRepository) UpdateReview(review *domain.Review) (*domain.Review, error) {
+	args := m.Called(review)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+const reviewTestReviewId = "review-1"
+
+func createTestReview(t *testing.T, rating int) *domain.Review {
+	t.Helper()
+	userId, _ := domain.NewUserId(orderTestUserId)
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	value, _ := domain.NewRating(rating)
+	content := domain.ReviewContent{Rating: *value, Title: "編みやすい", Body: "発色がきれいです"}
+	return domain.RestoreReview(reviewTestReviewId, *userId, "買い手", *itemId, content, time.Now(), time.Now())
+}
+
+func TestReviewUsecase_CreateReview(t *testing.T) {
+	req := request.CreateReviewRequest{UserId: orderTestUserId, ItemId: cartTestItemId, Rating: 5, Title: " 編みやすい ", Body: "発色がきれいです"}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockReviewRepository)
+		created := createTestReview(t, 5)
+		var saved *domain.Review
+		mockRepo.On("CreateReview", mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(0).(*domain.Review)
+		}).Return(created, nil)
+
+		result, err := NewReviewUsecase(mockRepo).CreateReview(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, result)
+		assert.Equal(t, "編みやすい", saved.Title())
+		assert.Equal(t, orderTestUserId, saved.UserId())
+	})
+
+	t.Run("Invalid Rating", func(t *testing.T) {
+		mockRepo := new(MockReviewRepository)
+		invalid := req
+		invalid.Rating = 6
+
+		_, err := NewReviewUsecase(mockRepo).CreateReview(invalid)
+
+		assert.True(t, errors.Is(err, ErrInvalidReview))
+		mockRepo.AssertNotCalled(t, "CreateReview", mock.Anything)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		mockRepo := new(MockReviewRepository)
+		mockRepo.On("CreateReview", mock.Anything).Return(nil, fmt.Errorf("%w: review already exists", domain.ErrDuplicateReview))
+
+		_, err := NewReviewUsecase(mockRepo).CreateReview(req)
+
+		assert.True(t, errors.Is(err, ErrDuplicateReview))
+	})
+
+	t.Run("Item Not Found", func(t *testing.T) {
+		mockRepo := new(MockReviewRepository)
+		mockRepo.On("CreateReview", mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := NewReviewUsecase(mockRepo).CreateReview(req)
+
+		assert.True(t, errors.Is(err, ErrItemNotFound))
+	})
+}
+
+func TestReviewUsecase_UpdateReview(t *testing.T) {
+	req := request.UpdateReviewRequest{UserId: orderTestUserId, ItemId: cartTestItemId, ReviewId: reviewTestReviewId, Rating: 2, Title: "毛玉ができやすい", Body: "洗濯すると毛玉が目立ちます"}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockReviewRepository)
+		mockRepo.On("GetReviewByID", reviewTestReviewId).Return(createTestReview(t, 5), nil)
+		mockRepo.On("UpdateReview", mock.MatchedBy(func(r *domain.Review) bool {
+			return r.Rating() == 2 && r.Title() == "毛玉ができやすい"
+		})).Return(createTestReview(t, 2), nil)
+
+		result, err := NewReviewUsecase(mockRepo).UpdateReview(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Rating())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Written By Another User", func(t *testing.T) {
+		mockRepo := new(MockReviewRepository)
+		mockRepo.On("GetReviewByID", reviewTestReviewId).Return(createTestReview(t, 5), nil)
+		other := req
+		other.UserId = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+
+		_, err := NewReviewUsecase(mockRepo).UpdateReview(other)
+
+		assert.True(t, errors.Is(err, ErrReviewNotFound))
+		mockRepo.AssertNotCalled(t, "UpdateReview", mock.Anything)
+	})
+
+	t.Run("Review Of Another Item", func(t *testing.T) {
+		mockRepo := new(MockReviewRepository)
+		mockRepo.On("GetReviewByID", reviewTestReviewId).Return(createTestReview(t, 5), nil)
+		other := req
+		other.ItemId = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+
+		_, err := NewReviewUsecase(mockRepo).UpdateReview(other)
+
+		assert.True(t, errors.Is(err, ErrReviewNotFound))
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockRepo := new(MockReviewRepository)
+		dbErr := errors.New("connection refused")
+		mockRepo.On("GetReviewByID", reviewTestReviewId).Return(createTestReview(t, 5), nil)
+		mockRepo.On("UpdateReview", mock.Anything).Return(nil, dbErr)
+
+		_, err := NewReviewUsecase(mockRepo).UpdateReview(req)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.False(t, errors.Is(err, ErrReviewNotFound))
+	})
+
+	t.Run("Deleted While Editing", func(t *testing.T) {
+		mockRepo := new(MockReviewRepository)
+		mockRepo.On("GetReviewByID", reviewTestReviewId).Return(createTestReview(t, 5), nil)
+		mockRepo.On("UpdateReview", mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := NewReviewUsecase(mockRepo).UpdateReview(req)
+
+		assert.True(t, errors.Is(err, ErrReviewNotFound))
+	})
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IShippingAddressUsecase interface {
+	GetAddresses(userId string) ([]*domain.ShippingAddress, error)
+	GetAddress(userId string, addressId string) (*domain.ShippingAddress, error)
+	CreateAddress(req request.CreateShippingAddressRequest) (*domain.ShippingAddress, error)
+	UpdateAddress(req request.UpdateShippingAddressRequest) (*domain.ShippingAddress, error)
+	DeleteAddress(userId string, addressId string) error
+}
+
+type shippingAddressUsecase struct {
+	sr repository.IShippingAddressRepository
+}
+
+func NewShippingAddressUsecase(sr repository.IShippingAddressRepository) IShippingAddressUsecase {
+	return &shippingAddressUsecase{sr}
+}
+
+func (su *shippingAddressUsecase) GetAddresses(userId string) ([]*domain.ShippingAddress, error) {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return nil, err
+	}
+	return su.sr.GetAddresses(id)
+}
+
+// GetAddress は配送先を返す。他のユーザーの配送先は見つからないものとして扱う
+func (su *shippingAddressUsecase) GetAddress(userId string, addressId string) (*domain.ShippingAddress, error) {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return nil, err
+	}
+	address, err := su.sr.GetAddressByID(id, addressId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrShippingAddressNotFound, err)
+	}
+	return address, nil
+}
+
+func (su *shippingAddressUsecase) CreateAddress(req request.CreateShippingAddressRequest) (*domain.ShippingAddress, error) {
+	userId, err := domain.NewUserId(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := newShippingAddressLines(req)
+	if err != nil {
+		return nil, err
+	}
+	address, err := domain.NewShippingAddress(*userId, *lines)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidShippingAddress, err)
+	}
+	return su.sr.CreateAddress(address)
+}
+
+// UpdateAddress は宛名と住所をすべて置き換える
+func (su *shippingAddressUsecase) UpdateAddress(req request.UpdateShippingAddressRequest) (*domain.ShippingAddress, error) {
+	existing, err := su.GetAddress(req.UserId, req.AddressId)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := newShippingAddressLines(request.CreateShippingAddressRequest{
+		UserId:        req.UserId,
+		RecipientName: req.RecipientName,
+		PostalCode:    req.PostalCode,
+		Prefecture:    req.Prefecture,
+		City:          req.City,
+		AddressLine1:  req.AddressLine1,
+		AddressLine2:  req.AddressLine2,
+		PhoneNumber:   req.PhoneNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+	address, err := existing.Edit(*lines)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidShippingAddress, err)
+	}
+
+	updated, err := su.sr.UpdateAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrShippingAddressNotFound, err)
+	}
+	return updated, nil
+}
+
+func (su *shippingAddressUsecase) DeleteAddress(userId string, addressId string) error {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return err
+	}
+	if err := su.sr.DeleteAddress(id, addressId); err != nil {
+		return fmt.Errorf("%w: %v", ErrShippingAddressNotFound, err)
+	}
+	return nil
+}
+
+// newShippingAddressLines は郵便番号と都道府県を値オブジェクトにする。残りの項目は domain.NewShippingAddress で検証する
+func newShippingAddressLines(req request.CreateShippingAddressRequest) (*domain.ShippingAddressLines, error) {
+	postalCode, err := domain.NewPostalCode(req.PostalCode)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidShippingAddress, err)
+	}
+	prefecture, err := domain.NewPrefecture(req.Prefecture)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidShippingAddress, err)
+	}
+	return &domain.ShippingAddressLines{
+		RecipientName: req.RecipientName,
+		PostalCode:    *postalCode,
+		Prefecture:    *prefecture,
+		City:          req.City,
+		AddressLine1:  req.AddressLine1,
+		AddressLine2:  req.AddressLine2,
+		PhoneNumber:   req.PhoneNumber,
+	}, nil
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockShippingAddressRepository struct {
+	mock.Mock
+}
+
+func (m *MockShippingAddressRepository) GetAddresses(userId *domain.UserId) ([]*domain.ShippingAddress, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ShippingAddress), args.Error(1)
+}
+
+func (m *MockShippingAddressRepository) GetAddressByID(userId *domain.UserId, addressId string) (*domain.ShippingAddress, error) {
+	args := m.Called(userId, addressId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShippingAddress), args.Error(1)
+}
+
+func (m *MockShippingAddressRepository) CreateAddress(address *domain.ShippingAddress) (*domain.ShippingAddress, error) {
+	args := m.Called(address)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShippingAddress), args.Error(1)
+}
+
+func (m *MockShippingAddressRepository) UpdateAddress(address *domain.ShippingAddress) (*domain.ShippingAddress, error) {
+	args := m.Called(address)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShippingAddress), args.Error(1)
+}
+
+func (m *MockShippingAddressRepository) DeleteAddress(userId *domain.UserId, addressId string) error {
+	args := m.Called(userId, addressId)
+	return args.Error(0)
+}
+
+const shippingTestAddressId = "f47ac10b-58cc-4372-a567-0e02b2c3d920"
+
+// createTestShippingAddress は orderTestUserId のユーザーが保存した prefectureName への配送先を返す
+func createTestShippingAddress(prefectureName string) *domain.ShippingAddress {
+	userId, _ := domain.NewUserId(orderTestUserId)
+	postalCode, _ := domain.NewPostalCode("100-0001")
+	prefecture, _ := domain.NewPrefecture(prefectureName)
+	return domain.RestoreShippingAddress(shippingTestAddressId, *userId, domain.ShippingAddressLines{
+		RecipientName: "山田 花子",
+		PostalCode:    *postalCode,
+		Prefecture:    *prefecture,
+		City:          "千代田区",
+		AddressLine1:  "千代田1-1",
+		PhoneNumber:   "0312345678",
+	}, time.Now(), time.Now())
+}
+
+func newCreateShippingAddressRequest() request.CreateShippingAddressRequest {
+	return request.CreateShippingAddressRequest{
+		UserId:        orderTestUserId,
+		RecipientName: "山田 花子",
+		PostalCode:    "〒1000001",
+		Prefecture:    "東京都",
+		City:          "千代田区",
+		AddressLine1:  "千代田1-1",
+		PhoneNumber:   "03-1234-5678",
+	}
+}
+
+func TestCreateShippingAddress_Success(t *testing.T) {
+	mockRepo := new(MockShippingAddressRepository)
+	uc := NewShippingAddressUsecase(mockRepo)
+	mockRepo.On("CreateAddress", mock.MatchedBy(func(address *domain.ShippingAddress) bool {
+		return address.UserId() == orderTestUserId &&
+			address.PostalCode().Value() == "100-0001" &&
+			address.Prefecture().Code() == 13 &&
+			address.PhoneNumber() == "0312345678"
+	})).Return(createTestShippingAddress("東京都"), nil)
+
+	address, err := uc.CreateAddress(newCreateShippingAddressRequest())
+
+	assert.NoError(t, err)
+	assert.Equal(t, shippingTestAddressId, address.AddressId())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateShippingAddress_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(req *request.CreateShippingAddressRequest)
+	}{
+		{name: "malformed postal code", modify: func(req *request.CreateShippingAddressRequest) { req.PostalCode = "100-001" }},
+		{name: "unknown prefecture", modify: func(req *request.CreateShippingAddressRequest) { req.Prefecture = "東京" }},
+		{name: "missing recipient", modify: func(req *request.CreateShippingAddressRequest) { req.RecipientName = "" }},
+		{name: "malformed phone number", modify: func(req *request.CreateShippingAddressRequest) { req.PhoneNumber = "12345" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockShippingAddressRepository)
+			uc := NewShippingAddressUsecase(mockRepo)
+
+			req := newCreateShippingAddressRequest()
+			tt.modify(&req)
+			_, err := uc.CreateAddress(req)
+
+			assert.True(t, errors.Is(err, ErrInvalidShippingAddress), err)
+			mockRepo.AssertNotCalled(t, "CreateAddress", mock.Anything)
+		})
+	}
+}
+
+func TestUpdateShippingAddress_Success(t *testing.T) {
+	mockRepo := new(MockShippingAddressRepository)
+	uc := NewShippingAddressUsecase(mockRepo)
+	existing := createTestShippingAddress("東京都")
+	mockRepo.On("GetAddressByID", mock.Anything, shippingTestAddressId).Return(existing, nil)
+	mockRepo.On("UpdateAddress", mock.MatchedBy(func(address *domain.ShippingAddress) bool {
+		return address.AddressId() == shippingTestAddressId &&
+			address.Prefecture().Name() == "大阪府" &&
+			address.CreatedAt().Equal(existing.CreatedAt())
+	})).Return(createTestShippingAddress("大阪府"), nil)
+
+	address, err := uc.UpdateAddress(request.UpdateShippingAddressRequest{
+		UserId:        orderTestUserId,
+		AddressId:     shippingTestAddressId,
+		RecipientName: "山田 花子",
+		PostalCode:    "530-0001",
+		Prefecture:    "大阪府",
+		City:          "大阪市北区",
+		AddressLine1:  "梅田1-1",
+		PhoneNumber:   "06-1234-5678",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "大阪府", address.Prefecture().Name())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateShippingAddress_NotFound(t *testing.T) {
+	mockRepo := new(MockShippingAddressRepository)
+	uc := NewShippingAddressUsecase(mockRepo)
+	mockRepo.On("GetAddressByID", mock.Anything, shippingTestAddressId).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := uc.UpdateAddress(request.UpdateShippingAddressRequest{UserId: orderTestUserId, AddressId: shippingTestAddressId})
+
+	assert.True(t, errors.Is(err, ErrShippingAddressNotFound))
+	mockRepo.AssertNotCalled(t, "UpdateAddress", mock.Anything)
+}
+
+func TestDeleteShippingAddress_NotFound(t *testing.T) {
+	mockRepo := new(MockShippingAddressRepository)
+	uc := NewShippingAddressUsecase(mockRepo)
+	mockRepo.On("DeleteAddress", mock.Anything, shippingTestAddressId).Return(gorm.ErrRecordNotFound)
+
+	err := uc.DeleteAddress(orderTestUserId, shippingTestAddressId)
+
+	assert.True(t, errors.Is(err, ErrShippingAddressNotFound))
+}
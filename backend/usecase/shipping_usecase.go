@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IShippingUsecase interface {
+	GetRateTable() (*domain.ShippingRateTable, error)
+	ReplaceRateTable(req request.ReplaceShippingRatesRequest) (*domain.ShippingRateTable, error)
+	QuoteShipping(req request.QuoteShippingRequest) (*domain.ShippingQuote, error)
+}
+
+type shippingUsecase struct {
+	rr repository.IShippingRateRepository
+	ar repository.IShippingAddressRepository
+	ir repository.IItemRepository
+}
+
+func NewShippingUsecase(rr repository.IShippingRateRepository, ar repository.IShippingAddressRepository, ir repository.IItemRepository) IShippingUsecase {
+	return &shippingUsecase{rr, ar, ir}
+}
+
+func (su *shippingUsecase) GetRateTable() (*domain.ShippingRateTable, error) {
+	return su.rr.GetRateTable()
+}
+
+// ReplaceRateTable は配送料の表をまとめて置き換える
+func (su *shippingUsecase) ReplaceRateTable(req request.ReplaceShippingRatesRequest) (*domain.ShippingRateTable, error) {
+	rates := make([]domain.ShippingRate, 0, len(req.Rates))
+	for _, reqRate := range req.Rates {
+		prefecture, err := domain.NewPrefecture(reqRate.Prefecture)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidShippingRate, err)
+		}
+		fee, err := domain.NewMoneyFromString(reqRate.Fee, req.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidShippingRate, prefecture.Name(), err)
+		}
+		rates = append(rates, *domain.NewShippingRate(*prefecture, *fee))
+	}
+	var threshold *domain.Money
+	if req.FreeShippingThreshold != "" {
+		var err error
+		threshold, err = domain.NewMoneyFromString(req.FreeShippingThreshold, req.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("%w: free shipping threshold: %v", ErrInvalidShippingRate, err)
+		}
+	}
+	table, err := domain.NewShippingRateTable(rates, threshold)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidShippingRate, err)
+	}
+	return su.rr.ReplaceRateTable(table)
+}
+
+// QuoteShipping は保存した配送先へ明細の商品を配送する場合の配送料を、商品の現在の価格で見積もる
+func (su *shippingUsecase) QuoteShipping(req request.QuoteShippingRequest) (*domain.ShippingQuote, error) {
+	userId, err := domain.NewUserId(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	address, err := su.ar.GetAddressByID(userId, req.AddressId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrShippingAddressNotFound, err)
+	}
+	orderRequest, err := newOrderRequest(req.Lines)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := priceOrderLines(su.ir, orderRequest)
+	if err != nil {
+		return nil, err
+	}
+	subtotal := lines[0].LineTotal()
+	for _, line := range lines[1:] {
+		subtotal, err = subtotal.Add(*line.LineTotal())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidOrder, err)
+		}
+	}
+
+	table, err := su.rr.GetRateTable()
+	if err != nil {
+		return nil, err
+	}
+	quote, err := table.Quote(*address.Prefecture(), *subtotal)
+	if err != nil {
+		if errors.Is(err, domain.ErrShippingUnavailable) || errors.Is(err, domain.ErrCurrencyMismatch) {
+			return nil, fmt.Errorf("%w: %v", ErrShippingUnavailable, err)
+		}
+		return nil, err
+	}
+	return quote, nil
+}
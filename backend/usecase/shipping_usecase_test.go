@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockShippingRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockShippingRateRepository) GetRateTable() (*domain.ShippingRateTable, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShippingRateTable), args.Error(1)
+}
+
+func (m *MockShippingRateRepository) ReplaceRateTable(table *domain.ShippingRateTable) (*domain.ShippingRateTable, error) {
+	args := m.Called(table)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShippingRateTable), args.Error(1)
+}
+
+// createTestShippingRateTable は東京都へ800円、北海道へ1,200円で、5,000円以上は送料無料になる表を返す
+func createTestShippingRateTable() *domain.ShippingRateTable {
+	tokyo, _ := domain.NewPrefecture("東京都")
+	hokkaido, _ := domain.NewPrefecture("北海道")
+	tokyoFee, _ := domain.NewMoneyFromString("800", domain.CurrencyJPY)
+	hokkaidoFee, _ := domain.NewMoneyFromString("1200", domain.CurrencyJPY)
+	threshold, _ := domain.NewMoneyFromString("5000", domain.CurrencyJPY)
+	return domain.RestoreShippingRateTable([]domain.ShippingRate{
+		*domain.NewShippingRate(*tokyo, *tokyoFee),
+		*domain.NewShippingRate(*hokkaido, *hokkaidoFee),
+	}, threshold, time.Now())
+}
+
+func TestReplaceShippingRates_Success(t *testing.T) {
+	mockRateRepo := new(MockShippingRateRepository)
+	uc := NewShippingUsecase(mockRateRepo, new(MockShippingAddressRepository), new(MockItemRepository))
+	table := createTestShippingRateTable()
+	mockRateRepo.On("ReplaceRateTable", mock.MatchedBy(func(table *domain.ShippingRateTable) bool {
+		rates := table.Rates()
+		return len(rates) == 2 &&
+			rates[0].Prefecture().Name() == "北海道" &&
+			rates[1].Fee().String() == "800" &&
+			table.FreeShippingThreshold().String() == "5000"
+	})).Return(table, nil)
+
+	replaced, err := uc.ReplaceRateTable(request.ReplaceShippingRatesRequest{
+		Rates: []request.ShippingRateRequest{
+			{Prefecture: "東京都", Fee: "800"},
+			{Prefecture: "北海道", Fee: "1200"},
+		},
+		FreeShippingThreshold: "5000",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, table, replaced)
+	mockRateRepo.AssertExpectations(t)
+}
+
+func TestReplaceShippingRates_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		req  request.ReplaceShippingRatesRequest
+	}{
+		{name: "unknown prefecture", req: request.ReplaceShippingRatesRequest{Rates: []request.ShippingRateRequest{{Prefecture: "Tokyo", Fee: "800"}}}},
+		{name: "malformed fee", req: request.ReplaceShippingRatesRequest{Rates: []request.ShippingRateRequest{{Prefecture: "東京都", Fee: "abc"}}}},
+		{name: "negative fee", req: request.ReplaceShippingRatesRequest{Rates: []request.ShippingRateRequest{{Prefecture: "東京都", Fee: "-1"}}}},
+		{name: "duplicate prefecture", req: request.ReplaceShippingRatesRequest{Rates: []request.ShippingRateRequest{{Prefecture: "東京都", Fee: "800"}, {Prefecture: "東京都", Fee: "900"}}}},
+		{name: "malformed threshold", req: request.ReplaceShippingRatesRequest{FreeShippingThreshold: "free"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRateRepo := new(MockShippingRateRepository)
+			uc := NewShippingUsecase(mockRateRepo, new(MockShippingAddressRepository), new(MockItemRepository))
+
+			_, err := uc.ReplaceRateTable(tt.req)
+
+			assert.True(t, errors.Is(err, ErrInvalidShippingRate), err)
+			mockRateRepo.AssertNotCalled(t, "ReplaceRateTable", mock.Anything)
+		})
+	}
+}
+
+func newQuoteShippingRequest(quantity int) request.QuoteShippingRequest {
+	return request.QuoteShippingRequest{
+		UserId:    orderTestUserId,
+		AddressId: shippingTestAddressId,
+		Lines:     []request.PlaceOrderLine{{ItemId: cartTestItemId, Quantity: quantity}},
+	}
+}
+
+func TestQuoteShipping(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity int
+		fee      string
+		isFree   bool
+	}{
+		{name: "below threshold", quantity: 4, fee: "800", isFree: false},
+		{name: "reaches threshold", quantity: 10, fee: "0", isFree: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRateRepo := new(MockShippingRateRepository)
+			mockAddressRepo := new(MockShippingAddressRepository)
+			mockItemRepo := new(MockItemRepository)
+			uc := NewShippingUsecase(mockRateRepo, mockAddressRepo, mockItemRepo)
+			mockAddressRepo.On("GetAddressByID", mock.Anything, shippingTestAddressId).Return(createTestShippingAddress("東京都"), nil)
+			mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{*createCartTestItem(1)}, nil)
+			mockRateRepo.On("GetRateTable").Return(createTestShippingRateTable(), nil)
+
+			quote, err := uc.QuoteShipping(newQuoteShippingRequest(tt.quantity))
+
+			assert.NoError(t, err)
+			assert.Equal(t, "東京都", quote.Prefecture().Name())
+			assert.Equal(t, tt.fee, quote.Fee().String())
+			assert.Equal(t, tt.isFree, quote.IsFree())
+		})
+	}
+}
+
+func TestQuoteShipping_Errors(t *testing.T) {
+	t.Run("another user's address", func(t *testing.T) {
+		mockAddressRepo := new(MockShippingAddressRepository)
+		mockItemRepo := new(MockItemRepository)
+		uc := NewShippingUsecase(new(MockShippingRateRepository), mockAddressRepo, mockItemRepo)
+		mockAddressRepo.On("GetAddressByID", mock.Anything, shippingTestAddressId).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := uc.QuoteShipping(newQuoteShippingRequest(1))
+
+		assert.True(t, errors.Is(err, ErrShippingAddressNotFound))
+		mockItemRepo.AssertNotCalled(t, "GetItemsByIDs", mock.Anything)
+	})
+
+	t.Run("no rate for prefecture", func(t *testing.T) {
+		mockRateRepo := new(MockShippingRateRepository)
+		mockAddressRepo := new(MockShippingAddressRepository)
+		mockItemRepo := new(MockItemRepository)
+		uc := NewShippingUsecase(mockRateRepo, mockAddressRepo, mockItemRepo)
+		mockAddressRepo.On("GetAddressByID", mock.Anything, shippingTestAddressId).Return(createTestShippingAddress("沖縄県"), nil)
+		mockItemRepo.On("GetItemsByIDs", mock.Anything).Return(domain.Items{*createCartTestItem(1)}, nil)
+		mockRateRepo.On("GetRateTable").Return(createTestShippingRateTable(), nil)
+
+		_, err := uc.QuoteShipping(newQuoteShippingRequest(1))
+
+		assert.True(t, errors.Is(err, ErrShippingUnavailable))
+	})
+
+	t.Run("invalid quantity", func(t *testing.T) {
+		mockAddressRepo := new(MockShippingAddressRepository)
+		uc := NewShippingUsecase(new(MockShippingRateRepository), mockAddressRepo, new(MockItemRepository))
+		mockAddressRepo.On("GetAddressByID", mock.Anything, shippingTestAddressId).Return(createTestShippingAddress("東京都"), nil)
+
+		_, err := uc.QuoteShipping(newQuoteShippingRequest(0))
+
+		assert.True(t, errors.Is(err, ErrInvalidOrder))
+	})
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type IStockMovementUsecase interface {
+	RecordMovement(req request.RecordStockMovementRequest) (*domain.StockMovement, *domain.Item, error)
+	GetMovements(itemId string) ([]*domain.StockMovement, error)
+	FindStockDrifts() ([]*domain.StockDrift, error)
+}
+
+type stockMovementUsecase struct {
+	ir  repository.IItemRepository
+	smr repository.IStockMovementRepository
+}
+
+func NewStockMovementUsecase(ir repository.IItemRepository, smr repository.IStockMovementRepository) IStockMovementUsecase {
+	return &stockMovementUsecase{ir, smr}
+}
+
+func (smu *stockMovementUsecase) RecordMovement(req request.RecordStockMovementRequest) (*domain.StockMovement, *domain.Item, error) {
+	item, err := smu.findItem(req.ItemId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.VariantId != "" && item.Variants().FindByID(req.VariantId) == nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrVariantNotFound, req.VariantId)
+	}
+	itemId, err := domain.NewItemId(item.ItemId())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userId, err := domain.NewUserId(req.UserId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	movementType, err := domain.NewStockMovementType(req.MovementType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidStockMovement, err)
+	}
+
+	movement, err := domain.NewStockMovement(*itemId, *movementType, req.QuantityDelta, req.Reason, *userId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidStockMovement, err)
+	}
+
+	movement = movement.WithVariantId(req.VariantId)
+
+	updated, err := smu.smr.RecordMovement(movement)
+	if err != nil {
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInsufficientStock, err)
+		}
+		return nil, nil, err
+	}
+	return movement, updated, nil
+}
+
+func (smu *stockMovementUsecase) GetMovements(itemId string) ([]*domain.StockMovement, error) {
+	item, err := smu.findItem(itemId)
+	if err != nil {
+		return nil, err
+	}
+	itemIdDomain, err := domain.NewItemId(item.ItemId())
+	if err != nil {
+		return nil, err
+	}
+	return smu.smr.GetMovementsByItemID(itemIdDomain)
+}
+
+func (smu *stockMovementUsecase) FindStockDrifts() ([]*domain.StockDrift, error) {
+	return smu.smr.FindStockDrifts()
+}
+
+// findItem は対象の商品をバリエーションごと取得する
+func (smu *stockMovementUsecase) findItem(itemId string) (*domain.Item, error) {
+	itemIdDomain, err := domain.NewItemId(itemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	item, err := smu.ir.GetItemByID(itemIdDomain)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	return item, nil
+}
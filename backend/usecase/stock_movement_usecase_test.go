@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockStockMovementRepository struct {
+	mock.Mock
+}
+
+func (m *MockStockMovementRepository) RecordMovement(movement *domain.StockMovement) (*domain.Item, error) {
+	args := m.Called(movement)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Item), args.Error(1)
+}
+
+func (m *MockStockMovementRepository) GetMovementsByItemID(itemId *domain.ItemId) ([]*domain.StockMovement, error) {
+	args := m.Called(itemId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.StockMovement), args.Error(1)
+}
+
+func (m *MockStockMovementRepository) FindStockDrifts() ([]*domain.StockDrift, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.StockDrift), args.Error(1)
+}
+
+const stockTestItemId = "f47ac10b-58cc-4372-a567-0e02b2c3d601"
+const stockTestAdminId = "f47ac10b-58cc-4372-a567-0e02b2c3d600"
+
+func createStockTestItem(onHand int) *domain.Item {
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	userId, _ := domain.NewUserId("f47ac10b-58cc-4372-a567-0e02b2c3d602")
+	itemName, _ := domain.NewItemName("Merino Wool")
+	stock, _ := domain.NewStock(onHand, 0, 0)
+	description, _ := domain.NewDescription("Test Description")
+	item, _ := domain.NewItem(itemId, *userId, *itemName, *stock, *description, domain.ZeroYen())
+	return item
+}
+
+func TestRecordMovement_Success(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockMovementRepo := new(MockStockMovementRepository)
+	uc := NewStockMovementUsecase(mockItemRepo, mockMovementRepo)
+
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(createStockTestItem(2), nil)
+	mockMovementRepo.On("RecordMovement", mock.MatchedBy(func(m *domain.StockMovement) bool {
+		return m.ItemId() == stockTestItemId &&
+			m.MovementType() == domain.StockMovementReceive &&
+			m.QuantityDelta() == 3 &&
+			m.Reason() == "入荷" &&
+			m.UserId() == stockTestAdminId
+	})).Return(createStockTestItem(5), nil)
+
+	movement, item, err := uc.RecordMovement(request.RecordStockMovementRequest{
+		ItemId:        stockTestItemId,
+		MovementType:  domain.StockMovementReceive,
+		QuantityDelta: 3,
+		Reason:        "入荷",
+		UserId:        stockTestAdminId,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, movement.QuantityDelta())
+	assert.Equal(t, 5, item.Stock().OnHand())
+	mockMovementRepo.AssertExpectations(t)
+}
+
+// createStockTestItemWithVariant は商品自体の在庫がなく、バリエーションだけに在庫を持つ商品を作る
+func createStockTestItemWithVariant(t *testing.T, onHand int) (*domain.Item, *domain.ItemVariant) {
+	t.Helper()
+	item := createStockTestItem(0)
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	sku, _ := domain.NewSkuCode("YARN-RED")
+	options, _ := domain.NewVariantOptions(map[string]string{"color": "red"})
+	stock, _ := domain.NewStock(onHand, 0, 0)
+	variant := domain.NewItemVariant(*itemId, *sku, *options, *stock, nil)
+	item, err := item.WithVariants(domain.ItemVariants{*variant})
+	if err != nil {
+		t.Fatalf("Failed to attach variants: %v", err)
+	}
+	return item, variant
+}
+
+func TestRecordMovement_Variant(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockMovementRepo := new(MockStockMovementRepository)
+	uc := NewStockMovementUsecase(mockItemRepo, mockMovementRepo)
+
+	item, variant := createStockTestItemWithVariant(t, 0)
+	restocked, _ := createStockTestItemWithVariant(t, 4)
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+	mockMovementRepo.On("RecordMovement", mock.MatchedBy(func(m *domain.StockMovement) bool {
+		return m.ItemId() == stockTestItemId &&
+			m.VariantId() == variant.VariantId() &&
+			m.QuantityDelta() == 4
+	})).Return(restocked, nil)
+
+	movement, updated, err := uc.RecordMovement(request.RecordStockMovementRequest{
+		ItemId:        stockTestItemId,
+		VariantId:     variant.VariantId(),
+		MovementType:  domain.StockMovementReceive,
+		QuantityDelta: 4,
+		UserId:        stockTestAdminId,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, variant.VariantId(), movement.VariantId())
+	assert.True(t, updated.InStock())
+	mockMovementRepo.AssertExpectations(t)
+}
+
+func TestRecordMovement_VariantNotFound(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockMovementRepo := new(MockStockMovementRepository)
+	uc := NewStockMovementUsecase(mockItemRepo, mockMovementRepo)
+
+	item, _ := createStockTestItemWithVariant(t, 0)
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+
+	_, _, err := uc.RecordMovement(request.RecordStockMovementRequest{
+		ItemId:        stockTestItemId,
+		VariantId:     "f47ac10b-58cc-4372-a567-0e02b2c3d699",
+		MovementType:  domain.StockMovementReceive,
+		QuantityDelta: 4,
+		UserId:        stockTestAdminId,
+	})
+
+	assert.ErrorIs(t, err, ErrVariantNotFound)
+	mockMovementRepo.AssertNotCalled(t, "RecordMovement", mock.Anything)
+}
+
+func TestRecordMovement_InvalidMovement(t *testing.T) {
+	tests := []struct {
+		name         string
+		movementType string
+		delta        int
+	}{
+		{"unknown type", "transfer", 1},
+		{"zero delta", domain.StockMovementAdjustment, 0},
+		{"sale must decrease", domain.StockMovementSale, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockItemRepo := new(MockItemRepository)
+			mockMovementRepo := new(MockStockMovementRepository)
+			uc := NewStockMovementUsecase(mockItemRepo, mockMovementRepo)
+
+			itemId, _ := domain.NewItemId(stockTestItemId)
+			mockItemRepo.On("GetItemByID", itemId).Return(createStockTestItem(2), nil)
+
+			_, _, err := uc.RecordMovement(request.RecordStockMovementRequest{
+				ItemId:        stockTestItemId,
+				MovementType:  tt.movementType,
+				QuantityDelta: tt.delta,
+				UserId:        stockTestAdminId,
+			})
+
+			assert.ErrorIs(t, err, ErrInvalidStockMovement)
+			mockMovementRepo.AssertNotCalled(t, "RecordMovement", mock.Anything)
+		})
+	}
+}
+
+func TestRecordMovement_InsufficientStock(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockMovementRepo := new(MockStockMovementRepository)
+	uc := NewStockMovementUsecase(mockItemRepo, mockMovementRepo)
+
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(createStockTestItem(1), nil)
+	mockMovementRepo.On("RecordMovement", mock.AnythingOfType("*domain.StockMovement")).
+		Return(nil, fmt.Errorf("%w: on hand 1", domain.ErrInsufficientStock))
+
+	_, _, err := uc.RecordMovement(request.RecordStockMovementRequest{
+		ItemId:        stockTestItemId,
+		MovementType:  domain.StockMovementDamage,
+		QuantityDelta: -2,
+		UserId:        stockTestAdminId,
+	})
+
+	assert.ErrorIs(t, err, ErrInsufficientStock)
+}
+
+func TestGetMovements_ItemNotFound(t *testing.T) {
+	mockItemRepo := new(MockItemRepository)
+	mockMovementRepo := new(MockStockMovementRepository)
+	uc := NewStockMovementUsecase(mockItemRepo, mockMovementRepo)
+
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(nil, gorm.ErrRecordNotFound)
+
+	movements, err := uc.GetMovements(stockTestItemId)
+
+	assert.ErrorIs(t, err, ErrItemNotFound)
+	assert.Nil(t, movements)
+	mockMovementRepo.AssertNotCalled(t, "GetMovementsByItemID", mock.Anything)
+}
+
+func TestFindStockDrifts(t *testing.T) {
+	mockMovementRepo := new(MockStockMovementRepository)
+	uc := NewStockMovementUsecase(new(MockItemRepository), mockMovementRepo)
+
+	drifts := []*domain.StockDrift{domain.NewStockDrift(stockTestItemId, "", 5, 3)}
+	mockMovementRepo.On("FindStockDrifts").Return(drifts, nil)
+
+	result, err := uc.FindStockDrifts()
+
+	assert.NoError(t, err)
+	assert.Equal(t, drifts, result)
+}
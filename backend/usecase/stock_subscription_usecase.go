@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+)
+
+// stockNotificationBatchSize は1回の送信で処理する通知の上限
+const stockNotificationBatchSize = 100
+
+// Notifier はユーザーに通知を送る。to は宛先のメールアドレス
+type Notifier interface {
+	Notify(to string, subject string, body string) error
+}
+
+type IStockSubscriptionUsecase interface {
+	Subscribe(userId string, itemId string) error
+	Unsubscribe(userId string, itemId string) error
+	GetSubscriptions(userId string) ([]*domain.StockSubscription, error)
+	GetDemand() ([]*domain.ItemDemand, error)
+	DispatchNotifications() (int, error)
+}
+
+type stockSubscriptionUsecase struct {
+	ssr      repository.IStockSubscriptionRepository
+	notifier Notifier
+}
+
+func NewStockSubscriptionUsecase(ssr repository.IStockSubscriptionRepository, notifier Notifier) IStockSubscriptionUsecase {
+	return &stockSubscriptionUsecase{ssr: ssr, notifier: notifier}
+}
+
+// Subscribe は在庫切れの商品の再入荷通知を申し込む。申し込み済みでもエラーにしない
+func (su *stockSubscriptionUsecase) Subscribe(userId string, itemId string) error {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return err
+	}
+	item, err := domain.NewItemId(itemId)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	if err := su.ssr.Subscribe(id, item); err != nil {
+		if errors.Is(err, domain.ErrItemInStock) {
+			return fmt.Errorf("%w: %v", ErrItemInStock, err)
+		}
+		return fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	return nil
+}
+
+// Unsubscribe は申し込みを取り消す。申し込んでいなくてもエラーにしない
+func (su *stockSubscriptionUsecase) Unsubscribe(userId string, itemId string) error {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return err
+	}
+	item, err := domain.NewItemId(itemId)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	return su.ssr.Unsubscribe(id, item)
+}
+
+// GetSubscriptions は再入荷を待っている申し込みを新しい順に返す
+func (su *stockSubscriptionUsecase) GetSubscriptions(userId string) ([]*domain.StockSubscription, error) {
+	id, err := domain.NewUserId(userId)
+	if err != nil {
+		return nil, err
+	}
+	return su.ssr.GetSubscriptions(id)
+}
+
+// GetDemand は申し込みのある商品を申し込みの多い順に返す
+func (su *stockSubscriptionUsecase) GetDemand() ([]*domain.ItemDemand, error) {
+	return su.ssr.GetDemand()
+}
+
+// DispatchNotifications は積まれた再入荷通知を送り、送信できた件数を返す
+// 送信に失敗した通知は失敗を記録して次の通知に進み、次回の呼び出しで送り直す
+func (su *stockSubscriptionUsecase) DispatchNotifications() (int, error) {
+	notifications, err := su.ssr.GetPendingNotifications(stockNotificationBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, n := range notifications {
+		subject, body := restockMessage(n)
+		if err := su.notifier.Notify(n.Email(), subject, body); err != nil {
+			if err := su.ssr.RecordNotificationFailure(n.NotificationId(), err); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		if err := su.ssr.MarkNotificationSent(n.NotificationId()); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// restockMessage は再入荷通知の件名と本文を返す
+func restockMessage(n *domain.StockNotification) (subject string, body string) {
+	subject = fmt.Sprintf("【再入荷】%s", n.ItemName())
+	body = fmt.Sprintf("%s 様\n\n再入荷通知をお申し込みいただいた「%s」が再入荷しました。\n在庫には限りがありますので、お早めにお買い求めください。\n\n商品 ID: %s\n",
+		n.UserName(), n.ItemName(), n.ItemId())
+	return subject, body
+}
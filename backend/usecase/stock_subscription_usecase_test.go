@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockStockSubscriptionRepository struct {
+	mock.Mock
+}
+
+func (m *MockStockSubscriptionRepository) Subscribe(userId *domain.UserId, itemId *domain.ItemId) error {
+	args := m.Called(userId, itemId)
+	return args.Error(0)
+}
+
+func (m *MockStockSubscriptionRepository) Unsubscribe(userId *domain.UserId, itemId *domain.ItemId) error {
+	args := m.Called(userId, itemId)
+	return args.Error(0)
+}
+
+func (m *MockStockSubscriptionRepository) GetSubscriptions(userId *domain.UserId) ([]*domain.StockSubscription, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.StockSubscription), args.Error(1)
+}
+
+func (m *MockStockSubscriptionRepository) GetDemand() ([]*domain.ItemDemand, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ItemDemand), args.Error(1)
+}
+
+func (m *MockStockSubscriptionRepository) GetPendingNotifications(limit int) ([]*domain.StockNotification, error) {
+	args := m.Called(limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.StockNotification), args.Error(1)
+}
+
+func (m *MockStockSubscriptionRepository) MarkNotificationSent(notificationId string) error {
+	args := m.Called(notificationId)
+	return args.Error(0)
+}
+
+func (m *MockStockSubscriptionRepository) RecordNotificationFailure(notificationId string, cause error) error {
+	args := m.Called(notificationId, cause)
+	return args.Error(0)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) Notify(to string, subject string, body string) error {
+	args := m.Called(to, subject, body)
+	return args.Error(0)
+}
+
+func TestStockSubscriptionUsecase_Subscribe(t *testing.T) {
+	userId, _ := domain.NewUserId(orderTestUserId)
+	itemId, _ := domain.NewItemId(cartTestItemId)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockStockSubscriptionRepository)
+		mockRepo.On("Subscribe", userId, itemId).Return(nil)
+
+		err := NewStockSubscriptionUsecase(mockRepo, new(MockNotifier)).Subscribe(orderTestUserId, cartTestItemId)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("In Stock", func(t *testing.T) {
+		mockRepo := new(MockStockSubscriptionRepository)
+		mockRepo.On("Subscribe", userId, itemId).Return(domain.ErrItemInStock)
+
+		err := NewStockSubscriptionUsecase(mockRepo, new(MockNotifier)).Subscribe(orderTestUserId, cartTestItemId)
+
+		assert.True(t, errors.Is(err, ErrItemInStock))
+	})
+
+	t.Run("Item Not Found", func(t *testing.T) {
+		mockRepo := new(MockStockSubscriptionRepository)
+		mockRepo.On("Subscribe", userId, itemId).Return(gorm.ErrRecordNotFound)
+
+		err := NewStockSubscriptionUsecase(mockRepo, new(MockNotifier)).Subscribe(orderTestUserId, cartTestItemId)
+
+		assert.True(t, errors.Is(err, ErrItemNotFound))
+	})
+}
+
+func TestStockSubscriptionUsecase_DispatchNotifications(t *testing.T) {
+	userId, _ := domain.NewUserId(orderTestUserId)
+	itemId, _ := domain.NewItemId(cartTestItemId)
+	delivered := domain.RestoreStockNotification("notification-1", *userId, "buyer@example.com", "買い手", *itemId, "手編みのセーター", 0, time.Now())
+	failing := domain.RestoreStockNotification("notification-2", *userId, "bounce@example.com", "買い手", *itemId, "手編みのセーター", 2, time.Now())
+
+	mockRepo := new(MockStockSubscriptionRepository)
+	mockNotifier := new(MockNotifier)
+	mockRepo.On("GetPendingNotifications", stockNotificationBatchSize).Return([]*domain.StockNotification{delivered, failing}, nil)
+	mockNotifier.On("Notify", "buyer@example.com", mock.Anything, mock.Anything).Return(nil)
+	mockNotifier.On("Notify", "bounce@example.com", mock.Anything, mock.Anything).Return(errors.New("mailbox full"))
+	mockRepo.On("MarkNotificationSent", "notification-1").Return(nil)
+	mockRepo.On("RecordNotificationFailure", "notification-2", mock.Anything).Return(nil)
+
+	sent, err := NewStockSubscriptionUsecase(mockRepo, mockNotifier).DispatchNotifications()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Contains(t, mockNotifier.Calls[0].Arguments.String(1), "手編みのセーター")
+	assert.Contains(t, mockNotifier.Calls[0].Arguments.String(2), "買い手 様")
+	mockRepo.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
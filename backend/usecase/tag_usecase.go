@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/repository"
+	"github.com/posiposi/project/backend/usecase/request"
+)
+
+type ITagUsecase interface {
+	GetTags() ([]domain.Tag, error)
+	ReplaceItemTags(req request.ReplaceItemTagsRequest) (*domain.Item, error)
+	DeleteTag(tagId string) error
+}
+
+type tagUsecase struct {
+	tr repository.ITagRepository
+	ir repository.IItemRepository
+}
+
+func NewTagUsecase(tr repository.ITagRepository, ir repository.IItemRepository) ITagUsecase {
+	return &tagUsecase{tr, ir}
+}
+
+func (tu *tagUsecase) GetTags() ([]domain.Tag, error) {
+	return tu.tr.GetAllTags()
+}
+
+func (tu *tagUsecase) ReplaceItemTags(req request.ReplaceItemTagsRequest) (*domain.Item, error) {
+	itemId, err := domain.NewItemId(req.ItemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+	if _, err := tu.ir.GetItemByID(itemId); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrItemNotFound, err)
+	}
+
+	names, err := domain.NewTagNames(req.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTag, err)
+	}
+	if err := tu.tr.ReplaceItemTags(itemId, names); err != nil {
+		return nil, err
+	}
+	return tu.ir.GetItemByID(itemId)
+}
+
+// DeleteTag はタグを削除し、付いていたすべての商品から外す
+func (tu *tagUsecase) DeleteTag(tagId string) error {
+	if _, err := tu.tr.GetTagByID(tagId); err != nil {
+		return fmt.Errorf("%w: %v", ErrTagNotFound, err)
+	}
+	return tu.tr.DeleteTag(tagId)
+}
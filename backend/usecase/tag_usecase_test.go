@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/posiposi/project/backend/domain"
+	"github.com/posiposi/project/backend/usecase/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockTagRepository struct {
+	mock.Mock
+}
+
+func (m *MockTagRepository) GetAllTags() ([]domain.Tag, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) GetTagByID(tagId string) (*domain.Tag, error) {
+	args := m.Called(tagId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) ReplaceItemTags(itemId *domain.ItemId, names []domain.TagName) error {
+	args := m.Called(itemId, names)
+	return args.Error(0)
+}
+
+func (m *MockTagRepository) DeleteTag(tagId string) error {
+	args := m.Called(tagId)
+	return args.Error(0)
+}
+
+func TestReplaceItemTags_NormalizesNames(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewTagUsecase(mockTagRepo, mockItemRepo)
+
+	item := createStockTestItem(0)
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(item, nil)
+	mockTagRepo.On("ReplaceItemTags", itemId, mock.MatchedBy(func(names []domain.TagName) bool {
+		return len(names) == 2 && names[0].Value() == "handmade" && names[1].Value() == "ウール"
+	})).Return(nil)
+
+	_, err := uc.ReplaceItemTags(request.ReplaceItemTagsRequest{
+		ItemId: stockTestItemId,
+		Tags:   []string{"Handmade", " handmade ", "ウール"},
+	})
+
+	assert.NoError(t, err)
+	mockTagRepo.AssertExpectations(t)
+}
+
+func TestReplaceItemTags_Invalid(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewTagUsecase(mockTagRepo, mockItemRepo)
+
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(createStockTestItem(0), nil)
+
+	_, err := uc.ReplaceItemTags(request.ReplaceItemTagsRequest{ItemId: stockTestItemId, Tags: []string{"wool,cotton"}})
+
+	assert.ErrorIs(t, err, ErrInvalidTag)
+	mockTagRepo.AssertNotCalled(t, "ReplaceItemTags", mock.Anything, mock.Anything)
+}
+
+func TestReplaceItemTags_ItemNotFound(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	mockItemRepo := new(MockItemRepository)
+	uc := NewTagUsecase(mockTagRepo, mockItemRepo)
+
+	itemId, _ := domain.NewItemId(stockTestItemId)
+	mockItemRepo.On("GetItemByID", itemId).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := uc.ReplaceItemTags(request.ReplaceItemTagsRequest{ItemId: stockTestItemId, Tags: []string{"wool"}})
+
+	assert.ErrorIs(t, err, ErrItemNotFound)
+}
+
+func TestDeleteTag_NotFound(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	uc := NewTagUsecase(mockTagRepo, new(MockItemRepository))
+	mockTagRepo.On("GetTagByID", "missing").Return(nil, gorm.ErrRecordNotFound)
+
+	err := uc.DeleteTag("missing")
+
+	assert.ErrorIs(t, err, ErrTagNotFound)
+	mockTagRepo.AssertNotCalled(t, "DeleteTag", mock.Anything)
+}
@@ -27,7 +27,7 @@ const ItemCard = ({ item }: ItemCardProps) => {
     >
       <Box position="relative">
         <Image
-          src="https://images.unsplash.com/photo-1555041469-a586c61ea9bc?ixlib=rb-4.0.3&ixid=MnwxMjA3fDB8MHxwaG90by1wYWdlfHx8fGVufDB8fHx8&auto=format&fit=crop&w=1770&q=80"
+          src={item.images[0]?.url || "/images/tour_de_france.jpg"}
           alt={item.item_name}
           opacity={item.stock ? 1 : 0.6}
           transition="opacity 0.3s"
@@ -56,6 +56,9 @@ const ItemCard = ({ item }: ItemCardProps) => {
           <Card.Description>
             {item.description || "No description available"}
           </Card.Description>
+          <Text fontSize="xl" fontWeight="bold">
+            {item.price_display}
+          </Text>
 
           <HStack gap={2} align="center">
             <Circle
@@ -11,12 +11,13 @@ import {
   Stack,
 } from "@chakra-ui/react";
 import { Field } from "../ui/field";
-import { Checkbox } from "../ui/checkbox";
 
 interface ItemFormData {
   item_name: string;
-  stock: boolean;
+  on_hand_quantity: number;
+  low_stock_threshold: number;
   description: string;
+  price: string;
 }
 
 interface AdminCreateItemProps {
@@ -32,8 +33,10 @@ const AdminCreateItem: React.FC<AdminCreateItemProps> = ({
 
   const [formData, setFormData] = useState<ItemFormData>({
     item_name: "",
-    stock: true,
+    on_hand_quantity: 1,
+    low_stock_threshold: 0,
     description: "",
+    price: "0",
   });
 
   const [loading, setLoading] = useState(false);
@@ -75,11 +78,10 @@ const AdminCreateItem: React.FC<AdminCreateItemProps> = ({
   ) => {
     const { name, value, type } = e.target;
 
-    if (type === "checkbox") {
-      const checkbox = e.target as HTMLInputElement;
+    if (type === "number") {
       setFormData((prev) => ({
         ...prev,
-        [name]: checkbox.checked,
+        [name]: value === "" ? 0 : Number(value),
       }));
     } else {
       setFormData((prev) => ({
@@ -120,18 +122,43 @@ const AdminCreateItem: React.FC<AdminCreateItemProps> = ({
               />
             </Field>
 
-            <Checkbox
-              name="stock"
-              checked={formData.stock}
-              onCheckedChange={(e) => {
-                setFormData((prev) => ({
-                  ...prev,
-                  stock: e.checked as boolean,
-                }));
-              }}
+            <Field label="在庫数">
+              <Input
+                type="number"
+                name="on_hand_quantity"
+                min={0}
+                value={formData.on_hand_quantity}
+                onChange={handleChange}
+              />
+            </Field>
+
+            <Field
+              label="在庫僅少の閾値"
+              helperText="販売可能数がこの値以下になると在庫僅少として表示します"
+            >
+              <Input
+                type="number"
+                name="low_stock_threshold"
+                min={0}
+                value={formData.low_stock_threshold}
+                onChange={handleChange}
+              />
+            </Field>
+
+            <Field
+              label="価格（税抜）"
+              helperText="税込価格は消費税10%で計算します"
             >
-              在庫あり
-            </Checkbox>
+              <Input
+                type="number"
+                name="price"
+                min={0}
+                value={formData.price}
+                onChange={(e) =>
+                  setFormData((prev) => ({ ...prev, price: e.target.value }))
+                }
+              />
+            </Field>
 
             <Field label="商品説明">
               <Textarea
@@ -10,12 +10,12 @@ import {
   Stack,
 } from "@chakra-ui/react";
 import { Field } from "../ui/field";
-import { Checkbox } from "../ui/checkbox";
 
 interface ItemFormData {
   item_name: string;
-  stock: boolean;
+  low_stock_threshold: number;
   description: string;
+  price: string;
 }
 
 interface AdminEditItemProps {
@@ -31,10 +31,13 @@ const AdminEditItem: React.FC<AdminEditItemProps> = ({
 }) => {
   const [formData, setFormData] = useState<ItemFormData>({
     item_name: "",
-    stock: true,
+    low_stock_threshold: 0,
     description: "",
+    price: "0",
   });
 
+  // 実在庫数は在庫移動でのみ変更するため、編集フォームでは表示のみ
+  const [onHandQuantity, setOnHandQuantity] = useState(0);
   const [loading, setLoading] = useState(false);
   const [loadingData, setLoadingData] = useState(true);
   const [error, setError] = useState<string | null>(null);
@@ -48,9 +51,11 @@ const AdminEditItem: React.FC<AdminEditItemProps> = ({
         const item = await response.json();
         setFormData({
           item_name: item.item_name,
-          stock: item.stock,
+          low_stock_threshold: item.low_stock_threshold,
           description: item.description,
+          price: item.price,
         });
+        setOnHandQuantity(item.on_hand_quantity);
         setError(null);
       } else {
         setError("商品の取得に失敗しました");
@@ -102,11 +107,10 @@ const AdminEditItem: React.FC<AdminEditItemProps> = ({
   ) => {
     const { name, value, type } = e.target;
 
-    if (type === "checkbox") {
-      const checkbox = e.target as HTMLInputElement;
+    if (type === "number") {
       setFormData((prev) => ({
         ...prev,
-        [name]: checkbox.checked,
+        [name]: value === "" ? 0 : Number(value),
       }));
     } else {
       setFormData((prev) => ({
@@ -143,20 +147,45 @@ const AdminEditItem: React.FC<AdminEditItemProps> = ({
             />
           </Field>
 
-          <Box>
-            <Checkbox
-              name="stock"
-              checked={formData.stock}
-              onCheckedChange={(e) => {
-                setFormData((prev) => ({
-                  ...prev,
-                  stock: e.checked as boolean,
-                }));
-              }}
-            >
-              在庫あり
-            </Checkbox>
-          </Box>
+          <Field
+            label="在庫数"
+            helperText="在庫数の変更は在庫移動として記録してください"
+          >
+            <Input
+              type="number"
+              name="on_hand_quantity"
+              value={onHandQuantity}
+              readOnly
+            />
+          </Field>
+
+          <Field
+            label="在庫僅少の閾値"
+            helperText="販売可能数がこの値以下になると在庫僅少として表示します"
+          >
+            <Input
+              type="number"
+              name="low_stock_threshold"
+              min={0}
+              value={formData.low_stock_threshold}
+              onChange={handleChange}
+            />
+          </Field>
+
+          <Field
+            label="価格（税抜）"
+            helperText="税込価格は消費税10%で計算します"
+          >
+            <Input
+              type="number"
+              name="price"
+              min={0}
+              value={formData.price}
+              onChange={(e) =>
+                setFormData((prev) => ({ ...prev, price: e.target.value }))
+              }
+            />
+          </Field>
 
           <Field label="商品説明">
             <Textarea
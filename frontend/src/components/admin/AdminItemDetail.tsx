@@ -16,6 +16,7 @@ import {
   Stack,
 } from "@chakra-ui/react";
 import { Button } from "../ui/button";
+import { ItemImage } from "../../../types/item";
 import { FiEdit, FiArrowLeft } from "react-icons/fi";
 
 interface Item {
@@ -25,7 +26,7 @@ interface Item {
   description: string;
   created_at: string;
   updated_at: string;
-  image_url?: string;
+  images?: ItemImage[];
 }
 
 interface AdminItemDetailProps {
@@ -125,8 +126,7 @@ const AdminItemDetail: React.FC<AdminItemDetailProps> = ({
           <HStack gap={4}>
             <Image
               src={
-                item.image_url ||
-                "https://images.unsplash.com/photo-1555041469-a586c61ea9bc?ixlib=rb-4.0.3&ixid=MnwxMjA3fDB8MHxwaG90by1wYWdlfHx8fGVufDB8fHx8&auto=format&fit=crop&w=1770&q=80"
+                item.images?.[0]?.thumbnail_url || "/images/tour_de_france.jpg"
               }
               alt={item.item_name}
               width="100px"
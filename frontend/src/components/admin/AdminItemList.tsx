@@ -31,6 +31,7 @@ import {
 import { FiEdit, FiTrash2, FiPlus } from "react-icons/fi";
 import AdminItemForm from "./AdminItemForm";
 import AdminItemDetail from "./AdminItemDetail";
+import { ItemImage } from "../../../types/item";
 
 interface Item {
   item_id: string;
@@ -39,7 +40,7 @@ interface Item {
   description: string;
   created_at: string;
   updated_at: string;
-  image_url?: string;
+  images?: ItemImage[];
 }
 
 const AdminItemList: React.FC = () => {
@@ -59,15 +60,26 @@ const AdminItemList: React.FC = () => {
   const fetchItems = async () => {
     try {
       setLoading(true);
-      const response = await get("/v1/admin/items", true);
+      // 一覧はクライアント側でページ分けするので next_cursor が無くなるまで取得する
+      const allItems: Item[] = [];
+      let cursor: string | null = null;
+      do {
+        const query = cursor
+          ? `limit=100&cursor=${encodeURIComponent(cursor)}`
+          : "limit=100";
+        const response = await get(`/v1/admin/items?${query}`, true);
+        if (!response.ok) {
+          setError("商品の取得に失敗しました");
+          return;
+        }
+        const data: { items: Item[]; next_cursor: string | null } =
+          await response.json();
+        allItems.push(...data.items);
+        cursor = data.next_cursor;
+      } while (cursor);
 
-      if (response.ok) {
-        const data = await response.json();
-        setItems(data);
-        setError(null);
-      } else {
-        setError("商品の取得に失敗しました");
-      }
+      setItems(allItems);
+      setError(null);
     } catch {
       setError("ネットワークエラーが発生しました");
     } finally {
@@ -40,7 +40,8 @@ describe("AdminItemForm", () => {
 
       expect(screen.getByText("商品登録")).toBeInTheDocument();
       expect(screen.getByLabelText("商品名 *")).toBeInTheDocument();
-      expect(screen.getByLabelText("在庫あり")).toBeInTheDocument();
+      expect(screen.getByLabelText("在庫数")).toBeInTheDocument();
+      expect(screen.getByLabelText("在庫僅少の閾値")).toBeInTheDocument();
       expect(screen.getByLabelText("商品説明")).toBeInTheDocument();
       expect(screen.getByText("登録")).toBeInTheDocument();
     });
@@ -76,8 +77,10 @@ describe("AdminItemForm", () => {
           "/v1/admin/items",
           {
             item_name: "テスト商品",
-            stock: true,
+            on_hand_quantity: 1,
+            low_stock_threshold: 0,
             description: "テスト説明",
+            price: "0",
           },
           true
         );
@@ -91,7 +94,10 @@ describe("AdminItemForm", () => {
       item_id: "1",
       item_name: "既存商品",
       stock: false,
+      on_hand_quantity: 0,
+      low_stock_threshold: 2,
       description: "既存説明",
+      price: "1980",
     };
 
     it("既存データがフォームに読み込まれる", async () => {
@@ -132,8 +138,9 @@ describe("AdminItemForm", () => {
           "/v1/admin/items/1",
           {
             item_name: "更新された商品",
-            stock: false,
+            low_stock_threshold: 2,
             description: "既存説明",
+            price: "1980",
           },
           true
         );
@@ -44,7 +44,7 @@ describe("AdminItemList", () => {
   it("商品一覧が正しく表示される", async () => {
     mockGet.mockResolvedValue({
       ok: true,
-      json: async () => mockItems,
+      json: async () => ({ items: mockItems, next_cursor: null }),
     });
 
     renderWithRouter(<AdminItemList />);
@@ -58,6 +58,36 @@ describe("AdminItemList", () => {
     expect(screen.getByText("在庫なし")).toBeInTheDocument();
   });
 
+  it("next_cursor が無くなるまで商品を取得する", async () => {
+    mockGet
+      .mockResolvedValueOnce({
+        ok: true,
+        json: async () => ({ items: [mockItems[0]], next_cursor: "c1" }),
+      })
+      .mockResolvedValueOnce({
+        ok: true,
+        json: async () => ({ items: [mockItems[1]], next_cursor: null }),
+      });
+
+    renderWithRouter(<AdminItemList />);
+
+    await waitFor(() => {
+      expect(screen.getByText("テスト商品1")).toBeInTheDocument();
+      expect(screen.getByText("テスト商品2")).toBeInTheDocument();
+    });
+
+    expect(mockGet).toHaveBeenNthCalledWith(
+      1,
+      "/v1/admin/items?limit=100",
+      true
+    );
+    expect(mockGet).toHaveBeenNthCalledWith(
+      2,
+      "/v1/admin/items?limit=100&cursor=c1",
+      true
+    );
+  });
+
   it("データ取得エラー時にエラーメッセージが表示される", async () => {
     mockGet.mockResolvedValue({
       ok: false,
@@ -73,7 +103,7 @@ describe("AdminItemList", () => {
   it("削除確認ダイアログで確認後、商品が削除される", async () => {
     mockGet.mockResolvedValue({
       ok: true,
-      json: async () => mockItems,
+      json: async () => ({ items: mockItems, next_cursor: null }),
     });
 
     mockDel.mockResolvedValue({
@@ -101,7 +131,7 @@ describe("AdminItemList", () => {
   it("削除確認ダイアログでキャンセルした場合、削除されない", async () => {
     mockGet.mockResolvedValue({
       ok: true,
-      json: async () => mockItems,
+      json: async () => ({ items: mockItems, next_cursor: null }),
     });
 
     (
@@ -1,4 +1,4 @@
-import { Item } from "../../types/item";
+import { Item, ItemListResponse } from "../../types/item";
 import { get } from "./api";
 
 export const getItems = async (): Promise<Item[]> => {
@@ -7,8 +7,8 @@ export const getItems = async (): Promise<Item[]> => {
     if (!response.ok) {
       throw new Error(`HTTP error! status: ${response.status}`);
     }
-    const data = await response.json();
-    return data;
+    const data: ItemListResponse = await response.json();
+    return data.items;
   } catch (error) {
     console.error("Failed to fetch items:", error);
     throw error;
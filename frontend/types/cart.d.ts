@@ -0,0 +1,30 @@
+export type CartLineStatus =
+  | "available"
+  | "insufficient_stock"
+  | "out_of_stock"
+  | "unavailable";
+
+export interface CartLine {
+  line_id: string;
+  item_id: string;
+  variant_id: string | null;
+  item_name: string | null;
+  sku: string | null;
+  quantity: number;
+  status: CartLineStatus;
+  available_quantity: number;
+  unit_price: string | null;
+  line_total: string | null;
+  line_total_tax_included: string | null;
+}
+
+export interface Cart {
+  items: CartLine[];
+  item_count: number;
+  subtotal: string;
+  tax: string;
+  total: string;
+  total_display: string;
+  currency: string;
+  checkout_ready: boolean;
+}
@@ -0,0 +1,29 @@
+export type DiscountType = "percent" | "fixed";
+
+export interface Coupon {
+  coupon_id: string;
+  code: string;
+  discount_type: DiscountType;
+  percent_off: number | null;
+  amount_off: string | null;
+  minimum_spend: string | null;
+  currency: string;
+  starts_at: string | null;
+  ends_at: string | null;
+  usage_limit: number;
+  per_user_limit: number;
+  used_count: number;
+  item_ids: string[];
+  created_at: string;
+  updated_at: string;
+}
+
+export interface CouponPreview {
+  code: string;
+  subtotal: string;
+  discount: string;
+  tax: string;
+  total: string;
+  total_display: string;
+  currency: string;
+}
@@ -0,0 +1,9 @@
+import type { Item } from "./item";
+
+export interface Favorite extends Item {
+  favorited_at: string;
+}
+
+export interface FavoriteListResponse {
+  items: Favorite[];
+}
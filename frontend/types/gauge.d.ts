@@ -0,0 +1,37 @@
+import type { Gauge } from "./item";
+
+export type LengthUnit = "cm" | "in";
+
+export interface Swatch {
+  stitches: number;
+  rows: number;
+  width: number;
+  height: number;
+}
+
+export interface TargetMeasurements {
+  width: number;
+  height: number;
+}
+
+export interface GaugeCalculationRequest {
+  unit: LengthUnit;
+  swatch: Swatch;
+  target: TargetMeasurements;
+  pattern_gauge?: Gauge;
+}
+
+export interface GaugeCalculation {
+  unit: LengthUnit;
+  gauge_span: number;
+  swatch_gauge: Gauge;
+  target_width: number;
+  target_height: number;
+  cast_on_stitches: number;
+  rows: number;
+  pattern_gauge: Gauge | null;
+  pattern_cast_on_stitches: number | null;
+  pattern_rows: number | null;
+  stitch_difference_percentage: number | null;
+  row_difference_percentage: number | null;
+}
@@ -1,9 +1,124 @@
+export type TaxRate = "standard" | "reduced";
+
+export type YarnWeight =
+  | "lace"
+  | "super_fine"
+  | "fine"
+  | "light"
+  | "medium"
+  | "bulky"
+  | "super_bulky";
+
+export type Fiber =
+  | "wool"
+  | "merino"
+  | "alpaca"
+  | "mohair"
+  | "cashmere"
+  | "silk"
+  | "cotton"
+  | "linen"
+  | "bamboo"
+  | "acrylic"
+  | "nylon"
+  | "polyester"
+  | "other";
+
 export interface Item {
   item_id: string;
   user_id: string;
   item_name: string;
   stock: boolean;
+  on_hand_quantity: number;
+  reserved_quantity: number;
+  available_quantity: number;
+  low_stock_threshold: number;
+  low_stock: boolean;
   description: string;
+  price: string;
+  tax_rate: TaxRate;
+  price_tax_included: string;
+  price_display: string;
+  currency: string;
+  variants: ItemVariant[];
+  images: ItemImage[];
+  category: Category | null;
+  tags: string[];
+  favorite_count: number;
+  is_favorited?: boolean;
+  rating_average: number;
+  rating_count: number;
+  yarn: ItemYarn | null;
   created_at: string;
   updated_at: string;
 }
+
+export interface ItemYarn {
+  weight: YarnWeight;
+  yardage_per_skein: number;
+  grams_per_skein: number | null;
+  fibers: FiberContent[];
+  needle_range: NeedleRange | null;
+  gauge: Gauge | null;
+}
+
+export interface FiberContent {
+  fiber: Fiber;
+  percentage: number;
+}
+
+export interface NeedleRange {
+  min: number;
+  max: number;
+}
+
+export interface Gauge {
+  stitches: number;
+  rows: number;
+}
+
+export interface ItemVariant {
+  variant_id: string;
+  item_id: string;
+  sku: string;
+  options: Record<string, string>;
+  stock: boolean;
+  on_hand_quantity: number;
+  reserved_quantity: number;
+  available_quantity: number;
+  low_stock_threshold: number;
+  low_stock: boolean;
+  price: string;
+  price_tax_included: string;
+  price_display: string;
+  price_override: string | null;
+  currency: string;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface ItemImage {
+  image_id: string;
+  position: number;
+  url: string;
+  thumbnail_url: string;
+  content_type: string;
+  width: number;
+  height: number;
+  created_at: string;
+}
+
+export interface Category {
+  category_id: string;
+  parent_id: string | null;
+  name: string;
+  slug: string;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface ItemListResponse {
+  items: Item[];
+  next_cursor: string | null;
+  total?: number;
+}
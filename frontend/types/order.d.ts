@@ -0,0 +1,45 @@
+import type { TaxRate } from "./item";
+
+export type OrderStatus =
+  | "pending_payment"
+  | "paid"
+  | "preparing"
+  | "shipped"
+  | "delivered"
+  | "cancelled"
+  | "refunded";
+
+export interface OrderTransition {
+  from_status: OrderStatus | null;
+  to_status: OrderStatus;
+  user_id: string;
+  created_at: string;
+}
+
+export interface OrderLine {
+  line_id: string;
+  item_id: string;
+  variant_id: string | null;
+  item_name: string;
+  sku: string | null;
+  options: Record<string, string> | null;
+  unit_price: string;
+  tax_rate: TaxRate;
+  quantity: number;
+  line_total: string;
+}
+
+export interface Order {
+  order_id: string;
+  status: OrderStatus;
+  items: OrderLine[];
+  subtotal: string;
+  discount: string;
+  tax: string;
+  total: string;
+  total_display: string;
+  currency: string;
+  coupon_code: string | null;
+  transitions: OrderTransition[];
+  created_at: string;
+}
@@ -0,0 +1,67 @@
+import type {
+  FiberContent,
+  Gauge,
+  Item,
+  NeedleRange,
+  YarnWeight,
+} from "./item";
+
+export type PatternDifficulty =
+  | "beginner"
+  | "easy"
+  | "intermediate"
+  | "experienced";
+
+export interface PatternSize {
+  label: string;
+  finished_measurements: string;
+  yardage: number;
+}
+
+export interface Pattern {
+  pattern_id: string;
+  name: string;
+  description: string;
+  difficulty: PatternDifficulty;
+  gauge: Gauge;
+  needle_sizes: number[];
+  yarn_weight: YarnWeight;
+  sizes: PatternSize[];
+  item_ids: string[];
+  created_at: string;
+  updated_at: string;
+}
+
+export interface PatternListResponse {
+  patterns: Pattern[];
+}
+
+export interface PatternItem extends Item {
+  skeins_needed: number | null;
+}
+
+export interface PatternDetail extends Pattern {
+  selected_size: PatternSize | null;
+  items: PatternItem[];
+}
+
+export interface PatternRequest {
+  name: string;
+  description?: string;
+  difficulty: PatternDifficulty;
+  gauge_stitches: number;
+  gauge_rows: number;
+  needle_sizes: number[];
+  yarn_weight: YarnWeight;
+  sizes: PatternSize[];
+  item_ids?: string[];
+}
+
+export interface ItemYarnRequest {
+  weight?: YarnWeight;
+  yardage_per_skein?: number;
+  grams_per_skein?: number;
+  fibers?: FiberContent[];
+  needle_range?: NeedleRange;
+  gauge?: Gauge;
+}
@@ -0,0 +1,18 @@
+export type PaymentStatus =
+  | "pending"
+  | "requires_action"
+  | "authorized"
+  | "captured"
+  | "declined"
+  | "refunded";
+
+export interface Payment {
+  payment_id: string;
+  order_id: string;
+  provider_payment_id: string | null;
+  status: PaymentStatus;
+  amount: string;
+  currency: string;
+  created_at: string;
+  updated_at: string;
+}
@@ -0,0 +1,35 @@
+export interface Project {
+  project_id: string;
+  name: string;
+  item_id: string | null;
+  pattern_id: string | null;
+  progress_percentage: number;
+  row_count: number;
+  notes: string;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface ProjectListResponse {
+  projects: Project[];
+}
+
+export interface ProjectRequest {
+  name: string;
+  item_id?: string;
+  pattern_id?: string;
+  progress_percentage?: number;
+  row_count?: number;
+  notes?: string;
+}
+
+export interface ProjectSnapshot {
+  progress_percentage: number;
+  row_count: number;
+  recorded_at: string;
+}
+
+export interface ProjectHistoryResponse {
+  project_id: string;
+  snapshots: ProjectSnapshot[];
+}
@@ -0,0 +1,21 @@
+export interface Review {
+  review_id: string;
+  item_id: string;
+  user_id: string;
+  author_name: string;
+  rating: number;
+  title: string;
+  body: string;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface ReviewListResponse {
+  reviews: Review[];
+}
+
+export interface ReviewRequest {
+  rating: number;
+  title: string;
+  body: string;
+}
@@ -0,0 +1,39 @@
+export interface ShippingAddress {
+  address_id: string;
+  recipient_name: string;
+  postal_code: string;
+  prefecture: string;
+  prefecture_code: number;
+  city: string;
+  address_line1: string;
+  address_line2: string;
+  phone_number: string;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface ShippingRate {
+  prefecture: string;
+  prefecture_code: number;
+  fee: string;
+  fee_display: string;
+}
+
+export interface ShippingRateTable {
+  rates: ShippingRate[];
+  free_shipping_threshold: string | null;
+  currency: string;
+  updated_at: string;
+}
+
+export interface ShippingQuote {
+  prefecture: string;
+  prefecture_code: number;
+  subtotal: string;
+  fee: string;
+  fee_display: string;
+  is_free_shipping: boolean;
+  free_shipping_threshold: string | null;
+  remaining_for_free_shipping: string | null;
+  currency: string;
+}
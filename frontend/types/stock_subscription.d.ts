@@ -0,0 +1,21 @@
+export interface StockSubscription {
+  item_id: string;
+  item_name: string;
+  subscribed_at: string;
+}
+
+export interface StockSubscriptionListResponse {
+  subscriptions: StockSubscription[];
+}
+
+export interface ItemDemand {
+  item_id: string;
+  item_name: string;
+  subscriber_count: number;
+  first_subscribed_at: string;
+  latest_subscribed_at: string;
+}
+
+export interface ItemDemandListResponse {
+  items: ItemDemand[];
+}